@@ -0,0 +1,251 @@
+package backtester
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// New returns a backtest for the supplied config and strategy
+func New(cfg *Config, s Strategy) (*Backtest, error) {
+	if s == nil {
+		return nil, ErrNoStrategy
+	}
+	if cfg.InitialFunds <= 0 {
+		return nil, ErrInvalidInitialFunds
+	}
+	if cfg.MakerFee < 0 || cfg.MakerFee >= 1 ||
+		cfg.TakerFee < 0 || cfg.TakerFee >= 1 {
+		return nil, ErrInvalidFee
+	}
+	if cfg.Slippage < 0 || cfg.Slippage >= 1 {
+		return nil, ErrInvalidSlippage
+	}
+	return &Backtest{
+		cfg:      *cfg,
+		strategy: s,
+	}, nil
+}
+
+// Run replays the candles through the strategy and returns a report. Each
+// candle first fills any open orders, then the portfolio is valued at the
+// close and finally the strategy is given the closed candle.
+func (b *Backtest) Run(k *kline.Item) (*Report, error) {
+	if len(k.Candles) == 0 {
+		return nil, kline.ErrNoCandles
+	}
+
+	candles := make([]kline.Candle, len(k.Candles))
+	copy(candles, k.Candles)
+	sort.Slice(candles, func(i, j int) bool {
+		return candles[i].Time.Before(candles[j].Time)
+	})
+
+	b.holdings = Holdings{
+		Quote: b.cfg.InitialFunds,
+		Value: b.cfg.InitialFunds,
+	}
+	b.pending = nil
+	b.trades = nil
+	b.fees = 0
+	b.values = []float64{b.cfg.InitialFunds}
+
+	for x := range candles {
+		b.fillOrders(&candles[x])
+
+		b.holdings.Value = b.holdings.Quote + b.holdings.Base*candles[x].Close
+		b.values = append(b.values, b.holdings.Value)
+
+		orders, err := b.strategy.OnData(&candles[x], b.holdings)
+		if err != nil {
+			return nil, fmt.Errorf("%s strategy error at %s: %v",
+				b.strategy.Name(), candles[x].Time, err)
+		}
+		for y := range orders {
+			err = validateOrder(&orders[y])
+			if err != nil {
+				return nil, err
+			}
+			b.pending = append(b.pending, orders[y])
+		}
+	}
+
+	return b.report(k, candles), nil
+}
+
+func validateOrder(o *Order) error {
+	if (o.Side != order.Buy && o.Side != order.Sell) || o.Amount <= 0 {
+		return ErrInvalidOrder
+	}
+	switch o.Type {
+	case order.Market:
+	case order.Limit:
+		if o.Price <= 0 {
+			return ErrInvalidLimitPrice
+		}
+	default:
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+// fillOrders simulates fills for open orders against a candle. Market orders
+// are takers and fill at the open adjusted for slippage, limit orders are
+// makers and fill at the limit price, or at the open if the candle gapped
+// through it.
+func (b *Backtest) fillOrders(c *kline.Candle) {
+	var open []Order
+	for x := range b.pending {
+		o := b.pending[x]
+		var price float64
+		var isMaker bool
+		switch o.Type {
+		case order.Market:
+			price = c.Open * (1 + b.cfg.Slippage)
+			if o.Side == order.Sell {
+				price = c.Open * (1 - b.cfg.Slippage)
+			}
+		case order.Limit:
+			switch {
+			case o.Side == order.Buy && c.Low <= o.Price:
+				price = o.Price
+				if c.Open < o.Price {
+					price = c.Open
+				}
+			case o.Side == order.Sell && c.High >= o.Price:
+				price = o.Price
+				if c.Open > o.Price {
+					price = c.Open
+				}
+			default:
+				open = append(open, o)
+				continue
+			}
+			isMaker = true
+		}
+		b.fill(c, &o, price, isMaker)
+	}
+	b.pending = open
+}
+
+// fill applies a fill to the holdings, amounts are reduced to the available
+// balance as the simulated account cannot borrow
+func (b *Backtest) fill(c *kline.Candle, o *Order, price float64, isMaker bool) {
+	fb := exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          b.cfg.Pair,
+		IsMaker:       isMaker,
+		PurchasePrice: price,
+		Amount:        o.Amount,
+	}
+
+	if o.Side == order.Buy {
+		rate := b.feeRate(&fb)
+		maxAmount := b.holdings.Quote / (price * (1 + rate))
+		if fb.Amount > maxAmount {
+			fb.Amount = maxAmount
+		}
+	} else if fb.Amount > b.holdings.Base {
+		fb.Amount = b.holdings.Base
+	}
+	if fb.Amount <= 0 {
+		return
+	}
+
+	fee := b.calculateFee(&fb)
+	value := fb.PurchasePrice * fb.Amount
+	if o.Side == order.Buy {
+		b.holdings.Base += fb.Amount
+		b.holdings.Quote -= value + fee
+	} else {
+		b.holdings.Base -= fb.Amount
+		b.holdings.Quote += value - fee
+	}
+	b.fees += fee
+
+	b.trades = append(b.trades, Trade{
+		Time:    c.Time,
+		Side:    o.Side,
+		Type:    o.Type,
+		Price:   fb.PurchasePrice,
+		Amount:  fb.Amount,
+		Fee:     fee,
+		IsMaker: isMaker,
+	})
+}
+
+func (b *Backtest) feeRate(f *exchange.FeeBuilder) float64 {
+	if f.IsMaker {
+		return b.cfg.MakerFee
+	}
+	return b.cfg.TakerFee
+}
+
+// calculateFee returns the fee for a simulated trade in the quote currency
+func (b *Backtest) calculateFee(f *exchange.FeeBuilder) float64 {
+	if f.FeeType != exchange.CryptocurrencyTradeFee {
+		return 0
+	}
+	return b.feeRate(f) * f.PurchasePrice * f.Amount
+}
+
+func (b *Backtest) report(k *kline.Item, candles []kline.Candle) *Report {
+	r := &Report{
+		Strategy:     b.strategy.Name(),
+		Exchange:     k.Exchange,
+		Pair:         k.Pair,
+		Asset:        k.Asset,
+		Interval:     k.Interval,
+		StartDate:    candles[0].Time,
+		EndDate:      candles[len(candles)-1].Time,
+		Candles:      len(candles),
+		InitialFunds: b.cfg.InitialFunds,
+		FinalValue:   b.holdings.Value,
+		TotalFees:    b.fees,
+		Holdings:     b.holdings,
+		Trades:       b.trades,
+	}
+	if r.Exchange == "" {
+		r.Exchange = b.cfg.Exchange
+	}
+	if r.Pair.IsEmpty() {
+		r.Pair = b.cfg.Pair
+	}
+	if r.Asset == "" {
+		r.Asset = b.cfg.Asset
+	}
+	if r.Interval == 0 {
+		r.Interval = b.cfg.Interval
+	}
+
+	r.PnL = r.FinalValue - r.InitialFunds
+	r.PnLPercent = r.PnL / r.InitialFunds * 100
+	r.MaxDrawdown = MaxDrawdown(b.values)
+	r.SharpeRatio = SharpeRatio(b.values, r.Interval, b.cfg.RiskFreeRate)
+	return r
+}
+
+// GetStrategy returns a new instance of a registered strategy by name
+func GetStrategy(name string) (Strategy, error) {
+	s, ok := strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("%v %s", ErrStrategyNotFound, name)
+	}
+	return s(), nil
+}
+
+// RegisterStrategy adds a strategy constructor so it can be selected by name
+func RegisterStrategy(name string, s func() Strategy) error {
+	if name == "" || s == nil {
+		return errors.New("strategy name and constructor must be set")
+	}
+	if _, ok := strategies[name]; ok {
+		return fmt.Errorf("strategy %s already registered", name)
+	}
+	strategies[name] = s
+	return nil
+}
@@ -0,0 +1,309 @@
+package backtester
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+type scriptedStrategy struct {
+	orders map[int][]Order
+	calls  int
+}
+
+func (s *scriptedStrategy) Name() string                  { return "scripted" }
+func (s *scriptedStrategy) Setup(_ json.RawMessage) error { return nil }
+func (s *scriptedStrategy) OnData(_ *kline.Candle, _ Holdings) ([]Order, error) {
+	o := s.orders[s.calls]
+	s.calls++
+	return o, nil
+}
+
+func testConfig() *Config {
+	return &Config{
+		Exchange:     "binance",
+		Pair:         currency.NewPair(currency.BTC, currency.USDT),
+		Asset:        asset.Spot,
+		Interval:     kline.OneHour,
+		InitialFunds: 1000,
+	}
+}
+
+func testItem(prices ...float64) *kline.Item {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := &kline.Item{Interval: kline.OneHour}
+	for x := range prices {
+		k.Candles = append(k.Candles, kline.Candle{
+			Time:   start.Add(time.Hour * time.Duration(x)),
+			Open:   prices[x],
+			High:   prices[x] * 1.01,
+			Low:    prices[x] * 0.99,
+			Close:  prices[x],
+			Volume: 1,
+		})
+	}
+	return k
+}
+
+func floatEquals(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestNew(t *testing.T) {
+	cfg := testConfig()
+	if _, err := New(cfg, nil); err != ErrNoStrategy {
+		t.Errorf("expected %v, received %v", ErrNoStrategy, err)
+	}
+
+	cfg.InitialFunds = 0
+	if _, err := New(cfg, &BuyAndHold{}); err != ErrInvalidInitialFunds {
+		t.Errorf("expected %v, received %v", ErrInvalidInitialFunds, err)
+	}
+
+	cfg = testConfig()
+	cfg.TakerFee = 1
+	if _, err := New(cfg, &BuyAndHold{}); err != ErrInvalidFee {
+		t.Errorf("expected %v, received %v", ErrInvalidFee, err)
+	}
+
+	cfg = testConfig()
+	cfg.Slippage = -0.1
+	if _, err := New(cfg, &BuyAndHold{}); err != ErrInvalidSlippage {
+		t.Errorf("expected %v, received %v", ErrInvalidSlippage, err)
+	}
+}
+
+func TestRunMarketOrders(t *testing.T) {
+	cfg := testConfig()
+	cfg.TakerFee = 0.001
+	cfg.Slippage = 0.01
+	s := &scriptedStrategy{orders: map[int][]Order{
+		0: {{Side: order.Buy, Type: order.Market, Amount: 5}},
+		1: {{Side: order.Sell, Type: order.Market, Amount: 5}},
+	}}
+	b, err := New(cfg, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := b.Run(testItem(100, 100, 120))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Trades) != 2 {
+		t.Fatalf("expected 2 trades, received %d", len(r.Trades))
+	}
+
+	buy := r.Trades[0]
+	if !floatEquals(buy.Price, 101) || buy.IsMaker {
+		t.Errorf("unexpected buy fill %+v", buy)
+	}
+	if !floatEquals(buy.Fee, 101*5*0.001) {
+		t.Errorf("expected buy fee %v, received %v", 101*5*0.001, buy.Fee)
+	}
+
+	sell := r.Trades[1]
+	if !floatEquals(sell.Price, 118.8) || !sell.Time.Equal(r.EndDate) {
+		t.Errorf("unexpected sell fill %+v", sell)
+	}
+
+	expected := 1000 - 505 - 0.505 + 594 - 0.594
+	if !floatEquals(r.FinalValue, expected) || !floatEquals(r.PnL, expected-1000) {
+		t.Errorf("expected final value %v, received %v", expected, r.FinalValue)
+	}
+	if !floatEquals(r.TotalFees, 0.505+0.594) {
+		t.Errorf("unexpected total fees %v", r.TotalFees)
+	}
+	if r.Holdings.Base != 0 {
+		t.Errorf("expected no base holdings, received %v", r.Holdings.Base)
+	}
+}
+
+func TestRunLimitOrders(t *testing.T) {
+	cfg := testConfig()
+	cfg.MakerFee = 0.002
+	s := &scriptedStrategy{orders: map[int][]Order{
+		0: {{Side: order.Buy, Type: order.Limit, Amount: 1, Price: 90}},
+	}}
+	b, err := New(cfg, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := b.Run(testItem(100, 95, 90.5, 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Trades) != 1 {
+		t.Fatalf("expected 1 trade, received %d", len(r.Trades))
+	}
+	if !r.Trades[0].IsMaker || !floatEquals(r.Trades[0].Price, 90) {
+		t.Errorf("unexpected limit fill %+v", r.Trades[0])
+	}
+	if !floatEquals(r.Trades[0].Fee, 0.18) {
+		t.Errorf("expected maker fee 0.18, received %v", r.Trades[0].Fee)
+	}
+}
+
+func TestRunInsufficientFunds(t *testing.T) {
+	s := &scriptedStrategy{orders: map[int][]Order{
+		0: {{Side: order.Buy, Type: order.Market, Amount: 100}},
+		1: {{Side: order.Sell, Type: order.Market, Amount: 100}},
+	}}
+	b, err := New(testConfig(), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := b.Run(testItem(100, 100, 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Trades) != 2 {
+		t.Fatalf("expected 2 trades, received %d", len(r.Trades))
+	}
+	if !floatEquals(r.Trades[0].Amount, 10) || !floatEquals(r.Trades[1].Amount, 10) {
+		t.Errorf("expected amounts to be reduced to available balance %+v", r.Trades)
+	}
+	if r.Holdings.Quote < 0 || r.Holdings.Base < 0 {
+		t.Errorf("holdings went negative %+v", r.Holdings)
+	}
+}
+
+func TestRunInvalidOrder(t *testing.T) {
+	s := &scriptedStrategy{orders: map[int][]Order{
+		0: {{Side: order.Buy, Type: order.Limit, Amount: 1}},
+	}}
+	b, err := New(testConfig(), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = b.Run(testItem(100, 100)); err != ErrInvalidLimitPrice {
+		t.Errorf("expected %v, received %v", ErrInvalidLimitPrice, err)
+	}
+
+	if _, err = b.Run(&kline.Item{}); err != kline.ErrNoCandles {
+		t.Errorf("expected %v, received %v", kline.ErrNoCandles, err)
+	}
+}
+
+func TestBuyAndHold(t *testing.T) {
+	s, err := GetStrategy("buyandhold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Setup(nil); err != nil {
+		t.Fatal(err)
+	}
+	b, err := New(testConfig(), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := b.Run(testItem(100, 100, 50, 150))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Trades) != 1 {
+		t.Fatalf("expected 1 trade, received %d", len(r.Trades))
+	}
+	if !floatEquals(r.FinalValue, 1500) || !floatEquals(r.PnLPercent, 50) {
+		t.Errorf("unexpected final value %v", r.FinalValue)
+	}
+	if !floatEquals(r.MaxDrawdown, 50) {
+		t.Errorf("expected max drawdown 50, received %v", r.MaxDrawdown)
+	}
+}
+
+func TestSMACross(t *testing.T) {
+	s, err := GetStrategy("smacross")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Setup(json.RawMessage(`{"fast":3,"slow":2}`)); err == nil {
+		t.Error("expected error when fast period exceeds slow period")
+	}
+	if err = s.Setup(json.RawMessage(`{"fast":2,"slow":4}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(testConfig(), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := b.Run(testItem(10, 10, 10, 10, 12, 14, 16, 14, 10, 8, 6, 6))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Trades) != 2 {
+		t.Fatalf("expected 2 trades, received %d", len(r.Trades))
+	}
+	if r.Trades[0].Side != order.Buy || r.Trades[1].Side != order.Sell {
+		t.Errorf("unexpected trades %+v", r.Trades)
+	}
+
+	if _, err = GetStrategy("bad"); err == nil {
+		t.Error("expected error for unknown strategy")
+	}
+}
+
+func TestRegisterStrategy(t *testing.T) {
+	err := RegisterStrategy("scripted", func() Strategy { return &scriptedStrategy{} })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = RegisterStrategy("scripted", func() Strategy { return &scriptedStrategy{} }); err == nil {
+		t.Error("expected error registering duplicate strategy")
+	}
+	if _, err = GetStrategy("scripted"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	if dd := MaxDrawdown([]float64{100, 120, 90, 130, 65}); !floatEquals(dd, 50) {
+		t.Errorf("expected max drawdown 50, received %v", dd)
+	}
+	if s := SharpeRatio([]float64{100, 100, 100}, kline.OneDay, 0); s != 0 {
+		t.Errorf("expected 0 sharpe ratio for flat returns, received %v", s)
+	}
+	if s := SharpeRatio([]float64{100, 101, 103, 104}, kline.OneDay, 0); s <= 0 {
+		t.Errorf("expected positive sharpe ratio, received %v", s)
+	}
+	if s := SharpeRatio([]float64{100, 99, 97, 96}, kline.OneDay, 0); s >= 0 {
+		t.Errorf("expected negative sharpe ratio, received %v", s)
+	}
+}
+
+func TestLoadCSV(t *testing.T) {
+	data := "timestamp,open,high,low,close,volume\n" +
+		"1577836800,100,110,90,105,10\n" +
+		"2020-01-01T01:00:00Z,105,115,100,110,12\n"
+	candles, err := LoadCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 2 {
+		t.Fatalf("expected 2 candles, received %d", len(candles))
+	}
+	if !candles[1].Time.Equal(candles[0].Time.Add(time.Hour)) || candles[1].Close != 110 {
+		t.Errorf("unexpected candle %+v", candles[1])
+	}
+
+	_, err = LoadCSV(strings.NewReader("1577836800,100,110,90,105,10\nbad,1,1,1,1,1\n"))
+	if err == nil {
+		t.Error("expected error for invalid row")
+	}
+
+	_, err = LoadCSV(strings.NewReader("timestamp,open,high,low,close,volume\n"))
+	if err != kline.ErrNoCandles {
+		t.Errorf("expected %v, received %v", kline.ErrNoCandles, err)
+	}
+}
@@ -0,0 +1,119 @@
+package backtester
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Backtester errors
+var (
+	ErrNoStrategy          = errors.New("backtester strategy not set")
+	ErrStrategyNotFound    = errors.New("backtester strategy not found")
+	ErrInvalidInitialFunds = errors.New("backtester initial funds must be greater than zero")
+	ErrInvalidFee          = errors.New("backtester fees must be between zero and one")
+	ErrInvalidSlippage     = errors.New("backtester slippage must be between zero and one")
+	ErrInvalidOrder        = errors.New("backtester order requires a buy or sell side and an amount greater than zero")
+	ErrInvalidLimitPrice   = errors.New("backtester limit order requires a price greater than zero")
+	ErrUnsupportedType     = errors.New("backtester only supports market and limit orders")
+)
+
+// Config holds the market and simulated exchange settings for a backtest
+type Config struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval kline.Interval
+	// InitialFunds is the starting balance of the quote currency
+	InitialFunds float64
+	// MakerFee and TakerFee are fee rates applied to the traded value e.g
+	// 0.001 is 0.1%
+	MakerFee float64
+	TakerFee float64
+	// Slippage is the fraction market orders are filled away from the open
+	// price of the next candle e.g 0.0005 is 0.05%
+	Slippage float64
+	// RiskFreeRate is the annual risk free rate used when calculating the
+	// Sharpe ratio e.g 0.02 is 2%
+	RiskFreeRate float64
+}
+
+// Strategy is the interface a backtesting strategy must implement. OnData is
+// called once each candle has closed, any orders returned are filled against
+// subsequent candles so a strategy is never filled at prices it has already
+// seen.
+type Strategy interface {
+	Name() string
+	Setup(settings json.RawMessage) error
+	OnData(c *kline.Candle, h Holdings) ([]Order, error)
+}
+
+// Holdings are the current balances and value of a backtest
+type Holdings struct {
+	Base  float64
+	Quote float64
+	// Value is the quote balance plus the base balance valued at the last
+	// close price
+	Value float64
+}
+
+// Order is a request from a strategy to trade. Market orders are filled at
+// the open of the next candle, limit orders remain open until a candle trades
+// through the limit price or the backtest ends.
+type Order struct {
+	Side   order.Side
+	Type   order.Type
+	Amount float64
+	Price  float64
+}
+
+// Trade is a simulated fill
+type Trade struct {
+	Time    time.Time
+	Side    order.Side
+	Type    order.Type
+	Price   float64
+	Amount  float64
+	Fee     float64
+	IsMaker bool
+}
+
+// Report holds the results of a backtest
+type Report struct {
+	Strategy     string
+	Exchange     string
+	Pair         currency.Pair
+	Asset        asset.Item
+	Interval     kline.Interval
+	StartDate    time.Time
+	EndDate      time.Time
+	Candles      int
+	InitialFunds float64
+	FinalValue   float64
+	PnL          float64
+	PnLPercent   float64
+	// MaxDrawdown is the largest peak to trough decline of the portfolio
+	// value as a percentage
+	MaxDrawdown float64
+	SharpeRatio float64
+	TotalFees   float64
+	Holdings    Holdings
+	Trades      []Trade
+}
+
+// Backtest replays candles through a strategy and simulates fills
+type Backtest struct {
+	cfg      Config
+	strategy Strategy
+
+	holdings Holdings
+	pending  []Order
+	trades   []Trade
+	values   []float64
+	fees     float64
+}
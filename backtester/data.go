@@ -0,0 +1,78 @@
+package backtester
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// LoadCSV reads candles from CSV data with the columns
+// timestamp,open,high,low,close,volume. Timestamps can be unix seconds or
+// RFC3339, a header row is skipped if present.
+func LoadCSV(r io.Reader) ([]kline.Candle, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 6
+	reader.TrimLeadingSpace = true
+
+	var candles []kline.Candle
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := parseCSVCandle(record)
+		if err != nil {
+			if line == 1 {
+				// Assume a header row
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		candles = append(candles, c)
+	}
+
+	if len(candles) == 0 {
+		return nil, kline.ErrNoCandles
+	}
+	return candles, nil
+}
+
+func parseCSVCandle(record []string) (kline.Candle, error) {
+	var c kline.Candle
+	t, err := parseCSVTime(record[0])
+	if err != nil {
+		return c, err
+	}
+	c.Time = t
+
+	values := make([]float64, 5)
+	for x := range values {
+		values[x], err = strconv.ParseFloat(strings.TrimSpace(record[x+1]), 64)
+		if err != nil {
+			return c, err
+		}
+	}
+	c.Open, c.High, c.Low, c.Close, c.Volume = values[0], values[1], values[2], values[3], values[4]
+	if c.High < c.Low {
+		return c, errors.New("candle high is lower than low")
+	}
+	return c, nil
+}
+
+func parseCSVTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if unix, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(unix, 0).UTC(), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
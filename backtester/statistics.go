@@ -0,0 +1,66 @@
+package backtester
+
+import (
+	"math"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+const yearDuration = time.Hour * 24 * 365
+
+// MaxDrawdown returns the largest peak to trough decline of a series of
+// portfolio values as a percentage
+func MaxDrawdown(values []float64) float64 {
+	var peak, maxDrawdown float64
+	for x := range values {
+		if values[x] > peak {
+			peak = values[x]
+		}
+		if peak <= 0 {
+			continue
+		}
+		if dd := (peak - values[x]) / peak * 100; dd > maxDrawdown {
+			maxDrawdown = dd
+		}
+	}
+	return maxDrawdown
+}
+
+// SharpeRatio returns the annualised Sharpe ratio for a series of portfolio
+// values sampled each interval, riskFreeRate is the annual risk free rate
+func SharpeRatio(values []float64, interval kline.Interval, riskFreeRate float64) float64 {
+	if len(values) < 3 || interval <= 0 {
+		return 0
+	}
+
+	periods := float64(yearDuration) / float64(interval)
+	riskFree := riskFreeRate / periods
+
+	returns := make([]float64, 0, len(values)-1)
+	for x := 1; x < len(values); x++ {
+		if values[x-1] == 0 {
+			continue
+		}
+		returns = append(returns, values[x]/values[x-1]-1-riskFree)
+	}
+	if len(returns) < 2 {
+		return 0
+	}
+
+	var mean float64
+	for x := range returns {
+		mean += returns[x]
+	}
+	mean /= float64(len(returns))
+
+	var variance float64
+	for x := range returns {
+		variance += (returns[x] - mean) * (returns[x] - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(returns)-1))
+	if stdDev == 0 {
+		return 0
+	}
+	return mean / stdDev * math.Sqrt(periods)
+}
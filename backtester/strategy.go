@@ -0,0 +1,116 @@
+package backtester
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var strategies = map[string]func() Strategy{
+	"buyandhold": func() Strategy { return &BuyAndHold{} },
+	"smacross":   func() Strategy { return &SMACross{} },
+}
+
+// BuyAndHold spends all quote funds on the first candle and holds for the
+// remainder of the backtest
+type BuyAndHold struct {
+	bought bool
+}
+
+// Name returns the strategy name
+func (b *BuyAndHold) Name() string {
+	return "buyandhold"
+}
+
+// Setup resets the strategy, BuyAndHold has no settings
+func (b *BuyAndHold) Setup(_ json.RawMessage) error {
+	b.bought = false
+	return nil
+}
+
+// OnData buys once with all available funds
+func (b *BuyAndHold) OnData(c *kline.Candle, h Holdings) ([]Order, error) {
+	if b.bought || c.Close <= 0 {
+		return nil, nil
+	}
+	b.bought = true
+	return []Order{{
+		Side:   order.Buy,
+		Type:   order.Market,
+		Amount: h.Quote / c.Close,
+	}}, nil
+}
+
+// SMACross buys when the fast simple moving average of the close price
+// crosses above the slow average and sells all holdings when it crosses
+// below
+type SMACross struct {
+	Fast int `json:"fast"`
+	Slow int `json:"slow"`
+
+	closes   []float64
+	prevDiff float64
+}
+
+// Name returns the strategy name
+func (s *SMACross) Name() string {
+	return "smacross"
+}
+
+// Setup loads the moving average periods, defaults of 10 and 30 are used
+// when not set
+func (s *SMACross) Setup(settings json.RawMessage) error {
+	s.Fast, s.Slow = 10, 30
+	if len(settings) > 0 {
+		err := json.Unmarshal(settings, s)
+		if err != nil {
+			return err
+		}
+	}
+	if s.Fast <= 0 || s.Slow <= 0 || s.Fast >= s.Slow {
+		return errors.New("smacross fast period must be greater than zero and less than the slow period")
+	}
+	s.closes = nil
+	s.prevDiff = 0
+	return nil
+}
+
+// OnData updates the moving averages and trades on a crossover
+func (s *SMACross) OnData(c *kline.Candle, h Holdings) ([]Order, error) {
+	s.closes = append(s.closes, c.Close)
+	if len(s.closes) > s.Slow {
+		s.closes = s.closes[1:]
+	}
+	if len(s.closes) < s.Slow {
+		return nil, nil
+	}
+
+	diff := sma(s.closes[s.Slow-s.Fast:]) - sma(s.closes)
+	prev := s.prevDiff
+	s.prevDiff = diff
+	switch {
+	case prev <= 0 && diff > 0 && h.Quote > 0 && c.Close > 0:
+		return []Order{{
+			Side:   order.Buy,
+			Type:   order.Market,
+			Amount: h.Quote / c.Close,
+		}}, nil
+	case prev >= 0 && diff < 0 && h.Base > 0:
+		return []Order{{
+			Side:   order.Sell,
+			Type:   order.Market,
+			Amount: h.Base,
+		}}, nil
+	}
+	return nil, nil
+}
+
+func sma(values []float64) float64 {
+	var total float64
+	for x := range values {
+		total += values[x]
+	}
+	return total / float64(len(values))
+}
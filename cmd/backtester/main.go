@@ -0,0 +1,250 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester"
+	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/core"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dbPSQL "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
+	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Config defines a backtest run
+type Config struct {
+	Strategy         string          `json:"strategy"`
+	StrategySettings json.RawMessage `json:"strategySettings,omitempty"`
+	Exchange         string          `json:"exchange"`
+	Pair             string          `json:"pair"`
+	Asset            string          `json:"asset"`
+	Interval         string          `json:"interval"`
+	StartDate        time.Time       `json:"startDate"`
+	EndDate          time.Time       `json:"endDate"`
+	// CSVFile loads candles from a CSV file instead of the database
+	CSVFile      string  `json:"csvFile,omitempty"`
+	InitialFunds float64 `json:"initialFunds"`
+	MakerFee     float64 `json:"makerFee"`
+	TakerFee     float64 `json:"takerFee"`
+	Slippage     float64 `json:"slippage"`
+	RiskFreeRate float64 `json:"riskFreeRate"`
+}
+
+var (
+	configFile    string
+	gctConfigFile string
+	outputFile    string
+	verbose       bool
+)
+
+func main() {
+	fmt.Println("GoCryptoTrader backtester")
+	fmt.Println(core.Copyright)
+	fmt.Println()
+
+	flag.StringVar(&configFile, "config", filepath.Join("cmd", "backtester", "backtester_example.json"), "backtest config file to load")
+	flag.StringVar(&gctConfigFile, "gctconfig", gctconfig.DefaultFilePath(), "GoCryptoTrader config file used for the database connection")
+	flag.StringVar(&outputFile, "output", "", "writes the report as JSON to the supplied file")
+	flag.BoolVar(&verbose, "verbose", false, "prints each simulated trade")
+	flag.Parse()
+
+	err := run()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	data, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	var cfg Config
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		return err
+	}
+
+	btCfg, err := cfg.backtestConfig()
+	if err != nil {
+		return err
+	}
+
+	s, err := backtester.GetStrategy(cfg.Strategy)
+	if err != nil {
+		return err
+	}
+	err = s.Setup(cfg.StrategySettings)
+	if err != nil {
+		return err
+	}
+
+	k, err := loadCandles(&cfg, btCfg)
+	if err != nil {
+		return err
+	}
+
+	bt, err := backtester.New(btCfg, s)
+	if err != nil {
+		return err
+	}
+
+	r, err := bt.Run(&k)
+	if err != nil {
+		return err
+	}
+
+	printReport(r)
+
+	if outputFile != "" {
+		out, err := json.MarshalIndent(r, "", " ")
+		if err != nil {
+			return err
+		}
+		err = ioutil.WriteFile(outputFile, out, 0644)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Report written to %s\n", outputFile)
+	}
+	return nil
+}
+
+func (c *Config) backtestConfig() (*backtester.Config, error) {
+	if c.Exchange == "" || c.Pair == "" {
+		return nil, errors.New("exchange and pair must be set")
+	}
+
+	a := asset.Item(strings.ToLower(c.Asset))
+	if !asset.IsValid(a) {
+		return nil, fmt.Errorf("invalid asset type %s", c.Asset)
+	}
+
+	interval, err := kline.ParseInterval(c.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &backtester.Config{
+		Exchange:     c.Exchange,
+		Pair:         currency.NewPairFromString(c.Pair),
+		Asset:        a,
+		Interval:     interval,
+		InitialFunds: c.InitialFunds,
+		MakerFee:     c.MakerFee,
+		TakerFee:     c.TakerFee,
+		Slippage:     c.Slippage,
+		RiskFreeRate: c.RiskFreeRate,
+	}, nil
+}
+
+// loadCandles loads the candles for the backtest from the CSV file if
+// supplied, otherwise from the candle repository
+func loadCandles(c *Config, btCfg *backtester.Config) (kline.Item, error) {
+	k := kline.Item{
+		Exchange: btCfg.Exchange,
+		Pair:     btCfg.Pair,
+		Asset:    btCfg.Asset,
+		Interval: btCfg.Interval,
+	}
+
+	if c.CSVFile != "" {
+		f, err := os.Open(c.CSVFile)
+		if err != nil {
+			return k, err
+		}
+		defer f.Close()
+
+		k.Candles, err = backtester.LoadCSV(f)
+		if err != nil {
+			return k, err
+		}
+		fmt.Printf("Loaded %d candles from %s\n", len(k.Candles), c.CSVFile)
+		return k, nil
+	}
+
+	if c.StartDate.IsZero() || c.EndDate.IsZero() {
+		return k, errors.New("start and end dates must be set when loading candles from the database")
+	}
+
+	err := openDbConnection()
+	if err != nil {
+		return k, err
+	}
+	defer database.DB.SQL.Close()
+
+	k, err = candle.Series(btCfg.Exchange, btCfg.Pair, btCfg.Asset, btCfg.Interval, c.StartDate, c.EndDate)
+	if err != nil {
+		return k, err
+	}
+	fmt.Printf("Loaded %d candles from the database\n", len(k.Candles))
+	return k, nil
+}
+
+func openDbConnection() error {
+	var conf gctconfig.Config
+	err := conf.LoadConfig(gctConfigFile, true)
+	if err != nil {
+		return err
+	}
+
+	if !conf.Database.Enabled {
+		return errors.New("database support is disabled")
+	}
+
+	if conf.Database.Driver == database.DBPostgreSQL {
+		_, err = dbPSQL.Connect()
+	} else if conf.Database.Driver == database.DBSQLite || conf.Database.Driver == database.DBSQLite3 {
+		_, err = dbsqlite3.Connect()
+	} else {
+		err = fmt.Errorf("unsupported database driver %s", conf.Database.Driver)
+	}
+	if err != nil {
+		return fmt.Errorf("database failed to connect: %v", err)
+	}
+	return nil
+}
+
+func printReport(r *backtester.Report) {
+	fmt.Println()
+	fmt.Printf("Strategy: %s\n", r.Strategy)
+	fmt.Printf("Market: %s %s %s %s\n", r.Exchange, r.Pair, r.Asset, r.Interval)
+	fmt.Printf("Period: %s to %s (%d candles)\n",
+		r.StartDate.Format(time.RFC3339), r.EndDate.Format(time.RFC3339), r.Candles)
+	fmt.Printf("Initial funds: %.8f %s\n", r.InitialFunds, r.Pair.Quote)
+	fmt.Printf("Final value: %.8f %s\n", r.FinalValue, r.Pair.Quote)
+	fmt.Printf("PnL: %.8f %s (%.2f%%)\n", r.PnL, r.Pair.Quote, r.PnLPercent)
+	fmt.Printf("Max drawdown: %.2f%%\n", r.MaxDrawdown)
+	fmt.Printf("Sharpe ratio: %.4f\n", r.SharpeRatio)
+	fmt.Printf("Total fees: %.8f %s\n", r.TotalFees, r.Pair.Quote)
+	fmt.Printf("Holdings: %.8f %s %.8f %s\n",
+		r.Holdings.Base, r.Pair.Base, r.Holdings.Quote, r.Pair.Quote)
+	fmt.Printf("Trades: %d\n", len(r.Trades))
+
+	if !verbose {
+		return
+	}
+	for x := range r.Trades {
+		fmt.Printf("\t%s %s %s %.8f @ %.8f fee %.8f\n",
+			r.Trades[x].Time.Format(time.RFC3339),
+			r.Trades[x].Side,
+			r.Trades[x].Type,
+			r.Trades[x].Amount,
+			r.Trades[x].Price,
+			r.Trades[x].Fee)
+	}
+}
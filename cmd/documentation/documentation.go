@@ -39,6 +39,7 @@ var (
 		"node_modules",
 		".vscode",
 		".idea",
+		"backtester_templates",
 		"cmd_templates",
 		"common_templates",
 		"communications_templates",
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -1607,6 +1608,1452 @@ func whaleBomb(c *cli.Context) error {
 	return nil
 }
 
+var getOrderbookDepthCommand = cli.Command{
+	Name:      "getorderbookdepth",
+	Usage:     "gets orderbook depth analytics including spread, imbalance and the VWAP and price impact for each order amount",
+	ArgsUsage: "<exchange> <pair> <asset> <side> <amounts>",
+	Action:    getOrderbookDepth,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the orderbook depth for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the orderbook depth for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to calculate the VWAP for (BUY OR SELL)",
+		},
+		cli.StringFlag{
+			Name:  "amounts",
+			Usage: "comma delimited list of order amounts e.g. 0.1,1,10",
+		},
+		cli.BoolFlag{
+			Name:  "quote",
+			Usage: "order amounts are in the quote currency instead of the base currency",
+		},
+		cli.Float64Flag{
+			Name:  "depthpercent",
+			Usage: "the percentage either side of the mid price to calculate cumulative depth and imbalance for",
+			Value: 1,
+		},
+	},
+}
+
+func getOrderbookDepth(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getorderbookdepth")
+		return nil
+	}
+
+	var exchangeName string
+	var currencyPair string
+	var assetType string
+	var orderSide string
+	var amountsStr string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	assetType = c.String("asset")
+	if !c.IsSet("asset") && c.Args().Get(2) != "" {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(3)
+	}
+
+	if c.IsSet("amounts") {
+		amountsStr = c.String("amounts")
+	} else {
+		amountsStr = c.Args().Get(4)
+	}
+
+	var amounts []float64
+	if amountsStr != "" {
+		for _, a := range strings.Split(amountsStr, ",") {
+			amount, err := strconv.ParseFloat(strings.TrimSpace(a), 64)
+			if err != nil {
+				return err
+			}
+			amounts = append(amounts, amount)
+		}
+	}
+
+	if len(amounts) > 0 && orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderbookDepth(context.Background(),
+		&gctrpc.GetOrderbookDepthRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:    assetType,
+			Side:         orderSide,
+			Amounts:      amounts,
+			QuoteAmounts: c.Bool("quote"),
+			DepthPercent: c.Float64("depthpercent"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getArbitrageOpportunitiesCommand = cli.Command{
+	Name:      "getarbitrageopportunities",
+	Usage:     "gets the current cross exchange arbitrage opportunities net of taker fees and the most recent alerts",
+	ArgsUsage: "<asset> <pair>",
+	Action:    getArbitrageOpportunities,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "optional asset type to filter by",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "optional currency pair to filter by",
+		},
+	},
+}
+
+func getArbitrageOpportunities(c *cli.Context) error {
+	var assetType string
+	var currencyPair string
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().First()
+	}
+
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	req := &gctrpc.GetArbitrageOpportunitiesRequest{AssetType: assetType}
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		req.Pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetArbitrageOpportunities(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getDispatchStatsCommand = cli.Command{
+	Name:   "getdispatchstats",
+	Usage:  "gets the dispatch system state and the published, dropped and lag counters of each subscriber",
+	Action: getDispatchStats,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "laggingonly",
+			Usage: "only show subscribers that have buffered or dropped data",
+		},
+	},
+}
+
+func getDispatchStats(c *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDispatchStats(context.Background(),
+		&gctrpc.GetDispatchStatsRequest{
+			LaggingOnly: c.Bool("laggingonly"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getOrderReconciliationCommand = cli.Command{
+	Name:      "getorderreconciliation",
+	Usage:     "gets the most recent comparison of locally tracked orders with exchange active orders and order history",
+	ArgsUsage: "<exchange>",
+	Action:    getOrderReconciliation,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "optional exchange to get the report for",
+		},
+		cli.BoolFlag{
+			Name:  "reconcile",
+			Usage: "reconciles orders with the exchange before returning the report",
+		},
+	},
+}
+
+func getOrderReconciliation(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderReconciliation(context.Background(),
+		&gctrpc.GetOrderReconciliationRequest{
+			Exchange:  exchangeName,
+			Reconcile: c.Bool("reconcile"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var addConditionalOrderCommand = cli.Command{
+	Name:      "addconditionalorder",
+	Usage:     "adds an engine managed stop-loss, take-profit or trailing stop order which is submitted when its condition is met",
+	ArgsUsage: "<exchange> <pair> <side> <condition> <amount>",
+	Action:    addConditionalOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the order to",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		cli.StringFlag{
+			Name:  "condition",
+			Usage: "the trigger condition (STOP_LOSS, TAKE_PROFIT OR TRAILING_STOP)",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount for the order",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+		cli.Float64Flag{
+			Name:  "trigger_price",
+			Usage: "the price which triggers a stop-loss or take-profit order",
+		},
+		cli.Float64Flag{
+			Name:  "trailing_amount",
+			Usage: "the distance a trailing stop follows the price by",
+		},
+		cli.Float64Flag{
+			Name:  "trailing_percent",
+			Usage: "the percentage a trailing stop follows the price by",
+		},
+		cli.Float64Flag{
+			Name:  "limit_price",
+			Usage: "submits a limit order at this price instead of a market order",
+		},
+	},
+}
+
+func addConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "addconditionalorder")
+		return nil
+	}
+
+	var exchangeName string
+	var currencyPair string
+	var orderSide string
+	var condition string
+	var amount float64
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(2)
+	}
+
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	if c.IsSet("condition") {
+		condition = c.String("condition")
+	} else {
+		condition = c.Args().Get(3)
+	}
+
+	if condition == "" {
+		return errors.New("condition must be set")
+	}
+
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(4) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	assetType := strings.ToLower(c.String("asset"))
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddConditionalOrder(context.Background(),
+		&gctrpc.AddConditionalOrderRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:       assetType,
+			Side:            orderSide,
+			Condition:       condition,
+			TriggerPrice:    c.Float64("trigger_price"),
+			TrailingAmount:  c.Float64("trailing_amount"),
+			TrailingPercent: c.Float64("trailing_percent"),
+			LimitPrice:      c.Float64("limit_price"),
+			Amount:          amount,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var cancelConditionalOrderCommand = cli.Command{
+	Name:      "cancelconditionalorder",
+	Usage:     "cancels an active conditional order",
+	ArgsUsage: "<id>",
+	Action:    cancelConditionalOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the conditional order id",
+		},
+	},
+}
+
+func cancelConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "cancelconditionalorder")
+		return nil
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	if id == "" {
+		return errors.New("conditional order id must be set")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelConditionalOrder(context.Background(),
+		&gctrpc.CancelConditionalOrderRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getConditionalOrdersCommand = cli.Command{
+	Name:      "getconditionalorders",
+	Usage:     "gets the engine managed stop-loss, take-profit and trailing stop orders",
+	ArgsUsage: "<exchange> <status>",
+	Action:    getConditionalOrders,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "optional exchange to filter by",
+		},
+		cli.StringFlag{
+			Name:  "status",
+			Usage: "optional status to filter by (ACTIVE, TRIGGERED, SUBMITTED, CANCELLED OR FAILED)",
+		},
+	},
+}
+
+func getConditionalOrders(c *cli.Context) error {
+	var exchangeName string
+	var status string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("status") {
+		status = c.String("status")
+	} else {
+		status = c.Args().Get(1)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetConditionalOrders(context.Background(),
+		&gctrpc.GetConditionalOrdersRequest{
+			Exchange: exchangeName,
+			Status:   status,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var addOCOOrderGroupCommand = cli.Command{
+	Name:      "addocoordergroup",
+	Usage:     "submits a limit order and a stop order where a fill or cancel on one cancels the other",
+	ArgsUsage: "<exchange> <pair> <side> <amount> <price> <trigger_price>",
+	Action:    addOCOOrderGroup,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the orders to",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side of both orders (BUY OR SELL)",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount for each order",
+		},
+		cli.Float64Flag{
+			Name:  "price",
+			Usage: "the price of the limit order",
+		},
+		cli.Float64Flag{
+			Name:  "trigger_price",
+			Usage: "the price which triggers the stop order",
+		},
+		cli.Float64Flag{
+			Name:  "stop_limit_price",
+			Usage: "submits the stop order as a limit order at this price instead of a market order",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+	},
+}
+
+func addOCOOrderGroup(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "addocoordergroup")
+		return nil
+	}
+
+	exchangeName, currencyPair, orderSide, amount, err := orderGroupArgs(c)
+	if err != nil {
+		return err
+	}
+
+	price, err := float64Arg(c, "price", 4)
+	if err != nil {
+		return err
+	}
+	if price == 0 {
+		return errors.New("price must be set")
+	}
+
+	triggerPrice, err := float64Arg(c, "trigger_price", 5)
+	if err != nil {
+		return err
+	}
+	if triggerPrice == 0 {
+		return errors.New("trigger price must be set")
+	}
+
+	assetType := strings.ToLower(c.String("asset"))
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddOCOOrderGroup(context.Background(),
+		&gctrpc.AddOCOOrderGroupRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Legs: []*gctrpc.OrderGroupLegRequest{
+				{
+					Side:   orderSide,
+					Price:  price,
+					Amount: amount,
+				},
+				{
+					Side:         orderSide,
+					Price:        c.Float64("stop_limit_price"),
+					TriggerPrice: triggerPrice,
+					Amount:       amount,
+				},
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var addBracketOrderGroupCommand = cli.Command{
+	Name:      "addbracketordergroup",
+	Usage:     "submits an entry order which once filled is followed by a take-profit and stop-loss order where a fill or cancel on one cancels the other",
+	ArgsUsage: "<exchange> <pair> <side> <amount> <take_profit_price> <stop_loss_price>",
+	Action:    addBracketOrderGroup,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the orders to",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side of the entry order (BUY OR SELL)",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount for the entry order",
+		},
+		cli.Float64Flag{
+			Name:  "take_profit_price",
+			Usage: "the price of the take-profit limit order",
+		},
+		cli.Float64Flag{
+			Name:  "stop_loss_price",
+			Usage: "the price which triggers the stop-loss order",
+		},
+		cli.Float64Flag{
+			Name:  "price",
+			Usage: "submits the entry as a limit order at this price instead of a market order",
+		},
+		cli.Float64Flag{
+			Name:  "stop_limit_price",
+			Usage: "submits the stop-loss as a limit order at this price instead of a market order",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+	},
+}
+
+func addBracketOrderGroup(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "addbracketordergroup")
+		return nil
+	}
+
+	exchangeName, currencyPair, orderSide, amount, err := orderGroupArgs(c)
+	if err != nil {
+		return err
+	}
+
+	var exitSide string
+	switch strings.ToUpper(orderSide) {
+	case "BUY", "BID":
+		exitSide = "SELL"
+	case "SELL", "ASK":
+		exitSide = "BUY"
+	default:
+		return errors.New("order side must be BUY or SELL")
+	}
+
+	takeProfitPrice, err := float64Arg(c, "take_profit_price", 4)
+	if err != nil {
+		return err
+	}
+	if takeProfitPrice == 0 {
+		return errors.New("take profit price must be set")
+	}
+
+	stopLossPrice, err := float64Arg(c, "stop_loss_price", 5)
+	if err != nil {
+		return err
+	}
+	if stopLossPrice == 0 {
+		return errors.New("stop loss price must be set")
+	}
+
+	assetType := strings.ToLower(c.String("asset"))
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddBracketOrderGroup(context.Background(),
+		&gctrpc.AddBracketOrderGroupRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Entry: &gctrpc.OrderGroupLegRequest{
+				Side:   orderSide,
+				Price:  c.Float64("price"),
+				Amount: amount,
+			},
+			TakeProfit: &gctrpc.OrderGroupLegRequest{
+				Side:  exitSide,
+				Price: takeProfitPrice,
+			},
+			StopLoss: &gctrpc.OrderGroupLegRequest{
+				Side:         exitSide,
+				Price:        c.Float64("stop_limit_price"),
+				TriggerPrice: stopLossPrice,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+// orderGroupArgs returns the exchange, pair, side and amount arguments shared
+// by the order group commands
+func orderGroupArgs(c *cli.Context) (exchangeName, currencyPair, orderSide string, amount float64, err error) {
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return "", "", "", 0, errInvalidExchange
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		return "", "", "", 0, errInvalidPair
+	}
+
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(2)
+	}
+
+	if orderSide == "" {
+		return "", "", "", 0, errors.New("order side must be set")
+	}
+
+	amount, err = float64Arg(c, "amount", 3)
+	if err != nil {
+		return "", "", "", 0, err
+	}
+
+	if amount == 0 {
+		return "", "", "", 0, errors.New("amount must be set")
+	}
+	return exchangeName, currencyPair, orderSide, amount, nil
+}
+
+// float64Arg returns a float flag or the positional argument at index when
+// the flag is not set
+func float64Arg(c *cli.Context, name string, index int) (float64, error) {
+	if c.IsSet(name) {
+		return c.Float64(name), nil
+	}
+	if c.Args().Get(index) == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(c.Args().Get(index), 64)
+}
+
+var cancelOrderGroupCommand = cli.Command{
+	Name:      "cancelordergroup",
+	Usage:     "cancels the open orders of an active order group",
+	ArgsUsage: "<id>",
+	Action:    cancelOrderGroup,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the order group id",
+		},
+	},
+}
+
+func cancelOrderGroup(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "cancelordergroup")
+		return nil
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	if id == "" {
+		return errors.New("order group id must be set")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelOrderGroup(context.Background(),
+		&gctrpc.CancelOrderGroupRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getOrderGroupsCommand = cli.Command{
+	Name:      "getordergroups",
+	Usage:     "gets the one-cancels-other and bracket order groups tracked by the order manager",
+	ArgsUsage: "<exchange> <id>",
+	Action:    getOrderGroups,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "optional exchange to filter by",
+		},
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "optional order group id to get",
+		},
+	},
+}
+
+func getOrderGroups(c *cli.Context) error {
+	var exchangeName string
+	var id string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().Get(1)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderGroups(context.Background(),
+		&gctrpc.GetOrderGroupsRequest{
+			Exchange: exchangeName,
+			Id:       id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var addIcebergOrderCommand = cli.Command{
+	Name:      "addicebergorder",
+	Usage:     "submits a limit order which only shows part of its amount on the book, the visible order is replaced as it fills",
+	ArgsUsage: "<exchange> <pair> <side> <amount> <price> <display_amount>",
+	Action:    addIcebergOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the order to",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side (BUY OR SELL)",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the total amount of the order",
+		},
+		cli.Float64Flag{
+			Name:  "price",
+			Usage: "the limit price of the order",
+		},
+		cli.Float64Flag{
+			Name:  "display_amount",
+			Usage: "the amount shown on the book at a time",
+		},
+		cli.Float64Flag{
+			Name:  "size_variance",
+			Usage: "randomises each visible amount by up to this fraction of the display amount e.g. 0.2",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+	},
+}
+
+func addIcebergOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "addicebergorder")
+		return nil
+	}
+
+	exchangeName, currencyPair, orderSide, amount, err := orderGroupArgs(c)
+	if err != nil {
+		return err
+	}
+
+	price, err := float64Arg(c, "price", 4)
+	if err != nil {
+		return err
+	}
+	if price == 0 {
+		return errors.New("price must be set")
+	}
+
+	displayAmount, err := float64Arg(c, "display_amount", 5)
+	if err != nil {
+		return err
+	}
+	if displayAmount == 0 {
+		return errors.New("display amount must be set")
+	}
+
+	assetType := strings.ToLower(c.String("asset"))
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddIcebergOrder(context.Background(),
+		&gctrpc.AddIcebergOrderRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:     assetType,
+			Side:          orderSide,
+			Price:         price,
+			Amount:        amount,
+			DisplayAmount: displayAmount,
+			SizeVariance:  c.Float64("size_variance"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var cancelIcebergOrderCommand = cli.Command{
+	Name:      "cancelicebergorder",
+	Usage:     "cancels an active iceberg order and its visible order",
+	ArgsUsage: "<id>",
+	Action:    cancelIcebergOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the iceberg order id",
+		},
+	},
+}
+
+func cancelIcebergOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "cancelicebergorder")
+		return nil
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	if id == "" {
+		return errors.New("iceberg order id must be set")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelIcebergOrder(context.Background(),
+		&gctrpc.CancelIcebergOrderRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getIcebergOrdersCommand = cli.Command{
+	Name:      "geticebergorders",
+	Usage:     "gets the iceberg orders tracked by the order manager",
+	ArgsUsage: "<exchange> <id>",
+	Action:    getIcebergOrders,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "optional exchange to filter by",
+		},
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "optional iceberg order id to get",
+		},
+	},
+}
+
+func getIcebergOrders(c *cli.Context) error {
+	var exchangeName string
+	var id string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().Get(1)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetIcebergOrders(context.Background(),
+		&gctrpc.GetIcebergOrdersRequest{
+			Exchange: exchangeName,
+			Id:       id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var addExecutionOrderCommand = cli.Command{
+	Name:      "addexecutionorder",
+	Usage:     "slices an order into child orders submitted over a time window using a TWAP or VWAP algorithm",
+	ArgsUsage: "<exchange> <pair> <side> <amount> <algorithm> <duration>",
+	Action:    addExecutionOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the child orders to",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side (BUY OR SELL)",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the total amount to execute",
+		},
+		cli.StringFlag{
+			Name:  "algorithm",
+			Usage: "the execution algorithm (TWAP or VWAP)",
+			Value: "TWAP",
+		},
+		cli.StringFlag{
+			Name:  "duration",
+			Usage: "the time window to execute the amount over e.g. 30m",
+		},
+		cli.StringFlag{
+			Name:  "slice_interval",
+			Usage: "the time between child orders, defaults to a tenth of the duration",
+		},
+		cli.Float64Flag{
+			Name:  "limit_price",
+			Usage: "submits child orders as limit orders at this price and skips slices while the price is beyond it",
+		},
+		cli.Float64Flag{
+			Name:  "participation_rate",
+			Usage: "caps each child order at this fraction of the volume traded over the last slice interval e.g. 0.1",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+	},
+}
+
+func addExecutionOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "addexecutionorder")
+		return nil
+	}
+
+	exchangeName, currencyPair, orderSide, amount, err := orderGroupArgs(c)
+	if err != nil {
+		return err
+	}
+
+	algorithm := c.String("algorithm")
+	if !c.IsSet("algorithm") && c.Args().Get(4) != "" {
+		algorithm = c.Args().Get(4)
+	}
+
+	var duration string
+	if c.IsSet("duration") {
+		duration = c.String("duration")
+	} else {
+		duration = c.Args().Get(5)
+	}
+
+	if duration == "" {
+		return errors.New("duration must be set")
+	}
+
+	assetType := strings.ToLower(c.String("asset"))
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddExecutionOrder(context.Background(),
+		&gctrpc.AddExecutionOrderRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:         assetType,
+			Side:              orderSide,
+			Algorithm:         algorithm,
+			Amount:            amount,
+			LimitPrice:        c.Float64("limit_price"),
+			ParticipationRate: c.Float64("participation_rate"),
+			Duration:          duration,
+			SliceInterval:     c.String("slice_interval"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var pauseExecutionOrderCommand = cli.Command{
+	Name:      "pauseexecutionorder",
+	Usage:     "pauses a running execution order and cancels its working child order",
+	ArgsUsage: "<id>",
+	Action:    pauseExecutionOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the execution order id",
+		},
+	},
+}
+
+func pauseExecutionOrder(c *cli.Context) error {
+	return executionOrderAction(c, "pauseexecutionorder",
+		func(client gctrpc.GoCryptoTraderClient, r *gctrpc.ExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+			return client.PauseExecutionOrder(context.Background(), r)
+		})
+}
+
+var resumeExecutionOrderCommand = cli.Command{
+	Name:      "resumeexecutionorder",
+	Usage:     "resumes a paused execution order",
+	ArgsUsage: "<id>",
+	Action:    resumeExecutionOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the execution order id",
+		},
+	},
+}
+
+func resumeExecutionOrder(c *cli.Context) error {
+	return executionOrderAction(c, "resumeexecutionorder",
+		func(client gctrpc.GoCryptoTraderClient, r *gctrpc.ExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+			return client.ResumeExecutionOrder(context.Background(), r)
+		})
+}
+
+var cancelExecutionOrderCommand = cli.Command{
+	Name:      "cancelexecutionorder",
+	Usage:     "cancels an execution order and its working child order",
+	ArgsUsage: "<id>",
+	Action:    cancelExecutionOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the execution order id",
+		},
+	},
+}
+
+func cancelExecutionOrder(c *cli.Context) error {
+	return executionOrderAction(c, "cancelexecutionorder",
+		func(client gctrpc.GoCryptoTraderClient, r *gctrpc.ExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+			return client.CancelExecutionOrder(context.Background(), r)
+		})
+}
+
+// executionOrderAction runs an execution order control request for the id
+// argument
+func executionOrderAction(c *cli.Context, command string, action func(gctrpc.GoCryptoTraderClient, *gctrpc.ExecutionOrderRequest) (*gctrpc.ExecutionOrder, error)) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, command)
+		return nil
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	if id == "" {
+		return errors.New("execution order id must be set")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	result, err := action(gctrpc.NewGoCryptoTraderClient(conn),
+		&gctrpc.ExecutionOrderRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getExecutionOrdersCommand = cli.Command{
+	Name:      "getexecutionorders",
+	Usage:     "gets the TWAP and VWAP execution orders and their child orders",
+	ArgsUsage: "<exchange> <id>",
+	Action:    getExecutionOrders,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "optional exchange to filter by",
+		},
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "optional execution order id to get",
+		},
+	},
+}
+
+func getExecutionOrders(c *cli.Context) error {
+	var exchangeName string
+	var id string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().Get(1)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExecutionOrders(context.Background(),
+		&gctrpc.GetExecutionOrdersRequest{
+			Exchange: exchangeName,
+			Id:       id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var cancelOrderCommand = cli.Command{
 	Name:      "cancelorder",
 	Usage:     "cancel order cancels an exchange order",
@@ -1614,12 +3061,413 @@ var cancelOrderCommand = cli.Command{
 	Action:    cancelOrder,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  "exchange",
-			Usage: "the exchange to cancel the order for",
+			Name:  "exchange",
+			Usage: "the exchange to cancel the order for",
+		},
+		cli.StringFlag{
+			Name:  "account_id",
+			Usage: "the account id",
+		},
+		cli.StringFlag{
+			Name:  "order_id",
+			Usage: "the order id",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to cancel the order for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type",
+		},
+		cli.Float64Flag{
+			Name:  "wallet_address",
+			Usage: "the wallet address",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side",
+		},
+	},
+}
+
+func cancelOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "cancelorder")
+		return nil
+	}
+
+	var exchangeName string
+	var accountID string
+	var orderID string
+	var currencyPair string
+	var assetType string
+	var walletAddress string
+	var orderSide string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("account_id") {
+		accountID = c.String("account_id")
+	} else {
+		accountID = c.Args().Get(1)
+	}
+
+	if c.IsSet("order_id") {
+		orderID = c.String("order_id")
+	} else {
+		orderID = c.Args().Get(2)
+	}
+
+	if orderID == "" {
+		return errors.New("an order ID must be set")
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(3)
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(4)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("wallet_address") {
+		walletAddress = c.String("wallet_address")
+	} else {
+		walletAddress = c.Args().Get(5)
+	}
+
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(6)
+	}
+
+	// pair is optional, but if it's set, do a validity check
+	var p currency.Pair
+	if len(currencyPair) > 0 {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p = currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelOrder(context.Background(), &gctrpc.CancelOrderRequest{
+		Exchange:  exchangeName,
+		AccountId: accountID,
+		OrderId:   orderID,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType:     assetType,
+		WalletAddress: walletAddress,
+		Side:          orderSide,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var submitOrdersCommand = cli.Command{
+	Name:      "submitorders",
+	Usage:     "submits a batch of exchange orders in as few requests as the exchange allows",
+	ArgsUsage: "<exchange> <orders>",
+	Description: "orders is a JSON array, for example " +
+		`[{"pair":"BTC-USD","side":"BUY","type":"LIMIT","amount":1,"price":5000,"client_id":"1","asset_type":"spot"}]`,
+	Action: submitOrders,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the orders for",
+		},
+		cli.StringFlag{
+			Name:  "orders",
+			Usage: "the JSON array of orders to submit",
+		},
+		cli.StringFlag{
+			Name:  "file",
+			Usage: "a file containing the JSON array of orders to submit",
+		},
+	},
+}
+
+// batchOrder is an order read from the submitorders JSON array
+type batchOrder struct {
+	Pair      string  `json:"pair"`
+	Side      string  `json:"side"`
+	Type      string  `json:"type"`
+	Amount    float64 `json:"amount"`
+	Price     float64 `json:"price"`
+	ClientID  string  `json:"client_id"`
+	AssetType string  `json:"asset_type"`
+}
+
+func submitOrders(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "submitorders")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var data []byte
+	switch {
+	case c.IsSet("file"):
+		var err error
+		data, err = ioutil.ReadFile(c.String("file"))
+		if err != nil {
+			return err
+		}
+	case c.IsSet("orders"):
+		data = []byte(c.String("orders"))
+	default:
+		data = []byte(c.Args().Get(1))
+	}
+
+	if len(data) == 0 {
+		return errors.New("orders must be set")
+	}
+
+	var batch []batchOrder
+	err := json.Unmarshal(data, &batch)
+	if err != nil {
+		return fmt.Errorf("unable to parse orders: %v", err)
+	}
+
+	if len(batch) == 0 {
+		return errors.New("orders must contain at least one order")
+	}
+
+	orders := make([]*gctrpc.BatchOrder, len(batch))
+	for x := range batch {
+		if !validPair(batch[x].Pair) {
+			return fmt.Errorf("order %d: %v", x, errInvalidPair)
+		}
+		if batch[x].Side == "" {
+			return fmt.Errorf("order %d: order side must be set", x)
+		}
+		if batch[x].Type == "" {
+			return fmt.Errorf("order %d: order type must be set", x)
+		}
+		if batch[x].Amount == 0 {
+			return fmt.Errorf("order %d: amount must be set", x)
+		}
+		if batch[x].AssetType != "" && !validAsset(batch[x].AssetType) {
+			return fmt.Errorf("order %d: %v", x, errInvalidAsset)
+		}
+		p := currency.NewPairDelimiter(batch[x].Pair, pairDelimiter)
+		orders[x] = &gctrpc.BatchOrder{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Side:      batch[x].Side,
+			OrderType: batch[x].Type,
+			Amount:    batch[x].Amount,
+			Price:     batch[x].Price,
+			ClientId:  batch[x].ClientID,
+			AssetType: batch[x].AssetType,
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitOrders(context.Background(), &gctrpc.SubmitOrdersRequest{
+		Exchange: exchangeName,
+		Orders:   orders,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var cancelOrdersCommand = cli.Command{
+	Name:      "cancelorders",
+	Usage:     "cancels a batch of exchange orders in as few requests as the exchange allows",
+	ArgsUsage: "<exchange> <order_ids> <pair> <asset> <side>",
+	Action:    cancelOrders,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to cancel the orders for",
+		},
+		cli.StringFlag{
+			Name:  "order_ids",
+			Usage: "the comma separated order ids",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to cancel the orders for",
 		},
 		cli.StringFlag{
-			Name:  "account_id",
-			Usage: "the account id",
+			Name:  "asset",
+			Usage: "the asset type",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side",
+		},
+	},
+}
+
+func cancelOrders(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "cancelorders")
+		return nil
+	}
+
+	var exchangeName string
+	var orderIDs string
+	var currencyPair string
+	var assetType string
+	var orderSide string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("order_ids") {
+		orderIDs = c.String("order_ids")
+	} else {
+		orderIDs = c.Args().Get(1)
+	}
+
+	if orderIDs == "" {
+		return errors.New("order IDs must be set")
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(3)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(4)
+	}
+
+	// pair is optional, but if it's set, do a validity check
+	var pair *gctrpc.CurrencyPair
+	if len(currencyPair) > 0 {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	var orders []*gctrpc.BatchCancelOrder
+	for _, id := range strings.Split(orderIDs, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		orders = append(orders, &gctrpc.BatchCancelOrder{
+			OrderId:   id,
+			Pair:      pair,
+			AssetType: assetType,
+			Side:      orderSide,
+		})
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelOrders(context.Background(), &gctrpc.CancelOrdersRequest{
+		Exchange: exchangeName,
+		Orders:   orders,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var modifyOrderCommand = cli.Command{
+	Name:      "modifyorder",
+	Usage:     "amends the price or amount of an open exchange order, keeping its queue position where the exchange allows",
+	ArgsUsage: "<exchange> <order_id> <pair> <price> <amount> <asset> <side> <type>",
+	Action:    modifyOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to modify the order for",
 		},
 		cli.StringFlag{
 			Name:  "order_id",
@@ -1627,36 +3475,45 @@ var cancelOrderCommand = cli.Command{
 		},
 		cli.StringFlag{
 			Name:  "pair",
-			Usage: "the currency pair to cancel the order for",
+			Usage: "the currency pair of the order",
+		},
+		cli.Float64Flag{
+			Name:  "price",
+			Usage: "the new price for the order, 0 leaves it unchanged",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the new amount for the order, 0 leaves it unchanged",
 		},
 		cli.StringFlag{
 			Name:  "asset",
 			Usage: "the asset type",
 		},
-		cli.Float64Flag{
-			Name:  "wallet_address",
-			Usage: "the wallet address",
-		},
 		cli.StringFlag{
 			Name:  "side",
 			Usage: "the order side",
 		},
+		cli.StringFlag{
+			Name:  "type",
+			Usage: "the order type",
+		},
 	},
 }
 
-func cancelOrder(c *cli.Context) error {
+func modifyOrder(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
-		cli.ShowCommandHelp(c, "cancelorder")
+		cli.ShowCommandHelp(c, "modifyorder")
 		return nil
 	}
 
 	var exchangeName string
-	var accountID string
 	var orderID string
 	var currencyPair string
+	var price float64
+	var amount float64
 	var assetType string
-	var walletAddress string
 	var orderSide string
+	var orderType string
 
 	if c.IsSet("exchange") {
 		exchangeName = c.String("exchange")
@@ -1668,60 +3525,227 @@ func cancelOrder(c *cli.Context) error {
 		return errInvalidExchange
 	}
 
-	if c.IsSet("account_id") {
-		accountID = c.String("account_id")
-	} else {
-		accountID = c.Args().Get(1)
-	}
-
 	if c.IsSet("order_id") {
 		orderID = c.String("order_id")
 	} else {
-		orderID = c.Args().Get(2)
+		orderID = c.Args().Get(1)
 	}
 
 	if orderID == "" {
 		return errors.New("an order ID must be set")
 	}
 
-	if c.IsSet("pair") {
-		currencyPair = c.String("pair")
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		price, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(4) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if price <= 0 && amount <= 0 {
+		return errors.New("a new price or amount must be set")
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(5)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(6)
+	}
+
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(7)
+	}
+
+	// pair is optional, but if it's set, do a validity check
+	var pair *gctrpc.CurrencyPair
+	if len(currencyPair) > 0 {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ModifyOrder(context.Background(), &gctrpc.ModifyOrderRequest{
+		Exchange:  exchangeName,
+		OrderId:   orderID,
+		Pair:      pair,
+		AssetType: assetType,
+		Side:      orderSide,
+		OrderType: orderType,
+		Price:     price,
+		Amount:    amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var cancelAllOrdersCommand = cli.Command{
+	Name:      "cancelallorders",
+	Usage:     "cancels all orders (all or by exchange name)",
+	ArgsUsage: "<exchange>",
+	Action:    cancelAllOrders,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to cancel all orders on",
+		},
+	},
+}
+
+func cancelAllOrders(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	// exchange name is an optional param
+	if exchangeName != "" {
+		if !validExchange(exchangeName) {
+			return errInvalidExchange
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelAllOrders(context.Background(), &gctrpc.CancelAllOrdersRequest{
+		Exchange: exchangeName,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var activateKillSwitchCommand = cli.Command{
+	Name:      "activatekillswitch",
+	Usage:     "halts new order submissions and modifications and cancels all open orders on every authenticated exchange",
+	ArgsUsage: "<reason>",
+	Action:    activateKillSwitch,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "reason",
+			Usage: "optional reason for activating the kill switch",
+		},
+	},
+}
+
+func activateKillSwitch(c *cli.Context) error {
+	var reason string
+	if c.IsSet("reason") {
+		reason = c.String("reason")
 	} else {
-		currencyPair = c.Args().Get(3)
+		reason = c.Args().First()
 	}
 
-	if c.IsSet("asset") {
-		assetType = c.String("asset")
-	} else {
-		assetType = c.Args().Get(4)
+	conn, err := setupClient()
+	if err != nil {
+		return err
 	}
+	defer conn.Close()
 
-	assetType = strings.ToLower(assetType)
-	if !validAsset(assetType) {
-		return errInvalidAsset
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ActivateKillSwitch(context.Background(),
+		&gctrpc.ActivateKillSwitchRequest{
+			Reason: reason,
+		},
+	)
+	if err != nil {
+		return err
 	}
 
-	if c.IsSet("wallet_address") {
-		walletAddress = c.String("wallet_address")
-	} else {
-		walletAddress = c.Args().Get(5)
-	}
+	jsonOutput(result)
+	return nil
+}
 
-	if c.IsSet("side") {
-		orderSide = c.String("side")
-	} else {
-		orderSide = c.Args().Get(6)
+var resetKillSwitchCommand = cli.Command{
+	Name:   "resetkillswitch",
+	Usage:  "resumes order submissions and modifications halted by the kill switch",
+	Action: resetKillSwitch,
+}
+
+func resetKillSwitch(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
 	}
+	defer conn.Close()
 
-	// pair is optional, but if it's set, do a validity check
-	var p currency.Pair
-	if len(currencyPair) > 0 {
-		if !validPair(currencyPair) {
-			return errInvalidPair
-		}
-		p = currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ResetKillSwitch(context.Background(),
+		&gctrpc.ResetKillSwitchRequest{},
+	)
+	if err != nil {
+		return err
 	}
 
+	jsonOutput(result)
+	return nil
+}
+
+var getKillSwitchStatusCommand = cli.Command{
+	Name:   "getkillswitchstatus",
+	Usage:  "gets whether the kill switch is halting order submissions",
+	Action: getKillSwitchStatus,
+}
+
+func getKillSwitchStatus(_ *cli.Context) error {
 	conn, err := setupClient()
 	if err != nil {
 		return err
@@ -1729,19 +3753,9 @@ func cancelOrder(c *cli.Context) error {
 	defer conn.Close()
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.CancelOrder(context.Background(), &gctrpc.CancelOrderRequest{
-		Exchange:  exchangeName,
-		AccountId: accountID,
-		OrderId:   orderID,
-		Pair: &gctrpc.CurrencyPair{
-			Delimiter: p.Delimiter,
-			Base:      p.Base.String(),
-			Quote:     p.Quote.String(),
-		},
-		AssetType:     assetType,
-		WalletAddress: walletAddress,
-		Side:          orderSide,
-	})
+	result, err := client.GetKillSwitchStatus(context.Background(),
+		&gctrpc.GetKillSwitchStatusRequest{},
+	)
 	if err != nil {
 		return err
 	}
@@ -1750,20 +3764,34 @@ func cancelOrder(c *cli.Context) error {
 	return nil
 }
 
-var cancelAllOrdersCommand = cli.Command{
-	Name:      "cancelallorders",
-	Usage:     "cancels all orders (all or by exchange name)",
-	ArgsUsage: "<exchange>",
-	Action:    cancelAllOrders,
+var getTradingRulesCommand = cli.Command{
+	Name:      "gettradingrules",
+	Usage:     "gets the price and amount increments and order size limits an exchange enforces on a currency pair",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getTradingRules,
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  "exchange",
-			Usage: "the exchange to cancel all orders on",
+			Usage: "the exchange to get the trading rules for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the trading rules for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
 		},
 	},
 }
 
-func cancelAllOrders(c *cli.Context) error {
+func getTradingRules(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "gettradingrules")
+		return nil
+	}
+
 	var exchangeName string
 	if c.IsSet("exchange") {
 		exchangeName = c.String("exchange")
@@ -1771,11 +3799,29 @@ func cancelAllOrders(c *cli.Context) error {
 		exchangeName = c.Args().First()
 	}
 
-	// exchange name is an optional param
-	if exchangeName != "" {
-		if !validExchange(exchangeName) {
-			return errInvalidExchange
-		}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	assetType := c.String("asset")
+	if !c.IsSet("asset") && c.Args().Get(2) != "" {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
 	}
 
 	conn, err := setupClient()
@@ -1784,10 +3830,19 @@ func cancelAllOrders(c *cli.Context) error {
 	}
 	defer conn.Close()
 
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.CancelAllOrders(context.Background(), &gctrpc.CancelAllOrdersRequest{
-		Exchange: exchangeName,
-	})
+	result, err := client.GetTradingRules(context.Background(),
+		&gctrpc.GetTradingRulesRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+		},
+	)
 	if err != nil {
 		return err
 	}
@@ -2580,38 +4635,188 @@ func getOrderbookStream(c *cli.Context) error {
 	var pair string
 	var assetType string
 
-	if c.IsSet("exchange") {
-		exchangeName = c.String("exchange")
-	} else {
-		exchangeName = c.Args().First()
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(pair, pairDelimiter)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderbookStream(context.Background(),
+		&gctrpc.GetOrderbookStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType: assetType,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Orderbook stream for %s %s:\n\n", exchangeName,
+			resp.Pair.String())
+		fmt.Println("\t\tBids\t\t\t\tAsks")
+		fmt.Println()
+
+		bidLen := len(resp.Bids) - 1
+		askLen := len(resp.Asks) - 1
+
+		var maxLen int
+		if bidLen >= askLen {
+			maxLen = bidLen
+		} else {
+			maxLen = askLen
+		}
+
+		for i := 0; i < maxLen; i++ {
+			var bidAmount, bidPrice float64
+			if i <= bidLen {
+				bidAmount = resp.Bids[i].Amount
+				bidPrice = resp.Bids[i].Price
+			}
+
+			var askAmount, askPrice float64
+			if i <= askLen {
+				askAmount = resp.Asks[i].Amount
+				askPrice = resp.Asks[i].Price
+			}
+
+			fmt.Printf("%f %s @ %f %s\t\t%f %s @ %f %s\n",
+				bidAmount,
+				resp.Pair.Base,
+				bidPrice,
+				resp.Pair.Quote,
+				askAmount,
+				resp.Pair.Base,
+				askPrice,
+				resp.Pair.Quote)
+
+			if i >= 49 {
+				// limits orderbook display output
+				break
+			}
+		}
+	}
+}
+
+var getConsolidatedOrderbookStreamCommand = cli.Command{
+	Name:      "getconsolidatedorderbookstream",
+	Usage:     "gets a stream of the orderbooks of every enabled exchange trading a currency pair merged into a single orderbook",
+	ArgsUsage: "<pair> <asset> <exchanges>",
+	Action:    getConsolidatedOrderbookStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "currency pair, orderbooks quoted in another fiat currency are converted to its quote currency",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+		cli.StringFlag{
+			Name:  "exchanges",
+			Usage: "optional comma delimited list of exchanges to consolidate, defaults to all enabled exchanges",
+		},
+	},
+}
+
+func getConsolidatedOrderbookStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getconsolidatedorderbookstream")
+		return nil
 	}
 
-	if !validExchange(exchangeName) {
-		return errInvalidExchange
-	}
+	var pair string
+	var exchangesStr string
 
 	if c.IsSet("pair") {
 		pair = c.String("pair")
 	} else {
-		pair = c.Args().Get(1)
+		pair = c.Args().First()
 	}
 
 	if !validPair(pair) {
 		return errInvalidPair
 	}
 
-	if c.IsSet("asset") {
-		assetType = c.String("asset")
-	} else {
-		assetType = c.Args().Get(2)
+	assetType := c.String("asset")
+	if !c.IsSet("asset") && c.Args().Get(1) != "" {
+		assetType = c.Args().Get(1)
 	}
 
 	assetType = strings.ToLower(assetType)
-
 	if !validAsset(assetType) {
 		return errInvalidAsset
 	}
 
+	if c.IsSet("exchanges") {
+		exchangesStr = c.String("exchanges")
+	} else {
+		exchangesStr = c.Args().Get(2)
+	}
+
+	var exchanges []string
+	if exchangesStr != "" {
+		for _, e := range strings.Split(exchangesStr, ",") {
+			e = strings.TrimSpace(e)
+			if !validExchange(e) {
+				return errInvalidExchange
+			}
+			exchanges = append(exchanges, e)
+		}
+	}
+
 	conn, err := setupClient()
 	if err != nil {
 		return err
@@ -2621,15 +4826,15 @@ func getOrderbookStream(c *cli.Context) error {
 	p := currency.NewPairDelimiter(pair, pairDelimiter)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.GetOrderbookStream(context.Background(),
-		&gctrpc.GetOrderbookStreamRequest{
-			Exchange: exchangeName,
+	result, err := client.GetConsolidatedOrderbookStream(context.Background(),
+		&gctrpc.GetConsolidatedOrderbookStreamRequest{
 			Pair: &gctrpc.CurrencyPair{
 				Base:      p.Base.String(),
 				Quote:     p.Quote.String(),
 				Delimiter: p.Delimiter,
 			},
 			AssetType: assetType,
+			Exchanges: exchanges,
 		},
 	)
 
@@ -2648,9 +4853,9 @@ func getOrderbookStream(c *cli.Context) error {
 			return err
 		}
 
-		fmt.Printf("Orderbook stream for %s %s:\n\n", exchangeName,
-			resp.Pair.String())
-		fmt.Println("\t\tBids\t\t\t\tAsks")
+		fmt.Printf("Consolidated orderbook stream for %s from %d exchange pairs:\n\n",
+			resp.Pair.String(), len(resp.Sources))
+		fmt.Println("\t\tBids\t\t\t\t\tAsks")
 		fmt.Println()
 
 		bidLen := len(resp.Bids) - 1
@@ -2663,24 +4868,27 @@ func getOrderbookStream(c *cli.Context) error {
 			maxLen = askLen
 		}
 
-		for i := 0; i < maxLen; i++ {
-			var bidAmount, bidPrice float64
+		for i := 0; i <= maxLen; i++ {
+			var bidExchange, askExchange string
+			var bidAmount, bidPrice, askAmount, askPrice float64
 			if i <= bidLen {
+				bidExchange = resp.Bids[i].Exchange
 				bidAmount = resp.Bids[i].Amount
 				bidPrice = resp.Bids[i].Price
 			}
-
-			var askAmount, askPrice float64
 			if i <= askLen {
+				askExchange = resp.Asks[i].Exchange
 				askAmount = resp.Asks[i].Amount
 				askPrice = resp.Asks[i].Price
 			}
 
-			fmt.Printf("%f %s @ %f %s\t\t%f %s @ %f %s\n",
+			fmt.Printf("%-10s %f %s @ %f %s\t\t%-10s %f %s @ %f %s\n",
+				bidExchange,
 				bidAmount,
 				resp.Pair.Base,
 				bidPrice,
 				resp.Pair.Quote,
+				askExchange,
 				askAmount,
 				resp.Pair.Base,
 				askPrice,
@@ -3495,11 +5703,11 @@ func gctScriptUpload(c *cli.Context) error {
 	return nil
 }
 
-var candleRangeSize, candleGranularity int64
+var candleInterval string
 var getHistoricCandlesCommand = cli.Command{
 	Name:      "gethistoriccandles",
-	Usage:     "gets historical candles for the specified granularity up to range size time from now.",
-	ArgsUsage: "<exchange> <pair> <rangesize> <granularity>",
+	Usage:     "gets historical candles for the specified interval between the start and end time",
+	ArgsUsage: "<exchange> <pair> <asset> <interval> <start> <end>",
 	Action:    getHistoricCandles,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -3510,17 +5718,28 @@ var getHistoricCandlesCommand = cli.Command{
 			Name:  "pair",
 			Usage: "the currency pair to get the candles for",
 		},
-		cli.Int64Flag{
-			Name:        "rangesize, r",
-			Usage:       "the amount of time to go back from now to fetch candles in the given granularity",
-			Value:       10,
-			Destination: &candleRangeSize,
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+		cli.StringFlag{
+			Name:        "interval, i",
+			Usage:       "the candle interval, can be one of the following {1m, 5m, 15m, 1h, 4h, 1d, 1w}",
+			Value:       "1d",
+			Destination: &candleInterval,
+		},
+		cli.StringFlag{
+			Name:        "start",
+			Usage:       "the UTC date to begin retrieving candles",
+			Value:       time.Now().UTC().AddDate(0, 0, -7).Format(timeFormat),
+			Destination: &startTime,
 		},
-		cli.Int64Flag{
-			Name:        "granularity, g",
-			Usage:       "value is in seconds and can be one of the following {60, 300, 900, 3600, 21600, 86400}",
-			Value:       86400,
-			Destination: &candleGranularity,
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "the UTC date to end retrieving candles",
+			Value:       time.Now().UTC().Format(timeFormat),
+			Destination: &endTime,
 		},
 	},
 }
@@ -3552,26 +5771,48 @@ func getHistoricCandles(c *cli.Context) error {
 	}
 	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
 
-	if c.IsSet("rangesize") {
-		candleRangeSize = c.Int64("rangesize")
-	} else if c.Args().Get(2) != "" {
-		var err error
-		candleRangeSize, err = strconv.ParseInt(c.Args().Get(2), 10, 64)
-		if err != nil {
-			return err
+	assetType := c.String("asset")
+	if !c.IsSet("asset") && c.Args().Get(2) != "" {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("interval") {
+		if c.Args().Get(3) != "" {
+			candleInterval = c.Args().Get(3)
 		}
 	}
 
-	if c.IsSet("granularity") {
-		candleGranularity = c.Int64("granularity")
-	} else if c.Args().Get(3) != "" {
-		var err error
-		candleGranularity, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
-		if err != nil {
-			return err
+	if !c.IsSet("start") {
+		if c.Args().Get(4) != "" {
+			startTime = c.Args().Get(4)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(5) != "" {
+			endTime = c.Args().Get(5)
 		}
 	}
 
+	s, err := time.Parse(timeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(timeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
 	conn, err := setupClient()
 	if err != nil {
 		return err
@@ -3587,10 +5828,266 @@ func getHistoricCandles(c *cli.Context) error {
 				Base:      p.Base.String(),
 				Quote:     p.Quote.String(),
 			},
-			Rangesize:   candleRangeSize,
-			Granularity: candleGranularity,
+			AssetType: assetType,
+			Start:     s.Format(timeFormat),
+			End:       e.Format(timeFormat),
+			Interval:  candleInterval,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var jobID string
+var dataHistoryCommand = cli.Command{
+	Name:      "datahistory",
+	Usage:     "manages candle backfill jobs run by the data history manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "add",
+			Usage:     "adds a job to backfill candles from the start date, leave end unset to keep the job backfilling to the current time",
+			ArgsUsage: "<exchange> <pair> <asset> <interval> <start> <end>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange, e",
+					Usage: "the exchange to backfill candles from",
+				},
+				cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair to backfill candles for",
+				},
+				cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type of the currency pair",
+					Value: "spot",
+				},
+				cli.StringFlag{
+					Name:        "interval, i",
+					Usage:       "the candle interval, can be one of the following {1m, 5m, 15m, 1h, 4h, 1d, 1w}",
+					Value:       "1d",
+					Destination: &candleInterval,
+				},
+				cli.StringFlag{
+					Name:        "start",
+					Usage:       "the UTC date to begin backfilling candles from",
+					Value:       time.Now().UTC().AddDate(0, -1, 0).Format(timeFormat),
+					Destination: &startTime,
+				},
+				cli.StringFlag{
+					Name:  "end",
+					Usage: "the UTC date to stop backfilling candles",
+				},
+			},
+			Action: addDataHistoryJob,
+		},
+		{
+			Name:      "get",
+			Usage:     "returns a job and its progress",
+			ArgsUsage: "<id>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "id",
+					Usage:       "the job ID",
+					Destination: &jobID,
+				},
+			},
+			Action: getDataHistoryJob,
+		},
+		{
+			Name:   "list",
+			Usage:  "returns all jobs and their progress",
+			Action: getDataHistoryJobs,
+		},
+		{
+			Name:      "remove",
+			Usage:     "removes a job",
+			ArgsUsage: "<id>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "id",
+					Usage:       "the job ID",
+					Destination: &jobID,
+				},
+			},
+			Action: removeDataHistoryJob,
+		},
+	},
+}
+
+func addDataHistoryJob(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+
+	assetType := c.String("asset")
+	if !c.IsSet("asset") && c.Args().Get(2) != "" {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("interval") {
+		if c.Args().Get(3) != "" {
+			candleInterval = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(4) != "" {
+			startTime = c.Args().Get(4)
+		}
+	}
+
+	var end string
+	if c.IsSet("end") {
+		end = c.String("end")
+	} else {
+		end = c.Args().Get(5)
+	}
+
+	s, err := time.Parse(timeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	if end != "" {
+		var e time.Time
+		e, err = time.Parse(timeFormat, end)
+		if err != nil {
+			return fmt.Errorf("invalid time format for end: %v", err)
+		}
+
+		if e.Before(s) {
+			return errors.New("start cannot be after end")
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddDataHistoryJob(context.Background(),
+		&gctrpc.AddDataHistoryJobRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Interval:  candleInterval,
+			StartDate: s.Format(timeFormat),
+			EndDate:   end,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getDataHistoryJob(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	if !c.IsSet("id") {
+		jobID = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataHistoryJob(context.Background(),
+		&gctrpc.GetDataHistoryJobRequest{
+			Id: jobID,
 		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getDataHistoryJobs(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataHistoryJobs(context.Background(),
+		&gctrpc.GetDataHistoryJobsRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func removeDataHistoryJob(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	if !c.IsSet("id") {
+		jobID = c.Args().First()
+	}
 
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RemoveDataHistoryJob(context.Background(),
+		&gctrpc.RemoveDataHistoryJobRequest{
+			Id: jobID,
+		})
 	if err != nil {
 		return err
 	}
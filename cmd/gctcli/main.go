@@ -113,10 +113,36 @@ func main() {
 		getOrdersCommand,
 		getOrderCommand,
 		submitOrderCommand,
+		submitOrdersCommand,
 		simulateOrderCommand,
 		whaleBombCommand,
+		getOrderbookDepthCommand,
+		getArbitrageOpportunitiesCommand,
+		getDispatchStatsCommand,
+		getOrderReconciliationCommand,
+		addConditionalOrderCommand,
+		cancelConditionalOrderCommand,
+		getConditionalOrdersCommand,
+		addOCOOrderGroupCommand,
+		addBracketOrderGroupCommand,
+		cancelOrderGroupCommand,
+		getOrderGroupsCommand,
+		addIcebergOrderCommand,
+		cancelIcebergOrderCommand,
+		getIcebergOrdersCommand,
+		addExecutionOrderCommand,
+		pauseExecutionOrderCommand,
+		resumeExecutionOrderCommand,
+		cancelExecutionOrderCommand,
+		getExecutionOrdersCommand,
 		cancelOrderCommand,
+		cancelOrdersCommand,
+		modifyOrderCommand,
 		cancelAllOrdersCommand,
+		activateKillSwitchCommand,
+		resetKillSwitchCommand,
+		getKillSwitchStatusCommand,
+		getTradingRulesCommand,
 		getEventsCommand,
 		addEventCommand,
 		removeEventCommand,
@@ -131,11 +157,13 @@ func main() {
 		disableExchangePairCommand,
 		getOrderbookStreamCommand,
 		getExchangeOrderbookStreamCommand,
+		getConsolidatedOrderbookStreamCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
 		getAuditEventCommand,
 		getHistoricCandlesCommand,
 		gctScriptCommand,
+		dataHistoryCommand,
 	}
 
 	err := app.Run(os.Args)
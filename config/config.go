@@ -1328,6 +1328,82 @@ func (c *Config) CheckConnectionMonitorConfig() {
 	}
 }
 
+// CheckDataHistoryConfig checks the data history manager config and sets
+// default values
+func (c *Config) CheckDataHistoryConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.DataHistory.CheckInterval <= 0 {
+		c.DataHistory.CheckInterval = defaultDataHistoryCheckInterval
+	}
+}
+
+// CheckArbitrageConfig checks the arbitrage monitor config and sets default
+// values
+func (c *Config) CheckArbitrageConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.Arbitrage.CheckInterval <= 0 {
+		c.Arbitrage.CheckInterval = defaultArbitrageCheckInterval
+	}
+	if c.Arbitrage.AlertThreshold <= 0 {
+		c.Arbitrage.AlertThreshold = defaultArbitrageAlertThreshold
+	}
+	if c.Arbitrage.AlertCooldown <= 0 {
+		c.Arbitrage.AlertCooldown = defaultArbitrageAlertCooldown
+	}
+	if c.Arbitrage.MaxOrderbookAge <= 0 {
+		c.Arbitrage.MaxOrderbookAge = defaultArbitrageMaxOrderbookAge
+	}
+}
+
+// CheckRiskConfig checks the pre-trade risk config and sets default values,
+// the base currency defaults to the fiat display currency
+func (c *Config) CheckRiskConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.Risk.BaseCurrency.IsEmpty() {
+		c.Risk.BaseCurrency = c.Currency.FiatDisplayCurrency
+		if c.Risk.BaseCurrency.IsEmpty() {
+			c.Risk.BaseCurrency = currency.USD
+		}
+	}
+	if c.Risk.MaxTickerAge <= 0 {
+		c.Risk.MaxTickerAge = defaultRiskMaxTickerAge
+	}
+}
+
+// AddDataHistoryJob adds or replaces a data history job by ID
+func (c *Config) AddDataHistoryJob(job *DataHistoryJobConfig) {
+	m.Lock()
+	defer m.Unlock()
+
+	for x := range c.DataHistory.Jobs {
+		if c.DataHistory.Jobs[x].ID == job.ID {
+			c.DataHistory.Jobs[x] = *job
+			return
+		}
+	}
+	c.DataHistory.Jobs = append(c.DataHistory.Jobs, *job)
+}
+
+// RemoveDataHistoryJob removes a data history job by ID
+func (c *Config) RemoveDataHistoryJob(id string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for x := range c.DataHistory.Jobs {
+		if c.DataHistory.Jobs[x].ID == id {
+			c.DataHistory.Jobs = append(c.DataHistory.Jobs[:x], c.DataHistory.Jobs[x+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("data history job %s not found", id)
+}
+
 // DefaultFilePath returns the default config file path
 // MacOS/Linux: $HOME/.gocryptotrader/config.json or config.dat
 // Windows: %APPDATA%\GoCryptoTrader\config.json or config.dat
@@ -1624,6 +1700,9 @@ func (c *Config) CheckConfig() error {
 	}
 
 	c.CheckConnectionMonitorConfig()
+	c.CheckDataHistoryConfig()
+	c.CheckArbitrageConfig()
+	c.CheckRiskConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckRemoteControlConfig()
@@ -3,6 +3,7 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/connchecker"
@@ -1906,3 +1907,80 @@ func TestPreengineConfigUpgrade(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCheckDataHistoryConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckDataHistoryConfig()
+	if c.DataHistory.CheckInterval != defaultDataHistoryCheckInterval {
+		t.Errorf("expected %v received %v",
+			defaultDataHistoryCheckInterval, c.DataHistory.CheckInterval)
+	}
+}
+
+func TestCheckArbitrageConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckArbitrageConfig()
+	if c.Arbitrage.CheckInterval != defaultArbitrageCheckInterval ||
+		c.Arbitrage.AlertThreshold != defaultArbitrageAlertThreshold ||
+		c.Arbitrage.AlertCooldown != defaultArbitrageAlertCooldown ||
+		c.Arbitrage.MaxOrderbookAge != defaultArbitrageMaxOrderbookAge {
+		t.Errorf("unexpected arbitrage defaults %+v", c.Arbitrage)
+	}
+
+	c.Arbitrage.AlertThreshold = 25
+	c.CheckArbitrageConfig()
+	if c.Arbitrage.AlertThreshold != 25 {
+		t.Errorf("expected 25 received %v", c.Arbitrage.AlertThreshold)
+	}
+}
+
+func TestCheckRiskConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckRiskConfig()
+	if c.Risk.BaseCurrency != currency.USD ||
+		c.Risk.MaxTickerAge != defaultRiskMaxTickerAge {
+		t.Errorf("unexpected risk defaults %+v", c.Risk)
+	}
+
+	c = Config{}
+	c.Currency.FiatDisplayCurrency = currency.AUD
+	c.Risk.MaxTickerAge = time.Second
+	c.CheckRiskConfig()
+	if c.Risk.BaseCurrency != currency.AUD {
+		t.Errorf("expected %v received %v", currency.AUD, c.Risk.BaseCurrency)
+	}
+	if c.Risk.MaxTickerAge != time.Second {
+		t.Errorf("expected %v received %v", time.Second, c.Risk.MaxTickerAge)
+	}
+}
+
+func TestAddRemoveDataHistoryJob(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.AddDataHistoryJob(&DataHistoryJobConfig{ID: "1", Exchange: "Bitstamp"})
+	c.AddDataHistoryJob(&DataHistoryJobConfig{ID: "2", Exchange: "Bitstamp"})
+	c.AddDataHistoryJob(&DataHistoryJobConfig{ID: "1", Exchange: "Binance"})
+	if len(c.DataHistory.Jobs) != 2 {
+		t.Fatalf("expected 2 jobs received %d", len(c.DataHistory.Jobs))
+	}
+	if c.DataHistory.Jobs[0].Exchange != "Binance" {
+		t.Error("expected existing job to be replaced")
+	}
+
+	if err := c.RemoveDataHistoryJob("1"); err != nil {
+		t.Error(err)
+	}
+	if err := c.RemoveDataHistoryJob("1"); err == nil {
+		t.Error("expected error removing a job which does not exist")
+	}
+	if len(c.DataHistory.Jobs) != 1 || c.DataHistory.Jobs[0].ID != "2" {
+		t.Error("unexpected jobs remaining")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -32,6 +33,12 @@ const (
 	maxAuthFailures                      = 3
 	defaultNTPAllowedDifference          = 50000000
 	defaultNTPAllowedNegativeDifference  = 50000000
+	defaultDataHistoryCheckInterval      = time.Minute
+	defaultArbitrageCheckInterval        = time.Second
+	defaultArbitrageAlertThreshold       = 10
+	defaultArbitrageAlertCooldown        = time.Minute * 5
+	defaultArbitrageMaxOrderbookAge      = time.Minute
+	defaultRiskMaxTickerAge              = time.Minute
 	DefaultAPIKey                        = "Key"
 	DefaultAPISecret                     = "Secret"
 	DefaultAPIClientID                   = "ClientID"
@@ -88,6 +95,9 @@ type Config struct {
 	Profiler          Profiler                `json:"profiler"`
 	NTPClient         NTPClientConfig         `json:"ntpclient"`
 	GCTScript         gctscript.Config        `json:"gctscript"`
+	DataHistory       DataHistoryConfig       `json:"dataHistory"`
+	Arbitrage         ArbitrageConfig         `json:"arbitrage"`
+	Risk              RiskConfig              `json:"risk"`
 	Currency          CurrencyConfig          `json:"currencyConfig"`
 	Communications    CommunicationsConfig    `json:"communications"`
 	RemoteControl     RemoteControlConfig     `json:"remoteControl"`
@@ -167,6 +177,54 @@ type NTPClientConfig struct {
 	AllowedNegativeDifference *time.Duration `json:"allowedNegativeDifference"`
 }
 
+// DataHistoryConfig defines the data history manager settings and the
+// candle backfill jobs it maintains
+type DataHistoryConfig struct {
+	Enabled       bool                   `json:"enabled"`
+	CheckInterval time.Duration          `json:"checkInterval"`
+	Jobs          []DataHistoryJobConfig `json:"jobs"`
+}
+
+// ArbitrageConfig defines the arbitrage monitor settings, the alert threshold
+// is the net spread in basis points an opportunity must reach to raise an
+// alert
+type ArbitrageConfig struct {
+	Enabled         bool          `json:"enabled"`
+	CheckInterval   time.Duration `json:"checkInterval"`
+	AlertThreshold  float64       `json:"alertThreshold"`
+	AlertCooldown   time.Duration `json:"alertCooldown"`
+	MaxOrderbookAge time.Duration `json:"maxOrderbookAge"`
+	CommsAlerts     bool          `json:"commsAlerts"`
+}
+
+// RiskConfig defines the pre-trade risk checks applied to order manager
+// submissions. Notional values and the daily loss are in the base currency,
+// the price band is a percentage of the latest ticker price and position
+// limits are keyed by currency code. Limits left at zero are not enforced.
+type RiskConfig struct {
+	Enabled              bool               `json:"enabled"`
+	BaseCurrency         currency.Code      `json:"baseCurrency"`
+	MaxOrderNotional     float64            `json:"maxOrderNotional"`
+	MaxExchangeNotional  float64            `json:"maxExchangeNotional"`
+	MaxOpenOrdersPerPair int                `json:"maxOpenOrdersPerPair"`
+	MaxPositions         map[string]float64 `json:"maxPositions"`
+	PriceBand            float64            `json:"priceBand"`
+	MaxTickerAge         time.Duration      `json:"maxTickerAge"`
+	MaxDailyLoss         float64            `json:"maxDailyLoss"`
+}
+
+// DataHistoryJobConfig defines a candle backfill job, an unset end date keeps
+// the job backfilling up to the current time
+type DataHistoryJobConfig struct {
+	ID        string        `json:"id"`
+	Exchange  string        `json:"exchange"`
+	Pair      currency.Pair `json:"pair"`
+	Asset     asset.Item    `json:"asset"`
+	Interval  string        `json:"interval"`
+	StartDate time.Time     `json:"startDate"`
+	EndDate   time.Time     `json:"endDate"`
+}
+
 // GRPCConfig stores the gRPC settings
 type GRPCConfig struct {
 	Enabled                bool   `json:"enabled"`
@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "candle"
+(
+    id              integer not null primary key,
+    exchange_name   text not null,
+    base            text not null,
+    quote           text not null,
+    asset           text not null,
+    interval        text not null,
+    timestamp       timestamp not null,
+    open            real not null,
+    high            real not null,
+    low             real not null,
+    close           real not null,
+    volume          real not null,
+    UNIQUE(exchange_name, base, quote, asset, interval, timestamp) ON CONFLICT IGNORE
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE "candle";
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS trade
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange_name varchar(255) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(30) NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    side varchar(30) NOT NULL,
+    tid varchar(255) NOT NULL,
+    timestamp TIMESTAMP NOT NULL
+);
+CREATE INDEX trade_lookup_idx ON trade (exchange_name, base, quote, asset, timestamp);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE trade;
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "trade"
+(
+    id              integer not null primary key,
+    exchange_name   text not null,
+    base            text not null,
+    quote           text not null,
+    asset           text not null,
+    price           real not null,
+    amount          real not null,
+    side            text not null,
+    tid             text not null,
+    timestamp       timestamp not null
+);
+CREATE INDEX trade_lookup_idx ON "trade" (exchange_name, base, quote, asset, timestamp);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE "trade";
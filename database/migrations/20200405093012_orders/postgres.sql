@@ -0,0 +1,30 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS orders
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    our_order_id varchar(36) NOT NULL,
+    exchange_name varchar(255) NOT NULL,
+    exchange_order_id varchar(255) NOT NULL,
+    client_id varchar(255) NOT NULL,
+    account_id varchar(255) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(30) NOT NULL,
+    side varchar(30) NOT NULL,
+    order_type varchar(30) NOT NULL,
+    status varchar(30) NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    CONSTRAINT orders_our_order_id_uniq UNIQUE (our_order_id)
+);
+CREATE INDEX orders_exchange_idx ON orders (exchange_name, exchange_order_id);
+CREATE INDEX orders_status_idx ON orders (status);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE orders;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "orders"
+(
+    id                  integer not null primary key,
+    our_order_id        text not null unique,
+    exchange_name       text not null,
+    exchange_order_id   text not null,
+    client_id           text not null,
+    account_id          text not null,
+    base                text not null,
+    quote               text not null,
+    asset               text not null,
+    side                text not null,
+    order_type          text not null,
+    status              text not null,
+    price               real not null,
+    amount              real not null,
+    executed_amount     real not null,
+    remaining_amount    real not null,
+    fee                 real not null,
+    created_at          timestamp not null,
+    updated_at          timestamp not null
+);
+CREATE INDEX orders_exchange_idx ON "orders" (exchange_name, exchange_order_id);
+CREATE INDEX orders_status_idx ON "orders" (status);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE "orders";
@@ -0,0 +1,31 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS conditional_orders
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    conditional_order_id varchar(36) NOT NULL,
+    exchange_name varchar(255) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(30) NOT NULL,
+    side varchar(30) NOT NULL,
+    condition_type varchar(30) NOT NULL,
+    order_type varchar(30) NOT NULL,
+    trigger_price DOUBLE PRECISION NOT NULL,
+    trailing_amount DOUBLE PRECISION NOT NULL,
+    trailing_percent DOUBLE PRECISION NOT NULL,
+    limit_price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    watermark DOUBLE PRECISION NOT NULL,
+    status varchar(30) NOT NULL,
+    exchange_order_id varchar(255) NOT NULL,
+    our_order_id varchar(36) NOT NULL,
+    error_message text NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    CONSTRAINT conditional_orders_conditional_order_id_uniq UNIQUE (conditional_order_id)
+);
+CREATE INDEX conditional_orders_status_idx ON conditional_orders (status);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE conditional_orders;
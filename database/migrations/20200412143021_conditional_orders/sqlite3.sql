@@ -0,0 +1,30 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "conditional_orders"
+(
+    id                      integer not null primary key,
+    conditional_order_id    text not null unique,
+    exchange_name           text not null,
+    base                    text not null,
+    quote                   text not null,
+    asset                   text not null,
+    side                    text not null,
+    condition_type          text not null,
+    order_type              text not null,
+    trigger_price           real not null,
+    trailing_amount         real not null,
+    trailing_percent        real not null,
+    limit_price             real not null,
+    amount                  real not null,
+    watermark               real not null,
+    status                  text not null,
+    exchange_order_id       text not null,
+    our_order_id            text not null,
+    error_message           text not null,
+    created_at              timestamp not null,
+    updated_at              timestamp not null
+);
+CREATE INDEX conditional_orders_status_idx ON "conditional_orders" (status);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE "conditional_orders";
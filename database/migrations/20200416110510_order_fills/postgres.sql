@@ -0,0 +1,22 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS order_fills
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    our_order_id varchar(36) NOT NULL,
+    exchange_name varchar(255) NOT NULL,
+    trade_id varchar(255) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(30) NOT NULL,
+    side varchar(30) NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    executed_at TIMESTAMP NOT NULL
+);
+CREATE INDEX order_fills_order_idx ON order_fills (our_order_id);
+CREATE INDEX order_fills_pair_idx ON order_fills (exchange_name, base, quote, asset, executed_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE order_fills;
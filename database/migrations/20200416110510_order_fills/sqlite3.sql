@@ -0,0 +1,22 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "order_fills"
+(
+    id              integer not null primary key,
+    our_order_id    text not null,
+    exchange_name   text not null,
+    trade_id        text not null,
+    base            text not null,
+    quote           text not null,
+    asset           text not null,
+    side            text not null,
+    price           real not null,
+    amount          real not null,
+    fee             real not null,
+    executed_at     timestamp not null
+);
+CREATE INDEX order_fills_order_idx ON "order_fills" (our_order_id);
+CREATE INDEX order_fills_pair_idx ON "order_fills" (exchange_name, base, quote, asset, executed_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE "order_fills";
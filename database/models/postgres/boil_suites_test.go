@@ -13,64 +13,128 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("Candles", testCandles)
+	t.Run("ConditionalOrders", testConditionalOrders)
+	t.Run("OrderFills", testOrderFills)
+	t.Run("Orders", testOrders)
 	t.Run("Scripts", testScripts)
+	t.Run("Trades", testTrades)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("Candles", testCandlesDelete)
+	t.Run("ConditionalOrders", testConditionalOrdersDelete)
+	t.Run("OrderFills", testOrderFillsDelete)
+	t.Run("Orders", testOrdersDelete)
 	t.Run("Scripts", testScriptsDelete)
+	t.Run("Trades", testTradesDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("ConditionalOrders", testConditionalOrdersQueryDeleteAll)
+	t.Run("OrderFills", testOrderFillsQueryDeleteAll)
+	t.Run("Orders", testOrdersQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
+	t.Run("Trades", testTradesQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("ConditionalOrders", testConditionalOrdersSliceDeleteAll)
+	t.Run("OrderFills", testOrderFillsSliceDeleteAll)
+	t.Run("Orders", testOrdersSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
+	t.Run("Trades", testTradesSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("Candles", testCandlesExists)
+	t.Run("ConditionalOrders", testConditionalOrdersExists)
+	t.Run("OrderFills", testOrderFillsExists)
+	t.Run("Orders", testOrdersExists)
 	t.Run("Scripts", testScriptsExists)
+	t.Run("Trades", testTradesExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("Candles", testCandlesFind)
+	t.Run("ConditionalOrders", testConditionalOrdersFind)
+	t.Run("OrderFills", testOrderFillsFind)
+	t.Run("Orders", testOrdersFind)
 	t.Run("Scripts", testScriptsFind)
+	t.Run("Trades", testTradesFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("Candles", testCandlesBind)
+	t.Run("ConditionalOrders", testConditionalOrdersBind)
+	t.Run("OrderFills", testOrderFillsBind)
+	t.Run("Orders", testOrdersBind)
 	t.Run("Scripts", testScriptsBind)
+	t.Run("Trades", testTradesBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("Candles", testCandlesOne)
+	t.Run("ConditionalOrders", testConditionalOrdersOne)
+	t.Run("OrderFills", testOrderFillsOne)
+	t.Run("Orders", testOrdersOne)
 	t.Run("Scripts", testScriptsOne)
+	t.Run("Trades", testTradesOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("Candles", testCandlesAll)
+	t.Run("ConditionalOrders", testConditionalOrdersAll)
+	t.Run("OrderFills", testOrderFillsAll)
+	t.Run("Orders", testOrdersAll)
 	t.Run("Scripts", testScriptsAll)
+	t.Run("Trades", testTradesAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("Candles", testCandlesCount)
+	t.Run("ConditionalOrders", testConditionalOrdersCount)
+	t.Run("OrderFills", testOrderFillsCount)
+	t.Run("Orders", testOrdersCount)
 	t.Run("Scripts", testScriptsCount)
+	t.Run("Trades", testTradesCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("Candles", testCandlesHooks)
+	t.Run("ConditionalOrders", testConditionalOrdersHooks)
+	t.Run("OrderFills", testOrderFillsHooks)
+	t.Run("Orders", testOrdersHooks)
 	t.Run("Scripts", testScriptsHooks)
+	t.Run("Trades", testTradesHooks)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("Candles", testCandlesInsert)
+	t.Run("ConditionalOrders", testConditionalOrdersInsert)
+	t.Run("OrderFills", testOrderFillsInsert)
+	t.Run("Orders", testOrdersInsert)
+	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("ConditionalOrders", testConditionalOrdersInsertWhitelist)
+	t.Run("OrderFills", testOrderFillsInsertWhitelist)
+	t.Run("Orders", testOrdersInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
+	t.Run("Trades", testTradesInsert)
 }
 
 // TestToOne tests cannot be run in parallel
@@ -115,25 +179,50 @@ func TestToManyRemove(t *testing.T) {}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("Candles", testCandlesReload)
+	t.Run("ConditionalOrders", testConditionalOrdersReload)
+	t.Run("OrderFills", testOrderFillsReload)
+	t.Run("Orders", testOrdersReload)
 	t.Run("Scripts", testScriptsReload)
+	t.Run("Trades", testTradesReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("Candles", testCandlesReloadAll)
+	t.Run("ConditionalOrders", testConditionalOrdersReloadAll)
+	t.Run("OrderFills", testOrderFillsReloadAll)
+	t.Run("Orders", testOrdersReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
+	t.Run("Trades", testTradesReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("Candles", testCandlesSelect)
+	t.Run("ConditionalOrders", testConditionalOrdersSelect)
+	t.Run("OrderFills", testOrderFillsSelect)
+	t.Run("Orders", testOrdersSelect)
 	t.Run("Scripts", testScriptsSelect)
+	t.Run("Trades", testTradesSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("Candles", testCandlesUpdate)
+	t.Run("ConditionalOrders", testConditionalOrdersUpdate)
+	t.Run("OrderFills", testOrderFillsUpdate)
+	t.Run("Orders", testOrdersUpdate)
 	t.Run("Scripts", testScriptsUpdate)
+	t.Run("Trades", testTradesUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("ConditionalOrders", testConditionalOrdersSliceUpdateAll)
+	t.Run("OrderFills", testOrderFillsSliceUpdateAll)
+	t.Run("Orders", testOrdersSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
+	t.Run("Trades", testTradesSliceUpdateAll)
 }
@@ -7,5 +7,10 @@ import "testing"
 
 func TestUpsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpsert)
+	t.Run("Candles", testCandlesUpsert)
+	t.Run("ConditionalOrders", testConditionalOrdersUpsert)
+	t.Run("OrderFills", testOrderFillsUpsert)
+	t.Run("Orders", testOrdersUpsert)
 	t.Run("Scripts", testScriptsUpsert)
+	t.Run("Trades", testTradesUpsert)
 }
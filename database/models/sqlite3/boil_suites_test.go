@@ -13,77 +13,141 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
-	t.Run("Scripts", testScripts)
+	t.Run("Candles", testCandles)
+	t.Run("ConditionalOrders", testConditionalOrders)
+	t.Run("OrderFills", testOrderFills)
+	t.Run("Orders", testOrders)
 	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("Scripts", testScripts)
+	t.Run("Trades", testTrades)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
-	t.Run("Scripts", testScriptsDelete)
+	t.Run("Candles", testCandlesDelete)
+	t.Run("ConditionalOrders", testConditionalOrdersDelete)
+	t.Run("OrderFills", testOrderFillsDelete)
+	t.Run("Orders", testOrdersDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("Scripts", testScriptsDelete)
+	t.Run("Trades", testTradesDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
-	t.Run("Scripts", testScriptsQueryDeleteAll)
+	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("ConditionalOrders", testConditionalOrdersQueryDeleteAll)
+	t.Run("OrderFills", testOrderFillsQueryDeleteAll)
+	t.Run("Orders", testOrdersQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("Scripts", testScriptsQueryDeleteAll)
+	t.Run("Trades", testTradesQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
-	t.Run("Scripts", testScriptsSliceDeleteAll)
+	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("ConditionalOrders", testConditionalOrdersSliceDeleteAll)
+	t.Run("OrderFills", testOrderFillsSliceDeleteAll)
+	t.Run("Orders", testOrdersSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("Scripts", testScriptsSliceDeleteAll)
+	t.Run("Trades", testTradesSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
-	t.Run("Scripts", testScriptsExists)
+	t.Run("Candles", testCandlesExists)
+	t.Run("ConditionalOrders", testConditionalOrdersExists)
+	t.Run("OrderFills", testOrderFillsExists)
+	t.Run("Orders", testOrdersExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("Scripts", testScriptsExists)
+	t.Run("Trades", testTradesExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
-	t.Run("Scripts", testScriptsFind)
+	t.Run("Candles", testCandlesFind)
+	t.Run("ConditionalOrders", testConditionalOrdersFind)
+	t.Run("OrderFills", testOrderFillsFind)
+	t.Run("Orders", testOrdersFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("Scripts", testScriptsFind)
+	t.Run("Trades", testTradesFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
-	t.Run("Scripts", testScriptsBind)
+	t.Run("Candles", testCandlesBind)
+	t.Run("ConditionalOrders", testConditionalOrdersBind)
+	t.Run("OrderFills", testOrderFillsBind)
+	t.Run("Orders", testOrdersBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("Scripts", testScriptsBind)
+	t.Run("Trades", testTradesBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
-	t.Run("Scripts", testScriptsOne)
+	t.Run("Candles", testCandlesOne)
+	t.Run("ConditionalOrders", testConditionalOrdersOne)
+	t.Run("OrderFills", testOrderFillsOne)
+	t.Run("Orders", testOrdersOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("Scripts", testScriptsOne)
+	t.Run("Trades", testTradesOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
-	t.Run("Scripts", testScriptsAll)
+	t.Run("Candles", testCandlesAll)
+	t.Run("ConditionalOrders", testConditionalOrdersAll)
+	t.Run("OrderFills", testOrderFillsAll)
+	t.Run("Orders", testOrdersAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("Scripts", testScriptsAll)
+	t.Run("Trades", testTradesAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
-	t.Run("Scripts", testScriptsCount)
+	t.Run("Candles", testCandlesCount)
+	t.Run("ConditionalOrders", testConditionalOrdersCount)
+	t.Run("OrderFills", testOrderFillsCount)
+	t.Run("Orders", testOrdersCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("Scripts", testScriptsCount)
+	t.Run("Trades", testTradesCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
-	t.Run("Scripts", testScriptsHooks)
+	t.Run("Candles", testCandlesHooks)
+	t.Run("ConditionalOrders", testConditionalOrdersHooks)
+	t.Run("OrderFills", testOrderFillsHooks)
+	t.Run("Orders", testOrdersHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("Scripts", testScriptsHooks)
+	t.Run("Trades", testTradesHooks)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
-	t.Run("Scripts", testScriptsInsert)
-	t.Run("Scripts", testScriptsInsertWhitelist)
+	t.Run("Candles", testCandlesInsert)
+	t.Run("ConditionalOrders", testConditionalOrdersInsert)
+	t.Run("OrderFills", testOrderFillsInsert)
+	t.Run("Orders", testOrdersInsert)
+	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("ConditionalOrders", testConditionalOrdersInsertWhitelist)
+	t.Run("OrderFills", testOrderFillsInsertWhitelist)
+	t.Run("Orders", testOrdersInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("Scripts", testScriptsInsert)
+	t.Run("Scripts", testScriptsInsertWhitelist)
+	t.Run("Trades", testTradesInsert)
 }
 
 // TestToOne tests cannot be run in parallel
@@ -136,30 +200,55 @@ func TestToManyRemove(t *testing.T) {}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
-	t.Run("Scripts", testScriptsReload)
+	t.Run("Candles", testCandlesReload)
+	t.Run("ConditionalOrders", testConditionalOrdersReload)
+	t.Run("OrderFills", testOrderFillsReload)
+	t.Run("Orders", testOrdersReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("Scripts", testScriptsReload)
+	t.Run("Trades", testTradesReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
-	t.Run("Scripts", testScriptsReloadAll)
+	t.Run("Candles", testCandlesReloadAll)
+	t.Run("ConditionalOrders", testConditionalOrdersReloadAll)
+	t.Run("OrderFills", testOrderFillsReloadAll)
+	t.Run("Orders", testOrdersReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("Scripts", testScriptsReloadAll)
+	t.Run("Trades", testTradesReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
-	t.Run("Scripts", testScriptsSelect)
+	t.Run("Candles", testCandlesSelect)
+	t.Run("ConditionalOrders", testConditionalOrdersSelect)
+	t.Run("OrderFills", testOrderFillsSelect)
+	t.Run("Orders", testOrdersSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("Scripts", testScriptsSelect)
+	t.Run("Trades", testTradesSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
-	t.Run("Scripts", testScriptsUpdate)
+	t.Run("Candles", testCandlesUpdate)
+	t.Run("ConditionalOrders", testConditionalOrdersUpdate)
+	t.Run("OrderFills", testOrderFillsUpdate)
+	t.Run("Orders", testOrdersUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("Scripts", testScriptsUpdate)
+	t.Run("Trades", testTradesUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
-	t.Run("Scripts", testScriptsSliceUpdateAll)
+	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("ConditionalOrders", testConditionalOrdersSliceUpdateAll)
+	t.Run("OrderFills", testOrderFillsSliceUpdateAll)
+	t.Run("Orders", testOrdersSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("Scripts", testScriptsSliceUpdateAll)
+	t.Run("Trades", testTradesSliceUpdateAll)
 }
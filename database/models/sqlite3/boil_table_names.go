@@ -4,11 +4,21 @@
 package sqlite3
 
 var TableNames = struct {
-	AuditEvent      string
-	Script          string
-	ScriptExecution string
+	AuditEvent       string
+	Candle           string
+	ConditionalOrder string
+	OrderFill        string
+	Order            string
+	Script           string
+	ScriptExecution  string
+	Trade            string
 }{
-	AuditEvent:      "audit_event",
-	Script:          "script",
-	ScriptExecution: "script_execution",
+	AuditEvent:       "audit_event",
+	Candle:           "candle",
+	ConditionalOrder: "conditional_orders",
+	OrderFill:        "order_fills",
+	Order:            "orders",
+	Script:           "script",
+	ScriptExecution:  "script_execution",
+	Trade:            "trade",
 }
@@ -0,0 +1,205 @@
+package candle
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// TableTimeFormat is the format candle timestamps are stored in for
+// databases that lack a native time type
+const TableTimeFormat = "2006-01-02 15:04:05"
+
+var (
+	errNoDatabase   = errors.New("database is nil")
+	errNoCandleData = errors.New("no candle data provided")
+)
+
+// Insert bulk writes the candles held by a kline.Item to the database in a
+// single transaction. Candles which already exist for the same exchange, pair,
+// asset, interval and timestamp are ignored.
+func Insert(in *kline.Item) error {
+	if database.DB.SQL == nil {
+		return errNoDatabase
+	}
+
+	if in == nil || len(in.Candles) == 0 {
+		return errNoCandleData
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		err = insertSQLite(ctx, tx, in)
+	} else {
+		err = insertPostgres(ctx, tx, in)
+	}
+
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Candle insert transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertSQLite relies on the ON CONFLICT IGNORE unique constraint in the
+// sqlite3 candle table to skip duplicate rows
+func insertSQLite(ctx context.Context, tx boil.ContextExecutor, in *kline.Item) error {
+	for x := range in.Candles {
+		var tempCandle = modelSQLite.Candle{
+			ExchangeName: strings.ToLower(in.Exchange),
+			Base:         in.Pair.Base.Upper().String(),
+			Quote:        in.Pair.Quote.Upper().String(),
+			Asset:        in.Asset.String(),
+			Interval:     in.Interval.Short(),
+			Timestamp:    in.Candles[x].Time.UTC().Format(TableTimeFormat),
+			Open:         in.Candles[x].Open,
+			High:         in.Candles[x].High,
+			Low:          in.Candles[x].Low,
+			Close:        in.Candles[x].Close,
+			Volume:       in.Candles[x].Volume,
+		}
+		err := tempCandle.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx boil.ContextExecutor, in *kline.Item) error {
+	for x := range in.Candles {
+		var tempCandle = modelPSQL.Candle{
+			ExchangeName: strings.ToLower(in.Exchange),
+			Base:         in.Pair.Base.Upper().String(),
+			Quote:        in.Pair.Quote.Upper().String(),
+			Asset:        in.Asset.String(),
+			Interval:     in.Interval.Short(),
+			Timestamp:    in.Candles[x].Time.UTC(),
+			Open:         in.Candles[x].Open,
+			High:         in.Candles[x].High,
+			Low:          in.Candles[x].Low,
+			Close:        in.Candles[x].Close,
+			Volume:       in.Candles[x].Volume,
+		}
+		err := tempCandle.Upsert(ctx, tx, false,
+			[]string{"exchange_name", "base", "quote", "asset", "interval", "timestamp"},
+			boil.Infer(),
+			boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Series returns the stored candles for an exchange, pair, asset and interval
+// which open between start (inclusive) and end (exclusive) in ascending order
+func Series(exchangeName string, p currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (kline.Item, error) {
+	if database.DB.SQL == nil {
+		return kline.Item{}, errNoDatabase
+	}
+
+	ret := kline.Item{
+		Exchange: exchangeName,
+		Pair:     p,
+		Asset:    a,
+		Interval: interval,
+	}
+
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		candles, err := modelSQLite.Candles(
+			modelSQLite.CandleWhere.ExchangeName.EQ(strings.ToLower(exchangeName)),
+			modelSQLite.CandleWhere.Base.EQ(p.Base.Upper().String()),
+			modelSQLite.CandleWhere.Quote.EQ(p.Quote.Upper().String()),
+			modelSQLite.CandleWhere.Asset.EQ(a.String()),
+			modelSQLite.CandleWhere.Interval.EQ(interval.Short()),
+			modelSQLite.CandleWhere.Timestamp.GTE(start.UTC().Format(TableTimeFormat)),
+			modelSQLite.CandleWhere.Timestamp.LT(end.UTC().Format(TableTimeFormat)),
+			qm.OrderBy(modelSQLite.CandleColumns.Timestamp),
+		).All(ctx, database.DB.SQL)
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for x := range candles {
+			t, err := parseSQLiteTime(candles[x].Timestamp)
+			if err != nil {
+				return kline.Item{}, err
+			}
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   t,
+				Open:   candles[x].Open,
+				High:   candles[x].High,
+				Low:    candles[x].Low,
+				Close:  candles[x].Close,
+				Volume: candles[x].Volume,
+			})
+		}
+	} else {
+		candles, err := modelPSQL.Candles(
+			modelPSQL.CandleWhere.ExchangeName.EQ(strings.ToLower(exchangeName)),
+			modelPSQL.CandleWhere.Base.EQ(p.Base.Upper().String()),
+			modelPSQL.CandleWhere.Quote.EQ(p.Quote.Upper().String()),
+			modelPSQL.CandleWhere.Asset.EQ(a.String()),
+			modelPSQL.CandleWhere.Interval.EQ(interval.Short()),
+			modelPSQL.CandleWhere.Timestamp.GTE(start.UTC()),
+			modelPSQL.CandleWhere.Timestamp.LT(end.UTC()),
+			qm.OrderBy(modelPSQL.CandleColumns.Timestamp),
+		).All(ctx, database.DB.SQL)
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for x := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   candles[x].Timestamp.UTC(),
+				Open:   candles[x].Open,
+				High:   candles[x].High,
+				Low:    candles[x].Low,
+				Close:  candles[x].Close,
+				Volume: candles[x].Volume,
+			})
+		}
+	}
+
+	if len(ret.Candles) == 0 {
+		return ret, kline.ErrNoCandles
+	}
+
+	return ret, nil
+}
+
+// parseSQLiteTime converts a stored sqlite3 timestamp, the driver returns
+// timestamp columns in RFC3339 format however raw values are stored in
+// TableTimeFormat
+func parseSQLiteTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse(TableTimeFormat, s)
+}
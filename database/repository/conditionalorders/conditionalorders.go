@@ -0,0 +1,274 @@
+package conditionalorders
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// TableTimeFormat is the format conditional order timestamps are stored in for
+// databases that lack a native time type
+const TableTimeFormat = "2006-01-02 15:04:05.000"
+
+var (
+	errNoDatabase              = errors.New("database is nil")
+	errInvalidConditionalOrder = errors.New("conditional order requires an ID, exchange name and pair")
+)
+
+// Data defines a locally stored conditional order
+type Data struct {
+	ID              string
+	Exchange        string
+	Pair            currency.Pair
+	AssetType       asset.Item
+	Side            order.Side
+	ConditionType   string
+	OrderType       order.Type
+	TriggerPrice    float64
+	TrailingAmount  float64
+	TrailingPercent float64
+	LimitPrice      float64
+	Amount          float64
+	Watermark       float64
+	Status          string
+	ExchangeOrderID string
+	OurOrderID      string
+	Error           string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (d *Data) validate() error {
+	if d.ID == "" || d.Exchange == "" || d.Pair.IsEmpty() {
+		return errInvalidConditionalOrder
+	}
+	return nil
+}
+
+// Insert writes a new conditional order to the database
+func Insert(d *Data) error {
+	if database.DB.SQL == nil {
+		return errNoDatabase
+	}
+
+	err := d.validate()
+	if err != nil {
+		return err
+	}
+
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now()
+	}
+	if d.UpdatedAt.IsZero() {
+		d.UpdatedAt = d.CreatedAt
+	}
+
+	ctx := boil.SkipTimestamps(context.Background())
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var tempOrder = modelSQLite.ConditionalOrder{
+			ConditionalOrderID: d.ID,
+			ExchangeName:       strings.ToLower(d.Exchange),
+			Base:               d.Pair.Base.Upper().String(),
+			Quote:              d.Pair.Quote.Upper().String(),
+			Asset:              d.AssetType.String(),
+			Side:               d.Side.String(),
+			ConditionType:      d.ConditionType,
+			OrderType:          d.OrderType.String(),
+			TriggerPrice:       d.TriggerPrice,
+			TrailingAmount:     d.TrailingAmount,
+			TrailingPercent:    d.TrailingPercent,
+			LimitPrice:         d.LimitPrice,
+			Amount:             d.Amount,
+			Watermark:          d.Watermark,
+			Status:             d.Status,
+			ExchangeOrderID:    d.ExchangeOrderID,
+			OurOrderID:         d.OurOrderID,
+			ErrorMessage:       d.Error,
+			CreatedAt:          d.CreatedAt.UTC().Format(TableTimeFormat),
+			UpdatedAt:          d.UpdatedAt.UTC().Format(TableTimeFormat),
+		}
+		return tempOrder.Insert(ctx, database.DB.SQL, boil.Infer())
+	}
+
+	var tempOrder = modelPSQL.ConditionalOrder{
+		ConditionalOrderID: d.ID,
+		ExchangeName:       strings.ToLower(d.Exchange),
+		Base:               d.Pair.Base.Upper().String(),
+		Quote:              d.Pair.Quote.Upper().String(),
+		Asset:              d.AssetType.String(),
+		Side:               d.Side.String(),
+		ConditionType:      d.ConditionType,
+		OrderType:          d.OrderType.String(),
+		TriggerPrice:       d.TriggerPrice,
+		TrailingAmount:     d.TrailingAmount,
+		TrailingPercent:    d.TrailingPercent,
+		LimitPrice:         d.LimitPrice,
+		Amount:             d.Amount,
+		Watermark:          d.Watermark,
+		Status:             d.Status,
+		ExchangeOrderID:    d.ExchangeOrderID,
+		OurOrderID:         d.OurOrderID,
+		ErrorMessage:       d.Error,
+		CreatedAt:          d.CreatedAt.UTC(),
+		UpdatedAt:          d.UpdatedAt.UTC(),
+	}
+	return tempOrder.Insert(ctx, database.DB.SQL, boil.Infer())
+}
+
+// Update writes the status, trailing watermark, resulting order IDs and error
+// of an existing conditional order
+func Update(d *Data) error {
+	if database.DB.SQL == nil {
+		return errNoDatabase
+	}
+
+	if d.ID == "" {
+		return errInvalidConditionalOrder
+	}
+
+	if d.UpdatedAt.IsZero() {
+		d.UpdatedAt = time.Now()
+	}
+
+	ctx := boil.SkipTimestamps(context.Background())
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		stored, err := modelSQLite.ConditionalOrders(
+			modelSQLite.ConditionalOrderWhere.ConditionalOrderID.EQ(d.ID),
+		).One(ctx, database.DB.SQL)
+		if err != nil {
+			return err
+		}
+		stored.Watermark = d.Watermark
+		stored.Status = d.Status
+		stored.ExchangeOrderID = d.ExchangeOrderID
+		stored.OurOrderID = d.OurOrderID
+		stored.ErrorMessage = d.Error
+		stored.UpdatedAt = d.UpdatedAt.UTC().Format(TableTimeFormat)
+		_, err = stored.Update(ctx, database.DB.SQL, boil.Infer())
+		return err
+	}
+
+	stored, err := modelPSQL.ConditionalOrders(
+		modelPSQL.ConditionalOrderWhere.ConditionalOrderID.EQ(d.ID),
+	).One(ctx, database.DB.SQL)
+	if err != nil {
+		return err
+	}
+	stored.Watermark = d.Watermark
+	stored.Status = d.Status
+	stored.ExchangeOrderID = d.ExchangeOrderID
+	stored.OurOrderID = d.OurOrderID
+	stored.ErrorMessage = d.Error
+	stored.UpdatedAt = d.UpdatedAt.UTC()
+	_, err = stored.Update(ctx, database.DB.SQL, boil.Infer())
+	return err
+}
+
+// GetByStatus returns the stored conditional orders with a status in ascending
+// creation order
+func GetByStatus(status string) ([]Data, error) {
+	if database.DB.SQL == nil {
+		return nil, errNoDatabase
+	}
+	return get(qm.Where("status = ?", status))
+}
+
+func get(mods ...qm.QueryMod) ([]Data, error) {
+	var ret []Data
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		mods = append(mods, qm.OrderBy(modelSQLite.ConditionalOrderColumns.CreatedAt+", "+modelSQLite.ConditionalOrderColumns.ID))
+		stored, err := modelSQLite.ConditionalOrders(mods...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+
+		for x := range stored {
+			created, err := parseSQLiteTime(stored[x].CreatedAt)
+			if err != nil {
+				return nil, err
+			}
+			updated, err := parseSQLiteTime(stored[x].UpdatedAt)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, Data{
+				ID:       stored[x].ConditionalOrderID,
+				Exchange: stored[x].ExchangeName,
+				Pair: currency.NewPair(currency.NewCode(stored[x].Base),
+					currency.NewCode(stored[x].Quote)),
+				AssetType:       asset.Item(stored[x].Asset),
+				Side:            order.Side(stored[x].Side),
+				ConditionType:   stored[x].ConditionType,
+				OrderType:       order.Type(stored[x].OrderType),
+				TriggerPrice:    stored[x].TriggerPrice,
+				TrailingAmount:  stored[x].TrailingAmount,
+				TrailingPercent: stored[x].TrailingPercent,
+				LimitPrice:      stored[x].LimitPrice,
+				Amount:          stored[x].Amount,
+				Watermark:       stored[x].Watermark,
+				Status:          stored[x].Status,
+				ExchangeOrderID: stored[x].ExchangeOrderID,
+				OurOrderID:      stored[x].OurOrderID,
+				Error:           stored[x].ErrorMessage,
+				CreatedAt:       created,
+				UpdatedAt:       updated,
+			})
+		}
+		return ret, nil
+	}
+
+	mods = append(mods, qm.OrderBy(modelPSQL.ConditionalOrderColumns.CreatedAt+", "+modelPSQL.ConditionalOrderColumns.ID))
+	stored, err := modelPSQL.ConditionalOrders(mods...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+
+	for x := range stored {
+		ret = append(ret, Data{
+			ID:       stored[x].ConditionalOrderID,
+			Exchange: stored[x].ExchangeName,
+			Pair: currency.NewPair(currency.NewCode(stored[x].Base),
+				currency.NewCode(stored[x].Quote)),
+			AssetType:       asset.Item(stored[x].Asset),
+			Side:            order.Side(stored[x].Side),
+			ConditionType:   stored[x].ConditionType,
+			OrderType:       order.Type(stored[x].OrderType),
+			TriggerPrice:    stored[x].TriggerPrice,
+			TrailingAmount:  stored[x].TrailingAmount,
+			TrailingPercent: stored[x].TrailingPercent,
+			LimitPrice:      stored[x].LimitPrice,
+			Amount:          stored[x].Amount,
+			Watermark:       stored[x].Watermark,
+			Status:          stored[x].Status,
+			ExchangeOrderID: stored[x].ExchangeOrderID,
+			OurOrderID:      stored[x].OurOrderID,
+			Error:           stored[x].ErrorMessage,
+			CreatedAt:       stored[x].CreatedAt.UTC(),
+			UpdatedAt:       stored[x].UpdatedAt.UTC(),
+		})
+	}
+	return ret, nil
+}
+
+// parseSQLiteTime converts a stored sqlite3 timestamp, the driver returns
+// timestamp columns in RFC3339 format however raw values are stored in
+// TableTimeFormat
+func parseSQLiteTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse(TableTimeFormat, s)
+}
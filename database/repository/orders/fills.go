@@ -0,0 +1,203 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+var errInvalidFill = errors.New("fill requires an internal order ID, exchange name and pair")
+
+// Fill defines a locally stored execution against an order
+type Fill struct {
+	// OrderID is the internal ID of the filled order
+	OrderID   string
+	Exchange  string
+	TradeID   string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Side      order.Side
+	Price     float64
+	Amount    float64
+	Fee       float64
+	Timestamp time.Time
+}
+
+func (f *Fill) validate() error {
+	if f.OrderID == "" || f.Exchange == "" || f.Pair.IsEmpty() {
+		return errInvalidFill
+	}
+	return nil
+}
+
+// InsertFill writes an execution of an order to the database
+func InsertFill(f *Fill) error {
+	if database.DB.SQL == nil {
+		return errNoDatabase
+	}
+
+	err := f.validate()
+	if err != nil {
+		return err
+	}
+
+	if f.Timestamp.IsZero() {
+		f.Timestamp = time.Now()
+	}
+
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var tempFill = modelSQLite.OrderFill{
+			OurOrderID:   f.OrderID,
+			ExchangeName: strings.ToLower(f.Exchange),
+			TradeID:      f.TradeID,
+			Base:         f.Pair.Base.Upper().String(),
+			Quote:        f.Pair.Quote.Upper().String(),
+			Asset:        f.AssetType.String(),
+			Side:         f.Side.String(),
+			Price:        f.Price,
+			Amount:       f.Amount,
+			Fee:          f.Fee,
+			ExecutedAt:   f.Timestamp.UTC().Format(TableTimeFormat),
+		}
+		return tempFill.Insert(ctx, database.DB.SQL, boil.Infer())
+	}
+
+	var tempFill = modelPSQL.OrderFill{
+		OurOrderID:   f.OrderID,
+		ExchangeName: strings.ToLower(f.Exchange),
+		TradeID:      f.TradeID,
+		Base:         f.Pair.Base.Upper().String(),
+		Quote:        f.Pair.Quote.Upper().String(),
+		Asset:        f.AssetType.String(),
+		Side:         f.Side.String(),
+		Price:        f.Price,
+		Amount:       f.Amount,
+		Fee:          f.Fee,
+		ExecutedAt:   f.Timestamp.UTC(),
+	}
+	return tempFill.Insert(ctx, database.DB.SQL, boil.Infer())
+}
+
+// GetFills returns the stored executions of an order by its internal ID in
+// ascending execution order
+func GetFills(orderID string) ([]Fill, error) {
+	if database.DB.SQL == nil {
+		return nil, errNoDatabase
+	}
+	return getFills(qm.Where("our_order_id = ?", orderID))
+}
+
+// GetPairFills returns every stored execution on an exchange currency pair
+// and asset type in ascending execution order
+func GetPairFills(exchangeName string, p currency.Pair, a asset.Item) ([]Fill, error) {
+	if database.DB.SQL == nil {
+		return nil, errNoDatabase
+	}
+	return getFills(
+		qm.Where("exchange_name = ?", strings.ToLower(exchangeName)),
+		qm.Where("base = ?", p.Base.Upper().String()),
+		qm.Where("quote = ?", p.Quote.Upper().String()),
+		qm.Where("asset = ?", a.String()),
+	)
+}
+
+// GetFillsSince returns every stored execution since a time in ascending
+// execution order
+func GetFillsSince(since time.Time) ([]Fill, error) {
+	if database.DB.SQL == nil {
+		return nil, errNoDatabase
+	}
+	return getFills(executedAt(">=", since))
+}
+
+// GetPairFillsBefore returns the stored executions on an exchange currency
+// pair and asset type before a time in ascending execution order
+func GetPairFillsBefore(exchangeName string, p currency.Pair, a asset.Item, before time.Time) ([]Fill, error) {
+	if database.DB.SQL == nil {
+		return nil, errNoDatabase
+	}
+	return getFills(
+		qm.Where("exchange_name = ?", strings.ToLower(exchangeName)),
+		qm.Where("base = ?", p.Base.Upper().String()),
+		qm.Where("quote = ?", p.Quote.Upper().String()),
+		qm.Where("asset = ?", a.String()),
+		executedAt("<", before),
+	)
+}
+
+// executedAt compares the execution time of stored fills with a time, sqlite3
+// timestamps are stored in TableTimeFormat so they compare as text
+func executedAt(op string, t time.Time) qm.QueryMod {
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		return qm.Where("executed_at "+op+" ?", t.UTC().Format(TableTimeFormat))
+	}
+	return qm.Where("executed_at "+op+" ?", t.UTC())
+}
+
+func getFills(mods ...qm.QueryMod) ([]Fill, error) {
+	var ret []Fill
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		mods = append(mods, qm.OrderBy(modelSQLite.OrderFillColumns.ExecutedAt+", "+modelSQLite.OrderFillColumns.ID))
+		stored, err := modelSQLite.OrderFills(mods...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+
+		for x := range stored {
+			executed, err := parseSQLiteTime(stored[x].ExecutedAt)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, Fill{
+				OrderID:  stored[x].OurOrderID,
+				Exchange: stored[x].ExchangeName,
+				TradeID:  stored[x].TradeID,
+				Pair: currency.NewPair(currency.NewCode(stored[x].Base),
+					currency.NewCode(stored[x].Quote)),
+				AssetType: asset.Item(stored[x].Asset),
+				Side:      order.Side(stored[x].Side),
+				Price:     stored[x].Price,
+				Amount:    stored[x].Amount,
+				Fee:       stored[x].Fee,
+				Timestamp: executed,
+			})
+		}
+		return ret, nil
+	}
+
+	mods = append(mods, qm.OrderBy(modelPSQL.OrderFillColumns.ExecutedAt+", "+modelPSQL.OrderFillColumns.ID))
+	stored, err := modelPSQL.OrderFills(mods...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+
+	for x := range stored {
+		ret = append(ret, Fill{
+			OrderID:  stored[x].OurOrderID,
+			Exchange: stored[x].ExchangeName,
+			TradeID:  stored[x].TradeID,
+			Pair: currency.NewPair(currency.NewCode(stored[x].Base),
+				currency.NewCode(stored[x].Quote)),
+			AssetType: asset.Item(stored[x].Asset),
+			Side:      order.Side(stored[x].Side),
+			Price:     stored[x].Price,
+			Amount:    stored[x].Amount,
+			Fee:       stored[x].Fee,
+			Timestamp: stored[x].ExecutedAt.UTC(),
+		})
+	}
+	return ret, nil
+}
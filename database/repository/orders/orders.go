@@ -0,0 +1,315 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// TableTimeFormat is the format order timestamps are stored in for databases
+// that lack a native time type
+const TableTimeFormat = "2006-01-02 15:04:05.000"
+
+var (
+	errNoDatabase    = errors.New("database is nil")
+	errInvalidOrder  = errors.New("order requires an internal ID, exchange name and pair")
+	errOrderNotFound = errors.New("order not found")
+)
+
+// closedStatuses are the order statuses which can no longer change on an
+// exchange
+var closedStatuses = []string{
+	order.Filled.String(),
+	order.Cancelled.String(),
+	order.PartiallyCancelled.String(),
+	order.Rejected.String(),
+	order.Expired.String(),
+}
+
+// Data defines a locally stored order
+type Data struct {
+	// ID is the internal order ID returned to the caller on submission
+	ID              string
+	Exchange        string
+	ExchangeOrderID string
+	ClientID        string
+	AccountID       string
+	Pair            currency.Pair
+	AssetType       asset.Item
+	Side            order.Side
+	Type            order.Type
+	Status          order.Status
+	Price           float64
+	Amount          float64
+	ExecutedAmount  float64
+	RemainingAmount float64
+	Fee             float64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// IsOpen returns if the order status can still change on the exchange
+func (d *Data) IsOpen() bool {
+	for x := range closedStatuses {
+		if d.Status.String() == closedStatuses[x] {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *Data) validate() error {
+	if d.ID == "" || d.Exchange == "" || d.Pair.IsEmpty() {
+		return errInvalidOrder
+	}
+	return nil
+}
+
+// Insert writes a new order to the database
+func Insert(d *Data) error {
+	if database.DB.SQL == nil {
+		return errNoDatabase
+	}
+
+	err := d.validate()
+	if err != nil {
+		return err
+	}
+
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now()
+	}
+	if d.UpdatedAt.IsZero() {
+		d.UpdatedAt = d.CreatedAt
+	}
+
+	ctx := boil.SkipTimestamps(context.Background())
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var tempOrder = modelSQLite.Order{
+			OurOrderID:      d.ID,
+			ExchangeName:    strings.ToLower(d.Exchange),
+			ExchangeOrderID: d.ExchangeOrderID,
+			ClientID:        d.ClientID,
+			AccountID:       d.AccountID,
+			Base:            d.Pair.Base.Upper().String(),
+			Quote:           d.Pair.Quote.Upper().String(),
+			Asset:           d.AssetType.String(),
+			Side:            d.Side.String(),
+			OrderType:       d.Type.String(),
+			Status:          d.Status.String(),
+			Price:           d.Price,
+			Amount:          d.Amount,
+			ExecutedAmount:  d.ExecutedAmount,
+			RemainingAmount: d.RemainingAmount,
+			Fee:             d.Fee,
+			CreatedAt:       d.CreatedAt.UTC().Format(TableTimeFormat),
+			UpdatedAt:       d.UpdatedAt.UTC().Format(TableTimeFormat),
+		}
+		return tempOrder.Insert(ctx, database.DB.SQL, boil.Infer())
+	}
+
+	var tempOrder = modelPSQL.Order{
+		OurOrderID:      d.ID,
+		ExchangeName:    strings.ToLower(d.Exchange),
+		ExchangeOrderID: d.ExchangeOrderID,
+		ClientID:        d.ClientID,
+		AccountID:       d.AccountID,
+		Base:            d.Pair.Base.Upper().String(),
+		Quote:           d.Pair.Quote.Upper().String(),
+		Asset:           d.AssetType.String(),
+		Side:            d.Side.String(),
+		OrderType:       d.Type.String(),
+		Status:          d.Status.String(),
+		Price:           d.Price,
+		Amount:          d.Amount,
+		ExecutedAmount:  d.ExecutedAmount,
+		RemainingAmount: d.RemainingAmount,
+		Fee:             d.Fee,
+		CreatedAt:       d.CreatedAt.UTC(),
+		UpdatedAt:       d.UpdatedAt.UTC(),
+	}
+	return tempOrder.Insert(ctx, database.DB.SQL, boil.Infer())
+}
+
+// Update writes the exchange order ID, status, fill amounts and fee of an
+// existing order identified by its internal ID
+func Update(d *Data) error {
+	if database.DB.SQL == nil {
+		return errNoDatabase
+	}
+
+	if d.ID == "" {
+		return errInvalidOrder
+	}
+
+	if d.UpdatedAt.IsZero() {
+		d.UpdatedAt = time.Now()
+	}
+
+	ctx := boil.SkipTimestamps(context.Background())
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		stored, err := modelSQLite.Orders(
+			modelSQLite.OrderWhere.OurOrderID.EQ(d.ID),
+		).One(ctx, database.DB.SQL)
+		if err != nil {
+			return err
+		}
+		stored.ExchangeOrderID = d.ExchangeOrderID
+		stored.Status = d.Status.String()
+		stored.Price = d.Price
+		stored.Amount = d.Amount
+		stored.ExecutedAmount = d.ExecutedAmount
+		stored.RemainingAmount = d.RemainingAmount
+		stored.Fee = d.Fee
+		stored.UpdatedAt = d.UpdatedAt.UTC().Format(TableTimeFormat)
+		_, err = stored.Update(ctx, database.DB.SQL, boil.Infer())
+		return err
+	}
+
+	stored, err := modelPSQL.Orders(
+		modelPSQL.OrderWhere.OurOrderID.EQ(d.ID),
+	).One(ctx, database.DB.SQL)
+	if err != nil {
+		return err
+	}
+	stored.ExchangeOrderID = d.ExchangeOrderID
+	stored.Status = d.Status.String()
+	stored.Price = d.Price
+	stored.Amount = d.Amount
+	stored.ExecutedAmount = d.ExecutedAmount
+	stored.RemainingAmount = d.RemainingAmount
+	stored.Fee = d.Fee
+	stored.UpdatedAt = d.UpdatedAt.UTC()
+	_, err = stored.Update(ctx, database.DB.SQL, boil.Infer())
+	return err
+}
+
+// GetByID returns the stored order with the internal ID
+func GetByID(id string) (*Data, error) {
+	if database.DB.SQL == nil {
+		return nil, errNoDatabase
+	}
+
+	ret, err := get(qm.Where("our_order_id = ?", id))
+	if err != nil {
+		return nil, err
+	}
+	if len(ret) == 0 {
+		return nil, errOrderNotFound
+	}
+	return &ret[0], nil
+}
+
+// GetOpen returns the stored orders which have not reached a closed status in
+// ascending creation order, an empty exchange name returns every exchange
+func GetOpen(exchangeName string) ([]Data, error) {
+	if database.DB.SQL == nil {
+		return nil, errNoDatabase
+	}
+
+	closed := make([]interface{}, len(closedStatuses))
+	for x := range closedStatuses {
+		closed[x] = closedStatuses[x]
+	}
+	mods := []qm.QueryMod{qm.WhereIn("status not in ?", closed...)}
+	if exchangeName != "" {
+		mods = append(mods, qm.Where("exchange_name = ?", strings.ToLower(exchangeName)))
+	}
+	return get(mods...)
+}
+
+func get(mods ...qm.QueryMod) ([]Data, error) {
+	var ret []Data
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		mods = append(mods, qm.OrderBy(modelSQLite.OrderColumns.CreatedAt+", "+modelSQLite.OrderColumns.ID))
+		stored, err := modelSQLite.Orders(mods...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+
+		for x := range stored {
+			created, err := parseSQLiteTime(stored[x].CreatedAt)
+			if err != nil {
+				return nil, err
+			}
+			updated, err := parseSQLiteTime(stored[x].UpdatedAt)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, Data{
+				ID:              stored[x].OurOrderID,
+				Exchange:        stored[x].ExchangeName,
+				ExchangeOrderID: stored[x].ExchangeOrderID,
+				ClientID:        stored[x].ClientID,
+				AccountID:       stored[x].AccountID,
+				Pair: currency.NewPair(currency.NewCode(stored[x].Base),
+					currency.NewCode(stored[x].Quote)),
+				AssetType:       asset.Item(stored[x].Asset),
+				Side:            order.Side(stored[x].Side),
+				Type:            order.Type(stored[x].OrderType),
+				Status:          order.Status(stored[x].Status),
+				Price:           stored[x].Price,
+				Amount:          stored[x].Amount,
+				ExecutedAmount:  stored[x].ExecutedAmount,
+				RemainingAmount: stored[x].RemainingAmount,
+				Fee:             stored[x].Fee,
+				CreatedAt:       created,
+				UpdatedAt:       updated,
+			})
+		}
+		return ret, nil
+	}
+
+	mods = append(mods, qm.OrderBy(modelPSQL.OrderColumns.CreatedAt+", "+modelPSQL.OrderColumns.ID))
+	stored, err := modelPSQL.Orders(mods...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+
+	for x := range stored {
+		ret = append(ret, Data{
+			ID:              stored[x].OurOrderID,
+			Exchange:        stored[x].ExchangeName,
+			ExchangeOrderID: stored[x].ExchangeOrderID,
+			ClientID:        stored[x].ClientID,
+			AccountID:       stored[x].AccountID,
+			Pair: currency.NewPair(currency.NewCode(stored[x].Base),
+				currency.NewCode(stored[x].Quote)),
+			AssetType:       asset.Item(stored[x].Asset),
+			Side:            order.Side(stored[x].Side),
+			Type:            order.Type(stored[x].OrderType),
+			Status:          order.Status(stored[x].Status),
+			Price:           stored[x].Price,
+			Amount:          stored[x].Amount,
+			ExecutedAmount:  stored[x].ExecutedAmount,
+			RemainingAmount: stored[x].RemainingAmount,
+			Fee:             stored[x].Fee,
+			CreatedAt:       stored[x].CreatedAt.UTC(),
+			UpdatedAt:       stored[x].UpdatedAt.UTC(),
+		})
+	}
+	return ret, nil
+}
+
+// parseSQLiteTime converts a stored sqlite3 timestamp, the driver returns
+// timestamp columns in RFC3339 format however raw values are stored in
+// TableTimeFormat
+func parseSQLiteTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse(TableTimeFormat, s)
+}
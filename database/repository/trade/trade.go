@@ -0,0 +1,206 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// TableTimeFormat is the format trade timestamps are stored in for databases
+// that lack a native time type
+const TableTimeFormat = "2006-01-02 15:04:05.000"
+
+var (
+	errNoDatabase    = errors.New("database is nil")
+	errNoTradeData   = errors.New("no trade data provided")
+	errInvalidTrades = errors.New("trade requires an exchange name, pair and asset")
+)
+
+// Data defines a single executed trade print
+type Data struct {
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Price     float64
+	Amount    float64
+	Side      string
+	TID       string
+	Timestamp time.Time
+}
+
+// Insert bulk writes trades to the database in a single transaction
+func Insert(trades ...Data) error {
+	if database.DB.SQL == nil {
+		return errNoDatabase
+	}
+
+	if len(trades) == 0 {
+		return errNoTradeData
+	}
+
+	for x := range trades {
+		if trades[x].Exchange == "" ||
+			trades[x].Pair.IsEmpty() ||
+			trades[x].AssetType == "" {
+			return errInvalidTrades
+		}
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		err = insertSQLite(ctx, tx, trades)
+	} else {
+		err = insertPostgres(ctx, tx, trades)
+	}
+
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Trade insert transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx boil.ContextExecutor, trades []Data) error {
+	for x := range trades {
+		var tempTrade = modelSQLite.Trade{
+			ExchangeName: strings.ToLower(trades[x].Exchange),
+			Base:         trades[x].Pair.Base.Upper().String(),
+			Quote:        trades[x].Pair.Quote.Upper().String(),
+			Asset:        trades[x].AssetType.String(),
+			Price:        trades[x].Price,
+			Amount:       trades[x].Amount,
+			Side:         trades[x].Side,
+			Tid:          trades[x].TID,
+			Timestamp:    trades[x].Timestamp.UTC().Format(TableTimeFormat),
+		}
+		err := tempTrade.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx boil.ContextExecutor, trades []Data) error {
+	for x := range trades {
+		var tempTrade = modelPSQL.Trade{
+			ExchangeName: strings.ToLower(trades[x].Exchange),
+			Base:         trades[x].Pair.Base.Upper().String(),
+			Quote:        trades[x].Pair.Quote.Upper().String(),
+			Asset:        trades[x].AssetType.String(),
+			Price:        trades[x].Price,
+			Amount:       trades[x].Amount,
+			Side:         trades[x].Side,
+			Tid:          trades[x].TID,
+			Timestamp:    trades[x].Timestamp.UTC(),
+		}
+		err := tempTrade.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetInRange returns the stored trades for an exchange, pair and asset which
+// occurred between start (inclusive) and end (exclusive) in ascending order
+func GetInRange(exchangeName string, p currency.Pair, a asset.Item, start, end time.Time) ([]Data, error) {
+	if database.DB.SQL == nil {
+		return nil, errNoDatabase
+	}
+
+	var ret []Data
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		trades, err := modelSQLite.Trades(
+			modelSQLite.TradeWhere.ExchangeName.EQ(strings.ToLower(exchangeName)),
+			modelSQLite.TradeWhere.Base.EQ(p.Base.Upper().String()),
+			modelSQLite.TradeWhere.Quote.EQ(p.Quote.Upper().String()),
+			modelSQLite.TradeWhere.Asset.EQ(a.String()),
+			modelSQLite.TradeWhere.Timestamp.GTE(start.UTC().Format(TableTimeFormat)),
+			modelSQLite.TradeWhere.Timestamp.LT(end.UTC().Format(TableTimeFormat)),
+			qm.OrderBy(modelSQLite.TradeColumns.Timestamp+", "+modelSQLite.TradeColumns.ID),
+		).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+
+		for x := range trades {
+			t, err := parseSQLiteTime(trades[x].Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, Data{
+				Exchange:  exchangeName,
+				Pair:      p,
+				AssetType: a,
+				Price:     trades[x].Price,
+				Amount:    trades[x].Amount,
+				Side:      trades[x].Side,
+				TID:       trades[x].Tid,
+				Timestamp: t,
+			})
+		}
+		return ret, nil
+	}
+
+	trades, err := modelPSQL.Trades(
+		modelPSQL.TradeWhere.ExchangeName.EQ(strings.ToLower(exchangeName)),
+		modelPSQL.TradeWhere.Base.EQ(p.Base.Upper().String()),
+		modelPSQL.TradeWhere.Quote.EQ(p.Quote.Upper().String()),
+		modelPSQL.TradeWhere.Asset.EQ(a.String()),
+		modelPSQL.TradeWhere.Timestamp.GTE(start.UTC()),
+		modelPSQL.TradeWhere.Timestamp.LT(end.UTC()),
+		qm.OrderBy(modelPSQL.TradeColumns.Timestamp+", "+modelPSQL.TradeColumns.ID),
+	).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+
+	for x := range trades {
+		ret = append(ret, Data{
+			Exchange:  exchangeName,
+			Pair:      p,
+			AssetType: a,
+			Price:     trades[x].Price,
+			Amount:    trades[x].Amount,
+			Side:      trades[x].Side,
+			TID:       trades[x].Tid,
+			Timestamp: trades[x].Timestamp.UTC(),
+		})
+	}
+	return ret, nil
+}
+
+// parseSQLiteTime converts a stored sqlite3 timestamp, the driver returns
+// timestamp columns in RFC3339 format however raw values are stored in
+// TableTimeFormat
+func parseSQLiteTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse(TableTimeFormat, s)
+}
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/goose"
+)
+
+var candleTestStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestCandle(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			writeCandles,
+			closeDatabase,
+		},
+		{
+			"SQLite-Read",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			readCandles,
+			closeDatabase,
+		},
+		{
+			"Postgres-Write",
+			postgresTestDatabase,
+			writeCandles,
+			nil,
+		},
+		{
+			"Postgres-Read",
+			postgresTestDatabase,
+			readCandles,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func genCandles(count int) *kline.Item {
+	item := &kline.Item{
+		Exchange: "Binance",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: kline.OneHour,
+	}
+	for x := 0; x < count; x++ {
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:   candleTestStart.Add(time.Hour * time.Duration(x)),
+			Open:   float64(x),
+			High:   float64(x + 2),
+			Low:    float64(x - 1),
+			Close:  float64(x + 1),
+			Volume: 1,
+		})
+	}
+	return item
+}
+
+func writeCandles(t *testing.T) {
+	t.Helper()
+
+	err := candle.Insert(genCandles(24))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Duplicate candles are ignored
+	err = candle.Insert(genCandles(48))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = candle.Insert(&kline.Item{})
+	if err == nil {
+		t.Error("expected error when inserting no candles")
+	}
+}
+
+func readCandles(t *testing.T) {
+	t.Helper()
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	ret, err := candle.Series("Binance", p, asset.Spot, kline.OneHour,
+		candleTestStart, candleTestStart.Add(time.Hour*24))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ret.Candles) != 24 {
+		t.Fatalf("expected 24 candles received %d", len(ret.Candles))
+	}
+
+	if !ret.Candles[0].Time.Equal(candleTestStart) {
+		t.Errorf("expected first candle at %v received %v",
+			candleTestStart, ret.Candles[0].Time)
+	}
+
+	_, err = candle.Series("Binance", p, asset.Spot, kline.OneDay,
+		candleTestStart, candleTestStart.Add(time.Hour*24))
+	if err != kline.ErrNoCandles {
+		t.Errorf("expected %v received %v", kline.ErrNoCandles, err)
+	}
+}
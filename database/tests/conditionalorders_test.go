@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/conditionalorders"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestConditionalOrders(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite-WriteRead",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			writeReadConditionalOrders,
+			closeDatabase,
+		},
+		{
+			"Postgres-WriteRead",
+			postgresTestDatabase,
+			writeReadConditionalOrders,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func writeReadConditionalOrders(t *testing.T) {
+	t.Helper()
+
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var ids []string
+	for x := 0; x < 3; x++ {
+		id, err := uuid.NewV4()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id.String())
+		err = conditionalorders.Insert(&conditionalorders.Data{
+			ID:             id.String(),
+			Exchange:       "Bitstamp",
+			Pair:           currency.NewPair(currency.BTC, currency.USD),
+			AssetType:      asset.Spot,
+			Side:           order.Sell,
+			ConditionType:  "TRAILING_STOP",
+			OrderType:      order.Market,
+			TrailingAmount: 50,
+			Amount:         1,
+			Watermark:      1000,
+			Status:         "ACTIVE",
+			CreatedAt:      created.Add(time.Second * time.Duration(x)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := conditionalorders.Insert(&conditionalorders.Data{Amount: 1})
+	if err == nil {
+		t.Error("expected error when inserting an invalid conditional order")
+	}
+
+	err = conditionalorders.Update(&conditionalorders.Data{
+		ID:        ids[0],
+		Watermark: 1100,
+		Status:    "ACTIVE",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = conditionalorders.Update(&conditionalorders.Data{
+		ID:              ids[1],
+		Watermark:       1000,
+		Status:          "SUBMITTED",
+		ExchangeOrderID: "1337",
+		OurOrderID:      ids[1],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	active, err := conditionalorders.GetByStatus("ACTIVE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := make(map[string]conditionalorders.Data)
+	for x := range active {
+		found[active[x].ID] = active[x]
+	}
+	if _, ok := found[ids[1]]; ok {
+		t.Errorf("expected %s to no longer be active", ids[1])
+	}
+	stored, ok := found[ids[0]]
+	if !ok {
+		t.Fatalf("expected %s to be active", ids[0])
+	}
+	if stored.Watermark != 1100 ||
+		stored.TrailingAmount != 50 ||
+		stored.Exchange != "bitstamp" ||
+		stored.Side != order.Sell ||
+		!stored.CreatedAt.Equal(created) {
+		t.Errorf("unexpected stored conditional order %+v", stored)
+	}
+	if _, ok = found[ids[2]]; !ok {
+		t.Errorf("expected %s to be active", ids[2])
+	}
+}
@@ -0,0 +1,277 @@
+package tests
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orders"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestOrders(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite-WriteRead",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			writeReadOrders,
+			closeDatabase,
+		},
+		{
+			"Postgres-WriteRead",
+			postgresTestDatabase,
+			writeReadOrders,
+			nil,
+		},
+		{
+			"SQLite-WriteReadFills",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			writeReadFills,
+			closeDatabase,
+		},
+		{
+			"Postgres-WriteReadFills",
+			postgresTestDatabase,
+			writeReadFills,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func writeReadOrders(t *testing.T) {
+	t.Helper()
+
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var ids []string
+	for x := 0; x < 3; x++ {
+		id, err := uuid.NewV4()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id.String())
+		err = orders.Insert(&orders.Data{
+			ID:              id.String(),
+			Exchange:        "Bitstamp",
+			ExchangeOrderID: id.String(),
+			ClientID:        "test",
+			Pair:            currency.NewPair(currency.BTC, currency.USD),
+			AssetType:       asset.Spot,
+			Side:            order.Buy,
+			Type:            order.Limit,
+			Status:          order.New,
+			Price:           1000,
+			Amount:          1,
+			RemainingAmount: 1,
+			CreatedAt:       created.Add(time.Second * time.Duration(x)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := orders.Insert(&orders.Data{Price: 1})
+	if err == nil {
+		t.Error("expected error when inserting an invalid order")
+	}
+
+	err = orders.Update(&orders.Data{
+		ID:              ids[0],
+		ExchangeOrderID: ids[0],
+		Status:          order.PartiallyFilled,
+		Price:           1000,
+		Amount:          1,
+		ExecutedAmount:  0.4,
+		RemainingAmount: 0.6,
+		Fee:             0.1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = orders.Update(&orders.Data{
+		ID:              ids[1],
+		ExchangeOrderID: ids[1],
+		Status:          order.Cancelled,
+		Price:           1000,
+		Amount:          1,
+		RemainingAmount: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := orders.GetByID(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != order.PartiallyFilled ||
+		stored.ExecutedAmount != 0.4 ||
+		stored.Fee != 0.1 ||
+		stored.Exchange != "bitstamp" ||
+		stored.ClientID != "test" ||
+		!stored.CreatedAt.Equal(created) {
+		t.Errorf("unexpected stored order %+v", stored)
+	}
+
+	open, err := orders.GetOpen("Bitstamp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := make(map[string]bool)
+	for x := range open {
+		found[open[x].ID] = true
+	}
+	if !found[ids[0]] || found[ids[1]] || !found[ids[2]] {
+		t.Errorf("expected open orders %s and %s only", ids[0], ids[2])
+	}
+
+	_, err = orders.GetByID("not a stored order")
+	if err == nil {
+		t.Error("expected error when getting an unknown order")
+	}
+}
+
+func writeReadFills(t *testing.T) {
+	t.Helper()
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := currency.NewPair(currency.BTC, currency.USD)
+	executed := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for x := 2; x >= 0; x-- {
+		err = orders.InsertFill(&orders.Fill{
+			OrderID:   id.String(),
+			Exchange:  "Bitstamp",
+			TradeID:   strconv.Itoa(x),
+			Pair:      p,
+			AssetType: asset.Spot,
+			Side:      order.Buy,
+			Price:     1000,
+			Amount:    0.1,
+			Fee:       0.01,
+			Timestamp: executed.Add(time.Second * time.Duration(x)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = orders.InsertFill(&orders.Fill{Price: 1})
+	if err == nil {
+		t.Error("expected error when inserting an invalid fill")
+	}
+
+	fills, err := orders.GetFills(id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 3 {
+		t.Fatalf("expected 3 fills received %d", len(fills))
+	}
+	for x := range fills {
+		if fills[x].TradeID != strconv.Itoa(x) ||
+			fills[x].Exchange != "bitstamp" ||
+			fills[x].AssetType != asset.Spot ||
+			fills[x].Side != order.Buy ||
+			fills[x].Amount != 0.1 ||
+			!fills[x].Timestamp.Equal(executed.Add(time.Second*time.Duration(x))) {
+			t.Errorf("unexpected stored fill %+v", fills[x])
+		}
+	}
+
+	fills, err = orders.GetPairFills("Bitstamp", p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) < 3 {
+		t.Errorf("expected at least 3 pair fills received %d", len(fills))
+	}
+
+	fills, err = orders.GetPairFills("Bitstamp", p, asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 0 {
+		t.Errorf("expected no futures fills received %d", len(fills))
+	}
+
+	since := executed.Add(time.Second)
+	fills, err = orders.GetFillsSince(since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) < 2 {
+		t.Errorf("expected at least 2 fills since %v received %d", since, len(fills))
+	}
+	for x := range fills {
+		if fills[x].Timestamp.Before(since) {
+			t.Errorf("expected fills since %v received %v", since, fills[x].Timestamp)
+		}
+	}
+
+	fills, err = orders.GetPairFillsBefore("Bitstamp", p, asset.Spot, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) < 1 {
+		t.Errorf("expected at least 1 pair fill before %v received %d", since, len(fills))
+	}
+	for x := range fills {
+		if !fills[x].Timestamp.Before(since) {
+			t.Errorf("expected fills before %v received %v", since, fills[x].Timestamp)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/goose"
+)
+
+var tradeTestStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestTrade(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			writeTrades,
+			closeDatabase,
+		},
+		{
+			"SQLite-Read",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			readTrades,
+			closeDatabase,
+		},
+		{
+			"Postgres-Write",
+			postgresTestDatabase,
+			writeTrades,
+			nil,
+		},
+		{
+			"Postgres-Read",
+			postgresTestDatabase,
+			readTrades,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func writeTrades(t *testing.T) {
+	t.Helper()
+
+	var trades []trade.Data
+	for x := 0; x < 20; x++ {
+		trades = append(trades, trade.Data{
+			Exchange:  "Bitstamp",
+			Pair:      currency.NewPair(currency.BTC, currency.USD),
+			AssetType: asset.Spot,
+			Price:     float64(x + 1),
+			Amount:    1,
+			Side:      "buy",
+			Timestamp: tradeTestStart.Add(time.Millisecond * 500 * time.Duration(x)),
+		})
+	}
+
+	err := trade.Insert(trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = trade.Insert()
+	if err == nil {
+		t.Error("expected error when inserting no trades")
+	}
+
+	err = trade.Insert(trade.Data{Price: 1})
+	if err == nil {
+		t.Error("expected error when inserting an invalid trade")
+	}
+}
+
+func readTrades(t *testing.T) {
+	t.Helper()
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	ret, err := trade.GetInRange("Bitstamp", p, asset.Spot,
+		tradeTestStart, tradeTestStart.Add(time.Second*5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ret) != 10 {
+		t.Fatalf("expected 10 trades received %d", len(ret))
+	}
+
+	if !ret[1].Timestamp.Equal(tradeTestStart.Add(time.Millisecond * 500)) {
+		t.Errorf("expected second trade at %v received %v",
+			tradeTestStart.Add(time.Millisecond*500), ret[1].Timestamp)
+	}
+}
@@ -13,7 +13,7 @@ import (
 
 func init() {
 	dispatcher = &Dispatcher{
-		routes: make(map[uuid.UUID][]chan interface{}),
+		routes: make(map[uuid.UUID][]*pipe),
 		outbound: sync.Pool{
 			New: func() interface{} {
 				// Create unbuffered channel for data pass
@@ -67,6 +67,17 @@ func DropWorker() error {
 	return nil
 }
 
+// GetStats returns the state of the dispatch system and the published,
+// dropped and lag counters of every subscribed pipe
+func GetStats() (Stats, error) {
+	if dispatcher == nil {
+		return Stats{}, errors.New(errNotInitialised)
+	}
+	mtx.Lock()
+	defer mtx.Unlock()
+	return dispatcher.stats(), nil
+}
+
 // SpawnWorker starts a new worker routine
 func SpawnWorker() error {
 	if dispatcher == nil {
@@ -133,7 +144,7 @@ func (d *Dispatcher) stop() error {
 		// close all routes
 		for key := range d.routes {
 			for i := range d.routes[key] {
-				close(d.routes[key][i])
+				close(d.routes[key][i].ch)
 			}
 
 			d.routes[key] = nil
@@ -190,29 +201,11 @@ func (d *Dispatcher) relayer(i *sync.WaitGroup) {
 				d.rMtx.RUnlock()
 				continue
 			}
-			// Channel handshake timeout feature if a channel is blocked for any
-			// period of time due to an issue with the receiving routine.
-			// This will wait on channel then fall over to the next route when
-			// the timer actuates and continue over the route list. Have to
-			// iterate across full length of routes so every routine can get
-			// their new info, cannot be buffered as we dont want to have an old
-			// orderbook etc contained in a buffered channel when a routine
-			// actually is ready for a receive.
-			// TODO: Need to consider optimal timer length
+			// Have to iterate across full length of routes so every routine
+			// can get their new info, each pipe policy decides what happens
+			// when its receiving routine is not ready. See pipe.relay().
 			for i := range d.routes[j.ID] {
-				if !timeout.Stop() { // Stop timer before reset
-					// Drain channel if timer has already actuated
-					select {
-					case <-timeout.C:
-					default:
-					}
-				}
-
-				timeout.Reset(DefaultHandshakeTimeout)
-				select {
-				case d.routes[j.ID][i] <- j.Data:
-				case <-timeout.C:
-				}
+				d.routes[j.ID][i].relay(j.Data, timeout)
 			}
 			d.rMtx.RUnlock()
 
@@ -257,6 +250,7 @@ func (d *Dispatcher) publish(id uuid.UUID, data interface{}) error {
 	select {
 	case d.jobs <- newJob:
 	default:
+		atomic.AddUint64(&d.droppedJobs, 1)
 		return fmt.Errorf("dispatcher jobs at limit [%d] current worker count [%d]. Spawn more workers via --dispatchworkers=x"+
 			", or increase the jobs limit via --dispatchjobslimit=x",
 			len(d.jobs),
@@ -269,11 +263,16 @@ func (d *Dispatcher) publish(id uuid.UUID, data interface{}) error {
 // Subscribe subscribes a system and returns a communication chan, this does not
 // ensure initial push. If your routine is out of sync with heartbeat and the
 // system does not get a change, its up to you to in turn get initial state.
-func (d *Dispatcher) subscribe(id uuid.UUID) (chan interface{}, error) {
+func (d *Dispatcher) subscribe(id uuid.UUID, opts SubscribeOptions) (chan interface{}, error) {
 	if atomic.LoadUint32(&d.running) == 0 {
 		return nil, errors.New(errNotInitialised)
 	}
 
+	opts, err := checkSubscribeOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	// Read lock to read route list
 	d.rMtx.RLock()
 	_, ok := d.routes[id]
@@ -282,12 +281,22 @@ func (d *Dispatcher) subscribe(id uuid.UUID) (chan interface{}, error) {
 		return nil, errors.New("dispatcher uuid not found in route list")
 	}
 
-	// Get an unused channel from the channel pool
-	unusedChan := d.outbound.Get().(chan interface{})
+	// Get an unused channel from the channel pool, buffered channels are
+	// sized per pipe so are not pooled
+	var unusedChan chan interface{}
+	if opts.BufferSize == 0 {
+		unusedChan = d.outbound.Get().(chan interface{})
+	} else {
+		unusedChan = make(chan interface{}, opts.BufferSize)
+	}
 
 	// Lock for writing to the route list
 	d.rMtx.Lock()
-	d.routes[id] = append(d.routes[id], unusedChan)
+	d.routes[id] = append(d.routes[id], &pipe{
+		ch:         unusedChan,
+		opts:       opts,
+		subscribed: time.Now(),
+	})
 	d.rMtx.Unlock()
 
 	return unusedChan, nil
@@ -311,7 +320,7 @@ func (d *Dispatcher) unsubscribe(id uuid.UUID, usedChan chan interface{}) error
 	// Lock for write to delete references
 	d.rMtx.Lock()
 	for i := range d.routes[id] {
-		if d.routes[id][i] != usedChan {
+		if d.routes[id][i].ch != usedChan {
 			continue
 		}
 		// Delete individual reference
@@ -321,6 +330,10 @@ func (d *Dispatcher) unsubscribe(id uuid.UUID, usedChan chan interface{}) error
 
 		d.rMtx.Unlock()
 
+		if cap(usedChan) != 0 {
+			return nil
+		}
+
 		// Drain and put the used chan back in pool; only if it is not closed.
 		select {
 		case _, ok := <-usedChan:
@@ -360,3 +373,25 @@ func (d *Dispatcher) getNewID() (uuid.UUID, error) {
 
 	return newID, nil
 }
+
+// stats returns the state of the dispatcher and the counters of every pipe
+func (d *Dispatcher) stats() Stats {
+	s := Stats{
+		Running:     d.isRunning(),
+		Workers:     atomic.LoadInt32(&d.count),
+		DroppedJobs: atomic.LoadUint64(&d.droppedJobs),
+	}
+	if s.Running {
+		s.Jobs = len(d.jobs)
+		s.JobsLimit = cap(d.jobs)
+	}
+
+	d.rMtx.RLock()
+	defer d.rMtx.RUnlock()
+	for id, pipes := range d.routes {
+		for i := range pipes {
+			s.Pipes = append(s.Pipes, pipes[i].stats(id))
+		}
+	}
+	return s
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 )
@@ -137,7 +138,7 @@ func TestDispatcher(t *testing.T) {
 		t.Error(err)
 	}
 
-	_, err = dispatcher.subscribe(id)
+	_, err = dispatcher.subscribe(id, SubscribeOptions{})
 	if err == nil {
 		t.Error("error cannot be nil")
 	}
@@ -154,7 +155,7 @@ func TestDispatcher(t *testing.T) {
 		t.Error(err)
 	}
 
-	_, err = dispatcher.subscribe(someID)
+	_, err = dispatcher.subscribe(someID, SubscribeOptions{})
 	if err == nil {
 		t.Error("error cannot be nil")
 	}
@@ -250,6 +251,211 @@ func TestSubscribe(t *testing.T) {
 	}
 }
 
+func TestPipePolicies(t *testing.T) {
+	timeout := time.NewTimer(0)
+	relay := func(opts SubscribeOptions, count int) *pipe {
+		opts, err := checkSubscribeOptions(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := &pipe{ch: make(chan interface{}, opts.BufferSize), opts: opts}
+		for i := 0; i < count; i++ {
+			p.relay(i, timeout)
+		}
+		return p
+	}
+
+	p := relay(SubscribeOptions{Policy: PolicyBlock, Timeout: time.Millisecond}, 3)
+	if s := p.stats(uuid.UUID{}); s.Published != 3 || s.Dropped != 3 || s.Lag != 0 {
+		t.Errorf("unexpected block stats %+v", s)
+	}
+
+	p = relay(SubscribeOptions{Policy: PolicyDropNewest, BufferSize: 2}, 5)
+	if s := p.stats(uuid.UUID{}); s.Published != 5 || s.Dropped != 3 || s.Lag != 2 || s.MaxLag != 2 {
+		t.Errorf("unexpected drop newest stats %+v", s)
+	}
+	if v := <-p.ch; v != 0 {
+		t.Errorf("expected oldest data to be kept, received %v", v)
+	}
+
+	p = relay(SubscribeOptions{Policy: PolicyDropOldest, BufferSize: 2}, 5)
+	if s := p.stats(uuid.UUID{}); s.Published != 5 || s.Dropped != 3 || s.Lag != 2 {
+		t.Errorf("unexpected drop oldest stats %+v", s)
+	}
+	if v := <-p.ch; v != 3 {
+		t.Errorf("expected newest data to be kept, received %v", v)
+	}
+
+	p = relay(SubscribeOptions{Policy: PolicyConflate, BufferSize: 10}, 5)
+	if s := p.stats(uuid.UUID{}); s.BufferSize != 1 || s.Dropped != 4 || s.Lag != 1 {
+		t.Errorf("unexpected conflate stats %+v", s)
+	}
+	if v := <-p.ch; v != 4 {
+		t.Errorf("expected latest data, received %v", v)
+	}
+
+	_, err := checkSubscribeOptions(SubscribeOptions{Policy: 99})
+	if err == nil {
+		t.Error("expected error with an unknown policy")
+	}
+	_, err = checkSubscribeOptions(SubscribeOptions{BufferSize: -1})
+	if err == nil {
+		t.Error("expected error with a negative buffer size")
+	}
+}
+
+func TestSubscribeWithOptions(t *testing.T) {
+	itemID, err := mux.GetID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pipe, err := mux.SubscribeWithOptions(itemID, SubscribeOptions{
+		Name:       "test",
+		Policy:     PolicyDropOldest,
+		BufferSize: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := "PAYLOAD"
+	for i := 0; i < 10; i++ {
+		err = mux.Publish([]uuid.UUID{itemID}, &payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Jobs are relayed asynchronously by the workers
+	var s PipeStats
+	for i := 0; i < 100; i++ {
+		stats, err := GetStats()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for x := range stats.Pipes {
+			if stats.Pipes[x].ID == itemID {
+				s = stats.Pipes[x]
+			}
+		}
+		if s.Published == 10 {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	if s.Name != "test" || s.Policy != PolicyDropOldest || s.BufferSize != 5 ||
+		s.Published != 10 || s.Dropped != 5 || s.Lag != 5 {
+		t.Errorf("unexpected pipe stats %+v", s)
+	}
+
+	err = pipe.Release()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := GetStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for x := range stats.Pipes {
+		if stats.Pipes[x].ID == itemID {
+			t.Error("expected released pipe to be removed from stats")
+		}
+	}
+}
+
+func TestRelay(t *testing.T) {
+	id, err := mux.GetID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pipe, err := mux.SubscribeWithOptions(id, SubscribeOptions{
+		Policy: PolicyDropNewest,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := make(chan string)
+	r := NewRelay(pipe, func(data interface{}, released <-chan struct{}) bool {
+		s, ok := data.(string)
+		if !ok {
+			t.Errorf("expected a copy of the published string received %T", data)
+			return true
+		}
+		select {
+		case c <- s:
+			return true
+		case <-released:
+			return false
+		}
+	}, func() { close(c) })
+
+	payload := "PAYLOAD"
+	if err = mux.Publish([]uuid.UUID{id}, &payload); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case s := <-c:
+		if s != payload {
+			t.Errorf("expected %s received %s", payload, s)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for relayed data")
+	}
+
+	if err = r.Release(); err != nil {
+		t.Error(err)
+	}
+	if _, ok := <-c; ok {
+		t.Error("expected typed channel to be closed once released")
+	}
+	if err = r.Release(); err != nil {
+		t.Error("expected releasing twice to be a no-op", err)
+	}
+
+	var nilRelay *Relay
+	if err = nilRelay.Release(); err == nil {
+		t.Error("expected error releasing a nil relay")
+	}
+}
+
+func TestSubscribeRelay(t *testing.T) {
+	id, err := mux.GetID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = mux.SubscribeRelay(id, SubscribeOptions{}, make(chan string)); err == nil {
+		t.Error("expected error relaying to a channel which is not of pointers")
+	}
+
+	c := make(chan *string)
+	r, err := mux.SubscribeRelay(id, SubscribeOptions{
+		Policy: PolicyDropNewest,
+	}, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payload := "PAYLOAD"
+	if err = mux.Publish([]uuid.UUID{id}, &payload); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case s := <-c:
+		if s == &payload || *s != payload {
+			t.Errorf("expected a copy of %s received %v", payload, s)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for relayed data")
+	}
+
+	if err = r.Release(); err != nil {
+		t.Error(err)
+	}
+	if _, ok := <-c; ok {
+		t.Error("expected typed channel to be closed once released")
+	}
+}
+
 func TestPublish(t *testing.T) {
 	itemID, err := mux.GetID()
 	if err != nil {
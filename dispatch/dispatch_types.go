@@ -18,6 +18,10 @@ const (
 	// an unbuffered channel for a receiver before moving on to next route
 	DefaultHandshakeTimeout = 200 * time.Nanosecond
 
+	// DefaultPipeBufferSize defines the channel capacity of a pipe using a
+	// drop policy when a buffer size is not set
+	DefaultPipeBufferSize = 100
+
 	errNotInitialised   = "dispatcher not initialised"
 	errAlreadyStarted   = "dispatcher already started"
 	errCannotShutdown   = "dispatcher cannot shutdown, already stopped"
@@ -34,7 +38,7 @@ type Dispatcher struct {
 	// channels, a relayer will be given a unique id through its job channel,
 	// then publish the data across the full registered channels for that uuid.
 	// See relayer() method below.
-	routes map[uuid.UUID][]chan interface{}
+	routes map[uuid.UUID][]*pipe
 
 	// rMtx protects the routes variable ensuring acceptable read/write access
 	rMtx sync.RWMutex
@@ -52,6 +56,8 @@ type Dispatcher struct {
 	count int32
 	// Dispatch status
 	running uint32
+	// Jobs dropped due to the jobs limit
+	droppedJobs uint64
 
 	// Unbufferd shutdown chan, sync wg for ensuring concurrency when only
 	// dropping a single relayer routine
@@ -85,3 +91,82 @@ type Pipe struct {
 	// Reference to multiplexor
 	m *Mux
 }
+
+// Relay forwards data received on a pipe to a typed channel so subscribers of
+// a package such as ticker or orderbook receive that package's type instead of
+// an interface{}
+type Relay struct {
+	pipe    Pipe
+	release chan struct{}
+	done    chan struct{}
+	once    sync.Once
+	err     error
+}
+
+// Policy defines how data is relayed to a pipe that is not ready to receive
+type Policy uint8
+
+// Pipe relay policies
+const (
+	// PolicyBlock waits up to the pipe timeout for the receiver to be ready
+	// then drops the data. This is the default policy.
+	PolicyBlock Policy = iota
+	// PolicyDropNewest buffers data, dropping new data while the buffer is
+	// full
+	PolicyDropNewest
+	// PolicyDropOldest buffers data, dropping the oldest buffered data to
+	// make room while the buffer is full
+	PolicyDropOldest
+	// PolicyConflate holds only the latest data, replacing any data the
+	// receiver has not yet received
+	PolicyConflate
+)
+
+// SubscribeOptions defines how data is relayed to a pipe
+type SubscribeOptions struct {
+	// Name identifies the pipe in its stats
+	Name   string
+	Policy Policy
+	// BufferSize sets the channel capacity of a drop policy, defaults to
+	// DefaultPipeBufferSize
+	BufferSize int
+	// Timeout sets how long the block policy waits for the receiver, defaults
+	// to DefaultHandshakeTimeout
+	Timeout time.Duration
+}
+
+// Stats defines the state of the dispatch system and each of its pipes
+type Stats struct {
+	Running     bool
+	Workers     int32
+	Jobs        int
+	JobsLimit   int
+	DroppedJobs uint64
+	Pipes       []PipeStats
+}
+
+// PipeStats defines the relay counters of a pipe. Lag is the amount of data
+// buffered and not yet received and MaxLag is the most that has been
+// buffered.
+type PipeStats struct {
+	ID         uuid.UUID
+	Name       string
+	Policy     Policy
+	BufferSize int
+	Published  uint64
+	Dropped    uint64
+	Lag        int
+	MaxLag     int64
+	Subscribed time.Time
+}
+
+// pipe defines a subscribed channel, its relay options and counters
+type pipe struct {
+	ch         chan interface{}
+	opts       SubscribeOptions
+	subscribed time.Time
+	// Atomic counters
+	published uint64
+	dropped   uint64
+	maxLag    int64
+}
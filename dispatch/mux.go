@@ -15,6 +15,13 @@ func GetNewMux() *Mux {
 // Subscribe takes in a package defined signature element pointing to an ID set
 // and returns the associated pipe
 func (m *Mux) Subscribe(id uuid.UUID) (Pipe, error) {
+	return m.SubscribeWithOptions(id, SubscribeOptions{})
+}
+
+// SubscribeWithOptions takes in a package defined signature element pointing
+// to an ID set and returns the associated pipe, relaying data according to the
+// options
+func (m *Mux) SubscribeWithOptions(id uuid.UUID, opts SubscribeOptions) (Pipe, error) {
 	if m == nil {
 		return Pipe{}, errors.New("mux is nil")
 	}
@@ -23,7 +30,7 @@ func (m *Mux) Subscribe(id uuid.UUID) (Pipe, error) {
 		return Pipe{}, errors.New("id not set")
 	}
 
-	ch, err := m.d.subscribe(id)
+	ch, err := m.d.subscribe(id, opts)
 	if err != nil {
 		return Pipe{}, err
 	}
@@ -31,6 +38,37 @@ func (m *Mux) Subscribe(id uuid.UUID) (Pipe, error) {
 	return Pipe{C: ch, id: id, m: m}, nil
 }
 
+// SubscribeRelay subscribes to an ID set and relays a pointer to a copy of
+// each published value to c, which must be a chan *T where T is the type
+// published to the ID. c is closed when the relay is released or the dispatch
+// system closes the pipe.
+func (m *Mux) SubscribeRelay(id uuid.UUID, opts SubscribeOptions, c interface{}) (*Relay, error) {
+	ch := reflect.ValueOf(c)
+	if ch.Kind() != reflect.Chan || ch.Type().Elem().Kind() != reflect.Ptr {
+		return nil, errors.New("relay channel must be a channel of pointers")
+	}
+
+	p, err := m.SubscribeWithOptions(id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	elem := ch.Type().Elem().Elem()
+	return NewRelay(p, func(data interface{}, released <-chan struct{}) bool {
+		v := reflect.ValueOf(data)
+		if !v.IsValid() || v.Type() != elem {
+			return true
+		}
+		cpy := reflect.New(elem)
+		cpy.Elem().Set(v)
+		chosen, _, _ := reflect.Select([]reflect.SelectCase{
+			{Dir: reflect.SelectSend, Chan: ch, Send: cpy},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(released)},
+		})
+		return chosen == 0
+	}, ch.Close), nil
+}
+
 // Unsubscribe returns channel to the pool for the full signature set
 func (m *Mux) Unsubscribe(id uuid.UUID, ch chan interface{}) error {
 	if m == nil {
@@ -40,7 +78,7 @@ func (m *Mux) Unsubscribe(id uuid.UUID, ch chan interface{}) error {
 }
 
 // Publish takes in a persistent memory address and dispatches changes to
-// required pipes. Data should be of *type.
+// required pipes. Data should be of *type and is relayed as a copy of type.
 func (m *Mux) Publish(ids []uuid.UUID, data interface{}) error {
 	if m == nil {
 		return errors.New("mux is nil")
@@ -50,11 +88,11 @@ func (m *Mux) Publish(ids []uuid.UUID, data interface{}) error {
 		return errors.New("data payload is nil")
 	}
 
+	// Create copy to not interfere with stored value
 	cpy := reflect.ValueOf(data).Elem().Interface()
 
 	for i := range ids {
-		// Create copy to not interfere with stored value
-		err := m.d.publish(ids[i], &cpy)
+		err := m.d.publish(ids[i], cpy)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,173 @@
+package dispatch
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// String implements the stringer interface
+func (p Policy) String() string {
+	switch p {
+	case PolicyBlock:
+		return "block"
+	case PolicyDropNewest:
+		return "drop newest"
+	case PolicyDropOldest:
+		return "drop oldest"
+	case PolicyConflate:
+		return "conflate"
+	}
+	return fmt.Sprintf("unknown policy %d", uint8(p))
+}
+
+// checkSubscribeOptions validates the options and sets their defaults
+func checkSubscribeOptions(opts SubscribeOptions) (SubscribeOptions, error) {
+	if opts.BufferSize < 0 {
+		return opts, fmt.Errorf("dispatcher pipe buffer size %d cannot be negative",
+			opts.BufferSize)
+	}
+	if opts.Timeout < 0 {
+		return opts, fmt.Errorf("dispatcher pipe timeout %s cannot be negative",
+			opts.Timeout)
+	}
+	switch opts.Policy {
+	case PolicyBlock:
+		if opts.Timeout == 0 {
+			opts.Timeout = DefaultHandshakeTimeout
+		}
+	case PolicyDropNewest, PolicyDropOldest:
+		if opts.BufferSize == 0 {
+			opts.BufferSize = DefaultPipeBufferSize
+		}
+	case PolicyConflate:
+		opts.BufferSize = 1
+	default:
+		return opts, fmt.Errorf("dispatcher %s", opts.Policy)
+	}
+	return opts, nil
+}
+
+// relay sends data to the pipe according to its policy. The block policy
+// waits on the receiver then falls over to the next route when the timer
+// actuates so a blocked receiving routine cannot hold up every other route.
+func (p *pipe) relay(data interface{}, timeout *time.Timer) {
+	atomic.AddUint64(&p.published, 1)
+	switch p.opts.Policy {
+	case PolicyDropNewest:
+		select {
+		case p.ch <- data:
+		default:
+			atomic.AddUint64(&p.dropped, 1)
+		}
+	case PolicyDropOldest, PolicyConflate:
+		select {
+		case p.ch <- data:
+		default:
+			// Make room by dropping the oldest data, if the receiver has
+			// already made room the send will succeed
+			select {
+			case <-p.ch:
+				atomic.AddUint64(&p.dropped, 1)
+			default:
+			}
+			select {
+			case p.ch <- data:
+			default:
+				atomic.AddUint64(&p.dropped, 1)
+			}
+		}
+	default:
+		if !timeout.Stop() { // Stop timer before reset
+			// Drain channel if timer has already actuated
+			select {
+			case <-timeout.C:
+			default:
+			}
+		}
+		timeout.Reset(p.opts.Timeout)
+		select {
+		case p.ch <- data:
+		case <-timeout.C:
+			atomic.AddUint64(&p.dropped, 1)
+		}
+	}
+
+	lag := int64(len(p.ch))
+	for {
+		maxLag := atomic.LoadInt64(&p.maxLag)
+		if lag <= maxLag || atomic.CompareAndSwapInt64(&p.maxLag, maxLag, lag) {
+			return
+		}
+	}
+}
+
+// stats returns the pipe counters
+func (p *pipe) stats(id uuid.UUID) PipeStats {
+	return PipeStats{
+		ID:         id,
+		Name:       p.opts.Name,
+		Policy:     p.opts.Policy,
+		BufferSize: cap(p.ch),
+		Published:  atomic.LoadUint64(&p.published),
+		Dropped:    atomic.LoadUint64(&p.dropped),
+		Lag:        len(p.ch),
+		MaxLag:     atomic.LoadInt64(&p.maxLag),
+		Subscribed: p.subscribed,
+	}
+}
+
+// NewRelay forwards data received on the pipe to send until the relay is
+// released or the dispatch system closes the pipe. send must deliver the data
+// to its typed channel or return false once released is closed. closed is
+// called when the relay stops so the typed channel can be closed.
+func NewRelay(p Pipe, send func(data interface{}, released <-chan struct{}) bool, closed func()) *Relay {
+	r := &Relay{
+		pipe:    p,
+		release: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	// Wait for the relay routine to start so it is ready to receive by the
+	// time the subscriber returns, the block policy only waits on a receiver
+	// for the pipe timeout
+	ready := make(chan struct{})
+	go r.forward(send, closed, ready)
+	<-ready
+	return r
+}
+
+// forward relays pipe data until released or the pipe is closed
+func (r *Relay) forward(send func(data interface{}, released <-chan struct{}) bool, closed func(), ready chan struct{}) {
+	defer close(r.done)
+	defer closed()
+	close(ready)
+	for {
+		select {
+		case <-r.release:
+			return
+		case data, ok := <-r.pipe.C:
+			if !ok || !send(data, r.release) {
+				return
+			}
+		}
+	}
+}
+
+// Release stops the relay and returns the pipe to the dispatch system, it is
+// safe to call more than once
+func (r *Relay) Release() error {
+	if r == nil {
+		return errors.New("relay is nil")
+	}
+	r.once.Do(func() {
+		close(r.release)
+		// Wait for the relay to stop receiving before the channel is
+		// returned to the pool
+		<-r.done
+		r.err = r.pipe.Release()
+	})
+	return r.err
+}
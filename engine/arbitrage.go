@@ -0,0 +1,332 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Started returns if the arbitrage monitor subsystem is started
+func (a *arbitrageMonitor) Started() bool {
+	return atomic.LoadInt32(&a.started) == 1
+}
+
+// Start starts the arbitrage monitor subsystem
+func (a *arbitrageMonitor) Start() error {
+	if atomic.AddInt32(&a.started, 1) != 1 {
+		return fmt.Errorf("%s %s", arbitrageMonitorName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.Global, arbitrageMonitorName, MsgSubSystemStarting)
+	Bot.Config.CheckArbitrageConfig()
+	a.cfg = Bot.Config.Arbitrage
+
+	a.m.Lock()
+	a.opportunities = make(map[string]*ArbitrageOpportunity)
+	a.alerts = nil
+	a.lastAlert = make(map[string]time.Time)
+	a.fees = make(map[string]arbitrageFee)
+	a.m.Unlock()
+
+	a.shutdown = make(chan struct{})
+	go a.run()
+	return nil
+}
+
+// Stop stops the arbitrage monitor subsystem
+func (a *arbitrageMonitor) Stop() error {
+	if atomic.LoadInt32(&a.started) == 0 {
+		return fmt.Errorf("%s %s", arbitrageMonitorName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&a.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", arbitrageMonitorName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.Global, arbitrageMonitorName, MsgSubSystemShuttingDown)
+	close(a.shutdown)
+	return nil
+}
+
+func (a *arbitrageMonitor) run() {
+	log.Debugln(log.Global, arbitrageMonitorName, MsgSubSystemStarted)
+	Bot.ServicesWG.Add(1)
+
+	tick := time.NewTicker(a.cfg.CheckInterval)
+
+	defer func() {
+		tick.Stop()
+		atomic.CompareAndSwapInt32(&a.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&a.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.Global, arbitrageMonitorName, MsgSubSystemShutdown)
+	}()
+
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case <-tick.C:
+			a.check()
+		}
+	}
+}
+
+// GetOpportunities returns the best current opportunity for each market sorted
+// by net spread, an unset asset or pair returns all assets or pairs
+func (a *arbitrageMonitor) GetOpportunities(assetType asset.Item, p currency.Pair) ([]ArbitrageOpportunity, error) {
+	if !a.Started() {
+		return nil, fmt.Errorf("%s %s", arbitrageMonitorName, ErrSubSystemNotStarted)
+	}
+
+	a.m.RLock()
+	defer a.m.RUnlock()
+	var resp []ArbitrageOpportunity
+	for _, o := range a.opportunities {
+		if assetType != "" && o.Asset != assetType {
+			continue
+		}
+		if !p.IsEmpty() && !o.Pair.Equal(p) {
+			continue
+		}
+		resp = append(resp, *o)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].NetSpreadBasisPoints > resp[j].NetSpreadBasisPoints
+	})
+	return resp, nil
+}
+
+// GetAlerts returns the most recent opportunities that exceeded the alert
+// threshold, newest first
+func (a *arbitrageMonitor) GetAlerts() ([]ArbitrageOpportunity, error) {
+	if !a.Started() {
+		return nil, fmt.Errorf("%s %s", arbitrageMonitorName, ErrSubSystemNotStarted)
+	}
+
+	a.m.RLock()
+	defer a.m.RUnlock()
+	resp := make([]ArbitrageOpportunity, len(a.alerts))
+	for x := range a.alerts {
+		resp[len(a.alerts)-1-x] = a.alerts[x]
+	}
+	return resp, nil
+}
+
+// AlertThreshold returns the net spread in basis points an opportunity must
+// reach to raise an alert
+func (a *arbitrageMonitor) AlertThreshold() float64 {
+	return a.cfg.AlertThreshold
+}
+
+// check recalculates the opportunity for every market and alerts on those
+// over the threshold
+func (a *arbitrageMonitor) check() {
+	now := time.Now()
+	markets := arbitrageMarkets()
+	opportunities := make(map[string]*ArbitrageOpportunity, len(markets))
+	for key, m := range markets {
+		var quotes []arbitrageQuote
+		for x := range m.sources {
+			q, ok := a.quote(m.sources[x].exch, m.sources[x].pair, m.asset, now)
+			if ok {
+				quotes = append(quotes, q)
+			}
+		}
+
+		o := calculateArbitrage(quotes)
+		if o == nil {
+			continue
+		}
+		o.Pair = m.pair
+		o.Asset = m.asset
+		opportunities[key] = o
+		if o.NetSpreadBasisPoints >= a.cfg.AlertThreshold {
+			a.alert(o, now)
+		}
+	}
+
+	a.m.Lock()
+	a.opportunities = opportunities
+	a.m.Unlock()
+}
+
+// arbitrageMarkets groups the enabled pairs of every enabled exchange by asset
+// and currency pair, returning those enabled on two or more exchanges
+func arbitrageMarkets() map[string]*arbitrageMarket {
+	markets := make(map[string]*arbitrageMarket)
+	exchanges := GetExchanges()
+	for x := range exchanges {
+		if !exchanges[x].IsEnabled() {
+			continue
+		}
+		assets := exchanges[x].GetAssetTypes()
+		for y := range assets {
+			pairs := exchanges[x].GetEnabledPairs(assets[y])
+			for z := range pairs {
+				p := pairs[z].Format("-", true)
+				key := assets[y].String() + " " + p.String()
+				m, ok := markets[key]
+				if !ok {
+					m = &arbitrageMarket{pair: p, asset: assets[y]}
+					markets[key] = m
+				}
+				m.sources = append(m.sources, arbitrageSource{
+					exch: exchanges[x],
+					pair: pairs[z],
+				})
+			}
+		}
+	}
+	for key, m := range markets {
+		if len(m.sources) < 2 {
+			delete(markets, key)
+		}
+	}
+	return markets
+}
+
+// quote returns the top of an exchange orderbook with its taker fee, stale or
+// one sided orderbooks are ignored
+func (a *arbitrageMonitor) quote(exch exchange.IBotExchange, p currency.Pair, assetType asset.Item, now time.Time) (arbitrageQuote, bool) {
+	ob, err := orderbook.Get(exch.GetName(), p, assetType)
+	if err != nil {
+		return arbitrageQuote{}, false
+	}
+	bids, asks := ob.Bids, ob.Asks
+	if len(bids) == 0 || len(asks) == 0 ||
+		now.Sub(ob.LastUpdated) > a.cfg.MaxOrderbookAge {
+		return arbitrageQuote{}, false
+	}
+
+	fee, err := a.takerFee(exch, p, assetType, asks[0].Price, asks[0].Amount, now)
+	if err != nil {
+		return arbitrageQuote{}, false
+	}
+	return arbitrageQuote{
+		exchange:  strings.ToLower(exch.GetName()),
+		bid:       bids[0].Price,
+		bidAmount: bids[0].Amount,
+		ask:       asks[0].Price,
+		askAmount: asks[0].Amount,
+		takerFee:  fee,
+		updated:   ob.LastUpdated,
+	}, true
+}
+
+// takerFee returns the cached taker fee rate for an exchange pair, refreshing
+// it from GetFeeByType once expired
+func (a *arbitrageMonitor) takerFee(exch exchange.IBotExchange, p currency.Pair, assetType asset.Item, price, amount float64, now time.Time) (float64, error) {
+	key := strings.ToLower(exch.GetName()) + " " + assetType.String() + " " + p.String()
+	a.m.RLock()
+	f, ok := a.fees[key]
+	a.m.RUnlock()
+	if ok && now.Sub(f.updated) < arbitrageFeeCacheDuration {
+		return f.rate, f.err
+	}
+
+	f = arbitrageFee{updated: now}
+	fee, err := exch.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          p,
+		PurchasePrice: price,
+		Amount:        amount,
+	})
+	if err != nil {
+		f.err = err
+		log.Errorf(log.Global, "%s unable to get %s %s %s taker fee: %v\n",
+			arbitrageMonitorName, exch.GetName(), p, assetType, err)
+	} else if price > 0 && amount > 0 {
+		f.rate = fee / (price * amount)
+	}
+
+	a.m.Lock()
+	a.fees[key] = f
+	a.m.Unlock()
+	return f.rate, f.err
+}
+
+// calculateArbitrage returns the opportunity with the highest net spread from
+// buying on one exchange and selling on another, nil is returned when fewer
+// than two quotes are supplied
+func calculateArbitrage(quotes []arbitrageQuote) *ArbitrageOpportunity {
+	var best *ArbitrageOpportunity
+	for i := range quotes {
+		for j := range quotes {
+			buy, sell := quotes[i], quotes[j]
+			if i == j || buy.ask <= 0 || sell.bid <= 0 {
+				continue
+			}
+
+			gross := sell.bid - buy.ask
+			net := sell.bid*(1-sell.takerFee) - buy.ask*(1+buy.takerFee)
+			o := ArbitrageOpportunity{
+				BuyExchange:            buy.exchange,
+				BuyPrice:               buy.ask,
+				BuyFee:                 buy.takerFee,
+				SellExchange:           sell.exchange,
+				SellPrice:              sell.bid,
+				SellFee:                sell.takerFee,
+				Amount:                 math.Min(buy.askAmount, sell.bidAmount),
+				GrossSpread:            gross,
+				GrossSpreadBasisPoints: gross / buy.ask * arbitrageBasisPoints,
+				NetSpread:              net,
+				NetSpreadBasisPoints:   net / buy.ask * arbitrageBasisPoints,
+				Updated:                buy.updated,
+			}
+			o.NetProfit = net * o.Amount
+			if sell.updated.After(o.Updated) {
+				o.Updated = sell.updated
+			}
+			if best == nil || o.NetSpreadBasisPoints > best.NetSpreadBasisPoints {
+				best = &o
+			}
+		}
+	}
+	return best
+}
+
+// alert records the opportunity and notifies the communications manager,
+// repeated alerts for the same route are suppressed until the cooldown expires
+func (a *arbitrageMonitor) alert(o *ArbitrageOpportunity, now time.Time) {
+	key := o.Asset.String() + " " + o.Pair.String() + " " + o.BuyExchange + " " + o.SellExchange
+	a.m.Lock()
+	if last, ok := a.lastAlert[key]; ok && now.Sub(last) < a.cfg.AlertCooldown {
+		a.m.Unlock()
+		return
+	}
+	a.lastAlert[key] = now
+	a.alerts = append(a.alerts, *o)
+	if len(a.alerts) > maxArbitrageAlerts {
+		a.alerts = a.alerts[len(a.alerts)-maxArbitrageAlerts:]
+	}
+	a.m.Unlock()
+
+	msg := fmt.Sprintf("%s %s %s buy %s @ %f sell %s @ %f net spread %.2f bps, %f %s profit for %f %s",
+		arbitrageMonitorName,
+		o.Pair,
+		o.Asset,
+		o.BuyExchange,
+		o.BuyPrice,
+		o.SellExchange,
+		o.SellPrice,
+		o.NetSpreadBasisPoints,
+		o.NetProfit,
+		o.Pair.Quote,
+		o.Amount,
+		o.Pair.Base)
+	log.Infoln(log.Global, msg)
+	if a.cfg.CommsAlerts {
+		Bot.CommsManager.PushEvent(base.Event{Type: "arbitrage", Message: msg})
+	}
+}
@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestCalculateArbitrage(t *testing.T) {
+	t.Parallel()
+	if o := calculateArbitrage([]arbitrageQuote{{exchange: "a", bid: 1, ask: 2}}); o != nil {
+		t.Error("expected no opportunity from a single quote")
+	}
+
+	now := time.Now()
+	quotes := []arbitrageQuote{
+		{exchange: "a", bid: 99, bidAmount: 1, ask: 100, askAmount: 2, takerFee: 0.001, updated: now.Add(-time.Second)},
+		{exchange: "b", bid: 101, bidAmount: 0.5, ask: 102, askAmount: 1, takerFee: 0.002, updated: now},
+	}
+	o := calculateArbitrage(quotes)
+	if o == nil {
+		t.Fatal("expected opportunity")
+	}
+	if o.BuyExchange != "a" || o.SellExchange != "b" || o.Amount != 0.5 {
+		t.Errorf("unexpected opportunity %+v", o)
+	}
+	net := 101*(1-0.002) - 100*(1+0.001)
+	if o.GrossSpread != 1 || o.GrossSpreadBasisPoints != 100 ||
+		math.Abs(o.NetSpread-net) > 1e-9 ||
+		math.Abs(o.NetSpreadBasisPoints-net/100*arbitrageBasisPoints) > 1e-9 ||
+		math.Abs(o.NetProfit-net*0.5) > 1e-9 {
+		t.Errorf("unexpected spread %+v", o)
+	}
+	if !o.Updated.Equal(now) {
+		t.Errorf("expected most recent orderbook time %v received %v", now, o.Updated)
+	}
+
+	// Crossed the other way the best route is still returned with a negative
+	// net spread
+	quotes[1].bid, quotes[1].ask = 98, 99
+	o = calculateArbitrage(quotes)
+	if o.BuyExchange != "b" || o.SellExchange != "a" || o.NetSpread >= 0 {
+		t.Errorf("unexpected opportunity %+v", o)
+	}
+}
+
+func TestArbitrageAlert(t *testing.T) {
+	t.Parallel()
+	a := arbitrageMonitor{
+		started:   1,
+		cfg:       config.ArbitrageConfig{AlertCooldown: time.Minute},
+		lastAlert: make(map[string]time.Time),
+	}
+	o := ArbitrageOpportunity{
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		Asset:        asset.Spot,
+		BuyExchange:  "a",
+		SellExchange: "b",
+	}
+	now := time.Now()
+	a.alert(&o, now)
+	a.alert(&o, now.Add(time.Second))
+	alerts, err := a.GetAlerts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("expected repeated alert to be suppressed, received %d alerts", len(alerts))
+	}
+
+	o.NetProfit = 1
+	a.alert(&o, now.Add(time.Minute))
+	o.SellExchange = "c"
+	o.NetProfit = 2
+	a.alert(&o, now.Add(time.Minute))
+	alerts, err = a.GetAlerts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 3 || alerts[0].NetProfit != 2 || alerts[2].NetProfit != 0 {
+		t.Errorf("expected 3 alerts newest first, received %+v", alerts)
+	}
+
+	for i := 0; i < maxArbitrageAlerts; i++ {
+		a.alert(&o, now.Add(time.Hour*time.Duration(i+1)))
+	}
+	alerts, err = a.GetAlerts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != maxArbitrageAlerts {
+		t.Errorf("expected %d alerts, received %d", maxArbitrageAlerts, len(alerts))
+	}
+
+	var stopped arbitrageMonitor
+	if _, err = stopped.GetAlerts(); err == nil {
+		t.Error("expected error when not started")
+	}
+}
@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+const (
+	arbitrageMonitorName      = "Arbitrage monitor"
+	arbitrageFeeCacheDuration = time.Hour
+	maxArbitrageAlerts        = 100
+	arbitrageBasisPoints      = 10000
+)
+
+// ArbitrageOpportunity is the executable spread from buying at the best ask on
+// one exchange and selling at the best bid on another. Net values are after
+// the taker fees of both exchanges and basis points are of the buy price.
+type ArbitrageOpportunity struct {
+	Pair                   currency.Pair
+	Asset                  asset.Item
+	BuyExchange            string
+	BuyPrice               float64
+	BuyFee                 float64
+	SellExchange           string
+	SellPrice              float64
+	SellFee                float64
+	Amount                 float64
+	GrossSpread            float64
+	GrossSpreadBasisPoints float64
+	NetSpread              float64
+	NetSpreadBasisPoints   float64
+	NetProfit              float64
+	Updated                time.Time
+}
+
+// arbitrageMonitor compares the top of the orderbooks of every pair enabled
+// on two or more exchanges and raises alerts for opportunities over the
+// configured threshold
+type arbitrageMonitor struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	cfg      config.ArbitrageConfig
+
+	m             sync.RWMutex
+	opportunities map[string]*ArbitrageOpportunity
+	alerts        []ArbitrageOpportunity
+	lastAlert     map[string]time.Time
+	fees          map[string]arbitrageFee
+}
+
+// arbitrageQuote is the top of an exchange orderbook and its taker fee rate
+type arbitrageQuote struct {
+	exchange  string
+	bid       float64
+	bidAmount float64
+	ask       float64
+	askAmount float64
+	takerFee  float64
+	updated   time.Time
+}
+
+// arbitrageFee caches an exchange taker fee rate, errors are cached so an
+// exchange that cannot return its fee is not requested each check
+type arbitrageFee struct {
+	rate    float64
+	err     error
+	updated time.Time
+}
+
+// arbitrageMarket is a pair and asset enabled on two or more exchanges
+type arbitrageMarket struct {
+	pair    currency.Pair
+	asset   asset.Item
+	sources []arbitrageSource
+}
+
+// arbitrageSource is an exchange and the enabled pair format it trades a
+// market with
+type arbitrageSource struct {
+	exch exchange.IBotExchange
+	pair currency.Pair
+}
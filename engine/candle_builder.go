@@ -0,0 +1,252 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	candleBuilderName = "Candle builder"
+
+	// DefaultCandleBuilderIntervals are the intervals candles are built for
+	// when none are specified
+	DefaultCandleBuilderIntervals = "1m,5m,15m,1h"
+
+	candleBuilderCheckDelay = time.Second
+	candleBuilderBufferSize = 1000
+)
+
+// candleBuilderManager aggregates websocket trade prints into candles for
+// each exchange, currency pair, asset type and configured interval. Closed
+// candles are published via the kline package and optionally persisted to
+// the database.
+type candleBuilderManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	closed   chan kline.Item
+
+	intervals []kline.Interval
+	persist   bool
+
+	m        sync.Mutex
+	builders map[candleBuilderKey]*kline.Builder
+}
+
+type candleBuilderKey struct {
+	Exchange string
+	Base     *currency.Item
+	Quote    *currency.Item
+	Asset    asset.Item
+	Interval kline.Interval
+}
+
+// Started returns if the candle builder subsystem is started
+func (c *candleBuilderManager) Started() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+// Start starts the candle builder subsystem
+func (c *candleBuilderManager) Start() (err error) {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return fmt.Errorf("%s %s", candleBuilderName, ErrSubSystemAlreadyStarted)
+	}
+
+	defer func() {
+		if err != nil {
+			atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		}
+	}()
+
+	log.Debugln(log.Global, candleBuilderName, MsgSubSystemStarting)
+
+	c.intervals, err = parseCandleBuilderIntervals(Bot.Settings.CandleBuilderIntervals)
+	if err != nil {
+		return err
+	}
+
+	c.persist = Bot.Settings.CandleBuilderPersist
+	if c.persist && !Bot.DatabaseManager.Started() {
+		log.Warnf(log.Global,
+			"%s database manager not running, candles will not be persisted\n",
+			candleBuilderName)
+		c.persist = false
+	}
+
+	c.builders = make(map[candleBuilderKey]*kline.Builder)
+	c.shutdown = make(chan struct{})
+	c.closed = make(chan kline.Item, candleBuilderBufferSize)
+	go c.run()
+	return nil
+}
+
+// Stop stops the candle builder subsystem
+func (c *candleBuilderManager) Stop() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return fmt.Errorf("%s %s", candleBuilderName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", candleBuilderName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.Global, candleBuilderName, MsgSubSystemShuttingDown)
+	close(c.shutdown)
+	return nil
+}
+
+func (c *candleBuilderManager) run() {
+	log.Debugln(log.Global, candleBuilderName, MsgSubSystemStarted)
+	Bot.ServicesWG.Add(1)
+
+	tick := time.NewTicker(candleBuilderCheckDelay)
+
+	defer func() {
+		tick.Stop()
+		atomic.CompareAndSwapInt32(&c.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.Global, candleBuilderName, MsgSubSystemShutdown)
+	}()
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case k := <-c.closed:
+			c.process(&k)
+		case t := <-tick.C:
+			c.closeExpired(t)
+		}
+	}
+}
+
+// Add applies a websocket trade print to the candles for all configured
+// intervals
+func (c *candleBuilderManager) Add(d *wshandler.TradeData) {
+	if d == nil || atomic.LoadInt32(&c.started) == 0 {
+		return
+	}
+
+	ts := d.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	for x := range c.intervals {
+		key := candleBuilderKey{
+			Exchange: strings.ToLower(d.Exchange),
+			Base:     d.CurrencyPair.Base.Item,
+			Quote:    d.CurrencyPair.Quote.Item,
+			Asset:    d.AssetType,
+			Interval: c.intervals[x],
+		}
+		b, ok := c.builders[key]
+		if !ok {
+			var err error
+			b, err = kline.NewBuilder(d.Exchange, d.CurrencyPair, d.AssetType, c.intervals[x])
+			if err != nil {
+				log.Errorf(log.Global, "%s unable to build candles for %s %s %s: %v\n",
+					candleBuilderName, d.Exchange, d.CurrencyPair, d.AssetType, err)
+				continue
+			}
+			c.builders[key] = b
+		}
+
+		closed, err := b.AddTrade(d.Price, d.Amount, ts)
+		if err != nil {
+			if Bot.Settings.Verbose {
+				log.Debugf(log.Global, "%s %s %s %s %s trade skipped: %v\n",
+					candleBuilderName, d.Exchange, d.CurrencyPair, d.AssetType,
+					c.intervals[x], err)
+			}
+			continue
+		}
+		if closed != nil {
+			c.enqueue(b, closed)
+		}
+	}
+}
+
+// closeExpired closes any open candles whose period has ended without a
+// subsequent trade
+func (c *candleBuilderManager) closeExpired(t time.Time) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	for _, b := range c.builders {
+		if closed := b.Close(t); closed != nil {
+			c.enqueue(b, closed)
+		}
+	}
+}
+
+// enqueue hands a closed candle off to the run routine so trade processing is
+// never blocked by dispatch or the database
+func (c *candleBuilderManager) enqueue(b *kline.Builder, closed *kline.Candle) {
+	k := kline.Item{
+		Exchange: b.Exchange,
+		Pair:     b.Pair,
+		Asset:    b.Asset,
+		Interval: b.Interval,
+		Candles:  []kline.Candle{*closed},
+	}
+	select {
+	case c.closed <- k:
+	default:
+		log.Warnf(log.Global, "%s buffer full, dropping %s %s %s %s candle\n",
+			candleBuilderName, k.Exchange, k.Pair, k.Asset, k.Interval)
+	}
+}
+
+func (c *candleBuilderManager) process(k *kline.Item) {
+	err := kline.ProcessCandles(k)
+	if err != nil {
+		log.Errorf(log.Global, "%s failed to publish %s %s %s %s candle: %v\n",
+			candleBuilderName, k.Exchange, k.Pair, k.Asset, k.Interval, err)
+	}
+
+	if !c.persist {
+		return
+	}
+
+	err = candle.Insert(k)
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "%s failed to store %s %s %s %s candle: %v\n",
+			candleBuilderName, k.Exchange, k.Pair, k.Asset, k.Interval, err)
+	}
+}
+
+// parseCandleBuilderIntervals converts a comma delimited list of short
+// intervals e.g 1m,1h to kline intervals
+func parseCandleBuilderIntervals(s string) ([]kline.Interval, error) {
+	if s == "" {
+		s = DefaultCandleBuilderIntervals
+	}
+
+	var intervals []kline.Interval
+	seen := make(map[kline.Interval]bool)
+	for _, i := range strings.Split(s, ",") {
+		interval, err := kline.ParseInterval(strings.TrimSpace(i))
+		if err != nil {
+			return nil, err
+		}
+		if seen[interval] {
+			continue
+		}
+		seen[interval] = true
+		intervals = append(intervals, interval)
+	}
+	return intervals, nil
+}
@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+func TestParseCandleBuilderIntervals(t *testing.T) {
+	i, err := parseCandleBuilderIntervals("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(i) != 4 {
+		t.Errorf("expected 4 default intervals received %d", len(i))
+	}
+
+	i, err = parseCandleBuilderIntervals("1m, 1h,1m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(i) != 2 || i[0] != kline.OneMin || i[1] != kline.OneHour {
+		t.Errorf("unexpected intervals %v", i)
+	}
+
+	if _, err = parseCandleBuilderIntervals("1m,3m"); err == nil {
+		t.Error("expected error for unsupported interval")
+	}
+}
+
+func TestCandleBuilderAdd(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+
+	c := candleBuilderManager{
+		started:   1,
+		intervals: []kline.Interval{kline.OneMin, kline.OneHour},
+		builders:  make(map[candleBuilderKey]*kline.Builder),
+		closed:    make(chan kline.Item, 10),
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := wshandler.TradeData{
+		Exchange:     "Bitstamp",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Price:        100,
+		Amount:       1,
+		Timestamp:    start,
+	}
+	c.Add(&d)
+	d.Price = 110
+	d.Timestamp = start.Add(time.Second * 30)
+	c.Add(&d)
+	if len(c.builders) != 2 {
+		t.Fatalf("expected a builder per interval received %d", len(c.builders))
+	}
+	if len(c.closed) != 0 {
+		t.Fatal("no candles should be closed yet")
+	}
+
+	d.Price = 90
+	d.Timestamp = start.Add(time.Minute)
+	c.Add(&d)
+	if len(c.closed) != 1 {
+		t.Fatalf("expected 1 closed candle received %d", len(c.closed))
+	}
+	k := <-c.closed
+	if k.Interval != kline.OneMin || k.Candles[0].Close != 110 || k.Candles[0].Volume != 2 {
+		t.Errorf("unexpected closed candle %+v", k)
+	}
+
+	c.closeExpired(start.Add(time.Hour))
+	if len(c.closed) != 2 {
+		t.Fatalf("expected 2 expired candles received %d", len(c.closed))
+	}
+
+	for _, x := range []kline.Item{<-c.closed, <-c.closed} {
+		if x.Interval == kline.OneHour && !x.Candles[0].Time.Equal(start) {
+			t.Errorf("unexpected expired candle %+v", x)
+		}
+	}
+
+	d.Timestamp = start.Add(time.Second * 45)
+	c.Add(&d)
+	for key, b := range c.builders {
+		if _, ok := b.Current(); ok {
+			t.Errorf("late trade re-opened a closed %s candle", key.Interval)
+		}
+	}
+
+	c.started = 0
+	c.Add(&d)
+	if len(c.closed) != 0 || len(c.builders) != 2 {
+		t.Error("trades should be ignored when the builder is not started")
+	}
+}
+
+func TestCandleBuilderAddInvalidInterval(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+
+	c := candleBuilderManager{
+		started:   1,
+		intervals: []kline.Interval{0, kline.OneMin},
+		builders:  make(map[candleBuilderKey]*kline.Builder),
+		closed:    make(chan kline.Item, 10),
+	}
+	c.Add(&wshandler.TradeData{
+		Exchange:     "Bitstamp",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Price:        100,
+		Amount:       1,
+		Timestamp:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if len(c.builders) != 1 {
+		t.Errorf("expected the valid interval to be built received %d builders",
+			len(c.builders))
+	}
+}
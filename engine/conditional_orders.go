@@ -0,0 +1,761 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/conditionalorders"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errConditionalOrderNotFound      = errors.New("conditional order not found")
+	errConditionalAmountInvalid      = errors.New("conditional order amount must be greater than zero")
+	errConditionalTriggerPriceNotSet = errors.New("conditional order trigger price must be greater than zero")
+	errTrailingStopInvalid           = errors.New("trailing stop requires either a trailing amount or a trailing percentage")
+	errTrailingPercentInvalid        = errors.New("trailing stop percentage must be less than 100")
+)
+
+// Started returns if the conditional order manager subsystem is started
+func (c *conditionalOrderManager) Started() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+// Start starts the conditional order manager subsystem
+func (c *conditionalOrderManager) Start() error {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.OrderMgr, conditionalOrderManagerName, MsgSubSystemStarting)
+	c.m.Lock()
+	c.orders = make(map[string]*ConditionalOrder)
+	c.markets = make(map[string]*conditionalMarket)
+	c.m.Unlock()
+
+	c.shutdown = make(chan struct{})
+	c.updates = make(chan conditionalPriceUpdate)
+	c.persist = Bot.DatabaseManager.Started()
+	if c.persist {
+		c.load()
+	}
+	go c.run()
+	return nil
+}
+
+// Stop stops the conditional order manager subsystem
+func (c *conditionalOrderManager) Stop() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.OrderMgr, conditionalOrderManagerName, MsgSubSystemShuttingDown)
+	close(c.shutdown)
+	return nil
+}
+
+func (c *conditionalOrderManager) run() {
+	log.Debugln(log.OrderMgr, conditionalOrderManagerName, MsgSubSystemStarted)
+	Bot.ServicesWG.Add(1)
+
+	tick := time.NewTicker(conditionalOrderRetryInterval)
+
+	defer func() {
+		tick.Stop()
+		c.wg.Wait()
+		c.flushWatermarks()
+		atomic.CompareAndSwapInt32(&c.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.OrderMgr, conditionalOrderManagerName, MsgSubSystemShutdown)
+	}()
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-tick.C:
+			c.m.Lock()
+			for _, m := range c.markets {
+				c.subscribe(m)
+			}
+			c.m.Unlock()
+			c.flushWatermarks()
+		case u := <-c.updates:
+			c.process(&u)
+		}
+	}
+}
+
+// load restores the active conditional orders written by a previous run.
+// Orders that were triggered but whose submission never completed are marked
+// as failed as it is unknown whether their order was placed.
+func (c *conditionalOrderManager) load() {
+	triggered, err := conditionalorders.GetByStatus(string(ConditionalTriggered))
+	if err != nil {
+		log.Errorf(log.OrderMgr, "%s unable to load triggered conditional orders. Err: %s\n",
+			conditionalOrderManagerName, err)
+	}
+	for x := range triggered {
+		o := conditionalOrderFromData(&triggered[x])
+		o.Status = ConditionalFailed
+		o.Error = "engine stopped before the order submission completed, check the exchange for the order"
+		o.UpdatedAt = time.Now()
+		c.m.Lock()
+		c.orders[o.ID] = o
+		c.m.Unlock()
+		c.updateStored(o)
+		c.notify(fmt.Sprintf("%s: Exchange %s conditional order ID=%v %s.",
+			conditionalOrderManagerName, o.Exchange, o.ID, o.Error))
+	}
+
+	active, err := conditionalorders.GetByStatus(string(ConditionalActive))
+	if err != nil {
+		log.Errorf(log.OrderMgr, "%s unable to load active conditional orders. Err: %s\n",
+			conditionalOrderManagerName, err)
+		return
+	}
+	c.m.Lock()
+	for x := range active {
+		o := conditionalOrderFromData(&active[x])
+		c.orders[o.ID] = o
+		c.market(o)
+	}
+	c.m.Unlock()
+	log.Debugf(log.OrderMgr, "%s loaded %d active conditional order(s) from the database.\n",
+		conditionalOrderManagerName, len(active))
+}
+
+// Add validates a conditional order and starts watching its market, the
+// stored conditional order is returned
+func (c *conditionalOrderManager) Add(o *ConditionalOrder) (ConditionalOrder, error) {
+	if !c.Started() {
+		return ConditionalOrder{}, fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+	if o == nil {
+		return ConditionalOrder{}, errors.New("conditional order is nil")
+	}
+
+	exch := GetExchangeByName(o.Exchange)
+	if exch == nil {
+		return ConditionalOrder{}, errors.New("unable to get exchange by name")
+	}
+	if !exch.GetAssetTypes().Contains(o.Asset) {
+		return ConditionalOrder{}, errors.New("conditional order asset type not supported by exchange")
+	}
+	if !exch.GetEnabledPairs(o.Asset).Contains(o.Pair, true) {
+		return ConditionalOrder{}, fmt.Errorf("%s %s is not enabled on exchange %s",
+			o.Pair, o.Asset, exch.GetName())
+	}
+
+	ord := *o
+	switch ord.Side {
+	case order.Bid:
+		ord.Side = order.Buy
+	case order.Ask:
+		ord.Side = order.Sell
+	}
+	if ord.OrderType == "" {
+		ord.OrderType = order.Market
+		if ord.LimitPrice > 0 {
+			ord.OrderType = order.Limit
+		}
+	}
+	err := ord.validate()
+	if err != nil {
+		return ConditionalOrder{}, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ConditionalOrder{}, err
+	}
+	now := time.Now()
+	ord.ID = id.String()
+	ord.Exchange = exch.GetName()
+	ord.Status = ConditionalActive
+	ord.Watermark = 0
+	ord.ExchangeOrderID = ""
+	ord.OurOrderID = ""
+	ord.Error = ""
+	ord.CreatedAt = now
+	ord.UpdatedAt = now
+	ord.dirty = false
+	if ord.Condition == ConditionTrailingStop {
+		// Set from the watermark on the first price update
+		ord.TriggerPrice = 0
+	}
+
+	if c.persist {
+		err = conditionalorders.Insert(conditionalOrderToData(&ord))
+		if err != nil {
+			return ConditionalOrder{}, err
+		}
+	}
+
+	c.m.Lock()
+	stored := ord
+	c.orders[stored.ID] = &stored
+	c.market(&stored)
+	c.m.Unlock()
+
+	log.Debugf(log.OrderMgr, "%s: Exchange %s added %s conditional order ID=%v pair=%v side=%v amount=%v.\n",
+		conditionalOrderManagerName, ord.Exchange, strings.ToLower(string(ord.Condition)),
+		ord.ID, ord.Pair, ord.Side, ord.Amount)
+	return ord, nil
+}
+
+// Cancel cancels an active conditional order
+func (c *conditionalOrderManager) Cancel(id string) (ConditionalOrder, error) {
+	if !c.Started() {
+		return ConditionalOrder{}, fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+
+	c.m.Lock()
+	o, ok := c.orders[id]
+	if !ok {
+		c.m.Unlock()
+		return ConditionalOrder{}, errConditionalOrderNotFound
+	}
+	if o.Status != ConditionalActive {
+		status := o.Status
+		c.m.Unlock()
+		return ConditionalOrder{}, fmt.Errorf("conditional order %s is %s and cannot be cancelled",
+			id, strings.ToLower(string(status)))
+	}
+	o.Status = ConditionalCancelled
+	o.UpdatedAt = time.Now()
+	o.dirty = false
+	cancelled := *o
+	c.releaseUnused(marketKey(o.Exchange, o.Pair, o.Asset))
+	c.m.Unlock()
+
+	c.updateStored(&cancelled)
+	log.Debugf(log.OrderMgr, "%s: Exchange %s cancelled conditional order ID=%v.\n",
+		conditionalOrderManagerName, cancelled.Exchange, cancelled.ID)
+	return cancelled, nil
+}
+
+// Get returns the conditional orders added since the subsystem started and
+// the active orders restored from the database in creation order. An empty
+// exchange name or status returns the orders of every exchange or status.
+func (c *conditionalOrderManager) Get(exchName string, status ConditionalOrderStatus) ([]ConditionalOrder, error) {
+	if !c.Started() {
+		return nil, fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+
+	c.m.Lock()
+	var resp []ConditionalOrder
+	for _, o := range c.orders {
+		if exchName != "" && !strings.EqualFold(o.Exchange, exchName) {
+			continue
+		}
+		if status != "" && o.Status != status {
+			continue
+		}
+		resp = append(resp, *o)
+	}
+	c.m.Unlock()
+
+	sort.Slice(resp, func(i, j int) bool {
+		if !resp[i].CreatedAt.Equal(resp[j].CreatedAt) {
+			return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+		}
+		return resp[i].ID < resp[j].ID
+	})
+	return resp, nil
+}
+
+// get returns a conditional order by ID
+func (c *conditionalOrderManager) get(id string) (ConditionalOrder, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	o, ok := c.orders[id]
+	if !ok {
+		return ConditionalOrder{}, false
+	}
+	return *o, true
+}
+
+// marketKey returns a key identifying an exchange, currency pair and asset
+func marketKey(exchName string, p currency.Pair, a asset.Item) string {
+	return strings.ToLower(exchName) + " " + p.Format("", true).String() + " " + a.String()
+}
+
+// market returns the market of a conditional order and subscribes to it if
+// it is not yet watched, the lock must be held
+func (c *conditionalOrderManager) market(o *ConditionalOrder) *conditionalMarket {
+	key := marketKey(o.Exchange, o.Pair, o.Asset)
+	m, ok := c.markets[key]
+	if ok {
+		return m
+	}
+	m = &conditionalMarket{
+		key:      key,
+		exchange: o.Exchange,
+		pair:     o.Pair,
+		asset:    o.Asset,
+		release:  make(chan struct{}),
+	}
+	c.markets[key] = m
+	c.subscribe(m)
+	return m
+}
+
+// releaseUnused stops watching a market once it has no active conditional
+// orders, the lock must be held
+func (c *conditionalOrderManager) releaseUnused(key string) {
+	m, ok := c.markets[key]
+	if !ok {
+		return
+	}
+	for _, o := range c.orders {
+		if o.Status == ConditionalActive &&
+			marketKey(o.Exchange, o.Pair, o.Asset) == key {
+			return
+		}
+	}
+	close(m.release)
+	delete(c.markets, key)
+}
+
+// subscribe subscribes to the ticker and orderbook of a market if they have
+// not yet been subscribed to, either may not exist until the exchange has
+// received its first update. The lock must be held.
+func (c *conditionalOrderManager) subscribe(m *conditionalMarket) {
+	if !m.tickerSubscribed {
+		pipe, err := ticker.SubscribeTickerWithOptions(m.exchange, m.pair, m.asset,
+			dispatch.SubscribeOptions{
+				Name: fmt.Sprintf("conditional orders ticker %s %s %s",
+					m.exchange, m.pair, m.asset),
+				Policy: dispatch.PolicyConflate,
+			})
+		if err == nil {
+			m.tickerSubscribed = true
+			c.wg.Add(1)
+			go c.relayTicker(m, pipe)
+		}
+	}
+	if !m.orderbookSubscribed {
+		pipe, err := orderbook.SubscribeOrderbookWithOptions(m.exchange, m.pair, m.asset,
+			dispatch.SubscribeOptions{
+				Name: fmt.Sprintf("conditional orders orderbook %s %s %s",
+					m.exchange, m.pair, m.asset),
+				Policy: dispatch.PolicyConflate,
+			})
+		if err == nil {
+			m.orderbookSubscribed = true
+			c.wg.Add(1)
+			go c.relayOrderbook(m, pipe)
+		}
+	}
+}
+
+// relayTicker seeds the current ticker of a market and forwards its updates
+// until the market is released or the subsystem stops
+func (c *conditionalOrderManager) relayTicker(m *conditionalMarket, pipe ticker.Pipe) {
+	defer c.wg.Done()
+	defer c.releasePipe(m, pipe.Release)
+
+	if t, err := ticker.GetTicker(m.exchange, m.pair, m.asset); err == nil {
+		u := tickerPriceUpdate(m, t)
+		if !c.send(m, &u) {
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-m.release:
+			return
+		case t, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			u := tickerPriceUpdate(m, t)
+			if !c.send(m, &u) {
+				return
+			}
+		}
+	}
+}
+
+// relayOrderbook seeds the current orderbook of a market and forwards its
+// updates until the market is released or the subsystem stops
+func (c *conditionalOrderManager) relayOrderbook(m *conditionalMarket, pipe orderbook.Pipe) {
+	defer c.wg.Done()
+	defer c.releasePipe(m, pipe.Release)
+
+	if b, err := orderbook.Get(m.exchange, m.pair, m.asset); err == nil {
+		u := orderbookPriceUpdate(m, b)
+		if !c.send(m, &u) {
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-m.release:
+			return
+		case b, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			u := orderbookPriceUpdate(m, b)
+			if !c.send(m, &u) {
+				return
+			}
+		}
+	}
+}
+
+// releasePipe releases a market subscription
+func (c *conditionalOrderManager) releasePipe(m *conditionalMarket, release func() error) {
+	if err := release(); err != nil {
+		log.Errorf(log.OrderMgr, "%s %s unable to release subscription: %v\n",
+			conditionalOrderManagerName, m.key, err)
+	}
+}
+
+// send delivers a price update, it returns false if the market was released
+// or the subsystem stopped first
+func (c *conditionalOrderManager) send(m *conditionalMarket, u *conditionalPriceUpdate) bool {
+	select {
+	case c.updates <- *u:
+		return true
+	case <-c.shutdown:
+	case <-m.release:
+	}
+	return false
+}
+
+func tickerPriceUpdate(m *conditionalMarket, t *ticker.Price) conditionalPriceUpdate {
+	return conditionalPriceUpdate{
+		market: m,
+		bid:    t.Bid,
+		ask:    t.Ask,
+		last:   t.Last,
+	}
+}
+
+func orderbookPriceUpdate(m *conditionalMarket, b *orderbook.Base) conditionalPriceUpdate {
+	u := conditionalPriceUpdate{
+		market:    m,
+		orderbook: true,
+	}
+	if len(b.Bids) > 0 {
+		u.bid = b.Bids[0].Price
+	}
+	if len(b.Asks) > 0 {
+		u.ask = b.Asks[0].Price
+	}
+	return u
+}
+
+// price returns the price a conditional order on a side would execute at, the
+// best orderbook bid for sells and ask for buys, falling back to the ticker
+func (m *conditionalMarket) price(side order.Side) float64 {
+	prices := []float64{m.bookAsk, m.ask, m.last}
+	if side == order.Sell {
+		prices = []float64{m.bookBid, m.bid, m.last}
+	}
+	for x := range prices {
+		if prices[x] > 0 {
+			return prices[x]
+		}
+	}
+	return 0
+}
+
+// process applies a price update to its market and submits the orders of the
+// conditional orders whose conditions are met
+func (c *conditionalOrderManager) process(u *conditionalPriceUpdate) {
+	c.m.Lock()
+	m := u.market
+	if c.markets[m.key] != m {
+		// Released after the update was sent
+		c.m.Unlock()
+		return
+	}
+	if u.orderbook {
+		m.bookBid, m.bookAsk = u.bid, u.ask
+	} else {
+		m.bid, m.ask, m.last = u.bid, u.ask, u.last
+	}
+
+	now := time.Now()
+	var triggered []ConditionalOrder
+	var prices []float64
+	for _, o := range c.orders {
+		if o.Status != ConditionalActive ||
+			marketKey(o.Exchange, o.Pair, o.Asset) != m.key {
+			continue
+		}
+		price := m.price(o.Side)
+		if price <= 0 || !o.evaluate(price) {
+			continue
+		}
+		o.Status = ConditionalTriggered
+		o.UpdatedAt = now
+		o.dirty = false
+		triggered = append(triggered, *o)
+		prices = append(prices, price)
+	}
+	if len(triggered) > 0 {
+		c.releaseUnused(m.key)
+	}
+	c.m.Unlock()
+
+	for x := range triggered {
+		log.Debugf(log.OrderMgr, "%s: Exchange %s %s conditional order ID=%v triggered at price=%v.\n",
+			conditionalOrderManagerName, triggered[x].Exchange,
+			strings.ToLower(string(triggered[x].Condition)), triggered[x].ID, prices[x])
+		c.updateStored(&triggered[x])
+		c.wg.Add(1)
+		go c.submit(triggered[x])
+	}
+}
+
+// submit places the order of a triggered conditional order through the order
+// manager and records the result
+func (c *conditionalOrderManager) submit(o ConditionalOrder) {
+	defer c.wg.Done()
+	resp, err := Bot.OrderManager.Submit(o.Exchange, o.submission())
+
+	c.m.Lock()
+	stored := c.orders[o.ID]
+	if err != nil {
+		stored.Status = ConditionalFailed
+		stored.Error = err.Error()
+	} else {
+		stored.Status = ConditionalSubmitted
+		stored.ExchangeOrderID = resp.OrderID
+		stored.OurOrderID = resp.OurOrderID
+	}
+	stored.UpdatedAt = time.Now()
+	result := *stored
+	c.m.Unlock()
+
+	c.updateStored(&result)
+	if err != nil {
+		c.notify(fmt.Sprintf("%s: Exchange %s %s conditional order ID=%v unable to submit order. Err: %s",
+			conditionalOrderManagerName, result.Exchange,
+			strings.ToLower(string(result.Condition)), result.ID, err))
+		return
+	}
+	c.notify(fmt.Sprintf("%s: Exchange %s %s conditional order ID=%v submitted order ID=%v [Ours: %v] pair=%v side=%v type=%v amount=%v.",
+		conditionalOrderManagerName, result.Exchange,
+		strings.ToLower(string(result.Condition)), result.ID, result.ExchangeOrderID,
+		result.OurOrderID, result.Pair, result.Side, result.OrderType, result.Amount))
+}
+
+func (c *conditionalOrderManager) notify(msg string) {
+	log.Debugln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// flushWatermarks writes the trailing stop watermarks that moved since they
+// were last written to the database
+func (c *conditionalOrderManager) flushWatermarks() {
+	if !c.persist {
+		return
+	}
+	var moved []ConditionalOrder
+	c.m.Lock()
+	for _, o := range c.orders {
+		if !o.dirty {
+			continue
+		}
+		o.dirty = false
+		moved = append(moved, *o)
+	}
+	c.m.Unlock()
+	for x := range moved {
+		c.updateStored(&moved[x])
+	}
+}
+
+// updateStored writes the status, watermark and result of a conditional order
+// to the conditional order repository
+func (c *conditionalOrderManager) updateStored(o *ConditionalOrder) {
+	if !c.persist {
+		return
+	}
+	err := conditionalorders.Update(conditionalOrderToData(o))
+	if err != nil {
+		log.Errorf(log.OrderMgr, "%s unable to update stored conditional order ID=%v. Err: %s\n",
+			conditionalOrderManagerName, o.ID, err)
+	}
+}
+
+// validate checks the condition and order parameters of a conditional order
+func (c *ConditionalOrder) validate() error {
+	if c.Amount <= 0 {
+		return errConditionalAmountInvalid
+	}
+	if c.Side != order.Buy && c.Side != order.Sell {
+		return order.ErrSideIsInvalid
+	}
+	if c.OrderType != order.Market && c.OrderType != order.Limit {
+		return order.ErrTypeIsInvalid
+	}
+	if c.OrderType == order.Limit && c.LimitPrice <= 0 {
+		return order.ErrPriceMustBeSetIfLimitOrder
+	}
+
+	switch c.Condition {
+	case ConditionStopLoss, ConditionTakeProfit:
+		if c.TriggerPrice <= 0 {
+			return errConditionalTriggerPriceNotSet
+		}
+	case ConditionTrailingStop:
+		if (c.TrailingAmount > 0) == (c.TrailingPercent > 0) ||
+			c.TrailingAmount < 0 || c.TrailingPercent < 0 {
+			return errTrailingStopInvalid
+		}
+		if c.TrailingPercent >= 100 {
+			return errTrailingPercentInvalid
+		}
+	default:
+		return fmt.Errorf("invalid conditional order condition %q", c.Condition)
+	}
+	return nil
+}
+
+// evaluate returns whether the condition of a conditional order is met at a
+// price. Trailing stops move their watermark and trigger price with the price
+// first.
+func (c *ConditionalOrder) evaluate(price float64) bool {
+	sell := c.Side == order.Sell
+	switch c.Condition {
+	case ConditionStopLoss:
+		if sell {
+			return price <= c.TriggerPrice
+		}
+		return price >= c.TriggerPrice
+	case ConditionTakeProfit:
+		if sell {
+			return price >= c.TriggerPrice
+		}
+		return price <= c.TriggerPrice
+	case ConditionTrailingStop:
+		if c.Watermark == 0 ||
+			(sell && price > c.Watermark) ||
+			(!sell && price < c.Watermark) {
+			c.Watermark = price
+			c.dirty = true
+		}
+		c.TriggerPrice = c.trailingStopPrice()
+		if sell {
+			return price <= c.TriggerPrice
+		}
+		return price >= c.TriggerPrice
+	}
+	return false
+}
+
+// trailingStopPrice returns the trigger price of a trailing stop from its
+// watermark
+func (c *ConditionalOrder) trailingStopPrice() float64 {
+	offset := c.TrailingAmount
+	if c.TrailingPercent > 0 {
+		offset = c.Watermark * c.TrailingPercent / 100
+	}
+	if c.Side == order.Sell {
+		return c.Watermark - offset
+	}
+	return c.Watermark + offset
+}
+
+// submission returns the order submitted when a conditional order triggers,
+// its ID is used as the client ID
+func (c *ConditionalOrder) submission() *order.Submit {
+	s := &order.Submit{
+		Pair:      c.Pair,
+		AssetType: c.Asset,
+		OrderType: c.OrderType,
+		OrderSide: c.Side,
+		Amount:    c.Amount,
+		ClientID:  c.ID,
+	}
+	if c.OrderType == order.Limit {
+		s.Price = c.LimitPrice
+	}
+	return s
+}
+
+func conditionalOrderToData(o *ConditionalOrder) *conditionalorders.Data {
+	return &conditionalorders.Data{
+		ID:              o.ID,
+		Exchange:        o.Exchange,
+		Pair:            o.Pair,
+		AssetType:       o.Asset,
+		Side:            o.Side,
+		ConditionType:   string(o.Condition),
+		OrderType:       o.OrderType,
+		TriggerPrice:    o.TriggerPrice,
+		TrailingAmount:  o.TrailingAmount,
+		TrailingPercent: o.TrailingPercent,
+		LimitPrice:      o.LimitPrice,
+		Amount:          o.Amount,
+		Watermark:       o.Watermark,
+		Status:          string(o.Status),
+		ExchangeOrderID: o.ExchangeOrderID,
+		OurOrderID:      o.OurOrderID,
+		Error:           o.Error,
+		CreatedAt:       o.CreatedAt,
+		UpdatedAt:       o.UpdatedAt,
+	}
+}
+
+func conditionalOrderFromData(d *conditionalorders.Data) *ConditionalOrder {
+	// Exchange names are stored in lower case
+	exchName := d.Exchange
+	if exch := GetExchangeByName(exchName); exch != nil {
+		exchName = exch.GetName()
+	}
+	return &ConditionalOrder{
+		ID:              d.ID,
+		Exchange:        exchName,
+		Pair:            d.Pair,
+		Asset:           d.AssetType,
+		Side:            d.Side,
+		Condition:       ConditionType(d.ConditionType),
+		OrderType:       d.OrderType,
+		TriggerPrice:    d.TriggerPrice,
+		TrailingAmount:  d.TrailingAmount,
+		TrailingPercent: d.TrailingPercent,
+		LimitPrice:      d.LimitPrice,
+		Amount:          d.Amount,
+		Watermark:       d.Watermark,
+		Status:          ConditionalOrderStatus(d.Status),
+		ExchangeOrderID: d.ExchangeOrderID,
+		OurOrderID:      d.OurOrderID,
+		Error:           d.Error,
+		CreatedAt:       d.CreatedAt,
+		UpdatedAt:       d.UpdatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestConditionalOrderValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		order ConditionalOrder
+		valid bool
+	}{
+		{"stop loss", ConditionalOrder{Condition: ConditionStopLoss, Side: order.Sell, OrderType: order.Market, Amount: 1, TriggerPrice: 100}, true},
+		{"no trigger price", ConditionalOrder{Condition: ConditionTakeProfit, Side: order.Sell, OrderType: order.Market, Amount: 1}, false},
+		{"no amount", ConditionalOrder{Condition: ConditionStopLoss, Side: order.Sell, OrderType: order.Market, TriggerPrice: 100}, false},
+		{"invalid side", ConditionalOrder{Condition: ConditionStopLoss, Side: order.AnySide, OrderType: order.Market, Amount: 1, TriggerPrice: 100}, false},
+		{"limit without price", ConditionalOrder{Condition: ConditionStopLoss, Side: order.Buy, OrderType: order.Limit, Amount: 1, TriggerPrice: 100}, false},
+		{"trailing amount", ConditionalOrder{Condition: ConditionTrailingStop, Side: order.Sell, OrderType: order.Market, Amount: 1, TrailingAmount: 10}, true},
+		{"trailing amount and percent", ConditionalOrder{Condition: ConditionTrailingStop, Side: order.Sell, OrderType: order.Market, Amount: 1, TrailingAmount: 10, TrailingPercent: 1}, false},
+		{"trailing percent too large", ConditionalOrder{Condition: ConditionTrailingStop, Side: order.Sell, OrderType: order.Market, Amount: 1, TrailingPercent: 100}, false},
+		{"invalid condition", ConditionalOrder{Condition: "BRACKET", Side: order.Sell, OrderType: order.Market, Amount: 1}, false},
+	}
+	for x := range tests {
+		err := tests[x].order.validate()
+		if (err == nil) != tests[x].valid {
+			t.Errorf("%s: expected valid %v received %v", tests[x].name, tests[x].valid, err)
+		}
+	}
+}
+
+func TestConditionalOrderEvaluate(t *testing.T) {
+	t.Parallel()
+	stop := ConditionalOrder{Condition: ConditionStopLoss, Side: order.Sell, TriggerPrice: 100}
+	if stop.evaluate(101) || !stop.evaluate(100) {
+		t.Error("sell stop loss should trigger at or below its trigger price")
+	}
+	stop.Side = order.Buy
+	if stop.evaluate(99) || !stop.evaluate(100) {
+		t.Error("buy stop loss should trigger at or above its trigger price")
+	}
+
+	takeProfit := ConditionalOrder{Condition: ConditionTakeProfit, Side: order.Sell, TriggerPrice: 100}
+	if takeProfit.evaluate(99) || !takeProfit.evaluate(100) {
+		t.Error("sell take profit should trigger at or above its trigger price")
+	}
+
+	trailing := ConditionalOrder{Condition: ConditionTrailingStop, Side: order.Sell, TrailingAmount: 10}
+	for _, price := range []float64{100, 120, 111} {
+		if trailing.evaluate(price) {
+			t.Fatalf("sell trailing stop should not trigger at %v", price)
+		}
+	}
+	if trailing.Watermark != 120 || trailing.TriggerPrice != 110 || !trailing.dirty {
+		t.Errorf("unexpected watermark %v trigger price %v", trailing.Watermark, trailing.TriggerPrice)
+	}
+	if !trailing.evaluate(110) {
+		t.Error("sell trailing stop should trigger at its trailing price")
+	}
+
+	trailing = ConditionalOrder{Condition: ConditionTrailingStop, Side: order.Buy, TrailingPercent: 10}
+	for _, price := range []float64{100, 80, 87} {
+		if trailing.evaluate(price) {
+			t.Fatalf("buy trailing stop should not trigger at %v", price)
+		}
+	}
+	if trailing.Watermark != 80 || trailing.TriggerPrice != 88 {
+		t.Errorf("unexpected watermark %v trigger price %v", trailing.Watermark, trailing.TriggerPrice)
+	}
+	if !trailing.evaluate(88) {
+		t.Error("buy trailing stop should trigger at its trailing price")
+	}
+}
+
+func TestConditionalMarketPrice(t *testing.T) {
+	t.Parallel()
+	m := conditionalMarket{last: 100}
+	if p := m.price(order.Sell); p != 100 {
+		t.Errorf("expected last price received %v", p)
+	}
+	m.bid, m.ask = 99, 101
+	if p := m.price(order.Buy); p != 101 {
+		t.Errorf("expected ticker ask received %v", p)
+	}
+	m.bookBid, m.bookAsk = 98, 102
+	if p := m.price(order.Sell); p != 98 {
+		t.Errorf("expected orderbook bid received %v", p)
+	}
+	if p := m.price(order.Buy); p != 102 {
+		t.Errorf("expected orderbook ask received %v", p)
+	}
+}
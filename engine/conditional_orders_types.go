@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	conditionalOrderManagerName = "Conditional order manager"
+	// conditionalOrderRetryInterval is how often markets without a ticker or
+	// orderbook to subscribe to are retried and trailing stop watermarks are
+	// written to the database
+	conditionalOrderRetryInterval = time.Second * 10
+)
+
+// ConditionType is the trigger condition of a conditional order
+type ConditionType string
+
+// Conditional order condition types
+const (
+	// ConditionStopLoss triggers a sell when the price falls to the trigger
+	// price or a buy when it rises to it
+	ConditionStopLoss ConditionType = "STOP_LOSS"
+	// ConditionTakeProfit triggers a sell when the price rises to the trigger
+	// price or a buy when it falls to it
+	ConditionTakeProfit ConditionType = "TAKE_PROFIT"
+	// ConditionTrailingStop triggers a sell when the price falls by the
+	// trailing amount or percentage from its highest price since the order was
+	// added, or a buy when it rises by it from its lowest price
+	ConditionTrailingStop ConditionType = "TRAILING_STOP"
+)
+
+// ConditionalOrderStatus is the state of a conditional order
+type ConditionalOrderStatus string
+
+// Conditional order statuses
+const (
+	// ConditionalActive is waiting for its condition to be met
+	ConditionalActive ConditionalOrderStatus = "ACTIVE"
+	// ConditionalTriggered has met its condition and its order is being
+	// submitted
+	ConditionalTriggered ConditionalOrderStatus = "TRIGGERED"
+	// ConditionalSubmitted has had its order placed on the exchange
+	ConditionalSubmitted ConditionalOrderStatus = "SUBMITTED"
+	// ConditionalCancelled was cancelled before its condition was met
+	ConditionalCancelled ConditionalOrderStatus = "CANCELLED"
+	// ConditionalFailed met its condition but its order could not be placed
+	ConditionalFailed ConditionalOrderStatus = "FAILED"
+)
+
+// ConditionalOrder is an order held by the engine that is submitted to its
+// exchange through the order manager once its condition is met. Market orders
+// are submitted unless a limit price is set. Trailing stops use either a
+// trailing amount or a trailing percentage, their watermark is the highest
+// price seen for sells and the lowest for buys and their trigger price follows
+// it.
+type ConditionalOrder struct {
+	ID              string
+	Exchange        string
+	Pair            currency.Pair
+	Asset           asset.Item
+	Side            order.Side
+	Condition       ConditionType
+	OrderType       order.Type
+	TriggerPrice    float64
+	TrailingAmount  float64
+	TrailingPercent float64
+	LimitPrice      float64
+	Amount          float64
+	Watermark       float64
+	Status          ConditionalOrderStatus
+	ExchangeOrderID string
+	OurOrderID      string
+	Error           string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	// dirty is set when the watermark has moved since it was last written
+	// to the database
+	dirty bool
+}
+
+// conditionalOrderManager watches the ticker and orderbook updates of the
+// markets with active conditional orders and submits their orders when their
+// conditions are met
+type conditionalOrderManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	// persist is set on start when the database manager is running,
+	// conditional orders are then written to the conditional order repository
+	// and active orders are restored on start
+	persist bool
+
+	m       sync.Mutex
+	orders  map[string]*ConditionalOrder
+	markets map[string]*conditionalMarket
+	updates chan conditionalPriceUpdate
+	wg      sync.WaitGroup
+}
+
+// conditionalMarket holds the latest prices of a market with active
+// conditional orders and the state of its subscriptions
+type conditionalMarket struct {
+	key                 string
+	exchange            string
+	pair                currency.Pair
+	asset               asset.Item
+	tickerSubscribed    bool
+	orderbookSubscribed bool
+	release             chan struct{}
+
+	bookBid float64
+	bookAsk float64
+	bid     float64
+	ask     float64
+	last    float64
+}
+
+// conditionalPriceUpdate is a ticker or orderbook update for a market
+type conditionalPriceUpdate struct {
+	market    *conditionalMarket
+	orderbook bool
+	bid       float64
+	ask       float64
+	last      float64
+}
@@ -0,0 +1,208 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SubscribeConsolidatedOrderbook returns a consolidated orderbook for a
+// currency pair that is kept up to date through the orderbook subscriptions of
+// every enabled exchange with an enabled pair sharing its base currency. Pairs
+// quoted in a different fiat currency are converted to the quote currency of
+// the supplied pair. When exchanges is set only those exchanges are merged.
+// The consolidated orderbook must be released once no longer required.
+func SubscribeConsolidatedOrderbook(p currency.Pair, a asset.Item, exchanges []string) (*ConsolidatedOrderbook, error) {
+	if p.IsEmpty() {
+		return nil, errors.New("consolidated orderbook currency pair not set")
+	}
+	if !asset.IsValid(a) {
+		return nil, fmt.Errorf("consolidated orderbook invalid asset type %s", a)
+	}
+
+	sources := consolidatedOrderbookSources(p, a, exchanges)
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no enabled exchanges trading %s %s", p, a)
+	}
+
+	ch := make(chan *orderbook.Consolidated, 1)
+	c := &ConsolidatedOrderbook{
+		C:        ch,
+		c:        ch,
+		pair:     p,
+		asset:    a,
+		sources:  sources,
+		books:    make(map[string]*orderbook.Base),
+		updates:  make(chan *orderbook.Base),
+		shutdown: make(chan struct{}),
+	}
+	c.wg.Add(1)
+	go c.run()
+	return c, nil
+}
+
+// Release stops updating the consolidated orderbook and releases its
+// orderbook subscriptions
+func (c *ConsolidatedOrderbook) Release() {
+	c.once.Do(func() {
+		close(c.shutdown)
+		c.wg.Wait()
+	})
+}
+
+// consolidatedOrderbookSources returns the exchange pairs that can be merged
+// into a consolidated orderbook for the pair, sorted by exchange name so the
+// consolidated levels are ordered consistently
+func consolidatedOrderbookSources(p currency.Pair, a asset.Item, exchanges []string) []consolidatedSource {
+	var sources []consolidatedSource
+	exchs := GetExchanges()
+	for x := range exchs {
+		if !exchs[x].IsEnabled() || !exchs[x].GetAssetTypes().Contains(a) {
+			continue
+		}
+		name := strings.ToLower(exchs[x].GetName())
+		if len(exchanges) > 0 && !common.StringDataCompareInsensitive(exchanges, name) {
+			continue
+		}
+		pairs := exchs[x].GetEnabledPairs(a)
+		for y := range pairs {
+			if pairs[y].Base.Item != p.Base.Item {
+				continue
+			}
+			if pairs[y].Quote.Item != p.Quote.Item &&
+				!(pairs[y].Quote.IsFiatCurrency() && p.Quote.IsFiatCurrency()) {
+				continue
+			}
+			sources = append(sources, consolidatedSource{
+				exchange: name,
+				pair:     pairs[y],
+			})
+		}
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		if sources[i].exchange != sources[j].exchange {
+			return sources[i].exchange < sources[j].exchange
+		}
+		return sources[i].pair.String() < sources[j].pair.String()
+	})
+	return sources
+}
+
+func consolidatedOrderbookKey(exchangeName string, p currency.Pair) string {
+	return strings.ToLower(exchangeName) + " " + p.Format("", true).String()
+}
+
+func (c *ConsolidatedOrderbook) run() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(consolidatedOrderbookRetryInterval)
+	defer ticker.Stop()
+
+	if c.subscribe() {
+		c.publish()
+	}
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-ticker.C:
+			if c.subscribe() {
+				c.publish()
+			}
+		case b := <-c.updates:
+			c.books[consolidatedOrderbookKey(b.ExchangeName, b.Pair)] = b
+			c.publish()
+		}
+	}
+}
+
+// subscribe subscribes to the orderbooks of sources that have not yet been
+// subscribed to and seeds their current orderbook, it returns whether any
+// orderbook was seeded
+func (c *ConsolidatedOrderbook) subscribe() bool {
+	var seeded bool
+	for x := range c.sources {
+		if c.sources[x].subscribed {
+			continue
+		}
+		pipe, err := orderbook.SubscribeOrderbookWithOptions(c.sources[x].exchange, c.sources[x].pair, c.asset,
+			dispatch.SubscribeOptions{
+				Name: fmt.Sprintf("consolidated orderbook %s %s %s",
+					c.sources[x].exchange, c.sources[x].pair, c.asset),
+				Policy: dispatch.PolicyConflate,
+			})
+		if err != nil {
+			// The exchange has not received an orderbook yet
+			continue
+		}
+		c.sources[x].subscribed = true
+		c.wg.Add(1)
+		go c.relay(pipe)
+
+		b, err := orderbook.Get(c.sources[x].exchange, c.sources[x].pair, c.asset)
+		if err != nil {
+			continue
+		}
+		cpy := *b
+		c.books[consolidatedOrderbookKey(c.sources[x].exchange, c.sources[x].pair)] = &cpy
+		seeded = true
+	}
+	return seeded
+}
+
+// relay forwards orderbook updates from a subscription until released
+func (c *ConsolidatedOrderbook) relay(pipe orderbook.Pipe) {
+	defer c.wg.Done()
+	defer func() {
+		err := pipe.Release()
+		if err != nil {
+			log.Errorf(log.OrderBook, "Consolidated orderbook %s %s unable to release subscription: %v\n",
+				c.pair, c.asset, err)
+		}
+	}()
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case b, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			select {
+			case c.updates <- b:
+			case <-c.shutdown:
+				return
+			}
+		}
+	}
+}
+
+// publish merges the latest orderbook of each source and replaces any
+// consolidated orderbook the subscriber has not yet received
+func (c *ConsolidatedOrderbook) publish() {
+	cons := orderbook.NewConsolidated(c.pair, c.asset)
+	for x := range c.sources {
+		b, ok := c.books[consolidatedOrderbookKey(c.sources[x].exchange, c.sources[x].pair)]
+		if !ok {
+			continue
+		}
+		err := cons.Add(b)
+		if err != nil {
+			log.Errorf(log.OrderBook, "Consolidated orderbook %s %s: %v\n", c.pair, c.asset, err)
+		}
+	}
+
+	select {
+	case <-c.c:
+	default:
+	}
+	c.c <- cons
+}
@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestSubscribeConsolidatedOrderbook(t *testing.T) {
+	SetupTest(t)
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := SubscribeConsolidatedOrderbook(currency.Pair{}, asset.Spot, nil)
+	if err == nil {
+		t.Error("expected error with an empty pair")
+	}
+	_, err = SubscribeConsolidatedOrderbook(currency.NewPair(currency.BTC, currency.USD), asset.Spot, []string{"fake"})
+	if err == nil {
+		t.Error("expected error with no matching exchanges")
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	b := &orderbook.Base{
+		ExchangeName: testExchange,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Bids:         []orderbook.Item{{Price: 100, Amount: 1}},
+		Asks:         []orderbook.Item{{Price: 101, Amount: 1}},
+	}
+	err = b.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := SubscribeConsolidatedOrderbook(p, asset.Spot, []string{testExchange})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Release()
+
+	cons := waitConsolidatedOrderbook(t, c)
+	if len(cons.Bids) != 1 || cons.Bids[0].Exchange != "bitstamp" || cons.Bids[0].Price != 100 {
+		t.Fatalf("unexpected seeded consolidated orderbook %+v", cons)
+	}
+
+	// The dispatcher drops updates for subscribers that are not ready to
+	// receive so keep processing until the update is relayed
+	b.Bids = []orderbook.Item{{Price: 100.5, Amount: 2}, {Price: 99, Amount: 3}}
+	for i := 0; ; i++ {
+		err = b.Process()
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case cons = <-c.C:
+		case <-time.After(time.Millisecond * 100):
+			if i == 50 {
+				t.Fatal("timed out waiting for consolidated orderbook update")
+			}
+			continue
+		}
+		break
+	}
+	if len(cons.Bids) != 2 || cons.Bids[0].Price != 100.5 {
+		t.Fatalf("unexpected updated consolidated orderbook %+v", cons)
+	}
+
+	c.Release()
+	c.Release()
+}
+
+func waitConsolidatedOrderbook(t *testing.T, c *ConsolidatedOrderbook) *orderbook.Consolidated {
+	t.Helper()
+	select {
+	case cons := <-c.C:
+		return cons
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for consolidated orderbook")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// consolidatedOrderbookRetryInterval is how often exchanges without an
+// orderbook to subscribe to are retried
+const consolidatedOrderbookRetryInterval = time.Second * 10
+
+// ConsolidatedOrderbook maintains a consolidated orderbook for a currency pair
+// from the orderbook updates of every enabled exchange trading it. Each update
+// is delivered on C, a subscriber that falls behind only receives the latest
+// consolidated orderbook.
+type ConsolidatedOrderbook struct {
+	C <-chan *orderbook.Consolidated
+
+	c        chan *orderbook.Consolidated
+	pair     currency.Pair
+	asset    asset.Item
+	sources  []consolidatedSource
+	books    map[string]*orderbook.Base
+	updates  chan *orderbook.Base
+	shutdown chan struct{}
+	once     sync.Once
+	wg       sync.WaitGroup
+}
+
+// consolidatedSource is an exchange pair merged into a consolidated orderbook
+type consolidatedSource struct {
+	exchange   string
+	pair       currency.Pair
+	subscribed bool
+}
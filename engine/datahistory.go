@@ -0,0 +1,377 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errDataHistoryJobNotFound = errors.New("data history job not found")
+
+// Started returns if the data history manager subsystem is started
+func (d *dataHistoryManager) Started() bool {
+	return atomic.LoadInt32(&d.started) == 1
+}
+
+// Start starts the data history manager subsystem and loads the configured
+// jobs, a database connection is required
+func (d *dataHistoryManager) Start() (err error) {
+	if atomic.AddInt32(&d.started, 1) != 1 {
+		return fmt.Errorf("%s %s", dataHistoryManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	defer func() {
+		if err != nil {
+			atomic.CompareAndSwapInt32(&d.started, 1, 0)
+		}
+	}()
+
+	log.Debugln(log.Global, dataHistoryManagerName, MsgSubSystemStarting)
+
+	if !Bot.DatabaseManager.Started() {
+		return fmt.Errorf("%s requires the database manager to be running",
+			dataHistoryManagerName)
+	}
+
+	d.m.Lock()
+	d.jobs = make(map[string]*DataHistoryJob)
+	d.m.Unlock()
+	for x := range Bot.Config.DataHistory.Jobs {
+		_, err := d.addJob(&Bot.Config.DataHistory.Jobs[x])
+		if err != nil {
+			log.Errorf(log.Global, "%s unable to load job %s: %v\n",
+				dataHistoryManagerName, Bot.Config.DataHistory.Jobs[x].ID, err)
+		}
+	}
+
+	d.shutdown = make(chan struct{})
+	d.check = make(chan struct{}, 1)
+	go d.run()
+	return nil
+}
+
+// Stop stops the data history manager subsystem
+func (d *dataHistoryManager) Stop() error {
+	if atomic.LoadInt32(&d.started) == 0 {
+		return fmt.Errorf("%s %s", dataHistoryManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&d.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", dataHistoryManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.Global, dataHistoryManagerName, MsgSubSystemShuttingDown)
+	close(d.shutdown)
+	return nil
+}
+
+func (d *dataHistoryManager) run() {
+	log.Debugln(log.Global, dataHistoryManagerName, MsgSubSystemStarted)
+	Bot.ServicesWG.Add(1)
+
+	tick := time.NewTicker(Bot.Config.DataHistory.CheckInterval)
+
+	defer func() {
+		tick.Stop()
+		atomic.CompareAndSwapInt32(&d.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&d.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.Global, dataHistoryManagerName, MsgSubSystemShutdown)
+	}()
+
+	d.processJobs()
+	for {
+		select {
+		case <-d.shutdown:
+			return
+		case <-tick.C:
+			d.processJobs()
+		case <-d.check:
+			d.processJobs()
+		}
+	}
+}
+
+// AddJob validates and adds a new candle backfill job, the job is stored in
+// the config so it is resumed on restart. A zero end date keeps the job
+// backfilling up to the current time.
+func (d *dataHistoryManager) AddJob(exchangeName string, p currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*DataHistoryJob, error) {
+	if atomic.LoadInt32(&d.started) == 0 {
+		return nil, fmt.Errorf("%s %s", dataHistoryManagerName, ErrSubSystemNotStarted)
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := config.DataHistoryJobConfig{
+		ID:        id.String(),
+		Exchange:  exchangeName,
+		Pair:      p.Format("-", true),
+		Asset:     a,
+		Interval:  interval.Short(),
+		StartDate: start.UTC(),
+	}
+	if !end.IsZero() {
+		cfg.EndDate = end.UTC()
+	}
+
+	job, err := d.addJob(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	Bot.Config.AddDataHistoryJob(&cfg)
+
+	select {
+	case d.check <- struct{}{}:
+	default:
+	}
+	return job, nil
+}
+
+func (d *dataHistoryManager) addJob(cfg *config.DataHistoryJobConfig) (*DataHistoryJob, error) {
+	if cfg.ID == "" {
+		return nil, errors.New("job ID not set")
+	}
+
+	interval, err := kline.ParseInterval(cfg.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	exch := GetExchangeByName(cfg.Exchange)
+	if exch == nil {
+		return nil, fmt.Errorf("exchange %s not found", cfg.Exchange)
+	}
+
+	start := interval.Truncate(cfg.StartDate)
+	end := cfg.EndDate
+	if end.IsZero() {
+		end = time.Now()
+	}
+	err = exch.GetBase().ValidateKline(cfg.Pair, cfg.Asset, start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &DataHistoryJob{
+		ID:        cfg.ID,
+		Exchange:  exch.GetName(),
+		Pair:      cfg.Pair,
+		Asset:     cfg.Asset,
+		Interval:  interval,
+		StartDate: start,
+		EndDate:   cfg.EndDate,
+		Status:    DataHistoryStatusActive,
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+	if _, ok := d.jobs[job.ID]; ok {
+		return nil, fmt.Errorf("data history job %s already exists", job.ID)
+	}
+	d.jobs[job.ID] = job
+	cpy := *job
+	return &cpy, nil
+}
+
+// RemoveJob removes a job from the manager and the config
+func (d *dataHistoryManager) RemoveJob(id string) error {
+	d.m.Lock()
+	_, ok := d.jobs[id]
+	delete(d.jobs, id)
+	d.m.Unlock()
+	if !ok {
+		return errDataHistoryJobNotFound
+	}
+	return Bot.Config.RemoveDataHistoryJob(id)
+}
+
+// GetJob returns a copy of a job by ID
+func (d *dataHistoryManager) GetJob(id string) (*DataHistoryJob, error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	job, ok := d.jobs[id]
+	if !ok {
+		return nil, errDataHistoryJobNotFound
+	}
+	cpy := *job
+	return &cpy, nil
+}
+
+// GetJobs returns a copy of all jobs
+func (d *dataHistoryManager) GetJobs() []DataHistoryJob {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	jobs := make([]DataHistoryJob, 0, len(d.jobs))
+	for _, job := range d.jobs {
+		jobs = append(jobs, *job)
+	}
+	return jobs
+}
+
+// Progress returns the percentage of expected candles which are stored
+func (j *DataHistoryJob) Progress() float64 {
+	if j.CandlesExpected == 0 {
+		return 0
+	}
+	return float64(j.CandlesStored) / float64(j.CandlesExpected) * 100
+}
+
+func (d *dataHistoryManager) processJobs() {
+	d.m.RLock()
+	ids := make([]string, 0, len(d.jobs))
+	for id, job := range d.jobs {
+		if job.Status == DataHistoryStatusActive {
+			ids = append(ids, id)
+		}
+	}
+	d.m.RUnlock()
+
+	for x := range ids {
+		select {
+		case <-d.shutdown:
+			return
+		default:
+			d.processJob(ids[x])
+		}
+	}
+}
+
+// processJob compares the stored candles for a job against its date range
+// and requests any missing ranges from the exchange. Requests go through the
+// exchange wrapper so the exchange rate limiter is respected.
+func (d *dataHistoryManager) processJob(id string) {
+	d.m.RLock()
+	j, ok := d.jobs[id]
+	if !ok {
+		d.m.RUnlock()
+		return
+	}
+	job := *j
+	job.unavailable = append([]kline.DateRange(nil), j.unavailable...)
+	d.m.RUnlock()
+
+	now := time.Now()
+	end := job.EndDate
+	if end.IsZero() || end.After(now) {
+		end = now
+	}
+	// Only request candles for periods which have closed
+	end = job.Interval.Truncate(end)
+
+	var lastErr error
+	remaining := 0
+	defer func() {
+		d.m.Lock()
+		defer d.m.Unlock()
+		j, ok := d.jobs[id]
+		if !ok {
+			return
+		}
+		j.CandlesExpected = job.CandlesExpected
+		j.CandlesStored = job.CandlesStored
+		j.MissingRanges = remaining
+		j.LastRun = now
+		j.unavailable = job.unavailable
+		j.Status = job.Status
+		j.Error = ""
+		if lastErr != nil {
+			j.Error = lastErr.Error()
+		}
+		if j.Status == DataHistoryStatusActive &&
+			lastErr == nil &&
+			remaining == 0 &&
+			!job.EndDate.IsZero() &&
+			!job.EndDate.After(now) {
+			j.Status = DataHistoryStatusComplete
+		}
+	}()
+
+	if !job.StartDate.Before(end) {
+		return
+	}
+
+	exch := GetExchangeByName(job.Exchange)
+	if exch == nil {
+		lastErr = fmt.Errorf("exchange %s not found", job.Exchange)
+		return
+	}
+
+	stored, err := candle.Series(job.Exchange, job.Pair, job.Asset, job.Interval, job.StartDate, end)
+	if err != nil && err != kline.ErrNoCandles {
+		lastErr = err
+		return
+	}
+
+	job.CandlesExpected = int64(kline.TotalCandlesPerInterval(job.StartDate, end, job.Interval))
+	job.CandlesStored = int64(len(stored.Candles))
+
+	var missing []kline.DateRange
+	for _, r := range stored.MissingRanges(job.StartDate, end) {
+		if !rangeCovered(r, job.unavailable) {
+			missing = append(missing, r)
+		}
+	}
+	remaining = len(missing)
+
+	for x := range missing {
+		select {
+		case <-d.shutdown:
+			return
+		default:
+		}
+
+		k, err := exch.GetHistoricCandles(job.Pair, job.Asset, missing[x].Start, missing[x].End, job.Interval)
+		if err != nil {
+			lastErr = err
+			if err == common.ErrNotYetImplemented || err == common.ErrFunctionNotSupported {
+				job.Status = DataHistoryStatusFailed
+			}
+			return
+		}
+
+		if len(k.Candles) > 0 {
+			err = candle.Insert(&k)
+			if err != nil {
+				lastErr = err
+				return
+			}
+			job.CandlesStored += int64(len(k.Candles))
+		}
+
+		// Record periods the exchange has no data for so they are not
+		// continually requested
+		k.Interval = job.Interval
+		job.unavailable = append(job.unavailable, k.MissingRanges(missing[x].Start, missing[x].End)...)
+		remaining--
+
+		if Bot.Settings.Verbose {
+			log.Debugf(log.Global, "%s job %s stored %d %s %s %s candles between %s and %s\n",
+				dataHistoryManagerName, job.ID, len(k.Candles), job.Exchange,
+				job.Pair, job.Interval, missing[x].Start, missing[x].End)
+		}
+	}
+}
+
+// rangeCovered returns whether r falls entirely within one of the supplied
+// ranges
+func rangeCovered(r kline.DateRange, ranges []kline.DateRange) bool {
+	for x := range ranges {
+		if !r.Start.Before(ranges[x].Start) && !r.End.After(ranges[x].End) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestDataHistoryManagerStart(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+
+	var d dataHistoryManager
+	if err := d.Start(); err == nil {
+		t.Error("expected error when the database manager is not running")
+	}
+	if d.Started() {
+		t.Error("manager should not be started after a failed start")
+	}
+	if _, err := d.AddJob("Binance", currency.NewPair(currency.BTC, currency.USDT), asset.Spot, kline.OneHour, time.Now(), time.Time{}); err == nil {
+		t.Error("expected error adding a job when the manager is not started")
+	}
+	if _, err := d.GetJob("1337"); err != errDataHistoryJobNotFound {
+		t.Errorf("expected %v received %v", errDataHistoryJobNotFound, err)
+	}
+	if err := d.RemoveJob("1337"); err != errDataHistoryJobNotFound {
+		t.Errorf("expected %v received %v", errDataHistoryJobNotFound, err)
+	}
+}
+
+func TestDataHistoryJobProgress(t *testing.T) {
+	var j DataHistoryJob
+	if j.Progress() != 0 {
+		t.Error("expected no progress when no candles are expected")
+	}
+	j.CandlesExpected = 200
+	j.CandlesStored = 50
+	if j.Progress() != 25 {
+		t.Errorf("expected 25 received %v", j.Progress())
+	}
+}
+
+func TestRangeCovered(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ranges := []kline.DateRange{{Start: start, End: start.Add(time.Hour * 5)}}
+	if !rangeCovered(kline.DateRange{Start: start.Add(time.Hour), End: start.Add(time.Hour * 2)}, ranges) {
+		t.Error("expected range to be covered")
+	}
+	if rangeCovered(kline.DateRange{Start: start.Add(time.Hour * 4), End: start.Add(time.Hour * 6)}, ranges) {
+		t.Error("expected range to not be covered")
+	}
+}
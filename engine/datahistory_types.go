@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Data history job statuses
+const (
+	DataHistoryStatusActive   = "active"
+	DataHistoryStatusComplete = "complete"
+	DataHistoryStatusFailed   = "failed"
+)
+
+const dataHistoryManagerName = "Data history"
+
+// DataHistoryJob holds a candle backfill job and its progress
+type DataHistoryJob struct {
+	ID              string
+	Exchange        string
+	Pair            currency.Pair
+	Asset           asset.Item
+	Interval        kline.Interval
+	StartDate       time.Time
+	EndDate         time.Time
+	Status          string
+	CandlesExpected int64
+	CandlesStored   int64
+	MissingRanges   int
+	LastRun         time.Time
+	Error           string
+
+	// unavailable holds the ranges the exchange returned no candles for so
+	// they are not requested again each check
+	unavailable []kline.DateRange
+}
+
+// dataHistoryManager detects missing candles for each job and backfills them
+// from the exchange
+type dataHistoryManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	check    chan struct{}
+
+	m    sync.RWMutex
+	jobs map[string]*DataHistoryJob
+}
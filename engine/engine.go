@@ -33,7 +33,13 @@ type Engine struct {
 	ConnectionManager           connectionManager
 	DatabaseManager             databaseManager
 	GctScriptManager            gctScriptManager
+	TradePersistenceManager     tradePersistenceManager
+	CandleBuilderManager        candleBuilderManager
+	DataHistoryManager          dataHistoryManager
+	ArbitrageMonitor            arbitrageMonitor
 	OrderManager                orderManager
+	ConditionalOrderManager     conditionalOrderManager
+	ExecutionManager            executionManager
 	PortfolioManager            portfolioManager
 	CommsManager                commsManager
 	exchangeManager             exchangeManager
@@ -118,6 +124,23 @@ func ValidateSettings(b *Engine, s *Settings) {
 	b.Settings.EnableCoinmarketcapAnalysis = s.EnableCoinmarketcapAnalysis
 	b.Settings.EnableDatabaseManager = s.EnableDatabaseManager
 	b.Settings.EnableGCTScriptManager = s.EnableGCTScriptManager
+	b.Settings.EnableTradePersistence = s.EnableTradePersistence
+	b.Settings.TradePersistenceBatchSize = s.TradePersistenceBatchSize
+	b.Settings.TradePersistenceFlushInterval = s.TradePersistenceFlushInterval
+	b.Settings.EnableCandleBuilder = s.EnableCandleBuilder
+	b.Settings.EnableDataHistoryManager = s.EnableDataHistoryManager
+	b.Settings.EnableArbitrageMonitor = s.EnableArbitrageMonitor
+	b.Settings.CandleBuilderIntervals = s.CandleBuilderIntervals
+	b.Settings.CandleBuilderPersist = s.CandleBuilderPersist
+	b.Settings.EnableOrderbookRecorder = s.EnableOrderbookRecorder
+	b.Settings.OrderReconciliationInterval = s.OrderReconciliationInterval
+	b.Settings.CancelOrdersOnShutdown = s.CancelOrdersOnShutdown
+	b.Settings.EnableDeadMansSwitch = s.EnableDeadMansSwitch
+	b.Settings.DeadMansSwitchTimeout = s.DeadMansSwitchTimeout
+	b.Settings.OrderbookRecorderDir = s.OrderbookRecorderDir
+	if b.Settings.OrderbookRecorderDir == "" {
+		b.Settings.OrderbookRecorderDir = filepath.Join(b.Settings.DataDir, "orderbooks")
+	}
 	b.Settings.MaxVirtualMachines = s.MaxVirtualMachines
 	b.Settings.EnableDispatcher = s.EnableDispatcher
 	b.Settings.EnablePortfolioManager = s.EnablePortfolioManager
@@ -175,6 +198,8 @@ func ValidateSettings(b *Engine, s *Settings) {
 	b.Settings.EnableConnectivityMonitor = s.EnableConnectivityMonitor
 	b.Settings.EnableNTPClient = s.EnableNTPClient
 	b.Settings.EnableOrderManager = s.EnableOrderManager
+	b.Settings.EnableConditionalOrderManager = s.EnableConditionalOrderManager
+	b.Settings.EnableExecutionManager = s.EnableExecutionManager
 	b.Settings.EnableExchangeSyncManager = s.EnableExchangeSyncManager
 	b.Settings.EnableTickerSyncing = s.EnableTickerSyncing
 	b.Settings.EnableOrderbookSyncing = s.EnableOrderbookSyncing
@@ -252,11 +277,27 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable event manager: %v", s.EnableEventManager)
 	gctlog.Debugf(gctlog.Global, "\t Event manager sleep delay: %v", s.EventManagerDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable order manager: %v", s.EnableOrderManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable conditional order manager: %v", s.EnableConditionalOrderManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable execution manager: %v", s.EnableExecutionManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange sync manager: %v", s.EnableExchangeSyncManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable deposit address manager: %v\n", s.EnableDepositAddressManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket routine: %v\n", s.EnableWebsocketRoutine)
 	gctlog.Debugf(gctlog.Global, "\t Enable NTP client: %v", s.EnableNTPClient)
 	gctlog.Debugf(gctlog.Global, "\t Enable Database manager: %v", s.EnableDatabaseManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable trade persistence: %v", s.EnableTradePersistence)
+	gctlog.Debugf(gctlog.Global, "\t Trade persistence batch size: %d", s.TradePersistenceBatchSize)
+	gctlog.Debugf(gctlog.Global, "\t Trade persistence flush interval: %v", s.TradePersistenceFlushInterval)
+	gctlog.Debugf(gctlog.Global, "\t Enable candle builder: %v", s.EnableCandleBuilder)
+	gctlog.Debugf(gctlog.Global, "\t Candle builder intervals: %v", s.CandleBuilderIntervals)
+	gctlog.Debugf(gctlog.Global, "\t Candle builder persist: %v", s.CandleBuilderPersist)
+	gctlog.Debugf(gctlog.Global, "\t Order reconciliation interval: %v", s.OrderReconciliationInterval)
+	gctlog.Debugf(gctlog.Global, "\t Cancel orders on shutdown: %v", s.CancelOrdersOnShutdown)
+	gctlog.Debugf(gctlog.Global, "\t Enable dead man's switch: %v", s.EnableDeadMansSwitch)
+	gctlog.Debugf(gctlog.Global, "\t Dead man's switch timeout: %v", s.DeadMansSwitchTimeout)
+	gctlog.Debugf(gctlog.Global, "\t Enable orderbook recorder: %v", s.EnableOrderbookRecorder)
+	gctlog.Debugf(gctlog.Global, "\t Orderbook recorder directory: %v", s.OrderbookRecorderDir)
+	gctlog.Debugf(gctlog.Global, "\t Enable data history manager: %v", s.EnableDataHistoryManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable arbitrage monitor: %v", s.EnableArbitrageMonitor)
 	gctlog.Debugf(gctlog.Global, "\t Enable dispatcher: %v", s.EnableDispatcher)
 	gctlog.Debugf(gctlog.Global, "\t Dispatch package max worker amount: %d", s.DispatchMaxWorkerAmount)
 	gctlog.Debugf(gctlog.Global, "\t Dispatch package jobs limit: %d", s.DispatchJobsLimit)
@@ -307,6 +348,18 @@ func (e *Engine) Start() error {
 		}
 	}
 
+	if e.Settings.EnableTradePersistence {
+		if err := e.TradePersistenceManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Trade persistence manager unable to start: %v", err)
+		}
+	}
+
+	if e.Settings.EnableCandleBuilder {
+		if err := e.CandleBuilderManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle builder unable to start: %v", err)
+		}
+	}
+
 	if e.Settings.EnableDispatcher {
 		if err := dispatch.Start(e.Settings.DispatchMaxWorkerAmount, e.Settings.DispatchJobsLimit); err != nil {
 			gctlog.Errorf(gctlog.DispatchMgr, "Dispatcher unable to start: %v", err)
@@ -414,6 +467,34 @@ func (e *Engine) Start() error {
 		}
 	}
 
+	if e.Settings.EnableConditionalOrderManager {
+		if err = e.ConditionalOrderManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Conditional order manager unable to start: %v", err)
+		}
+	}
+
+	if e.Settings.EnableExecutionManager {
+		if err = e.ExecutionManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Execution manager unable to start: %v", err)
+		}
+	}
+
+	if e.Settings.EnableDataHistoryManager {
+		if e.Config.DataHistory.Enabled {
+			if err = e.DataHistoryManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Data history manager unable to start: %v", err)
+			}
+		}
+	}
+
+	if e.Settings.EnableArbitrageMonitor {
+		if e.Config.Arbitrage.Enabled {
+			if err = e.ArbitrageMonitor.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Arbitrage monitor unable to start: %v", err)
+			}
+		}
+	}
+
 	if e.Settings.EnableExchangeSyncManager {
 		exchangeSyncCfg := CurrencyPairSyncerConfig{
 			SyncTicker:       e.Settings.EnableTickerSyncing,
@@ -465,6 +546,16 @@ func (e *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if e.ExecutionManager.Started() {
+		if err := e.ExecutionManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Execution manager unable to stop. Error: %v", err)
+		}
+	}
+	if e.ConditionalOrderManager.Started() {
+		if err := e.ConditionalOrderManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Conditional order manager unable to stop. Error: %v", err)
+		}
+	}
 	if e.OrderManager.Started() {
 		if err := e.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -495,6 +586,37 @@ func (e *Engine) Stop() {
 		}
 	}
 
+	if e.DataHistoryManager.Started() {
+		if err := e.DataHistoryManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Data history manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.ArbitrageMonitor.Started() {
+		if err := e.ArbitrageMonitor.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage monitor unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.CandleBuilderManager.Started() {
+		if err := e.CandleBuilderManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle builder unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.TradePersistenceManager.Started() {
+		if err := e.TradePersistenceManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Trade persistence manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.Settings.EnableOrderbookRecorder {
+		exchanges := GetExchanges()
+		for x := range exchanges {
+			stopOrderbookRecording(exchanges[x])
+		}
+	}
+
 	if e.DatabaseManager.Started() {
 		if err := e.DatabaseManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to stop. Error: %v", err)
@@ -12,28 +12,35 @@ type Settings struct {
 	CheckParamInteraction bool
 
 	// Core Settings
-	EnableDryRun                bool
-	EnableAllExchanges          bool
-	EnableAllPairs              bool
-	EnableCoinmarketcapAnalysis bool
-	EnablePortfolioManager      bool
-	PortfolioManagerDelay       time.Duration
-	EnableGRPC                  bool
-	EnableGRPCProxy             bool
-	EnableWebsocketRPC          bool
-	EnableDeprecatedRPC         bool
-	EnableCommsRelayer          bool
-	EnableExchangeSyncManager   bool
-	EnableDepositAddressManager bool
-	EnableEventManager          bool
-	EnableOrderManager          bool
-	EnableConnectivityMonitor   bool
-	EnableDatabaseManager       bool
-	EnableGCTScriptManager      bool
-	EnableNTPClient             bool
-	EnableWebsocketRoutine      bool
-	EventManagerDelay           time.Duration
-	Verbose                     bool
+	EnableDryRun                  bool
+	EnableAllExchanges            bool
+	EnableAllPairs                bool
+	EnableCoinmarketcapAnalysis   bool
+	EnablePortfolioManager        bool
+	PortfolioManagerDelay         time.Duration
+	EnableGRPC                    bool
+	EnableGRPCProxy               bool
+	EnableWebsocketRPC            bool
+	EnableDeprecatedRPC           bool
+	EnableCommsRelayer            bool
+	EnableExchangeSyncManager     bool
+	EnableDepositAddressManager   bool
+	EnableEventManager            bool
+	EnableOrderManager            bool
+	EnableConditionalOrderManager bool
+	EnableExecutionManager        bool
+	EnableConnectivityMonitor     bool
+	EnableDatabaseManager         bool
+	EnableGCTScriptManager        bool
+	EnableTradePersistence        bool
+	EnableCandleBuilder           bool
+	EnableOrderbookRecorder       bool
+	EnableDataHistoryManager      bool
+	EnableArbitrageMonitor        bool
+	EnableNTPClient               bool
+	EnableWebsocketRoutine        bool
+	EventManagerDelay             time.Duration
+	Verbose                       bool
 
 	// Exchange syncer settings
 	EnableTickerSyncing    bool
@@ -43,6 +50,23 @@ type Settings struct {
 	SyncContinuously       bool
 	SyncTimeout            time.Duration
 
+	// Trade persistence settings
+	TradePersistenceBatchSize     int
+	TradePersistenceFlushInterval time.Duration
+
+	// Candle builder settings
+	CandleBuilderIntervals string
+	CandleBuilderPersist   bool
+
+	// Orderbook recorder settings
+	OrderbookRecorderDir string
+
+	// Order manager settings
+	OrderReconciliationInterval time.Duration
+	CancelOrdersOnShutdown      bool
+	EnableDeadMansSwitch        bool
+	DeadMansSwitchTimeout       time.Duration
+
 	// Forex settings
 	EnableCurrencyConverter bool
 	EnableCurrencyLayer     bool
@@ -127,6 +127,10 @@ func (e *exchangeManager) unloadExchange(exchangeName string) error {
 		return err
 	}
 
+	if exch := e.getExchangeByName(exchangeName); exch != nil {
+		stopOrderbookRecording(exch)
+	}
+
 	err = e.removeExchange(exchangeName)
 	if err != nil {
 		return err
@@ -320,6 +324,13 @@ func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 
 	Bot.exchangeManager.add(exch)
 
+	if exch.IsWebsocketEnabled() {
+		setOrderbookResync(exch)
+		if Bot.Settings.EnableOrderbookRecorder {
+			startOrderbookRecording(exch)
+		}
+	}
+
 	base := exch.GetBase()
 	if base.API.AuthenticatedSupport ||
 		base.API.AuthenticatedWebsocketSupport {
@@ -336,6 +347,18 @@ func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 		}
 	}
 
+	// Trading rules are updated with the tradable pairs when the exchange
+	// updates its pairs automatically
+	if !base.GetEnabledFeatures().AutoPairUpdates {
+		err = exch.UpdateTradingRules()
+		if err != nil && err != common.ErrFunctionNotSupported {
+			log.Warnf(log.ExchangeSys,
+				"%s: Cannot fetch trading rules, orders will not be rounded to the exchange price and amount increments, Error: %s\n",
+				exch.GetName(),
+				err)
+		}
+	}
+
 	if useWG {
 		exch.Start(wg)
 	} else {
@@ -370,3 +393,43 @@ func SetupExchanges() {
 	}
 	wg.Wait()
 }
+
+// setOrderbookResync resynchronises websocket orderbooks that fail
+// verification from the exchange REST API
+func setOrderbookResync(exch exchange.IBotExchange) {
+	ws, err := exch.GetWebsocket()
+	if err != nil || ws == nil {
+		return
+	}
+	ws.Orderbook.SetResync(exch.UpdateOrderbook)
+}
+
+// startOrderbookRecording records the websocket orderbook snapshots and updates
+// of an exchange so they can be replayed
+func startOrderbookRecording(exch exchange.IBotExchange) {
+	ws, err := exch.GetWebsocket()
+	if err == nil {
+		err = ws.Orderbook.StartRecording(Bot.Settings.OrderbookRecorderDir)
+	}
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "%s unable to record orderbooks: %v\n",
+			exch.GetName(), err)
+		return
+	}
+	log.Debugf(log.ExchangeSys, "%s recording orderbooks to %s\n",
+		exch.GetName(), Bot.Settings.OrderbookRecorderDir)
+}
+
+// stopOrderbookRecording flushes and closes an exchange's orderbook
+// recordings
+func stopOrderbookRecording(exch exchange.IBotExchange) {
+	ws, err := exch.GetWebsocket()
+	if err != nil || ws == nil || !ws.Orderbook.IsRecording() {
+		return
+	}
+	err = ws.Orderbook.StopRecording()
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "%s unable to stop recording orderbooks: %v\n",
+			exch.GetName(), err)
+	}
+}
@@ -0,0 +1,691 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errExecutionOrderNotFound     = errors.New("execution order not found")
+	errExecutionAmountInvalid     = errors.New("execution order amount must be greater than zero")
+	errExecutionDurationInvalid   = errors.New("execution order duration must be greater than zero")
+	errExecutionParticipation     = errors.New("execution order participation rate must be between 0 and 1")
+	errExecutionLimitPrice        = errors.New("execution order limit price cannot be negative")
+	errExecutionRequiresOrderMgr  = errors.New("execution orders require the order manager")
+	errExecutionSliceIntervalLong = errors.New("execution order slice interval cannot be longer than its duration")
+)
+
+// Started returns if the execution manager subsystem is started
+func (e *executionManager) Started() bool {
+	return atomic.LoadInt32(&e.started) == 1
+}
+
+// Start starts the execution manager subsystem
+func (e *executionManager) Start() error {
+	if atomic.AddInt32(&e.started, 1) != 1 {
+		return fmt.Errorf("%s %s", executionManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.OrderMgr, executionManagerName, MsgSubSystemStarting)
+	e.m.Lock()
+	e.orders = make(map[string]*storedExecutionOrder)
+	e.m.Unlock()
+	e.volumeMtx.Lock()
+	e.volumes = make(map[string]float64)
+	e.volumeMtx.Unlock()
+
+	e.shutdown = make(chan struct{})
+	go e.run()
+	return nil
+}
+
+// Stop stops the execution manager subsystem, working child orders are left
+// to the order manager
+func (e *executionManager) Stop() error {
+	if atomic.LoadInt32(&e.started) == 0 {
+		return fmt.Errorf("%s %s", executionManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&e.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", executionManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.OrderMgr, executionManagerName, MsgSubSystemShuttingDown)
+	close(e.shutdown)
+	return nil
+}
+
+func (e *executionManager) run() {
+	log.Debugln(log.OrderMgr, executionManagerName, MsgSubSystemStarted)
+	Bot.ServicesWG.Add(1)
+
+	tick := time.NewTicker(executionCheckDelay)
+
+	defer func() {
+		tick.Stop()
+		atomic.CompareAndSwapInt32(&e.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&e.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.OrderMgr, executionManagerName, MsgSubSystemShutdown)
+	}()
+
+	for {
+		select {
+		case <-e.shutdown:
+			return
+		case t := <-tick.C:
+			e.check(t)
+		}
+	}
+}
+
+// Add validates an execution order and starts slicing it into child orders,
+// the stored execution order is returned
+func (e *executionManager) Add(o *ExecutionOrder) (ExecutionOrder, error) {
+	if !e.Started() {
+		return ExecutionOrder{}, fmt.Errorf("%s %s", executionManagerName, ErrSubSystemNotStarted)
+	}
+	if !Bot.OrderManager.Started() {
+		return ExecutionOrder{}, errExecutionRequiresOrderMgr
+	}
+	if o == nil {
+		return ExecutionOrder{}, errors.New("execution order is nil")
+	}
+
+	exch := GetExchangeByName(o.Exchange)
+	if exch == nil {
+		return ExecutionOrder{}, errors.New("unable to get exchange by name")
+	}
+	ord := o.copy()
+	ord.Children = nil
+	if ord.Asset == "" {
+		ord.Asset = asset.Spot
+	}
+	if !exch.GetAssetTypes().Contains(ord.Asset) {
+		return ExecutionOrder{}, errors.New("execution order asset type not supported by exchange")
+	}
+	if !exch.GetEnabledPairs(ord.Asset).Contains(ord.Pair, true) {
+		return ExecutionOrder{}, fmt.Errorf("%s %s is not enabled on exchange %s",
+			ord.Pair, ord.Asset, exch.GetName())
+	}
+	switch ord.Side {
+	case order.Bid:
+		ord.Side = order.Buy
+	case order.Ask:
+		ord.Side = order.Sell
+	}
+	if ord.SliceInterval == 0 {
+		ord.SliceInterval = ord.Duration / executionDefaultSlices
+	}
+	err := ord.validate()
+	if err != nil {
+		return ExecutionOrder{}, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ExecutionOrder{}, err
+	}
+	now := time.Now()
+	ord.ID = id.String()
+	ord.Exchange = exch.GetName()
+	ord.Status = ExecutionRunning
+	ord.Reason = ""
+	ord.ExecutedAmount = 0
+	ord.StartTime = now
+	ord.EndTime = now.Add(ord.Duration)
+	ord.CreatedAt = now
+	ord.UpdatedAt = now
+	ord.nextSlice = now
+	ord.failures = 0
+	ord.avgVolume = 0
+	ord.slices = 0
+
+	key := marketKey(ord.Exchange, ord.Pair, ord.Asset)
+	e.volumeMtx.Lock()
+	if _, ok := e.volumes[key]; !ok {
+		e.volumes[key] = 0
+	}
+	ord.volumeMark = e.volumes[key]
+	e.volumeMtx.Unlock()
+
+	e.m.Lock()
+	e.orders[ord.ID] = &storedExecutionOrder{order: ord.copy()}
+	e.m.Unlock()
+
+	log.Debugf(log.OrderMgr, "%s: Exchange %s added %s execution order ID=%v pair=%v side=%v amount=%v duration=%v slice interval=%v.\n",
+		executionManagerName, ord.Exchange, ord.Algorithm, ord.ID, ord.Pair, ord.Side,
+		ord.Amount, ord.Duration, ord.SliceInterval)
+	return ord, nil
+}
+
+// Pause stops submitting child orders for a running execution order and
+// cancels its working child order
+func (e *executionManager) Pause(id string) (ExecutionOrder, error) {
+	if !e.Started() {
+		return ExecutionOrder{}, fmt.Errorf("%s %s", executionManagerName, ErrSubSystemNotStarted)
+	}
+
+	stored, err := e.lookup(id)
+	if err != nil {
+		return ExecutionOrder{}, err
+	}
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	o := e.load(stored)
+	if err = o.checkStatus(ExecutionRunning); err != nil {
+		return ExecutionOrder{}, err
+	}
+	e.settle(&o)
+	o.Status = ExecutionPaused
+	o.Reason = "paused"
+	o.UpdatedAt = time.Now()
+	e.store(stored, &o)
+	return o, nil
+}
+
+// Resume resumes a paused execution order, its remaining amount is spread
+// over what is left of its execution window
+func (e *executionManager) Resume(id string) (ExecutionOrder, error) {
+	if !e.Started() {
+		return ExecutionOrder{}, fmt.Errorf("%s %s", executionManagerName, ErrSubSystemNotStarted)
+	}
+
+	stored, err := e.lookup(id)
+	if err != nil {
+		return ExecutionOrder{}, err
+	}
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	o := e.load(stored)
+	if err = o.checkStatus(ExecutionPaused); err != nil {
+		return ExecutionOrder{}, err
+	}
+	now := time.Now()
+	o.UpdatedAt = now
+	if !now.Before(o.EndTime) {
+		e.finish(&o, ExecutionExpired, fmt.Sprintf("execution window ended while paused with %v of %v executed",
+			o.ExecutedAmount, o.Amount))
+		e.store(stored, &o)
+		return o, nil
+	}
+	o.Status = ExecutionRunning
+	o.Reason = ""
+	o.nextSlice = now
+	e.store(stored, &o)
+	return o, nil
+}
+
+// Cancel cancels a running or paused execution order and its working child
+// order
+func (e *executionManager) Cancel(id string) (ExecutionOrder, error) {
+	if !e.Started() {
+		return ExecutionOrder{}, fmt.Errorf("%s %s", executionManagerName, ErrSubSystemNotStarted)
+	}
+
+	stored, err := e.lookup(id)
+	if err != nil {
+		return ExecutionOrder{}, err
+	}
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	o := e.load(stored)
+	if err = o.checkStatus(""); err != nil {
+		return ExecutionOrder{}, err
+	}
+	e.settle(&o)
+	e.finish(&o, ExecutionCancelled, fmt.Sprintf("cancelled with %v of %v executed",
+		o.ExecutedAmount, o.Amount))
+	e.store(stored, &o)
+	return o, nil
+}
+
+// Get returns the execution orders added since the subsystem started in
+// creation order. An empty exchange name or ID returns every execution order.
+func (e *executionManager) Get(exchName, id string) ([]ExecutionOrder, error) {
+	if !e.Started() {
+		return nil, fmt.Errorf("%s %s", executionManagerName, ErrSubSystemNotStarted)
+	}
+
+	e.m.Lock()
+	var resp []ExecutionOrder
+	for _, stored := range e.orders {
+		if exchName != "" && !strings.EqualFold(stored.order.Exchange, exchName) {
+			continue
+		}
+		if id != "" && stored.order.ID != id {
+			continue
+		}
+		resp = append(resp, stored.order.copy())
+	}
+	e.m.Unlock()
+
+	if id != "" && len(resp) == 0 {
+		return nil, errExecutionOrderNotFound
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		if !resp[i].CreatedAt.Equal(resp[j].CreatedAt) {
+			return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+		}
+		return resp[i].ID < resp[j].ID
+	})
+	return resp, nil
+}
+
+// AddTrade adds the amount of a websocket trade print to the traded volume of
+// its market when it has an execution order
+func (e *executionManager) AddTrade(d *wshandler.TradeData) {
+	if d == nil || !e.Started() {
+		return
+	}
+
+	key := marketKey(d.Exchange, d.CurrencyPair, d.AssetType)
+	e.volumeMtx.Lock()
+	if v, ok := e.volumes[key]; ok {
+		e.volumes[key] = v + d.Amount
+	}
+	e.volumeMtx.Unlock()
+}
+
+// lookup returns a stored execution order
+func (e *executionManager) lookup(id string) (*storedExecutionOrder, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	stored, ok := e.orders[id]
+	if !ok {
+		return nil, errExecutionOrderNotFound
+	}
+	return stored, nil
+}
+
+// load returns a copy of a stored execution order, the order mutex must be
+// held
+func (e *executionManager) load(stored *storedExecutionOrder) ExecutionOrder {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return stored.order.copy()
+}
+
+// store replaces a stored execution order with its updated copy, the order
+// mutex must be held
+func (e *executionManager) store(stored *storedExecutionOrder, o *ExecutionOrder) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	stored.order = o.copy()
+}
+
+// checkStatus returns an error if an execution order does not have a status,
+// an empty status matches either a running or paused order
+func (o *ExecutionOrder) checkStatus(status ExecutionStatus) error {
+	if (status != "" && o.Status != status) ||
+		(status == "" && o.Status != ExecutionRunning && o.Status != ExecutionPaused) {
+		return fmt.Errorf("execution order %s is %s", o.ID, strings.ToLower(string(o.Status)))
+	}
+	return nil
+}
+
+// check processes the slices of running execution orders which are due
+func (e *executionManager) check(now time.Time) {
+	e.m.Lock()
+	due := make([]*storedExecutionOrder, 0, len(e.orders))
+	for _, stored := range e.orders {
+		if stored.order.Status == ExecutionRunning && !now.Before(stored.order.nextSlice) {
+			due = append(due, stored)
+		}
+	}
+	e.m.Unlock()
+
+	for x := range due {
+		e.process(due[x], now)
+	}
+}
+
+// process slices a due execution order on a copy which is stored once its
+// child orders have been settled and submitted
+func (e *executionManager) process(stored *storedExecutionOrder, now time.Time) {
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	o := e.load(stored)
+	if o.Status != ExecutionRunning || now.Before(o.nextSlice) {
+		return
+	}
+	e.slice(&o, now)
+	e.store(stored, &o)
+}
+
+// slice settles the working child order of an execution order and submits
+// the next child order
+func (e *executionManager) slice(o *ExecutionOrder, now time.Time) {
+	settled := e.settle(o)
+	o.UpdatedAt = now
+	if o.remaining() <= 0 {
+		e.finish(o, ExecutionCompleted, fmt.Sprintf("%v executed", o.ExecutedAmount))
+		return
+	}
+	if !now.Before(o.EndTime) {
+		e.finish(o, ExecutionExpired, fmt.Sprintf("execution window ended with %v of %v executed",
+			o.ExecutedAmount, o.Amount))
+		return
+	}
+	if !settled {
+		// The next child order is held back so the parent amount is never
+		// exceeded, the working child is queried again on the next check
+		o.Reason = fmt.Sprintf("waiting for child order ID=%v to be cancelled or closed",
+			o.Children[len(o.Children)-1].OrderID)
+		return
+	}
+
+	for !o.nextSlice.After(now) {
+		o.nextSlice = o.nextSlice.Add(o.SliceInterval)
+	}
+	if o.nextSlice.After(o.EndTime) {
+		o.nextSlice = o.EndTime
+	}
+
+	key := marketKey(o.Exchange, o.Pair, o.Asset)
+	e.volumeMtx.Lock()
+	cumulative := e.volumes[key]
+	e.volumeMtx.Unlock()
+	volume := cumulative - o.volumeMark
+	o.volumeMark = cumulative
+
+	amount, reason := o.sliceAmount(now, volume, cumulative > 0)
+	if amount <= 0 {
+		o.Reason = reason
+		return
+	}
+
+	s := &order.Submit{
+		Pair:      o.Pair,
+		AssetType: o.Asset,
+		OrderType: order.Market,
+		OrderSide: o.Side,
+		Amount:    amount,
+	}
+	if o.LimitPrice > 0 {
+		if price := executionPrice(o); price > 0 &&
+			((o.Side == order.Buy && price > o.LimitPrice) ||
+				(o.Side == order.Sell && price < o.LimitPrice)) {
+			o.Reason = fmt.Sprintf("price %v beyond limit price %v", price, o.LimitPrice)
+			return
+		}
+		s.OrderType = order.Limit
+		s.Price = o.LimitPrice
+	}
+
+	child := ExecutionChild{
+		Amount:    amount,
+		Price:     s.Price,
+		Submitted: now,
+	}
+	resp, err := Bot.OrderManager.Submit(o.Exchange, s)
+	if err != nil {
+		child.Status = order.Rejected
+		child.Error = err.Error()
+		o.Children = append(o.Children, child)
+		o.failures++
+		o.Reason = fmt.Sprintf("unable to submit child order: %s", err)
+		log.Warnf(log.OrderMgr, "%s: Exchange %s execution order ID=%v unable to submit child order. Err: %s\n",
+			executionManagerName, o.Exchange, o.ID, err)
+		if o.failures >= executionMaxSubmitFailures {
+			e.finish(o, ExecutionFailed, fmt.Sprintf("%d consecutive child order submissions failed: %s",
+				o.failures, err))
+		}
+		return
+	}
+	o.failures = 0
+	o.Reason = ""
+	child.OrderID = resp.OrderID
+	child.InternalOrderID = resp.OurOrderID
+	child.Status = order.New
+	if det, err := Bot.OrderManager.orderStore.get(o.Exchange, resp.OrderID); err == nil {
+		child.Status = det.Status
+		child.ExecutedAmount = executedAmount(&det)
+	}
+	o.Children = append(o.Children, child)
+	o.ExecutedAmount = o.childExecutedAmount()
+	log.Debugf(log.OrderMgr, "%s: Exchange %s execution order ID=%v submitted child order ID=%v [Ours: %v] amount=%v price=%v, %v of %v executed.\n",
+		executionManagerName, o.Exchange, o.ID, child.OrderID, child.InternalOrderID,
+		child.Amount, child.Price, o.ExecutedAmount, o.Amount)
+}
+
+// settle brings the working child order of an execution order up to date
+// from the order manager and cancels it if it is still open, it returns
+// whether the child order is closed. A child order that can neither be
+// cancelled nor found closed on the exchange keeps its last known status and
+// fills and is queried again before another child order is submitted.
+func (e *executionManager) settle(o *ExecutionOrder) bool {
+	defer func() {
+		o.ExecutedAmount = o.childExecutedAmount()
+	}()
+	if len(o.Children) == 0 {
+		return true
+	}
+	child := &o.Children[len(o.Children)-1]
+	if child.OrderID == "" || isClosedStatus(child.Status) {
+		return true
+	}
+
+	if det, err := Bot.OrderManager.orderStore.get(o.Exchange, child.OrderID); err == nil {
+		child.Status = det.Status
+		child.ExecutedAmount = executedAmount(&det)
+		if isClosedStatus(child.Status) {
+			return true
+		}
+	}
+
+	cancelErr := Bot.OrderManager.Cancel(o.Exchange, &order.Cancel{
+		OrderID:      child.OrderID,
+		CurrencyPair: o.Pair,
+		AssetType:    o.Asset,
+		Side:         o.Side,
+	})
+
+	var info order.Detail
+	infoErr := errors.New("unable to get exchange by name")
+	if exch := GetExchangeByName(o.Exchange); exch != nil {
+		info, infoErr = exch.GetOrderInfo(child.OrderID)
+	}
+	if infoErr == nil && info.ExecutedAmount > child.ExecutedAmount {
+		child.ExecutedAmount = info.ExecutedAmount
+	}
+
+	switch {
+	case cancelErr == nil:
+		child.Status = order.Cancelled
+		if child.ExecutedAmount > 0 {
+			child.Status = order.PartiallyCancelled
+		}
+		child.Error = ""
+		return true
+	case infoErr == nil && isClosedStatus(info.Status):
+		child.Status = info.Status
+		child.ExecutedAmount = executedAmount(&info)
+		child.Error = ""
+		return true
+	}
+	if infoErr == nil && info.Status != "" {
+		child.Status = info.Status
+	}
+	child.Error = fmt.Sprintf("unable to cancel child order: %s", cancelErr)
+	log.Warnf(log.OrderMgr, "%s: Exchange %s execution order ID=%v unable to cancel child order ID=%v or confirm it closed, it will be queried again. Err: %s\n",
+		executionManagerName, o.Exchange, o.ID, child.OrderID, cancelErr)
+	return false
+}
+
+// finish closes an execution order and stops tracking the traded volume of
+// its market once no other execution order uses it
+func (e *executionManager) finish(o *ExecutionOrder, status ExecutionStatus, reason string) {
+	o.Status = status
+	o.Reason = reason
+	o.UpdatedAt = time.Now()
+
+	key := marketKey(o.Exchange, o.Pair, o.Asset)
+	var inUse bool
+	e.m.Lock()
+	for _, stored := range e.orders {
+		v := &stored.order
+		if v.ID != o.ID &&
+			(v.Status == ExecutionRunning || v.Status == ExecutionPaused) &&
+			marketKey(v.Exchange, v.Pair, v.Asset) == key {
+			inUse = true
+			break
+		}
+	}
+	e.m.Unlock()
+	if !inUse {
+		e.volumeMtx.Lock()
+		delete(e.volumes, key)
+		e.volumeMtx.Unlock()
+	}
+
+	msg := fmt.Sprintf("%s: Exchange %s %s execution order ID=%v pair=%v side=%v status=%v, %s.",
+		executionManagerName, o.Exchange, o.Algorithm, o.ID, o.Pair, o.Side, o.Status, reason)
+	log.Debugln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// executionPrice returns the ticker price an execution order child would
+// trade at, the ask for buys and the bid for sells falling back to the last
+// price
+func executionPrice(o *ExecutionOrder) float64 {
+	t, err := ticker.GetTicker(o.Exchange, o.Pair, o.Asset)
+	if err != nil {
+		return 0
+	}
+	price := t.Ask
+	if o.Side == order.Sell {
+		price = t.Bid
+	}
+	if price <= 0 {
+		price = t.Last
+	}
+	return price
+}
+
+// executedAmount returns the executed amount of an order, filled orders
+// without an executed amount are assumed to have filled in full
+func executedAmount(d *order.Detail) float64 {
+	if d.Status == order.Filled && d.ExecutedAmount == 0 {
+		return d.Amount
+	}
+	return d.ExecutedAmount
+}
+
+func (o *ExecutionOrder) childExecutedAmount() float64 {
+	var executed float64
+	for x := range o.Children {
+		executed += o.Children[x].ExecutedAmount
+	}
+	return executed
+}
+
+// remaining returns the amount left to execute, amounts within a rounding
+// error of the parent amount are treated as executed
+func (o *ExecutionOrder) remaining() float64 {
+	r := o.Amount - o.ExecutedAmount
+	if r <= o.Amount*1e-9 {
+		return 0
+	}
+	return r
+}
+
+// sliceAmount returns the amount of the next child order from the traded
+// volume since the last slice, or a reason when no child order is due. VWAP
+// orders use their first slice to measure traded volume and fall back to
+// slicing evenly on markets without websocket trade prints. The final slice
+// of the window is given the remaining amount.
+func (o *ExecutionOrder) sliceAmount(now time.Time, volume float64, volumeSeen bool) (float64, string) {
+	remaining := o.remaining()
+	slicesLeft := math.Ceil(float64(o.EndTime.Sub(now)) / float64(o.SliceInterval))
+	if slicesLeft < 1 {
+		slicesLeft = 1
+	}
+	o.slices++
+
+	amount := remaining / slicesLeft
+	if o.Algorithm == ExecutionVWAP && o.slices == 1 {
+		return 0, "measuring traded volume for the first slice interval"
+	}
+	if o.Algorithm == ExecutionVWAP && volumeSeen && slicesLeft > 1 {
+		measured := float64(o.slices - 1)
+		o.avgVolume += (volume - o.avgVolume) / measured
+		if o.avgVolume <= 0 {
+			return 0, "no traded volume"
+		}
+		amount = remaining * volume / (o.avgVolume * slicesLeft)
+	}
+
+	if o.ParticipationRate > 0 {
+		if !volumeSeen {
+			return 0, "waiting for websocket trade volume to apply the participation rate"
+		}
+		if limit := volume * o.ParticipationRate; amount > limit {
+			amount = limit
+		}
+	}
+	if amount > remaining {
+		amount = remaining
+	}
+	if amount <= 0 {
+		return 0, "no traded volume"
+	}
+	return amount, ""
+}
+
+// validate checks the parameters of an execution order
+func (o *ExecutionOrder) validate() error {
+	if o.Algorithm != ExecutionTWAP && o.Algorithm != ExecutionVWAP {
+		return fmt.Errorf("invalid execution algorithm %q", o.Algorithm)
+	}
+	if o.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+	if o.Side != order.Buy && o.Side != order.Sell {
+		return order.ErrSideIsInvalid
+	}
+	if o.Amount <= 0 {
+		return errExecutionAmountInvalid
+	}
+	if o.Duration <= 0 {
+		return errExecutionDurationInvalid
+	}
+	if o.SliceInterval < executionMinSliceInterval {
+		return fmt.Errorf("execution order slice interval must be at least %v", executionMinSliceInterval)
+	}
+	if o.SliceInterval > o.Duration {
+		return errExecutionSliceIntervalLong
+	}
+	if o.LimitPrice < 0 {
+		return errExecutionLimitPrice
+	}
+	if o.ParticipationRate < 0 || o.ParticipationRate > 1 {
+		return errExecutionParticipation
+	}
+	return nil
+}
+
+// copy returns a copy of an execution order that does not share its child
+// orders
+func (o *ExecutionOrder) copy() ExecutionOrder {
+	cpy := *o
+	cpy.Children = make([]ExecutionChild, len(o.Children))
+	copy(cpy.Children, o.Children)
+	return cpy
+}
@@ -0,0 +1,164 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestExecutionOrderValidate(t *testing.T) {
+	t.Parallel()
+	o := ExecutionOrder{
+		Pair:          currency.NewPair(currency.BTC, currency.USD),
+		Side:          order.Buy,
+		Algorithm:     ExecutionTWAP,
+		Amount:        10,
+		Duration:      time.Hour,
+		SliceInterval: time.Minute,
+	}
+	err := o.validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o.SliceInterval = time.Second
+	if err = o.validate(); err == nil {
+		t.Error("expected error for a slice interval below the minimum")
+	}
+	o.SliceInterval = time.Hour * 2
+	if err = o.validate(); err != errExecutionSliceIntervalLong {
+		t.Errorf("expected %v received %v", errExecutionSliceIntervalLong, err)
+	}
+	o.SliceInterval = time.Minute
+	o.ParticipationRate = 1.5
+	if err = o.validate(); err != errExecutionParticipation {
+		t.Errorf("expected %v received %v", errExecutionParticipation, err)
+	}
+	o.ParticipationRate = 0.1
+	o.Side = order.Bid
+	if err = o.validate(); err != order.ErrSideIsInvalid {
+		t.Errorf("expected %v received %v", order.ErrSideIsInvalid, err)
+	}
+	o.Side = order.Sell
+	o.Algorithm = "POV"
+	if err = o.validate(); err == nil {
+		t.Error("expected error for an invalid algorithm")
+	}
+}
+
+func TestExecutionOrderSliceAmount(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	twap := ExecutionOrder{
+		Algorithm:     ExecutionTWAP,
+		Amount:        10,
+		SliceInterval: time.Minute,
+		EndTime:       now.Add(time.Minute * 10),
+	}
+	amount, _ := twap.sliceAmount(now, 0, false)
+	if amount != 1 {
+		t.Errorf("expected 1 received %v", amount)
+	}
+
+	twap.ParticipationRate = 0.1
+	amount, reason := twap.sliceAmount(now, 0, false)
+	if amount != 0 || reason == "" {
+		t.Errorf("expected no slice without trade volume received %v", amount)
+	}
+	amount, _ = twap.sliceAmount(now, 5, true)
+	if amount != 0.5 {
+		t.Errorf("expected participation capped amount 0.5 received %v", amount)
+	}
+
+	twap.ParticipationRate = 0
+	amount, _ = twap.sliceAmount(now.Add(time.Minute*10), 0, false)
+	if amount != 10 {
+		t.Errorf("expected final slice to take the remaining amount received %v", amount)
+	}
+
+	vwap := ExecutionOrder{
+		Algorithm:     ExecutionVWAP,
+		Amount:        10,
+		SliceInterval: time.Minute,
+		EndTime:       now.Add(time.Minute * 10),
+	}
+	amount, _ = vwap.sliceAmount(now, 100, true)
+	if amount != 0 {
+		t.Errorf("expected first VWAP slice to measure volume received %v", amount)
+	}
+	amount, _ = vwap.sliceAmount(now, 100, true)
+	if amount != 1 {
+		t.Errorf("expected 1 received %v", amount)
+	}
+	amount, _ = vwap.sliceAmount(now, 200, true)
+	if amount <= 1 {
+		t.Errorf("expected above average volume to increase the slice received %v", amount)
+	}
+	amount, _ = vwap.sliceAmount(now, 0, false)
+	if amount != 1 {
+		t.Errorf("expected VWAP to fall back to TWAP without trades received %v", amount)
+	}
+}
+
+func TestExecutionSettle(t *testing.T) {
+	exch := newTestOrderExchange("executionSettle")
+	defer setupOrderManagerTest(t, exch)()
+	e := &executionManager{
+		orders:  make(map[string]*storedExecutionOrder),
+		volumes: make(map[string]float64),
+	}
+	now := time.Now()
+	o := ExecutionOrder{
+		ID:            "1",
+		Exchange:      exch.Name,
+		Pair:          currency.NewPair(currency.BTC, currency.USD),
+		Asset:         asset.Spot,
+		Algorithm:     ExecutionTWAP,
+		Side:          order.Buy,
+		Amount:        1,
+		Status:        ExecutionRunning,
+		SliceInterval: time.Minute,
+		EndTime:       now.Add(time.Minute * 10),
+		Children:      []ExecutionChild{{OrderID: "1", Amount: 0.5, Status: order.New}},
+	}
+
+	exch.cancelErr = errTestExchange
+	exch.info["1"] = order.Detail{ID: "1", Status: order.PartiallyFilled, Amount: 0.5, ExecutedAmount: 0.3}
+	if e.settle(&o) {
+		t.Fatal("expected a child order which could not be cancelled to be unsettled")
+	}
+	if o.Children[0].Status != order.PartiallyFilled ||
+		o.Children[0].ExecutedAmount != 0.3 ||
+		o.Children[0].Error == "" ||
+		o.ExecutedAmount != 0.3 {
+		t.Errorf("expected the queried child order state received %+v", o.Children[0])
+	}
+
+	e.slice(&o, now)
+	if len(exch.submitted) != 0 || o.Status != ExecutionRunning ||
+		!strings.Contains(o.Reason, "waiting for child order") {
+		t.Errorf("expected no child order while the last is unsettled received %v %v",
+			len(exch.submitted), o.Reason)
+	}
+
+	exch.info["1"] = order.Detail{ID: "1", Status: order.Filled, Amount: 0.5}
+	if !e.settle(&o) {
+		t.Fatal("expected a filled child order to be settled")
+	}
+	if o.Children[0].Status != order.Filled || o.ExecutedAmount != 0.5 || o.Children[0].Error != "" {
+		t.Errorf("expected the child order to be filled received %+v", o.Children[0])
+	}
+
+	exch.cancelErr = nil
+	o.Children = append(o.Children, ExecutionChild{OrderID: "2", Amount: 0.25, Status: order.New})
+	if !e.settle(&o) {
+		t.Fatal("expected a cancelled child order to be settled")
+	}
+	if o.Children[1].Status != order.Cancelled || len(exch.cancelled) != 1 {
+		t.Errorf("expected the child order to be cancelled received %+v", o.Children[1])
+	}
+}
@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	executionManagerName = "Execution manager"
+	// executionCheckDelay is how often execution orders are checked for a
+	// slice that is due
+	executionCheckDelay = time.Second
+	// executionMinSliceInterval is the shortest time between child orders
+	executionMinSliceInterval = time.Second * 5
+	// executionDefaultSlices is the number of slices an execution order is
+	// split into when no slice interval is set
+	executionDefaultSlices = 10
+	// executionMaxSubmitFailures is the number of consecutive child order
+	// submission failures after which an execution order fails
+	executionMaxSubmitFailures = 3
+)
+
+// ExecutionAlgorithm is how an execution order is sliced into child orders
+type ExecutionAlgorithm string
+
+// Execution algorithms
+const (
+	// ExecutionTWAP splits the remaining amount evenly over the slices left
+	// in the execution window
+	ExecutionTWAP ExecutionAlgorithm = "TWAP"
+	// ExecutionVWAP sizes each slice in proportion to the traded volume of the
+	// last slice interval relative to its average, spreading the remaining
+	// amount over the slices left in the execution window
+	ExecutionVWAP ExecutionAlgorithm = "VWAP"
+)
+
+// ExecutionStatus is the state of an execution order
+type ExecutionStatus string
+
+// Execution order statuses
+const (
+	ExecutionRunning   ExecutionStatus = "RUNNING"
+	ExecutionPaused    ExecutionStatus = "PAUSED"
+	ExecutionCompleted ExecutionStatus = "COMPLETED"
+	// ExecutionExpired reached the end of its window before its amount was
+	// executed
+	ExecutionExpired   ExecutionStatus = "EXPIRED"
+	ExecutionCancelled ExecutionStatus = "CANCELLED"
+	ExecutionFailed    ExecutionStatus = "FAILED"
+)
+
+// ExecutionChild is a child order submitted for an execution order
+type ExecutionChild struct {
+	OrderID         string
+	InternalOrderID string
+	Amount          float64
+	Price           float64
+	ExecutedAmount  float64
+	Status          order.Status
+	Submitted       time.Time
+	Error           string
+}
+
+// ExecutionOrder is a parent order executed by the engine as child orders
+// submitted through the order manager over a time window. Child orders are
+// limit orders at the limit price when one is set and are not submitted while
+// the price is beyond it, otherwise they are market orders. A participation
+// rate caps each child order at a fraction of the volume traded on the
+// exchange over the last slice interval.
+type ExecutionOrder struct {
+	ID                string
+	Exchange          string
+	Pair              currency.Pair
+	Asset             asset.Item
+	Side              order.Side
+	Algorithm         ExecutionAlgorithm
+	Amount            float64
+	LimitPrice        float64
+	ParticipationRate float64
+	Duration          time.Duration
+	SliceInterval     time.Duration
+	Status            ExecutionStatus
+	Reason            string
+	ExecutedAmount    float64
+	Children          []ExecutionChild
+	StartTime         time.Time
+	EndTime           time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+
+	nextSlice time.Time
+	failures  int
+	// volumeMark is the cumulative traded volume of the market at the last
+	// slice and avgVolume the average traded volume per slice interval
+	volumeMark float64
+	avgVolume  float64
+	slices     int
+}
+
+// storedExecutionOrder is an execution order held by the execution manager,
+// mtx serialises the slicing, pausing and cancelling of the order which are
+// made on a copy of it outside of the execution manager lock
+type storedExecutionOrder struct {
+	mtx   sync.Mutex
+	order ExecutionOrder
+}
+
+// executionManager slices execution orders into child orders and tracks
+// their fills
+type executionManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+
+	// m guards the orders map and the stored state of each execution order,
+	// it is not held while child orders are submitted or cancelled
+	m      sync.Mutex
+	orders map[string]*storedExecutionOrder
+
+	// volumes holds the cumulative traded volume of each market with an
+	// execution order from websocket trade prints
+	volumeMtx sync.Mutex
+	volumes   map[string]float64
+}
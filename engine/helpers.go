@@ -53,6 +53,8 @@ func GetSubsystemsStatus() map[string]bool {
 	systems["grpc"] = Bot.Settings.EnableGRPC
 	systems["grpc_proxy"] = Bot.Settings.EnableGRPCProxy
 	systems["gctscript"] = Bot.GctScriptManager.Started()
+	systems["data_history"] = Bot.DataHistoryManager.Started()
+	systems["arbitrage"] = Bot.ArbitrageMonitor.Started()
 	systems["deprecated_rpc"] = Bot.Settings.EnableDeprecatedRPC
 	systems["websocket_rpc"] = Bot.Settings.EnableWebsocketRPC
 	systems["dispatch"] = dispatch.IsRunning()
@@ -130,6 +132,16 @@ func SetSubsystem(subsys string, enable bool) error {
 			return dispatch.Start(Bot.Settings.DispatchMaxWorkerAmount, Bot.Settings.DispatchJobsLimit)
 		}
 		return dispatch.Stop()
+	case "data_history":
+		if enable {
+			return Bot.DataHistoryManager.Start()
+		}
+		return Bot.DataHistoryManager.Stop()
+	case "arbitrage":
+		if enable {
+			return Bot.ArbitrageMonitor.Start()
+		}
+		return Bot.ArbitrageMonitor.Stop()
 	case "gctscript":
 		if enable {
 			vm.GCTScriptConfig.Enabled = true
@@ -3,13 +3,19 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"math"
+	"path/filepath"
 	"sync/atomic"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orders"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -17,8 +23,17 @@ import (
 var (
 	OrderManagerDelay      = time.Second * 10
 	ErrOrdersAlreadyExists = errors.New("order already exists")
+	ErrOrderNotFound       = errors.New("order not found")
+
+	// DefaultOrderReconciliationInterval is the default amount of time
+	// between comparing stored orders with exchange state
+	DefaultOrderReconciliationInterval = time.Minute * 5
 )
 
+// pendingFillTimeout is how long a websocket fill received before its order is
+// held waiting for the order to be stored
+const pendingFillTimeout = time.Minute * 5
+
 func (o *orderStore) Get() map[string][]order.Detail {
 	o.m.Lock()
 	defer o.m.Unlock()
@@ -48,12 +63,324 @@ func (o *orderStore) Add(order *order.Detail) error {
 		return ErrOrdersAlreadyExists
 	}
 
-	orders := o.Orders[order.Exchange]
-	orders = append(orders, *order)
-	o.Orders[order.Exchange] = orders
+	exchOrders := o.Orders[order.Exchange]
+	exchOrders = append(exchOrders, *order)
+	o.Orders[order.Exchange] = exchOrders
 	return nil
 }
 
+// Update merges the exchange's view of a stored order into it and returns if
+// its state changed. Exchanges do not report every field so only a status,
+// executed amount and fee which were reported are applied. Executed amounts
+// and fees are cumulative so they are never lowered and a stale status cannot
+// move the order back to an earlier state. The merged order is copied to det.
+func (o *orderStore) Update(det *order.Detail) (bool, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	r := o.Orders[det.Exchange]
+	for x := range r {
+		if r[x].ID != det.ID {
+			continue
+		}
+		prev := r[x]
+		if det.Status != "" && det.Status != order.UnknownStatus &&
+			!isStaleStatus(r[x].Status, det.Status) {
+			r[x].Status = det.Status
+		}
+		if det.ExecutedAmount > r[x].ExecutedAmount {
+			r[x].ExecutedAmount = det.ExecutedAmount
+		}
+		if det.Fee > r[x].Fee {
+			r[x].Fee = det.Fee
+		}
+		setRemainingAmount(&r[x], det.RemainingAmount)
+		*det = r[x]
+		return orderStateChanged(&prev, &r[x]), nil
+	}
+	return false, ErrOrderNotFound
+}
+
+// setStatus sets the status of a stored order and returns a copy of it
+func (o *orderStore) setStatus(exchName, id string, s order.Status) (order.Detail, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	r := o.Orders[exchName]
+	for x := range r {
+		if r[x].ID == id {
+			r[x].Status = s
+			return r[x], nil
+		}
+	}
+	return order.Detail{}, ErrOrderNotFound
+}
+
+// modify applies an accepted modification to a stored order, moving it to the
+// order ID returned by the exchange, and returns a copy of it
+func (o *orderStore) modify(exchName string, mod *order.Modify, newID string) (order.Detail, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	r := o.Orders[exchName]
+	for x := range r {
+		if r[x].ID != mod.OrderID {
+			continue
+		}
+		if newID != "" {
+			r[x].ID = newID
+		}
+		if mod.Price > 0 {
+			r[x].Price = mod.Price
+		}
+		if mod.Amount > 0 {
+			r[x].Amount = mod.Amount
+			r[x].RemainingAmount = mod.Amount - r[x].ExecutedAmount
+		}
+		return r[x], nil
+	}
+	return order.Detail{}, ErrOrderNotFound
+}
+
+// all returns a copy of the stored orders of every exchange
+func (o *orderStore) all() []order.Detail {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	var resp []order.Detail
+	for _, v := range o.Orders {
+		resp = append(resp, v...)
+	}
+	return resp
+}
+
+// get returns a copy of a stored order
+func (o *orderStore) get(exchName, id string) (order.Detail, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	r := o.Orders[exchName]
+	for x := range r {
+		if r[x].ID == id {
+			return r[x], nil
+		}
+	}
+	return order.Detail{}, ErrOrderNotFound
+}
+
+// applyUpdate applies the fields supplied by a websocket order update to a
+// stored order and returns a copy of it and if its state changed. Executed
+// amounts and fees are cumulative so an update received out of order cannot
+// lower them or move a closed order back to an open status.
+func (o *orderStore) applyUpdate(u *wshandler.OrderUpdate) (order.Detail, bool, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	r := o.Orders[u.Exchange]
+	for x := range r {
+		if r[x].ID != u.OrderID {
+			continue
+		}
+		prev := r[x]
+		if u.Side != "" && r[x].OrderSide == "" {
+			r[x].OrderSide = u.Side
+		}
+		if u.Type != "" && u.Type != order.Unknown && r[x].OrderType == "" {
+			r[x].OrderType = u.Type
+		}
+		if u.Status != "" && !isStaleStatus(r[x].Status, u.Status) {
+			r[x].Status = u.Status
+		}
+		if u.Price > 0 {
+			r[x].Price = u.Price
+		}
+		if u.Amount > 0 {
+			r[x].Amount = u.Amount
+		}
+		if u.ExecutedAmount > r[x].ExecutedAmount {
+			r[x].ExecutedAmount = u.ExecutedAmount
+		}
+		if u.Fee > r[x].Fee {
+			r[x].Fee = u.Fee
+		}
+		setRemainingAmount(&r[x], u.RemainingAmount)
+		return r[x], orderStateChanged(&prev, &r[x]), nil
+	}
+	return order.Detail{}, false, ErrOrderNotFound
+}
+
+// setRemainingAmount works out the remaining amount of an order from its status
+// and fill amounts, the reported remaining amount is used when the order
+// amount is not known
+func setRemainingAmount(d *order.Detail, reported float64) {
+	switch {
+	case d.Status == order.Filled:
+		if d.ExecutedAmount == 0 {
+			d.ExecutedAmount = d.Amount
+		}
+		d.RemainingAmount = 0
+	case d.Amount > 0:
+		d.RemainingAmount = math.Max(d.Amount-d.ExecutedAmount, 0)
+	case reported > 0:
+		d.RemainingAmount = reported
+	}
+}
+
+// applyFill adds a websocket fill to the trades of a stored order and returns
+// a copy of it, false is returned if the fill has already been applied.
+// Exchanges replay recent fills on subscription so fills are deduplicated by
+// trade ID. Order updates carry the cumulative executed amount and fee of an
+// order, fills only raise them to the totals of the stored trades so neither
+// is counted twice. Fills for an order which is not yet stored are held until
+// it is added.
+func (o *orderStore) applyFill(f *wshandler.FillUpdate) (order.Detail, bool, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	r := o.Orders[f.Exchange]
+	for x := range r {
+		if r[x].ID != f.OrderID {
+			continue
+		}
+		for y := range r[x].Trades {
+			if f.TradeID != "" && r[x].Trades[y].TID == f.TradeID {
+				return r[x], false, nil
+			}
+		}
+		side := f.Side
+		if side == "" {
+			side = r[x].OrderSide
+		}
+		r[x].Trades = append(r[x].Trades, order.TradeHistory{
+			Timestamp: f.Timestamp,
+			TID:       f.TradeID,
+			Price:     f.Price,
+			Amount:    f.Amount,
+			Exchange:  f.Exchange,
+			Type:      r[x].OrderType,
+			Side:      side,
+			Fee:       f.Fee,
+		})
+		var executed, fee float64
+		for y := range r[x].Trades {
+			executed += r[x].Trades[y].Amount
+			fee += r[x].Trades[y].Fee
+		}
+		if executed > r[x].ExecutedAmount {
+			r[x].ExecutedAmount = executed
+		}
+		if fee > r[x].Fee {
+			r[x].Fee = fee
+		}
+		if r[x].Amount > 0 {
+			r[x].RemainingAmount = math.Max(r[x].Amount-r[x].ExecutedAmount, 0)
+		}
+		if !isClosedStatus(r[x].Status) {
+			if r[x].Amount > 0 && r[x].RemainingAmount == 0 {
+				r[x].Status = order.Filled
+			} else {
+				r[x].Status = order.PartiallyFilled
+			}
+		}
+		return r[x], true, nil
+	}
+
+	o.bufferFill(f)
+	return order.Detail{}, false, ErrOrderNotFound
+}
+
+// bufferFill holds a fill until its order is stored, fills held for longer
+// than pendingFillTimeout are dropped as their order is not going to be seen.
+// The lock must be held.
+func (o *orderStore) bufferFill(f *wshandler.FillUpdate) {
+	now := time.Now()
+	if o.pending == nil {
+		o.pending = make(map[string][]pendingFill)
+	}
+	for k, fills := range o.pending {
+		if now.Sub(fills[len(fills)-1].received) > pendingFillTimeout {
+			delete(o.pending, k)
+		}
+	}
+	key := pendingFillKey(f.Exchange, f.OrderID)
+	for x := range o.pending[key] {
+		if f.TradeID != "" && o.pending[key][x].fill.TradeID == f.TradeID {
+			return
+		}
+	}
+	o.pending[key] = append(o.pending[key], pendingFill{fill: *f, received: now})
+}
+
+// takePendingFills removes and returns the fills held for an order
+func (o *orderStore) takePendingFills(exchName, id string) []wshandler.FillUpdate {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	key := pendingFillKey(exchName, id)
+	held := o.pending[key]
+	if len(held) == 0 {
+		return nil
+	}
+	delete(o.pending, key)
+	fills := make([]wshandler.FillUpdate, len(held))
+	for x := range held {
+		fills[x] = held[x].fill
+	}
+	return fills
+}
+
+func pendingFillKey(exchName, id string) string {
+	return exchName + " " + id
+}
+
+// orderStateChanged returns if the status, fill amounts or fee of an order
+// changed
+func orderStateChanged(prev, det *order.Detail) bool {
+	return prev.Status != det.Status ||
+		prev.Price != det.Price ||
+		prev.Amount != det.Amount ||
+		prev.ExecutedAmount != det.ExecutedAmount ||
+		prev.RemainingAmount != det.RemainingAmount ||
+		prev.Fee != det.Fee
+}
+
+// openOrders returns copies of the stored orders for an exchange which have
+// not reached a closed status
+func (o *orderStore) openOrders(exchName string) []order.Detail {
+	o.m.Lock()
+	defer o.m.Unlock()
+
+	var open []order.Detail
+	r := o.Orders[exchName]
+	for x := range r {
+		if !isClosedStatus(r[x].Status) {
+			open = append(open, r[x])
+		}
+	}
+	return open
+}
+
+// isStaleStatus returns if an order status received out of order would move
+// an order back to an earlier state
+func isStaleStatus(current, next order.Status) bool {
+	if isClosedStatus(current) {
+		return !isClosedStatus(next)
+	}
+	return current == order.PartiallyFilled &&
+		(next == order.New || next == order.Active)
+}
+
+// isClosedStatus returns if an order status can no longer change on the
+// exchange
+func isClosedStatus(s order.Status) bool {
+	switch s {
+	case order.Filled, order.Cancelled, order.PartiallyCancelled,
+		order.Rejected, order.Expired:
+		return true
+	}
+	return false
+}
+
 func (o *orderManager) Started() bool {
 	return atomic.LoadInt32(&o.started) == 1
 }
@@ -67,10 +394,182 @@ func (o *orderManager) Start() error {
 
 	o.shutdown = make(chan struct{})
 	o.orderStore.Orders = make(map[string][]order.Detail)
+	o.orderStore.pending = make(map[string][]pendingFill)
+	o.reports = make(map[string]ReconciliationReport)
+	o.groupsMtx.Lock()
+	o.groups = make(map[string]*storedOrderGroup)
+	o.groupsMtx.Unlock()
+	o.icebergsMtx.Lock()
+	o.icebergs = make(map[string]*storedIcebergOrder)
+	o.icebergsMtx.Unlock()
+	o.groupCheck = make(chan struct{}, 1)
+	o.reconcileInterval = Bot.Settings.OrderReconciliationInterval
+	o.cfg.CancelOrdersOnShutdown = Bot.Settings.CancelOrdersOnShutdown
+	o.dmsMtx.Lock()
+	o.dms = make(map[string]*deadMansSwitchState)
+	o.dmsMtx.Unlock()
+	o.deadMansSwitch = 0
+	if Bot.Settings.EnableDeadMansSwitch {
+		o.deadMansSwitch = Bot.Settings.DeadMansSwitchTimeout
+		if o.deadMansSwitch <= 0 {
+			o.deadMansSwitch = DefaultDeadMansSwitchTimeout
+		}
+	}
+	o.persist = Bot.DatabaseManager.Started()
+	var riskState string
+	if Bot.Settings.DataDir != "" {
+		riskState = filepath.Join(Bot.Settings.DataDir, riskStateFile)
+	}
+	o.risk.setPersistence(o.persist, riskState)
+	if Bot.Config != nil {
+		Bot.Config.CheckRiskConfig()
+		o.risk.load(&Bot.Config.Risk)
+	}
+	if o.persist {
+		o.loadOpenOrders()
+	}
 	go o.run()
+	if o.deadMansSwitch > 0 {
+		o.heartbeatWG.Add(1)
+		go o.heartbeat()
+	}
 	return nil
 }
 
+// loadOpenOrders restores the open orders written by a previous run so their
+// internal order IDs are kept across restarts
+func (o *orderManager) loadOpenOrders() {
+	stored, err := orders.GetOpen("")
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to load open orders. Err: %s\n", err)
+		return
+	}
+
+	for x := range stored {
+		// Exchange names are stored in lower case
+		exchName := stored[x].Exchange
+		if exch := GetExchangeByName(exchName); exch != nil {
+			exchName = exch.GetName()
+		}
+		var trades []order.TradeHistory
+		fills, fillErr := orders.GetFills(stored[x].ID)
+		if fillErr != nil {
+			log.Warnf(log.OrderMgr, "Order manager: Unable to load fills for order ID=%v [Ours: %v]. Err: %s\n",
+				stored[x].ExchangeOrderID, stored[x].ID, fillErr)
+		}
+		for y := range fills {
+			trades = append(trades, order.TradeHistory{
+				Timestamp: fills[y].Timestamp,
+				TID:       fills[y].TradeID,
+				Price:     fills[y].Price,
+				Amount:    fills[y].Amount,
+				Exchange:  exchName,
+				Type:      stored[x].Type,
+				Side:      fills[y].Side,
+				Fee:       fills[y].Fee,
+			})
+		}
+		err = o.orderStore.Add(&order.Detail{
+			Exchange:        exchName,
+			AccountID:       stored[x].AccountID,
+			ID:              stored[x].ExchangeOrderID,
+			InternalOrderID: stored[x].ID,
+			CurrencyPair:    stored[x].Pair,
+			AssetType:       stored[x].AssetType,
+			OrderSide:       stored[x].Side,
+			OrderType:       stored[x].Type,
+			OrderDate:       stored[x].CreatedAt,
+			Status:          stored[x].Status,
+			Price:           stored[x].Price,
+			Amount:          stored[x].Amount,
+			ExecutedAmount:  stored[x].ExecutedAmount,
+			RemainingAmount: stored[x].RemainingAmount,
+			Fee:             stored[x].Fee,
+			Trades:          trades,
+		})
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: Unable to load order ID=%v [Ours: %v]. Err: %s\n",
+				stored[x].ExchangeOrderID, stored[x].ID, err)
+		}
+	}
+	log.Debugf(log.OrderMgr, "Order manager: Loaded %d open order(s) from the database.\n",
+		len(stored))
+}
+
+// insertOrder writes a new order to the order repository
+func (o *orderManager) insertOrder(det *order.Detail, clientID string) {
+	if !o.persist {
+		return
+	}
+	err := orders.Insert(&orders.Data{
+		ID:              det.InternalOrderID,
+		Exchange:        det.Exchange,
+		ExchangeOrderID: det.ID,
+		ClientID:        clientID,
+		AccountID:       det.AccountID,
+		Pair:            det.CurrencyPair,
+		AssetType:       det.AssetType,
+		Side:            det.OrderSide,
+		Type:            det.OrderType,
+		Status:          det.Status,
+		Price:           det.Price,
+		Amount:          det.Amount,
+		ExecutedAmount:  det.ExecutedAmount,
+		RemainingAmount: det.RemainingAmount,
+		Fee:             det.Fee,
+		CreatedAt:       det.OrderDate,
+	})
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to store order ID=%v [Ours: %v]. Err: %s\n",
+			det.ID, det.InternalOrderID, err)
+	}
+}
+
+// insertFill writes an execution of an order to the order repository
+func (o *orderManager) insertFill(det *order.Detail, t *order.TradeHistory) {
+	if !o.persist || det.InternalOrderID == "" {
+		return
+	}
+	err := orders.InsertFill(&orders.Fill{
+		OrderID:   det.InternalOrderID,
+		Exchange:  det.Exchange,
+		TradeID:   t.TID,
+		Pair:      det.CurrencyPair,
+		AssetType: det.AssetType,
+		Side:      t.Side,
+		Price:     t.Price,
+		Amount:    t.Amount,
+		Fee:       t.Fee,
+		Timestamp: t.Timestamp,
+	})
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to store fill %v of order ID=%v [Ours: %v]. Err: %s\n",
+			t.TID, det.ID, det.InternalOrderID, err)
+	}
+}
+
+// updateOrder writes the status, fill amounts and fee of an order to the
+// order repository
+func (o *orderManager) updateOrder(det *order.Detail) {
+	if !o.persist || det.InternalOrderID == "" {
+		return
+	}
+	err := orders.Update(&orders.Data{
+		ID:              det.InternalOrderID,
+		ExchangeOrderID: det.ID,
+		Status:          det.Status,
+		Price:           det.Price,
+		Amount:          det.Amount,
+		ExecutedAmount:  det.ExecutedAmount,
+		RemainingAmount: det.RemainingAmount,
+		Fee:             det.Fee,
+	})
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to update stored order ID=%v [Ours: %v]. Err: %s\n",
+			det.ID, det.InternalOrderID, err)
+	}
+}
+
 func (o *orderManager) Stop() error {
 	if atomic.LoadInt32(&o.started) == 0 {
 		return errors.New("order manager not started")
@@ -86,51 +585,53 @@ func (o *orderManager) Stop() error {
 
 	log.Debugln(log.OrderBook, "Order manager shutting down...")
 	close(o.shutdown)
+	o.heartbeatWG.Wait()
+	o.gracefulShutdown()
 	return nil
 }
 
+// gracefulShutdown cancels the open orders of every authenticated exchange
+// when configured to, otherwise only those of exchanges whose dead man's
+// switch is provided by the engine. Native dead man's switches are then
+// disarmed.
+//
+// WARNING: the engine side dead man's switch only runs here on a graceful
+// Stop. Open orders on exchanges without a native dead man's switch are left
+// on the book if the process crashes, is killed or loses its connection.
 func (o *orderManager) gracefulShutdown() {
+	var exchNames []string
 	if o.cfg.CancelOrdersOnShutdown {
 		log.Debugln(log.OrderMgr, "Order manager: Cancelling any open orders...")
-		orders := o.orderStore.Get()
-		if orders == nil {
-			return
+		exchNames = GetAuthAPISupportedExchanges()
+	} else if o.deadMansSwitch > 0 {
+		exchNames = o.engineDeadMansSwitchExchanges()
+		if len(exchNames) > 0 {
+			log.Debugf(log.OrderMgr, "Order manager: Cancelling open orders of exchange(s) without a dead man's switch: %v\n",
+				exchNames)
 		}
+	}
 
-		for k, v := range orders {
-			log.Debugf(log.OrderMgr, "Order manager: Cancelling order(s) for exchange %s.\n", k)
-			for y := range v {
-				log.Debugf(log.OrderMgr, "order manager: Cancelling order ID %v [%v]",
-					v[y].ID, v[y])
-				err := o.Cancel(k, &order.Cancel{
-					OrderID: v[y].ID,
-				})
-				if err != nil {
-					msg := fmt.Sprintf("Order manager: Exchange %s unable to cancel order ID=%v. Err: %s",
-						k, v[y].ID, err)
-					log.Debugln(log.OrderBook, msg)
-					Bot.CommsManager.PushEvent(base.Event{
-						Type:    "order",
-						Message: msg,
-					})
-					continue
-				}
-
-				msg := fmt.Sprintf("Order manager: Exchange %s order ID=%v cancelled.",
-					k, v[y].ID)
-				log.Debugln(log.OrderBook, msg)
-				Bot.CommsManager.PushEvent(base.Event{
-					Type:    "order",
-					Message: msg,
-				})
-			}
+	for x := range exchNames {
+		if _, err := o.CancelAllOrders(exchNames[x]); err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: Exchange %s unable to cancel open orders. Err: %s\n",
+				exchNames[x], err)
 		}
 	}
+
+	if o.deadMansSwitch > 0 {
+		o.disarmDeadMansSwitches()
+	}
 }
 
 func (o *orderManager) run() {
 	log.Debugln(log.OrderBook, "Order manager started.")
 	tick := time.NewTicker(OrderManagerDelay)
+	var reconcile <-chan time.Time
+	if o.reconcileInterval > 0 {
+		reconcileTick := time.NewTicker(o.reconcileInterval)
+		defer reconcileTick.Stop()
+		reconcile = reconcileTick.C
+	}
 	Bot.ServicesWG.Add(1)
 	defer func() {
 		log.Debugln(log.OrderMgr, "Order manager shutdown.")
@@ -141,15 +642,31 @@ func (o *orderManager) run() {
 	for {
 		select {
 		case <-o.shutdown:
-			o.gracefulShutdown()
 			return
 		case <-tick.C:
 			o.processOrders()
+			o.processGroups()
+			o.processIcebergs()
+		case <-o.groupCheck:
+			o.processGroups()
+			o.processIcebergs()
+		case <-reconcile:
+			_, err := o.Reconcile("")
+			if err != nil {
+				log.Errorf(log.OrderMgr, "Order manager: Reconciliation failed. Err: %s\n", err)
+			}
 		}
 	}
 }
 
-func (o *orderManager) CancelAllOrders() {}
+// GetOrder returns a copy of an order held by the order manager
+func (o *orderManager) GetOrder(exchName, id string) (order.Detail, error) {
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return order.Detail{}, errors.New("unable to get exchange by name")
+	}
+	return o.orderStore.get(exch.GetName(), id)
+}
 
 func (o *orderManager) Cancel(exchName string, cancel *order.Cancel) error {
 	if exchName == "" {
@@ -173,7 +690,85 @@ func (o *orderManager) Cancel(exchName string, cancel *order.Cancel) error {
 		return errors.New("order asset type not supported by exchange")
 	}
 
-	return exch.CancelOrder(cancel)
+	err := exch.CancelOrder(cancel)
+	if err != nil {
+		return err
+	}
+
+	det, err := o.orderStore.setStatus(exch.GetName(), cancel.OrderID, order.Cancelled)
+	if err == nil {
+		o.updateOrder(&det)
+	}
+	return nil
+}
+
+// Modify amends the price or amount of an open order on an exchange, keeping
+// its queue position where the exchange allows, and updates the stored order.
+// The order ID after the modification is returned as some exchanges replace
+// the order.
+func (o *orderManager) Modify(exchName string, mod *order.Modify) (string, error) {
+	if exchName == "" {
+		return "", errors.New("order exchange name is empty")
+	}
+
+	if err := o.checkKillSwitch(); err != nil {
+		return "", err
+	}
+
+	if err := mod.Validate(); err != nil {
+		return "", err
+	}
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return "", errors.New("unable to get exchange by name")
+	}
+
+	modified := o.modifiedSubmission(exch.GetName(), mod)
+	if err := o.conformTradingRules(exch, &modified); err != nil {
+		return "", err
+	}
+	if mod.Price > 0 {
+		mod.Price = modified.Price
+	}
+	if mod.Amount > 0 {
+		mod.Amount = modified.Amount
+	}
+
+	if err := o.checkLimits(exch.GetName(), &modified); err != nil {
+		return "", err
+	}
+
+	// The modified order replaces the stored order in the risk checks so only
+	// the amount left to execute is checked
+	stored := o.orderStore.all()
+	remaining := modified
+	remaining.Amount -= closeModifiedOrder(stored, exch.GetName(), mod.OrderID)
+	if err := o.checkRisk(exch.GetName(), &remaining, stored); err != nil {
+		return "", err
+	}
+
+	newID, err := exch.ModifyOrder(mod)
+	if err != nil {
+		return "", err
+	}
+	if newID == "" {
+		newID = mod.OrderID
+	}
+
+	msg := fmt.Sprintf("Order manager: Exchange %s order ID=%v modified to ID=%v price=%v amount=%v.",
+		exch.GetName(), mod.OrderID, newID, mod.Price, mod.Amount)
+	log.Debugln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+
+	det, err := o.orderStore.modify(exch.GetName(), mod, newID)
+	if err == nil {
+		o.updateOrder(&det)
+	}
+	return newID, nil
 }
 
 func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSubmitResponse, error) {
@@ -181,27 +776,99 @@ func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSu
 		return nil, errors.New("order exchange name must be specified")
 	}
 
-	if err := newOrder.Validate(); err != nil {
+	if err := o.checkSubmission(exchName, newOrder, nil); err != nil {
 		return nil, err
 	}
 
-	if o.cfg.EnforceLimitConfig {
-		if !o.cfg.AllowMarketOrders && newOrder.OrderType == order.Market {
-			return nil, errors.New("order market type is not allowed")
-		}
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, errors.New("unable to get exchange by name")
+	}
 
-		if o.cfg.LimitAmount > 0 && newOrder.Amount > o.cfg.LimitAmount {
-			return nil, errors.New("order limit exceeds allowed limit")
-		}
+	result, err := exch.SubmitOrder(newOrder)
+	if err != nil {
+		return nil, err
+	}
+	return o.addSubmitted(exch.GetName(), newOrder, &result)
+}
+
+// SubmitOrders submits a batch of orders to an exchange in as few requests as
+// the exchange allows, orders which fail validation or the limit checks are
+// not sent. Responses are returned in the order of the submissions.
+func (o *orderManager) SubmitOrders(exchName string, submissions []order.Submit) ([]orderBatchSubmitResponse, error) {
+	if exchName == "" {
+		return nil, errors.New("order exchange name must be specified")
+	}
+
+	if len(submissions) == 0 {
+		return nil, order.ErrBatchIsEmpty
+	}
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, errors.New("unable to get exchange by name")
+	}
 
-		if len(o.cfg.AllowedExchanges) > 0 &&
-			!common.StringDataCompareInsensitive(o.cfg.AllowedExchanges, exchName) {
-			return nil, errors.New("order exchange not found in allowed list")
+	resp := make([]orderBatchSubmitResponse, len(submissions))
+	var batch []order.Submit
+	var indexes []int
+	var pending []order.Detail
+	for x := range submissions {
+		if err := o.checkSubmission(exch.GetName(), &submissions[x], pending); err != nil {
+			resp[x].Error = err
+			continue
 		}
+		batch = append(batch, submissions[x])
+		indexes = append(indexes, x)
+		pending = append(pending, order.Detail{
+			Exchange:        exch.GetName(),
+			CurrencyPair:    submissions[x].Pair,
+			AssetType:       submissionAsset(&submissions[x]),
+			OrderSide:       submissions[x].OrderSide,
+			OrderType:       submissions[x].OrderType,
+			Status:          order.New,
+			Price:           submissions[x].Price,
+			Amount:          submissions[x].Amount,
+			RemainingAmount: submissions[x].Amount,
+		})
+	}
+	if len(batch) == 0 {
+		return resp, nil
+	}
 
-		if len(o.cfg.AllowedPairs) > 0 && !o.cfg.AllowedPairs.Contains(newOrder.Pair, true) {
-			return nil, errors.New("order pair not found in allowed list")
+	results, err := exch.SubmitOrders(batch)
+	if err != nil {
+		return nil, err
+	}
+	for y, x := range indexes {
+		if y >= len(results) {
+			resp[x].Error = errors.New("order missing from batch submission response")
+			continue
 		}
+		if results[y].Error != nil {
+			resp[x].Error = results[y].Error
+			continue
+		}
+		r, addErr := o.addSubmitted(exch.GetName(), &batch[y], &results[y].SubmitResponse)
+		if addErr != nil {
+			resp[x].Error = addErr
+			continue
+		}
+		resp[x].orderSubmitResponse = *r
+	}
+	return resp, nil
+}
+
+// CancelOrders cancels a batch of orders on an exchange in as few requests as
+// the exchange allows, orders which could not be cancelled are keyed by order
+// ID with the reason
+func (o *orderManager) CancelOrders(exchName string, cancellations []order.Cancel) (map[string]string, error) {
+	if exchName == "" {
+		return nil, errors.New("order exchange name is empty")
+	}
+
+	if len(cancellations) == 0 {
+		return nil, order.ErrBatchIsEmpty
 	}
 
 	exch := GetExchangeByName(exchName)
@@ -209,22 +876,186 @@ func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSu
 		return nil, errors.New("unable to get exchange by name")
 	}
 
-	id, err := uuid.NewV4()
-	if err != nil {
-		log.Warnf(log.OrderMgr,
-			"Order manager: Unable to generate UUID. Err: %s\n",
-			err)
+	status := make(map[string]string)
+	var batch []order.Cancel
+	for x := range cancellations {
+		switch {
+		case cancellations[x].OrderID == "":
+			return nil, errors.New("order id is empty")
+		case cancellations[x].AssetType.String() != "" && !exch.GetAssetTypes().Contains(cancellations[x].AssetType):
+			status[cancellations[x].OrderID] = "order asset type not supported by exchange"
+		default:
+			batch = append(batch, cancellations[x])
+		}
+	}
+	if len(batch) == 0 {
+		return status, nil
 	}
 
-	result, err := exch.SubmitOrder(newOrder)
+	resp, err := exch.BatchCancelOrders(batch)
 	if err != nil {
 		return nil, err
 	}
+	for x := range batch {
+		if reason, ok := resp.Status[batch[x].OrderID]; ok {
+			status[batch[x].OrderID] = reason
+			continue
+		}
+		det, setErr := o.orderStore.setStatus(exch.GetName(), batch[x].OrderID, order.Cancelled)
+		if setErr == nil {
+			o.updateOrder(&det)
+		}
+	}
+	return status, nil
+}
+
+// checkSubmission rejects order submissions while the kill switch is active,
+// otherwise it validates an order submission, rounds it to the exchange
+// trading rules and applies the order manager limit checks and pre-trade risk
+// checks. Pending holds orders accepted earlier in the same batch which are not
+// yet stored.
+func (o *orderManager) checkSubmission(exchName string, newOrder *order.Submit, pending []order.Detail) error {
+	if err := o.checkKillSwitch(); err != nil {
+		return err
+	}
+
+	if err := newOrder.Validate(); err != nil {
+		return err
+	}
+
+	if exch := GetExchangeByName(exchName); exch != nil {
+		if err := o.conformTradingRules(exch, newOrder); err != nil {
+			return err
+		}
+	}
+
+	if err := o.checkLimits(exchName, newOrder); err != nil {
+		return err
+	}
+
+	return o.checkRisk(exchName, newOrder, append(o.orderStore.all(), pending...))
+}
+
+// checkRisk runs the pre-trade risk checks against an order submission with
+// the orders known to the order manager, rejections are logged and audited
+func (o *orderManager) checkRisk(exchName string, newOrder *order.Submit, stored []order.Detail) error {
+	r := o.risk.check(exchName, newOrder, stored)
+	if r == nil {
+		return nil
+	}
+	msg := fmt.Sprintf("Order manager: Exchange %s order pair=%v price=%v amount=%v side=%v type=%v %s.",
+		exchName,
+		newOrder.Pair,
+		newOrder.Price,
+		newOrder.Amount,
+		newOrder.OrderSide,
+		newOrder.OrderType,
+		r)
+	log.Warnln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+	audit.Event(exchName, riskAuditType, r.Error())
+	return r
+}
+
+// checkLimits runs the order limits of the order manager config against an
+// order submission
+func (o *orderManager) checkLimits(exchName string, newOrder *order.Submit) error {
+	if !o.cfg.EnforceLimitConfig {
+		return nil
+	}
+
+	if !o.cfg.AllowMarketOrders && newOrder.OrderType == order.Market {
+		return errors.New("order market type is not allowed")
+	}
 
+	if o.cfg.LimitAmount > 0 && newOrder.Amount > o.cfg.LimitAmount {
+		return errors.New("order limit exceeds allowed limit")
+	}
+
+	if len(o.cfg.AllowedExchanges) > 0 &&
+		!common.StringDataCompareInsensitive(o.cfg.AllowedExchanges, exchName) {
+		return errors.New("order exchange not found in allowed list")
+	}
+
+	if len(o.cfg.AllowedPairs) > 0 && !o.cfg.AllowedPairs.Contains(newOrder.Pair, true) {
+		return errors.New("order pair not found in allowed list")
+	}
+	return nil
+}
+
+// modifiedSubmission returns the order a modification leaves on the exchange
+// as a submission so it is run through the same checks as new orders. Fields
+// the modification leaves unset are taken from the stored order.
+func (o *orderManager) modifiedSubmission(exchName string, mod *order.Modify) order.Submit {
+	s := order.Submit{
+		Pair:      mod.CurrencyPair,
+		OrderType: mod.Type,
+		OrderSide: mod.Side,
+		Price:     mod.Price,
+		Amount:    mod.Amount,
+	}
+	det, err := o.orderStore.get(exchName, mod.OrderID)
+	if err != nil {
+		return s
+	}
+	if s.Pair.IsEmpty() {
+		s.Pair = det.CurrencyPair
+	}
+	s.AssetType = det.AssetType
+	if s.OrderType == "" {
+		s.OrderType = det.OrderType
+	}
+	if s.OrderSide == "" {
+		s.OrderSide = det.OrderSide
+	}
+	if s.Price == 0 {
+		s.Price = det.Price
+	}
+	if s.Amount == 0 {
+		s.Amount = det.Amount
+	}
+	return s
+}
+
+// closeModifiedOrder marks the order being modified as filled in a copy of the
+// stored orders so its executed amount is kept without counting it as open,
+// the executed amount is returned
+func closeModifiedOrder(stored []order.Detail, exchName, id string) float64 {
+	for x := range stored {
+		if stored[x].Exchange == exchName && stored[x].ID == id {
+			stored[x].Status = order.Filled
+			return stored[x].ExecutedAmount
+		}
+	}
+	return 0
+}
+
+// AddRiskCheck adds a pre-trade risk check which is run against every order
+// submission after the checks configured in the risk config
+func (o *orderManager) AddRiskCheck(c RiskCheck) error {
+	if c == nil {
+		return errors.New("risk check is nil")
+	}
+	o.risk.add(c)
+	return nil
+}
+
+// addSubmitted notifies and stores an order placed on an exchange
+func (o *orderManager) addSubmitted(exchName string, newOrder *order.Submit, result *order.SubmitResponse) (*orderSubmitResponse, error) {
 	if !result.IsOrderPlaced {
 		return nil, errors.New("order unable to be placed")
 	}
 
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Warnf(log.OrderMgr,
+			"Order manager: Unable to generate UUID. Err: %s\n",
+			err)
+	}
+
 	msg := fmt.Sprintf("Order manager: Exchange %s submitted order ID=%v [Ours: %v] pair=%v price=%v amount=%v side=%v type=%v.",
 		exchName,
 		result.OrderID,
@@ -241,19 +1072,72 @@ func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSu
 		Message: msg,
 	})
 
+	status := order.New
+	if result.FullyMatched {
+		status = order.Filled
+	}
+	det := &order.Detail{
+		Exchange:        exchName,
+		ID:              result.OrderID,
+		InternalOrderID: id.String(),
+		CurrencyPair:    newOrder.Pair,
+		AssetType:       submissionAsset(newOrder),
+		OrderSide:       newOrder.OrderSide,
+		OrderType:       newOrder.OrderType,
+		OrderDate:       time.Now(),
+		Status:          status,
+		Price:           newOrder.Price,
+		Amount:          newOrder.Amount,
+		RemainingAmount: newOrder.Amount,
+	}
+	if result.FullyMatched {
+		det.ExecutedAmount = newOrder.Amount
+		det.RemainingAmount = 0
+	}
+	ourOrderID := id.String()
+	if o.Started() {
+		err = o.orderStore.Add(det)
+		switch {
+		case err == ErrOrdersAlreadyExists:
+			// A websocket order update can be processed before the
+			// submission returns, the order is already stored
+			if stored, getErr := o.orderStore.get(det.Exchange, det.ID); getErr == nil {
+				ourOrderID = stored.InternalOrderID
+			}
+		case err != nil:
+			log.Warnf(log.OrderMgr, "Order manager: Unable to add order ID=%v [Ours: %v]. Err: %s\n",
+				result.OrderID, id.String(), err)
+		default:
+			o.insertOrder(det, newOrder.ClientID)
+			o.applyPendingFills(det.Exchange, det.ID)
+		}
+	}
+
 	return &orderSubmitResponse{
-		SubmitResponse: order.SubmitResponse{
-			OrderID: result.OrderID,
-		},
-		OurOrderID: id.String(),
+		SubmitResponse: *result,
+		OurOrderID:     ourOrderID,
 	}, nil
 }
 
+// submissionAsset returns the asset type of an order submission, submissions
+// without an asset type are placed on the spot market
+func submissionAsset(s *order.Submit) asset.Item {
+	if s.AssetType == "" {
+		return asset.Spot
+	}
+	return s.AssetType
+}
+
 func (o *orderManager) processOrders() {
 	authExchanges := GetAuthAPISupportedExchanges()
 	for x := range authExchanges {
-		log.Debugf(log.OrderMgr, "Order manager: Procesing orders for exchange %v.\n", authExchanges[x])
 		exch := GetExchangeByName(authExchanges[x])
+		ws, err := exch.GetWebsocket()
+		if err == nil && ws.CanUseOrderUpdates() {
+			// Order state is kept up to date by websocket order updates
+			continue
+		}
+		log.Debugf(log.OrderMgr, "Order manager: Procesing orders for exchange %v.\n", authExchanges[x])
 		req := order.GetOrdersRequest{
 			OrderSide: order.AnySide,
 			OrderType: order.AnyType,
@@ -264,19 +1148,157 @@ func (o *orderManager) processOrders() {
 			continue
 		}
 
-		for x := range result {
-			ord := &result[x]
-			result := o.orderStore.Add(ord)
-			if result != ErrOrdersAlreadyExists {
-				msg := fmt.Sprintf("Order manager: Exchange %s added order ID=%v pair=%v price=%v amount=%v side=%v type=%v.",
-					ord.Exchange, ord.ID, ord.CurrencyPair, ord.Price, ord.Amount, ord.OrderSide, ord.OrderType)
-				log.Debugf(log.OrderMgr, "%v\n", msg)
-				Bot.CommsManager.PushEvent(base.Event{
-					Type:    "order",
-					Message: msg,
-				})
+		for y := range result {
+			ord := &result[y]
+			if ord.Exchange == "" {
+				ord.Exchange = authExchanges[x]
+			}
+
+			changed, err := o.orderStore.Update(ord)
+			if err == nil {
+				if changed {
+					msg := fmt.Sprintf("Order manager: Exchange %s updated order ID=%v [Ours: %v] status=%v executed=%v remaining=%v.",
+						ord.Exchange, ord.ID, ord.InternalOrderID, ord.Status, ord.ExecutedAmount, ord.RemainingAmount)
+					log.Debugf(log.OrderMgr, "%v\n", msg)
+					Bot.CommsManager.PushEvent(base.Event{
+						Type:    "order",
+						Message: msg,
+					})
+					o.updateOrder(ord)
+					o.signalGroupCheck()
+				}
+				continue
+			}
+
+			// Orders placed outside of the order manager are given an
+			// internal order ID when first seen
+			id, err := uuid.NewV4()
+			if err != nil {
+				log.Warnf(log.OrderMgr,
+					"Order manager: Unable to generate UUID. Err: %s\n",
+					err)
+			}
+			ord.InternalOrderID = id.String()
+			if ord.AssetType == "" {
+				ord.AssetType = asset.Spot
+			}
+			if o.orderStore.Add(ord) != nil {
 				continue
 			}
+			o.insertOrder(ord, "")
+			o.applyPendingFills(ord.Exchange, ord.ID)
+
+			msg := fmt.Sprintf("Order manager: Exchange %s added order ID=%v pair=%v price=%v amount=%v side=%v type=%v.",
+				ord.Exchange, ord.ID, ord.CurrencyPair, ord.Price, ord.Amount, ord.OrderSide, ord.OrderType)
+			log.Debugf(log.OrderMgr, "%v\n", msg)
+			Bot.CommsManager.PushEvent(base.Event{
+				Type:    "order",
+				Message: msg,
+			})
+		}
+	}
+}
+
+// processOrderUpdate applies a websocket order update to the order store,
+// orders placed outside of the order manager are added when first seen
+func (o *orderManager) processOrderUpdate(u *wshandler.OrderUpdate) {
+	if !o.Started() || u.OrderID == "" {
+		return
+	}
+
+	det, changed, err := o.orderStore.applyUpdate(u)
+	if err == nil {
+		if changed {
+			msg := fmt.Sprintf("Order manager: Exchange %s updated order ID=%v [Ours: %v] status=%v executed=%v remaining=%v.",
+				det.Exchange, det.ID, det.InternalOrderID, det.Status, det.ExecutedAmount, det.RemainingAmount)
+			log.Debugf(log.OrderMgr, "%v\n", msg)
+			Bot.CommsManager.PushEvent(base.Event{
+				Type:    "order",
+				Message: msg,
+			})
+			o.updateOrder(&det)
+			o.signalGroupCheck()
 		}
+		return
+	}
+
+	if isClosedStatus(u.Status) {
+		return
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Warnf(log.OrderMgr,
+			"Order manager: Unable to generate UUID. Err: %s\n",
+			err)
+	}
+	det = order.Detail{
+		Exchange:        u.Exchange,
+		ID:              u.OrderID,
+		InternalOrderID: id.String(),
+		CurrencyPair:    u.CurrencyPair,
+		AssetType:       u.AssetType,
+		OrderSide:       u.Side,
+		OrderType:       u.Type,
+		OrderDate:       u.Timestamp,
+		Status:          u.Status,
+		Price:           u.Price,
+		Amount:          u.Amount,
+		ExecutedAmount:  u.ExecutedAmount,
+		RemainingAmount: u.RemainingAmount,
+		Fee:             u.Fee,
+	}
+	if det.OrderDate.IsZero() {
+		det.OrderDate = time.Now()
+	}
+	if det.AssetType == "" {
+		det.AssetType = asset.Spot
+	}
+	if o.orderStore.Add(&det) != nil {
+		return
+	}
+	o.insertOrder(&det, u.ClientOrderID)
+	o.applyPendingFills(det.Exchange, det.ID)
+
+	msg := fmt.Sprintf("Order manager: Exchange %s added order ID=%v pair=%v price=%v amount=%v side=%v type=%v.",
+		det.Exchange, det.ID, det.CurrencyPair, det.Price, det.Amount, det.OrderSide, det.OrderType)
+	log.Debugf(log.OrderMgr, "%v\n", msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// processFillUpdate applies a websocket fill to a stored order, fills for
+// orders which have not been seen are held and applied once the order is
+// stored
+func (o *orderManager) processFillUpdate(f *wshandler.FillUpdate) {
+	if !o.Started() || f.OrderID == "" {
+		return
+	}
+
+	det, changed, err := o.orderStore.applyFill(f)
+	if err != nil || !changed {
+		return
+	}
+	o.insertFill(&det, &det.Trades[len(det.Trades)-1])
+
+	msg := fmt.Sprintf("Order manager: Exchange %s filled order ID=%v [Ours: %v] price=%v amount=%v fee=%v status=%v remaining=%v.",
+		det.Exchange, det.ID, det.InternalOrderID, f.Price, f.Amount, f.Fee, det.Status, det.RemainingAmount)
+	log.Debugf(log.OrderMgr, "%v\n", msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+	o.updateOrder(&det)
+	o.signalGroupCheck()
+}
+
+// applyPendingFills applies the websocket fills received for an order before
+// it was stored
+func (o *orderManager) applyPendingFills(exchName, id string) {
+	fills := o.orderStore.takePendingFills(exchName, id)
+	for x := range fills {
+		o.processFillUpdate(&fills[x])
 	}
 }
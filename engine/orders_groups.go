@@ -0,0 +1,599 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errOrderGroupNotFound        = errors.New("order group not found")
+	errOCOLegsRequired           = errors.New("one-cancels-other order group requires at least two legs")
+	errBracketLegsRequired       = errors.New("bracket order group requires an entry, take-profit and stop-loss leg")
+	errBracketExitSide           = errors.New("bracket take-profit and stop-loss legs must be on the opposite side to the entry")
+	errBracketTakeProfitTrigger  = errors.New("bracket take-profit leg must be a limit order without a trigger price")
+	errBracketStopLossTrigger    = errors.New("bracket stop-loss leg requires a trigger price")
+	errLegTriggerPriceInvalid    = errors.New("order group leg trigger price cannot be negative")
+	errConditionalLegUnsupported = errors.New("order group legs with a trigger price require the conditional order manager")
+)
+
+// AddOrderGroup validates an order group and submits its legs, every leg of a
+// one-cancels-other group is submitted at once and only the entry leg of a
+// bracket group. The stored order group is returned.
+func (o *orderManager) AddOrderGroup(g *OrderGroup) (OrderGroup, error) {
+	if !o.Started() {
+		return OrderGroup{}, errOrderManagerNotStarted
+	}
+	if g == nil {
+		return OrderGroup{}, errors.New("order group is nil")
+	}
+
+	exch := GetExchangeByName(g.Exchange)
+	if exch == nil {
+		return OrderGroup{}, errors.New("unable to get exchange by name")
+	}
+	grp := g.copy()
+	grp.Exchange = exch.GetName()
+	if grp.Asset == "" {
+		grp.Asset = asset.Spot
+	}
+	if !exch.GetAssetTypes().Contains(grp.Asset) {
+		return OrderGroup{}, errors.New("order group asset type not supported by exchange")
+	}
+	err := grp.validate()
+	if err != nil {
+		return OrderGroup{}, err
+	}
+	for x := range grp.Legs {
+		if grp.Legs[x].TriggerPrice > 0 && !Bot.ConditionalOrderManager.Started() {
+			return OrderGroup{}, errConditionalLegUnsupported
+		}
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return OrderGroup{}, err
+	}
+	now := time.Now()
+	grp.ID = id.String()
+	grp.Status = OrderGroupActive
+	grp.Reason = ""
+	grp.CreatedAt = now
+	grp.UpdatedAt = now
+
+	stored := &storedOrderGroup{group: grp.copy()}
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	o.groupsMtx.Lock()
+	o.groups[grp.ID] = stored
+	o.groupsMtx.Unlock()
+
+	legs := grp.Legs
+	if grp.Type == OrderGroupBracket {
+		legs = grp.Legs[:1]
+	}
+	o.activateLegs(&grp, legs)
+	if grp.Status == OrderGroupActive {
+		o.notifyGroup(&grp, fmt.Sprintf("created with %d leg(s)", len(grp.Legs)))
+	}
+	o.storeGroup(stored, &grp)
+	if grp.Status == OrderGroupFailed {
+		return grp, errors.New(grp.Reason)
+	}
+	return grp, nil
+}
+
+// CancelOrderGroup cancels the open legs of an active order group, legs which
+// could not be cancelled are retried as the group is checked
+func (o *orderManager) CancelOrderGroup(id string) (OrderGroup, error) {
+	if !o.Started() {
+		return OrderGroup{}, errOrderManagerNotStarted
+	}
+
+	o.groupsMtx.Lock()
+	stored, ok := o.groups[id]
+	o.groupsMtx.Unlock()
+	if !ok {
+		return OrderGroup{}, errOrderGroupNotFound
+	}
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	g := o.loadGroup(stored)
+	if g.Status != OrderGroupActive {
+		return OrderGroup{}, fmt.Errorf("order group %s is %s and cannot be cancelled",
+			id, strings.ToLower(string(g.Status)))
+	}
+
+	var failed int
+	for x := range g.Legs {
+		switch g.Legs[x].Status {
+		case OrderLegPending:
+			g.Legs[x].Status = OrderLegCancelled
+		case OrderLegOpen:
+			if !o.cancelLeg(&g, &g.Legs[x]) {
+				failed++
+			}
+		}
+	}
+	g.UpdatedAt = time.Now()
+	if failed > 0 {
+		o.storeGroup(stored, &g)
+		return g.copy(), fmt.Errorf("unable to cancel %d order group leg(s)", failed)
+	}
+	o.finishGroup(&g, OrderGroupCancelled, "order group cancelled")
+	o.storeGroup(stored, &g)
+	return g.copy(), nil
+}
+
+// GetOrderGroups returns the order groups created since the order manager
+// started in creation order. An empty exchange name or ID returns every
+// order group.
+func (o *orderManager) GetOrderGroups(exchName, id string) ([]OrderGroup, error) {
+	if !o.Started() {
+		return nil, errOrderManagerNotStarted
+	}
+
+	o.groupsMtx.Lock()
+	var resp []OrderGroup
+	for _, stored := range o.groups {
+		if exchName != "" && !strings.EqualFold(stored.group.Exchange, exchName) {
+			continue
+		}
+		if id != "" && stored.group.ID != id {
+			continue
+		}
+		resp = append(resp, stored.group.copy())
+	}
+	o.groupsMtx.Unlock()
+
+	if id != "" && len(resp) == 0 {
+		return nil, errOrderGroupNotFound
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		if !resp[i].CreatedAt.Equal(resp[j].CreatedAt) {
+			return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+		}
+		return resp[i].ID < resp[j].ID
+	})
+	return resp, nil
+}
+
+// loadGroup returns a copy of a stored order group, the group mutex must be
+// held
+func (o *orderManager) loadGroup(stored *storedOrderGroup) OrderGroup {
+	o.groupsMtx.Lock()
+	defer o.groupsMtx.Unlock()
+	return stored.group.copy()
+}
+
+// storeGroup replaces a stored order group with its updated copy, the group
+// mutex must be held
+func (o *orderManager) storeGroup(stored *storedOrderGroup, g *OrderGroup) {
+	o.groupsMtx.Lock()
+	defer o.groupsMtx.Unlock()
+	stored.group = g.copy()
+}
+
+// signalGroupCheck requests an order group check without blocking
+func (o *orderManager) signalGroupCheck() {
+	select {
+	case o.groupCheck <- struct{}{}:
+	default:
+	}
+}
+
+// processGroups updates the legs of every active order group from the order
+// store and the conditional order manager and cancels or submits linked legs
+func (o *orderManager) processGroups() {
+	o.groupsMtx.Lock()
+	active := make([]*storedOrderGroup, 0, len(o.groups))
+	for _, stored := range o.groups {
+		if stored.group.Status == OrderGroupActive {
+			active = append(active, stored)
+		}
+	}
+	o.groupsMtx.Unlock()
+
+	for x := range active {
+		o.processGroup(active[x])
+	}
+}
+
+// processGroup checks an active order group on a copy which is stored once
+// its legs have been submitted or cancelled
+func (o *orderManager) processGroup(stored *storedOrderGroup) {
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	g := o.loadGroup(stored)
+	if g.Status != OrderGroupActive {
+		return
+	}
+	for x := range g.Legs {
+		if o.refreshLeg(&g, &g.Legs[x]) {
+			g.UpdatedAt = time.Now()
+		}
+	}
+	o.evaluateGroup(&g)
+	o.storeGroup(stored, &g)
+}
+
+// refreshLeg updates an open leg from its conditional order and exchange
+// order, it returns whether the leg changed
+func (o *orderManager) refreshLeg(g *OrderGroup, leg *OrderGroupLeg) bool {
+	if leg.Status != OrderLegOpen {
+		return false
+	}
+
+	var changed bool
+	if leg.OrderID == "" {
+		if leg.ConditionalOrderID == "" {
+			return false
+		}
+		c, ok := Bot.ConditionalOrderManager.get(leg.ConditionalOrderID)
+		if !ok {
+			return false
+		}
+		switch c.Status {
+		case ConditionalSubmitted:
+			leg.OrderID = c.ExchangeOrderID
+			leg.InternalOrderID = c.OurOrderID
+			changed = true
+		case ConditionalCancelled:
+			leg.Status = OrderLegCancelled
+			return true
+		case ConditionalFailed:
+			leg.Status = OrderLegFailed
+			leg.Error = c.Error
+			return true
+		default:
+			return false
+		}
+	}
+
+	det, err := o.orderStore.get(g.Exchange, leg.OrderID)
+	if err != nil {
+		return changed
+	}
+	status, executed := legStatusFromOrder(&det)
+	if status != leg.Status || executed != leg.ExecutedAmount {
+		leg.Status = status
+		leg.ExecutedAmount = executed
+		changed = true
+	}
+	return changed
+}
+
+// legStatusFromOrder returns the leg status and executed amount of an
+// exchange order
+func legStatusFromOrder(det *order.Detail) (OrderLegStatus, float64) {
+	switch det.Status {
+	case order.Filled:
+		if det.ExecutedAmount == 0 {
+			return OrderLegFilled, det.Amount
+		}
+		return OrderLegFilled, det.ExecutedAmount
+	case order.Rejected:
+		return OrderLegFailed, det.ExecutedAmount
+	case order.Cancelled, order.PartiallyCancelled, order.Expired:
+		return OrderLegCancelled, det.ExecutedAmount
+	}
+	return OrderLegOpen, det.ExecutedAmount
+}
+
+// evaluateGroup submits the exit legs of a bracket group once its entry has
+// filled and cancels the other legs once a linked leg fills or closes
+func (o *orderManager) evaluateGroup(g *OrderGroup) {
+	legs := g.Legs
+	if g.Type == OrderGroupBracket {
+		entry := &g.Legs[0]
+		legs = g.Legs[1:]
+		if legs[0].Status == OrderLegPending {
+			switch {
+			case entry.Status == OrderLegOpen:
+				// Exit legs are submitted once the entry has closed
+			case entry.ExecutedAmount > 0:
+				for x := range legs {
+					if legs[x].Amount > entry.ExecutedAmount {
+						legs[x].Amount = entry.ExecutedAmount
+					}
+				}
+				o.activateLegs(g, legs)
+				if g.Status == OrderGroupActive {
+					o.notifyGroup(g, fmt.Sprintf("entry order ID=%v filled %v, take-profit and stop-loss legs submitted",
+						entry.OrderID, entry.ExecutedAmount))
+				}
+			default:
+				for x := range legs {
+					legs[x].Status = OrderLegCancelled
+				}
+				o.finishGroup(g, OrderGroupCancelled, "entry order closed without being filled")
+			}
+			return
+		}
+	}
+
+	closed := -1
+	for x := range legs {
+		if legs[x].ExecutedAmount > 0 ||
+			(legs[x].Status != OrderLegOpen && legs[x].Status != OrderLegPending) {
+			closed = x
+			break
+		}
+	}
+	if closed == -1 {
+		return
+	}
+
+	var open int
+	for x := range legs {
+		if x == closed || legs[x].Status != OrderLegOpen {
+			continue
+		}
+		if !o.cancelLeg(g, &legs[x]) {
+			open++
+		}
+	}
+	if open > 0 {
+		// Legs which failed to cancel are retried on the next check
+		return
+	}
+
+	for x := range legs {
+		if legs[x].ExecutedAmount > 0 {
+			o.finishGroup(g, OrderGroupCompleted,
+				fmt.Sprintf("%s leg filled %v", legName(g, legs, x), legs[x].ExecutedAmount))
+			return
+		}
+	}
+	o.finishGroup(g, OrderGroupCancelled,
+		fmt.Sprintf("%s leg %s", legName(g, legs, closed), strings.ToLower(string(legs[closed].Status))))
+}
+
+// activateLegs submits pending legs, if any leg cannot be submitted the legs
+// already submitted are cancelled and the group fails
+func (o *orderManager) activateLegs(g *OrderGroup, legs []OrderGroupLeg) {
+	for x := range legs {
+		err := o.activateLeg(g, &legs[x])
+		if err == nil {
+			continue
+		}
+		for y := range g.Legs {
+			switch g.Legs[y].Status {
+			case OrderLegPending:
+				g.Legs[y].Status = OrderLegCancelled
+			case OrderLegOpen:
+				if g.Type == OrderGroupBracket && y == 0 {
+					// An open entry is left to the order manager
+					continue
+				}
+				o.cancelLeg(g, &g.Legs[y])
+			}
+		}
+		o.finishGroup(g, OrderGroupFailed,
+			fmt.Sprintf("unable to submit %s leg: %s", legName(g, legs, x), err))
+		return
+	}
+}
+
+// activateLeg submits a leg to its exchange or to the conditional order
+// manager when it has a trigger price
+func (o *orderManager) activateLeg(g *OrderGroup, leg *OrderGroupLeg) error {
+	if leg.TriggerPrice > 0 {
+		c, err := Bot.ConditionalOrderManager.Add(&ConditionalOrder{
+			Exchange:     g.Exchange,
+			Pair:         g.Pair,
+			Asset:        g.Asset,
+			Side:         leg.Side,
+			Condition:    legCondition(g, leg),
+			OrderType:    leg.OrderType,
+			TriggerPrice: leg.TriggerPrice,
+			LimitPrice:   leg.Price,
+			Amount:       leg.Amount,
+		})
+		if err != nil {
+			leg.Status = OrderLegFailed
+			leg.Error = err.Error()
+			return err
+		}
+		leg.ConditionalOrderID = c.ID
+		leg.Status = OrderLegOpen
+		return nil
+	}
+
+	resp, err := o.Submit(g.Exchange, &order.Submit{
+		Pair:      g.Pair,
+		AssetType: g.Asset,
+		OrderType: leg.OrderType,
+		OrderSide: leg.Side,
+		Price:     leg.Price,
+		Amount:    leg.Amount,
+	})
+	if err != nil {
+		leg.Status = OrderLegFailed
+		leg.Error = err.Error()
+		return err
+	}
+	leg.OrderID = resp.OrderID
+	leg.InternalOrderID = resp.OurOrderID
+	leg.Status = OrderLegOpen
+	return nil
+}
+
+// legCondition returns the trigger condition of a leg with a trigger price.
+// Take-profit and stop-loss legs use their role, other legs compare their
+// trigger price with the last price of the pair, a buy triggered above it or a
+// sell triggered below it is a stop and the reverse is a take-profit. Legs are
+// treated as stops when there is no last price.
+func legCondition(g *OrderGroup, leg *OrderGroupLeg) ConditionType {
+	switch leg.Role {
+	case OrderLegTakeProfit:
+		return ConditionTakeProfit
+	case OrderLegStopLoss:
+		return ConditionStopLoss
+	}
+	t, err := ticker.GetTicker(g.Exchange, g.Pair, g.Asset)
+	if err != nil || t.Last == 0 {
+		return ConditionStopLoss
+	}
+	if (leg.Side == order.Buy) == (leg.TriggerPrice > t.Last) {
+		return ConditionStopLoss
+	}
+	return ConditionTakeProfit
+}
+
+// cancelLeg cancels an open leg, it returns whether the leg was cancelled
+func (o *orderManager) cancelLeg(g *OrderGroup, leg *OrderGroupLeg) bool {
+	if leg.OrderID == "" && leg.ConditionalOrderID != "" {
+		_, err := Bot.ConditionalOrderManager.Cancel(leg.ConditionalOrderID)
+		if err == nil {
+			leg.Status = OrderLegCancelled
+			return true
+		}
+		// The conditional order may have triggered, its order is cancelled
+		// once it has been submitted
+		if o.refreshLeg(g, leg) && leg.Status != OrderLegOpen {
+			return true
+		}
+		if leg.OrderID == "" {
+			leg.Error = err.Error()
+			return false
+		}
+	}
+
+	err := o.Cancel(g.Exchange, &order.Cancel{
+		OrderID:      leg.OrderID,
+		CurrencyPair: g.Pair,
+		AssetType:    g.Asset,
+		Side:         leg.Side,
+	})
+	if err != nil {
+		leg.Error = err.Error()
+		log.Warnf(log.OrderMgr, "Order manager: Exchange %s order group ID=%v unable to cancel order ID=%v. Err: %s\n",
+			g.Exchange, g.ID, leg.OrderID, err)
+		return false
+	}
+	leg.Status = OrderLegCancelled
+	return true
+}
+
+func (o *orderManager) finishGroup(g *OrderGroup, status OrderGroupStatus, reason string) {
+	g.Status = status
+	g.Reason = reason
+	g.UpdatedAt = time.Now()
+	o.notifyGroup(g, reason)
+}
+
+func (o *orderManager) notifyGroup(g *OrderGroup, reason string) {
+	msg := fmt.Sprintf("Order manager: Exchange %s %s order group ID=%v pair=%v status=%v, %s.",
+		g.Exchange, strings.ToLower(string(g.Type)), g.ID, g.Pair, g.Status, reason)
+	log.Debugln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// legName describes a leg for logging
+func legName(g *OrderGroup, legs []OrderGroupLeg, x int) string {
+	if legs[x].Role != "" {
+		return strings.ToLower(strings.Replace(string(legs[x].Role), "_", "-", 1))
+	}
+	if g.Type == OrderGroupOCO {
+		return fmt.Sprintf("#%d", x+1)
+	}
+	return "order"
+}
+
+// validate normalises and checks the legs of an order group, bracket groups
+// must have their entry, take-profit and stop-loss legs in that order
+func (g *OrderGroup) validate() error {
+	if g.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+
+	switch g.Type {
+	case OrderGroupOCO:
+		if len(g.Legs) < 2 {
+			return errOCOLegsRequired
+		}
+	case OrderGroupBracket:
+		if len(g.Legs) != 3 {
+			return errBracketLegsRequired
+		}
+		g.Legs[0].Role = OrderLegEntry
+		g.Legs[1].Role = OrderLegTakeProfit
+		g.Legs[2].Role = OrderLegStopLoss
+		for x := 1; x < 3; x++ {
+			if g.Legs[x].Amount == 0 {
+				g.Legs[x].Amount = g.Legs[0].Amount
+			}
+		}
+	default:
+		return fmt.Errorf("invalid order group type %q", g.Type)
+	}
+
+	for x := range g.Legs {
+		leg := &g.Legs[x]
+		switch leg.Side {
+		case order.Bid:
+			leg.Side = order.Buy
+		case order.Ask:
+			leg.Side = order.Sell
+		}
+		if leg.OrderType == "" {
+			leg.OrderType = order.Market
+			if leg.Price > 0 {
+				leg.OrderType = order.Limit
+			}
+		}
+		if leg.TriggerPrice < 0 {
+			return errLegTriggerPriceInvalid
+		}
+		err := (&order.Submit{
+			Pair:      g.Pair,
+			OrderType: leg.OrderType,
+			OrderSide: leg.Side,
+			Price:     leg.Price,
+			Amount:    leg.Amount,
+		}).Validate()
+		if err != nil {
+			return fmt.Errorf("%s leg: %v", legName(g, g.Legs, x), err)
+		}
+		leg.Status = OrderLegPending
+		leg.ExecutedAmount = 0
+		leg.OrderID = ""
+		leg.InternalOrderID = ""
+		leg.ConditionalOrderID = ""
+		leg.Error = ""
+	}
+
+	if g.Type == OrderGroupBracket {
+		if g.Legs[1].Side == g.Legs[0].Side || g.Legs[2].Side == g.Legs[0].Side {
+			return errBracketExitSide
+		}
+		if g.Legs[1].TriggerPrice > 0 || g.Legs[1].OrderType != order.Limit {
+			return errBracketTakeProfitTrigger
+		}
+		if g.Legs[2].TriggerPrice == 0 {
+			return errBracketStopLossTrigger
+		}
+	}
+	return nil
+}
+
+// copy returns a copy of an order group that does not share its legs
+func (g *OrderGroup) copy() OrderGroup {
+	cpy := *g
+	cpy.Legs = make([]OrderGroupLeg, len(g.Legs))
+	copy(cpy.Legs, g.Legs)
+	return cpy
+}
@@ -0,0 +1,312 @@
+package engine
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestOrderGroupValidate(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	bracket := OrderGroup{
+		Type: OrderGroupBracket,
+		Pair: p,
+		Legs: []OrderGroupLeg{
+			{Side: order.Bid, Price: 100, Amount: 1},
+			{Side: order.Sell, Price: 110},
+			{Side: order.Sell, TriggerPrice: 95},
+		},
+	}
+	err := bracket.validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bracket.Legs[0].Side != order.Buy || bracket.Legs[0].OrderType != order.Limit ||
+		bracket.Legs[0].Role != OrderLegEntry {
+		t.Errorf("unexpected entry leg %+v", bracket.Legs[0])
+	}
+	if bracket.Legs[1].Amount != 1 || bracket.Legs[1].Role != OrderLegTakeProfit {
+		t.Errorf("unexpected take-profit leg %+v", bracket.Legs[1])
+	}
+	if bracket.Legs[2].OrderType != order.Market || bracket.Legs[2].Status != OrderLegPending {
+		t.Errorf("unexpected stop-loss leg %+v", bracket.Legs[2])
+	}
+
+	bracket.Legs[2].Side = order.Buy
+	if err = bracket.validate(); err != errBracketExitSide {
+		t.Errorf("expected %v received %v", errBracketExitSide, err)
+	}
+	bracket.Legs[2].Side = order.Sell
+	bracket.Legs[2].TriggerPrice = 0
+	if err = bracket.validate(); err != errBracketStopLossTrigger {
+		t.Errorf("expected %v received %v", errBracketStopLossTrigger, err)
+	}
+
+	oco := OrderGroup{
+		Type: OrderGroupOCO,
+		Pair: p,
+		Legs: []OrderGroupLeg{{Side: order.Sell, Price: 110, Amount: 1}},
+	}
+	if err = oco.validate(); err != errOCOLegsRequired {
+		t.Errorf("expected %v received %v", errOCOLegsRequired, err)
+	}
+	oco.Legs = append(oco.Legs, OrderGroupLeg{Side: order.Sell, TriggerPrice: 95})
+	if err = oco.validate(); err == nil {
+		t.Error("expected error for a leg without an amount")
+	}
+	oco.Legs[1].Amount = 1
+	if err = oco.validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLegStatusFromOrder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		status   order.Status
+		executed float64
+		expected OrderLegStatus
+		amount   float64
+	}{
+		{order.New, 0, OrderLegOpen, 0},
+		{order.PartiallyFilled, 0.5, OrderLegOpen, 0.5},
+		{order.Filled, 0, OrderLegFilled, 1},
+		{order.PartiallyCancelled, 0.5, OrderLegCancelled, 0.5},
+		{order.Rejected, 0, OrderLegFailed, 0},
+	}
+	for x := range tests {
+		s, executed := legStatusFromOrder(&order.Detail{
+			Status:         tests[x].status,
+			Amount:         1,
+			ExecutedAmount: tests[x].executed,
+		})
+		if s != tests[x].expected || executed != tests[x].amount {
+			t.Errorf("%v expected %v %v received %v %v", tests[x].status,
+				tests[x].expected, tests[x].amount, s, executed)
+		}
+	}
+}
+
+// newTestOrderGroup returns an active order group on a test exchange whose
+// legs have been validated
+func newTestOrderGroup(t *testing.T, exchName string, groupType OrderGroupType, legs ...OrderGroupLeg) *OrderGroup {
+	g := &OrderGroup{
+		ID:       string(groupType),
+		Exchange: exchName,
+		Type:     groupType,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Asset:    asset.Spot,
+		Status:   OrderGroupActive,
+		Legs:     legs,
+	}
+	if err := g.validate(); err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestEvaluateGroup(t *testing.T) {
+	exch := newTestOrderExchange("evaluateGroup")
+	exch.CurrencyPairs.Store(asset.Spot, currency.PairStore{
+		Enabled:      currency.Pairs{currency.NewPair(currency.BTC, currency.USD)},
+		ConfigFormat: &currency.PairFormat{Uppercase: true},
+	})
+	defer setupOrderManagerTest(t, exch)()
+	if err := Bot.ConditionalOrderManager.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := Bot.ConditionalOrderManager.Stop(); err != nil {
+			t.Error(err)
+		}
+		for Bot.ConditionalOrderManager.Started() {
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	o := newTestOrderManager()
+	atomic.StoreInt32(&o.started, 1)
+
+	oco := newTestOrderGroup(t, exch.Name, OrderGroupOCO,
+		OrderGroupLeg{Side: order.Sell, Price: 110, Amount: 1},
+		OrderGroupLeg{Side: order.Sell, Price: 120, Amount: 1})
+	o.activateLegs(oco, oco.Legs)
+	if oco.Legs[0].Status != OrderLegOpen || oco.Legs[1].Status != OrderLegOpen {
+		t.Fatalf("expected open legs received %+v", oco.Legs)
+	}
+	o.evaluateGroup(oco)
+	if oco.Status != OrderGroupActive || len(exch.cancelled) != 0 {
+		t.Errorf("expected group to remain active while its legs are open received %v", oco.Status)
+	}
+
+	oco.Legs[0].ExecutedAmount = 0.2
+	exch.cancelErr = errTestExchange
+	o.evaluateGroup(oco)
+	if oco.Status != OrderGroupActive || oco.Legs[1].Status != OrderLegOpen || oco.Legs[1].Error == "" {
+		t.Errorf("expected a failed cancel to be retried received %+v", oco)
+	}
+	exch.cancelErr = nil
+	o.evaluateGroup(oco)
+	if oco.Status != OrderGroupCompleted || oco.Legs[1].Status != OrderLegCancelled ||
+		len(exch.cancelled) != 1 || exch.cancelled[0] != oco.Legs[1].OrderID {
+		t.Errorf("expected the linked leg to be cancelled received %+v", oco)
+	}
+
+	bracket := newTestOrderGroup(t, exch.Name, OrderGroupBracket,
+		OrderGroupLeg{Side: order.Buy, Price: 100, Amount: 1},
+		OrderGroupLeg{Side: order.Sell, Price: 110},
+		OrderGroupLeg{Side: order.Sell, TriggerPrice: 95})
+	o.activateLegs(bracket, bracket.Legs[:1])
+	o.evaluateGroup(bracket)
+	if bracket.Legs[1].Status != OrderLegPending || bracket.Legs[2].Status != OrderLegPending {
+		t.Errorf("expected exit legs to wait for the entry received %+v", bracket.Legs)
+	}
+
+	bracket.Legs[0].Status = OrderLegCancelled
+	bracket.Legs[0].ExecutedAmount = 0.5
+	submissions := len(exch.submitted)
+	o.evaluateGroup(bracket)
+	if bracket.Status != OrderGroupActive ||
+		bracket.Legs[1].Status != OrderLegOpen ||
+		bracket.Legs[2].Status != OrderLegOpen ||
+		bracket.Legs[1].Amount != 0.5 ||
+		bracket.Legs[2].Amount != 0.5 {
+		t.Fatalf("expected exit legs for the entry fill to be open received %+v", bracket.Legs)
+	}
+	if len(exch.submitted) != submissions+1 || exch.submitted[submissions].Price != 110 {
+		t.Errorf("expected the take-profit leg to be submitted received %+v", exch.submitted)
+	}
+	c, ok := Bot.ConditionalOrderManager.get(bracket.Legs[2].ConditionalOrderID)
+	if !ok || c.Condition != ConditionStopLoss || c.Side != order.Sell || c.Amount != 0.5 {
+		t.Errorf("expected a stop-loss conditional order received %+v", c)
+	}
+
+	unfilled := newTestOrderGroup(t, exch.Name, OrderGroupBracket,
+		OrderGroupLeg{Side: order.Buy, Price: 100, Amount: 1},
+		OrderGroupLeg{Side: order.Sell, Price: 110},
+		OrderGroupLeg{Side: order.Sell, TriggerPrice: 95})
+	unfilled.Legs[0].Status = OrderLegCancelled
+	o.evaluateGroup(unfilled)
+	if unfilled.Status != OrderGroupCancelled ||
+		unfilled.Legs[1].Status != OrderLegCancelled ||
+		unfilled.Legs[2].Status != OrderLegCancelled {
+		t.Errorf("expected an unfilled entry to cancel the group received %+v", unfilled)
+	}
+}
+
+func TestActivateLegs(t *testing.T) {
+	exch := newTestOrderExchange("activateLegs")
+	defer setupOrderManagerTest(t, exch)()
+	o := newTestOrderManager()
+	atomic.StoreInt32(&o.started, 1)
+
+	exch.maxSubmissions = 1
+	g := newTestOrderGroup(t, exch.Name, OrderGroupOCO,
+		OrderGroupLeg{Side: order.Sell, Price: 110, Amount: 1},
+		OrderGroupLeg{Side: order.Sell, Price: 120, Amount: 1},
+		OrderGroupLeg{Side: order.Sell, Price: 130, Amount: 1})
+	o.activateLegs(g, g.Legs)
+	if g.Status != OrderGroupFailed {
+		t.Errorf("expected the group to fail received %v", g.Status)
+	}
+	if g.Legs[0].Status != OrderLegCancelled || len(exch.cancelled) != 1 {
+		t.Errorf("expected the submitted leg to be cancelled received %+v", g.Legs[0])
+	}
+	if g.Legs[1].Status != OrderLegFailed || g.Legs[1].Error == "" {
+		t.Errorf("expected the rejected leg to fail received %+v", g.Legs[1])
+	}
+	if g.Legs[2].Status != OrderLegCancelled {
+		t.Errorf("expected the pending leg to be cancelled received %+v", g.Legs[2])
+	}
+
+}
+
+func TestLegCondition(t *testing.T) {
+	t.Parallel()
+	g := &OrderGroup{
+		Exchange: "legCondition",
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Asset:    asset.Spot,
+	}
+	tests := []struct {
+		role     OrderLegRole
+		side     order.Side
+		trigger  float64
+		expected ConditionType
+	}{
+		{OrderLegTakeProfit, order.Sell, 90, ConditionTakeProfit},
+		{OrderLegStopLoss, order.Sell, 110, ConditionStopLoss},
+		{"", order.Buy, 110, ConditionStopLoss},
+	}
+	for x := range tests {
+		leg := &OrderGroupLeg{Role: tests[x].role, Side: tests[x].side, TriggerPrice: tests[x].trigger}
+		if c := legCondition(g, leg); c != tests[x].expected {
+			t.Errorf("without a ticker %v %v %v expected %v received %v", tests[x].role,
+				tests[x].side, tests[x].trigger, tests[x].expected, c)
+		}
+	}
+
+	err := ticker.ProcessTicker(g.Exchange, &ticker.Price{
+		Pair:        g.Pair,
+		Last:        100,
+		LastUpdated: time.Now(),
+	}, g.Asset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests = []struct {
+		role     OrderLegRole
+		side     order.Side
+		trigger  float64
+		expected ConditionType
+	}{
+		{"", order.Buy, 110, ConditionStopLoss},
+		{"", order.Buy, 90, ConditionTakeProfit},
+		{"", order.Sell, 90, ConditionStopLoss},
+		{"", order.Sell, 110, ConditionTakeProfit},
+		{OrderLegEntry, order.Buy, 90, ConditionTakeProfit},
+		{OrderLegStopLoss, order.Buy, 90, ConditionStopLoss},
+	}
+	for x := range tests {
+		leg := &OrderGroupLeg{Role: tests[x].role, Side: tests[x].side, TriggerPrice: tests[x].trigger}
+		if c := legCondition(g, leg); c != tests[x].expected {
+			t.Errorf("%v %v %v expected %v received %v", tests[x].role,
+				tests[x].side, tests[x].trigger, tests[x].expected, c)
+		}
+	}
+}
+
+func TestProcessGroups(t *testing.T) {
+	exch := newTestOrderExchange("processGroups")
+	defer setupOrderManagerTest(t, exch)()
+	o := newTestOrderManager()
+	atomic.StoreInt32(&o.started, 1)
+
+	g := newTestOrderGroup(t, exch.Name, OrderGroupOCO,
+		OrderGroupLeg{Side: order.Sell, Price: 110, Amount: 1},
+		OrderGroupLeg{Side: order.Sell, Price: 120, Amount: 1})
+	o.activateLegs(g, g.Legs)
+	o.groups[g.ID] = &storedOrderGroup{group: g.copy()}
+	if _, err := o.orderStore.setStatus(exch.Name, g.Legs[0].OrderID, order.Filled); err != nil {
+		t.Fatal(err)
+	}
+
+	o.processGroups()
+	groups, err := o.GetOrderGroups(exch.Name, g.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if groups[0].Status != OrderGroupCompleted ||
+		groups[0].Legs[0].Status != OrderLegFilled ||
+		groups[0].Legs[1].Status != OrderLegCancelled {
+		t.Errorf("expected the filled leg to complete the group received %+v", groups[0])
+	}
+	if _, err = o.CancelOrderGroup(g.ID); err == nil {
+		t.Error("expected an error cancelling a completed group")
+	}
+}
@@ -0,0 +1,420 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errIcebergNotFound         = errors.New("iceberg order not found")
+	errIcebergPriceRequired    = errors.New("iceberg order requires a limit price")
+	errIcebergDisplayAmount    = errors.New("iceberg order display amount must be greater than zero and no more than its amount")
+	errIcebergSizeVariance     = errors.New("iceberg order size variance must be between 0 and 1")
+	errIcebergAmountIsInvalid  = errors.New("iceberg order amount must be greater than zero")
+	errIcebergVisibleNotClosed = errors.New("visible order could not be cancelled")
+)
+
+// AddIcebergOrder validates an iceberg order and submits its first visible
+// order, the stored iceberg order is returned
+func (o *orderManager) AddIcebergOrder(i *IcebergOrder) (IcebergOrder, error) {
+	if !o.Started() {
+		return IcebergOrder{}, errOrderManagerNotStarted
+	}
+	if i == nil {
+		return IcebergOrder{}, errors.New("iceberg order is nil")
+	}
+
+	exch := GetExchangeByName(i.Exchange)
+	if exch == nil {
+		return IcebergOrder{}, errors.New("unable to get exchange by name")
+	}
+	ice := *i
+	ice.Exchange = exch.GetName()
+	if ice.Asset == "" {
+		ice.Asset = asset.Spot
+	}
+	if !exch.GetAssetTypes().Contains(ice.Asset) {
+		return IcebergOrder{}, errors.New("iceberg order asset type not supported by exchange")
+	}
+	err := ice.validate()
+	if err != nil {
+		return IcebergOrder{}, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return IcebergOrder{}, err
+	}
+	now := time.Now()
+	ice.ID = id.String()
+	ice.Status = IcebergActive
+	ice.Reason = ""
+	ice.ExecutedAmount = 0
+	ice.OrderID = ""
+	ice.InternalOrderID = ""
+	ice.VisibleAmount = 0
+	ice.Slices = 0
+	ice.CreatedAt = now
+	ice.UpdatedAt = now
+	ice.filled = 0
+
+	stored := &storedIcebergOrder{order: ice}
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	o.icebergsMtx.Lock()
+	o.icebergs[ice.ID] = stored
+	o.icebergsMtx.Unlock()
+
+	err = o.submitIcebergSlice(&ice)
+	o.storeIceberg(stored, &ice)
+	if err != nil {
+		return ice, err
+	}
+	o.notifyIceberg(&ice, fmt.Sprintf("created showing %v of %v", ice.VisibleAmount, ice.Amount))
+	return ice, nil
+}
+
+// CancelIcebergOrder cancels an active iceberg order and its visible order
+func (o *orderManager) CancelIcebergOrder(id string) (IcebergOrder, error) {
+	if !o.Started() {
+		return IcebergOrder{}, errOrderManagerNotStarted
+	}
+
+	stored, ok := o.lookupIceberg(id)
+	if !ok {
+		return IcebergOrder{}, errIcebergNotFound
+	}
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	i := o.loadIceberg(stored)
+	if i.Status != IcebergActive {
+		return IcebergOrder{}, fmt.Errorf("iceberg order %s is %s and cannot be cancelled",
+			id, strings.ToLower(string(i.Status)))
+	}
+
+	if i.OrderID != "" {
+		err := o.cancelVisibleOrder(&i)
+		if err != nil {
+			o.storeIceberg(stored, &i)
+			return i, err
+		}
+	}
+	if i.remaining() <= 0 {
+		o.finishIceberg(&i, IcebergCompleted, fmt.Sprintf("%v executed over %d visible order(s)",
+			i.ExecutedAmount, i.Slices))
+	} else {
+		o.finishIceberg(&i, IcebergCancelled, fmt.Sprintf("cancelled with %v of %v executed",
+			i.ExecutedAmount, i.Amount))
+	}
+	o.storeIceberg(stored, &i)
+	return i, nil
+}
+
+// GetIcebergOrders returns the iceberg orders created since the order manager
+// started in creation order. An empty exchange name or ID returns every
+// iceberg order.
+func (o *orderManager) GetIcebergOrders(exchName, id string) ([]IcebergOrder, error) {
+	if !o.Started() {
+		return nil, errOrderManagerNotStarted
+	}
+
+	o.icebergsMtx.Lock()
+	var resp []IcebergOrder
+	for _, stored := range o.icebergs {
+		if exchName != "" && !strings.EqualFold(stored.order.Exchange, exchName) {
+			continue
+		}
+		if id != "" && stored.order.ID != id {
+			continue
+		}
+		resp = append(resp, stored.order)
+	}
+	o.icebergsMtx.Unlock()
+
+	if id != "" && len(resp) == 0 {
+		return nil, errIcebergNotFound
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		if !resp[i].CreatedAt.Equal(resp[j].CreatedAt) {
+			return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+		}
+		return resp[i].ID < resp[j].ID
+	})
+	return resp, nil
+}
+
+// lookupIceberg returns a stored iceberg order by its ID
+func (o *orderManager) lookupIceberg(id string) (*storedIcebergOrder, bool) {
+	o.icebergsMtx.Lock()
+	defer o.icebergsMtx.Unlock()
+	stored, ok := o.icebergs[id]
+	return stored, ok
+}
+
+// loadIceberg returns a copy of a stored iceberg order, the iceberg order
+// mutex must be held
+func (o *orderManager) loadIceberg(stored *storedIcebergOrder) IcebergOrder {
+	o.icebergsMtx.Lock()
+	defer o.icebergsMtx.Unlock()
+	return stored.order
+}
+
+// storeIceberg replaces a stored iceberg order with its updated copy, the
+// iceberg order mutex must be held
+func (o *orderManager) storeIceberg(stored *storedIcebergOrder, i *IcebergOrder) {
+	o.icebergsMtx.Lock()
+	defer o.icebergsMtx.Unlock()
+	stored.order = *i
+}
+
+// processIcebergs replaces the visible order of every active iceberg order
+// which has filled or partially filled
+func (o *orderManager) processIcebergs() {
+	o.icebergsMtx.Lock()
+	active := make([]*storedIcebergOrder, 0, len(o.icebergs))
+	for _, stored := range o.icebergs {
+		if stored.order.Status == IcebergActive {
+			active = append(active, stored)
+		}
+	}
+	o.icebergsMtx.Unlock()
+
+	for x := range active {
+		o.processIceberg(active[x])
+	}
+}
+
+// processIceberg checks an active iceberg order on a copy which is stored once
+// its visible order has been replaced. A partially filled visible order is
+// cancelled and replaced so the display amount is shown again.
+func (o *orderManager) processIceberg(stored *storedIcebergOrder) {
+	stored.mtx.Lock()
+	defer stored.mtx.Unlock()
+	i := o.loadIceberg(stored)
+	if i.Status != IcebergActive {
+		return
+	}
+
+	status := o.refreshIceberg(&i)
+	if status == "" && i.OrderID != "" && i.ExecutedAmount > i.filled {
+		err := o.cancelVisibleOrder(&i)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: Exchange %s iceberg order ID=%v unable to replenish partially filled visible order ID=%v. Err: %s\n",
+				i.Exchange, i.ID, i.OrderID, err)
+		} else {
+			status = OrderLegFilled
+		}
+	}
+	o.settleClosedOrder(&i)
+
+	switch {
+	case status == "":
+	case i.remaining() <= 0:
+		o.finishIceberg(&i, IcebergCompleted, fmt.Sprintf("%v executed over %d visible order(s)",
+			i.ExecutedAmount, i.Slices))
+	case status != OrderLegFilled:
+		o.finishIceberg(&i, IcebergCancelled, fmt.Sprintf("visible order %s with %v of %v executed",
+			strings.ToLower(string(status)), i.ExecutedAmount, i.Amount))
+	default:
+		o.submitIcebergSlice(&i)
+	}
+	o.storeIceberg(stored, &i)
+}
+
+// cancelVisibleOrder cancels the visible order of an iceberg order and clears
+// it, an error is returned if the visible order could not be cancelled and is
+// still open
+func (o *orderManager) cancelVisibleOrder(i *IcebergOrder) error {
+	err := o.Cancel(i.Exchange, &order.Cancel{
+		OrderID:      i.OrderID,
+		CurrencyPair: i.Pair,
+		AssetType:    i.Asset,
+		Side:         i.Side,
+	})
+	if err != nil {
+		// The visible order may have closed since the last check
+		if o.refreshIceberg(i) == "" {
+			return fmt.Errorf("%v: %v", errIcebergVisibleNotClosed, err)
+		}
+		return nil
+	}
+	if o.refreshIceberg(i) == "" {
+		o.closeVisibleOrder(i, i.ExecutedAmount-i.filled)
+	}
+	return nil
+}
+
+// closeVisibleOrder clears the visible order of an iceberg order once it has
+// closed with the executed amount. Late fills of the visible order closed
+// before it are settled first.
+func (o *orderManager) closeVisibleOrder(i *IcebergOrder, executed float64) {
+	o.settleClosedOrder(i)
+	i.closedOrderID = i.OrderID
+	i.closedExecuted = executed
+	i.filled += executed
+	i.ExecutedAmount = i.filled
+	i.OrderID = ""
+	i.InternalOrderID = ""
+	i.UpdatedAt = time.Now()
+}
+
+// settleClosedOrder adds the fills of the last visible order to close which
+// were stored after it closed, such as fills executed before a cancellation
+// took effect but reported after it was confirmed
+func (o *orderManager) settleClosedOrder(i *IcebergOrder) {
+	if i.closedOrderID == "" {
+		return
+	}
+	det, err := o.orderStore.get(i.Exchange, i.closedOrderID)
+	if err != nil {
+		return
+	}
+	_, executed := legStatusFromOrder(&det)
+	if executed <= i.closedExecuted {
+		return
+	}
+	late := executed - i.closedExecuted
+	i.closedExecuted = executed
+	i.filled += late
+	i.ExecutedAmount += late
+	i.UpdatedAt = time.Now()
+}
+
+// refreshIceberg updates an iceberg order from its visible order in the order
+// store. Once the visible order has closed it is cleared and its status is
+// returned, otherwise an empty status is returned.
+func (o *orderManager) refreshIceberg(i *IcebergOrder) OrderLegStatus {
+	if i.OrderID == "" {
+		return ""
+	}
+	det, err := o.orderStore.get(i.Exchange, i.OrderID)
+	if err != nil {
+		return ""
+	}
+	status, executed := legStatusFromOrder(&det)
+	if i.filled+executed != i.ExecutedAmount {
+		i.ExecutedAmount = i.filled + executed
+		i.UpdatedAt = time.Now()
+	}
+	if status == OrderLegOpen {
+		return ""
+	}
+	o.closeVisibleOrder(i, executed)
+	return status
+}
+
+// submitIcebergSlice submits the next visible order of an iceberg order, the
+// iceberg order fails if it cannot be submitted
+func (o *orderManager) submitIcebergSlice(i *IcebergOrder) error {
+	var rules order.TradingRules
+	if exch := GetExchangeByName(i.Exchange); exch != nil {
+		if r, err := exch.GetTradingRules(i.Pair, i.Asset); err == nil {
+			rules = r
+		}
+	}
+	amount := i.sliceAmount(rand.Float64(), &rules)
+	resp, err := o.Submit(i.Exchange, &order.Submit{
+		Pair:      i.Pair,
+		AssetType: i.Asset,
+		OrderType: order.Limit,
+		OrderSide: i.Side,
+		Price:     i.Price,
+		Amount:    amount,
+	})
+	if err != nil {
+		o.finishIceberg(i, IcebergFailed, fmt.Sprintf("unable to submit visible order with %v of %v executed: %s",
+			i.filled, i.Amount, err))
+		return err
+	}
+	i.OrderID = resp.OrderID
+	i.InternalOrderID = resp.OurOrderID
+	i.VisibleAmount = amount
+	i.Slices++
+	i.UpdatedAt = time.Now()
+	log.Debugf(log.OrderMgr, "Order manager: Exchange %s iceberg order ID=%v submitted visible order ID=%v [Ours: %v] amount=%v, %v of %v executed.\n",
+		i.Exchange, i.ID, i.OrderID, i.InternalOrderID, amount, i.filled, i.Amount)
+	return nil
+}
+
+func (o *orderManager) finishIceberg(i *IcebergOrder, status IcebergStatus, reason string) {
+	i.Status = status
+	i.Reason = reason
+	i.UpdatedAt = time.Now()
+	o.notifyIceberg(i, reason)
+}
+
+func (o *orderManager) notifyIceberg(i *IcebergOrder, reason string) {
+	msg := fmt.Sprintf("Order manager: Exchange %s iceberg order ID=%v pair=%v side=%v price=%v status=%v, %s.",
+		i.Exchange, i.ID, i.Pair, i.Side, i.Price, i.Status, reason)
+	log.Debugln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// remaining returns the amount of an iceberg order left to execute, amounts
+// within a rounding error of the iceberg amount are treated as executed
+func (i *IcebergOrder) remaining() float64 {
+	r := i.Amount - i.filled
+	if r <= i.Amount*1e-9 {
+		return 0
+	}
+	return r
+}
+
+// sliceAmount returns the amount of the next visible order for a random
+// number in [0, 1), the display amount is varied by up to the size variance
+// either way and rounded down to the amount step size of the trading rules
+// with a minimum of one step. The remaining amount is returned instead when
+// the slice would leave less than a step to execute.
+func (i *IcebergOrder) sliceAmount(r float64, rules *order.TradingRules) float64 {
+	amount := rules.RoundAmount(i.DisplayAmount * (1 + i.SizeVariance*(2*r-1)))
+	if amount < rules.AmountStepSize {
+		amount = rules.AmountStepSize
+	}
+	remaining := i.remaining()
+	if amount > remaining || rules.RoundAmount(remaining-amount) < rules.AmountStepSize {
+		return remaining
+	}
+	return amount
+}
+
+// validate normalises and checks the parameters of an iceberg order
+func (i *IcebergOrder) validate() error {
+	switch i.Side {
+	case order.Bid:
+		i.Side = order.Buy
+	case order.Ask:
+		i.Side = order.Sell
+	}
+	if i.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+	if i.Side != order.Buy && i.Side != order.Sell {
+		return order.ErrSideIsInvalid
+	}
+	if i.Price <= 0 {
+		return errIcebergPriceRequired
+	}
+	if i.Amount <= 0 {
+		return errIcebergAmountIsInvalid
+	}
+	if i.DisplayAmount <= 0 || i.DisplayAmount > i.Amount {
+		return errIcebergDisplayAmount
+	}
+	if i.SizeVariance < 0 || i.SizeVariance >= 1 {
+		return errIcebergSizeVariance
+	}
+	return nil
+}
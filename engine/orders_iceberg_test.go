@@ -0,0 +1,245 @@
+package engine
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestIcebergOrderValidate(t *testing.T) {
+	t.Parallel()
+	i := IcebergOrder{
+		Pair:          currency.NewPair(currency.BTC, currency.USD),
+		Side:          order.Ask,
+		Price:         100,
+		Amount:        10,
+		DisplayAmount: 1,
+		SizeVariance:  0.2,
+	}
+	err := i.validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i.Side != order.Sell {
+		t.Errorf("expected %v received %v", order.Sell, i.Side)
+	}
+
+	i.DisplayAmount = 11
+	if err = i.validate(); err != errIcebergDisplayAmount {
+		t.Errorf("expected %v received %v", errIcebergDisplayAmount, err)
+	}
+	i.DisplayAmount = 1
+	i.SizeVariance = 1
+	if err = i.validate(); err != errIcebergSizeVariance {
+		t.Errorf("expected %v received %v", errIcebergSizeVariance, err)
+	}
+	i.SizeVariance = 0
+	i.Price = 0
+	if err = i.validate(); err != errIcebergPriceRequired {
+		t.Errorf("expected %v received %v", errIcebergPriceRequired, err)
+	}
+}
+
+func TestIcebergOrderSliceAmount(t *testing.T) {
+	t.Parallel()
+	i := IcebergOrder{
+		Amount:        10,
+		DisplayAmount: 2,
+	}
+	var rules order.TradingRules
+	if amount := i.sliceAmount(0.9, &rules); amount != 2 {
+		t.Errorf("expected 2 received %v", amount)
+	}
+
+	i.SizeVariance = 0.5
+	if amount := i.sliceAmount(0, &rules); amount != 1 {
+		t.Errorf("expected 1 received %v", amount)
+	}
+	if amount := i.sliceAmount(0.5, &rules); amount != 2 {
+		t.Errorf("expected 2 received %v", amount)
+	}
+
+	rules.AmountStepSize = 0.3
+	if amount := i.sliceAmount(0.9, &rules); amount != 2.7 {
+		t.Errorf("expected 2.7 rounded to the step size received %v", amount)
+	}
+	i.DisplayAmount = 0.1
+	if amount := i.sliceAmount(0.5, &rules); amount != 0.3 {
+		t.Errorf("expected the minimum of one step 0.3 received %v", amount)
+	}
+	i.DisplayAmount = 2
+	i.filled = 8
+	if amount := i.sliceAmount(0.5, &rules); amount != 2 {
+		t.Errorf("expected the remaining amount 2 rather than leaving less than a step received %v", amount)
+	}
+	rules.AmountStepSize = 0
+
+	i.filled = 9
+	if amount := i.sliceAmount(0.5, &rules); amount != 1 {
+		t.Errorf("expected the remaining amount 1 received %v", amount)
+	}
+	i.filled = 10
+	if amount := i.sliceAmount(0.5, &rules); amount != 0 {
+		t.Errorf("expected 0 received %v", amount)
+	}
+}
+
+// setTestOrderExecuted sets the status and executed amount of a stored order
+func setTestOrderExecuted(o *orderManager, exchName, id string, s order.Status, executed float64) {
+	o.orderStore.m.Lock()
+	defer o.orderStore.m.Unlock()
+	for x := range o.orderStore.Orders[exchName] {
+		if o.orderStore.Orders[exchName][x].ID == id {
+			o.orderStore.Orders[exchName][x].Status = s
+			o.orderStore.Orders[exchName][x].ExecutedAmount = executed
+		}
+	}
+}
+
+func TestProcessIcebergs(t *testing.T) {
+	exch := newTestOrderExchange("processIcebergs")
+	p := currency.NewPair(currency.BTC, currency.USD)
+	err := exch.LoadTradingRules(asset.Spot, []order.TradingRules{
+		{Pair: p, AmountStepSize: 0.1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer setupOrderManagerTest(t, exch)()
+	o := newTestOrderManager()
+	atomic.StoreInt32(&o.started, 1)
+
+	i, err := o.AddIcebergOrder(&IcebergOrder{
+		Exchange:      exch.Name,
+		Pair:          p,
+		Side:          order.Buy,
+		Price:         100,
+		Amount:        1,
+		DisplayAmount: 0.4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i.OrderID != "1" || i.VisibleAmount != 0.4 {
+		t.Fatalf("expected visible order 1 of 0.4 received %v of %v", i.OrderID, i.VisibleAmount)
+	}
+
+	// A partial fill cancels and replaces the visible order
+	setTestOrderExecuted(o, exch.Name, "1", order.PartiallyFilled, 0.1)
+	o.processIcebergs()
+	icebergs, err := o.GetIcebergOrders(exch.Name, i.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i = icebergs[0]
+	if len(exch.cancelled) != 1 || exch.cancelled[0] != "1" {
+		t.Errorf("expected the partially filled visible order to be cancelled received %v", exch.cancelled)
+	}
+	if i.Status != IcebergActive || i.OrderID != "2" || i.VisibleAmount != 0.4 ||
+		i.ExecutedAmount != 0.1 || i.Slices != 2 {
+		t.Errorf("expected a replenished visible order received %+v", i)
+	}
+
+	// A failed cancellation keeps the visible order until the next check
+	exch.cancelErr = errTestExchange
+	setTestOrderExecuted(o, exch.Name, "2", order.PartiallyFilled, 0.2)
+	o.processIcebergs()
+	if icebergs, err = o.GetIcebergOrders(exch.Name, i.ID); err != nil {
+		t.Fatal(err)
+	}
+	i = icebergs[0]
+	if i.Status != IcebergActive || i.OrderID != "2" || !floatEquals(i.ExecutedAmount, 0.3) {
+		t.Errorf("expected the visible order to be kept received %+v", i)
+	}
+	exch.cancelErr = nil
+
+	// A filled visible order is replaced by one of the display amount until
+	// the remaining amount is shown
+	setTestOrderExecuted(o, exch.Name, "2", order.Filled, 0.4)
+	o.processIcebergs()
+	if icebergs, err = o.GetIcebergOrders(exch.Name, i.ID); err != nil {
+		t.Fatal(err)
+	}
+	i = icebergs[0]
+	if i.OrderID != "3" || i.VisibleAmount != 0.4 {
+		t.Errorf("expected visible order 3 of 0.4 received %v of %v", i.OrderID, i.VisibleAmount)
+	}
+
+	setTestOrderExecuted(o, exch.Name, "3", order.Filled, 0.4)
+	o.processIcebergs()
+	if icebergs, err = o.GetIcebergOrders(exch.Name, i.ID); err != nil {
+		t.Fatal(err)
+	}
+	i = icebergs[0]
+	if i.OrderID != "4" || !floatEquals(i.VisibleAmount, 0.1) {
+		t.Errorf("expected visible order 4 of 0.1 received %v of %v", i.OrderID, i.VisibleAmount)
+	}
+
+	setTestOrderExecuted(o, exch.Name, "4", order.Filled, 0.1)
+	o.processIcebergs()
+	if icebergs, err = o.GetIcebergOrders(exch.Name, i.ID); err != nil {
+		t.Fatal(err)
+	}
+	if icebergs[0].Status != IcebergCompleted || len(exch.submitted) != 4 {
+		t.Errorf("expected the iceberg order to complete after 4 visible orders received %+v", icebergs[0])
+	}
+}
+
+func TestProcessIcebergLateFills(t *testing.T) {
+	exch := newTestOrderExchange("processIcebergLateFills")
+	p := currency.NewPair(currency.BTC, currency.USD)
+	err := exch.LoadTradingRules(asset.Spot, []order.TradingRules{
+		{Pair: p, AmountStepSize: 0.1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer setupOrderManagerTest(t, exch)()
+	o := newTestOrderManager()
+	atomic.StoreInt32(&o.started, 1)
+
+	i, err := o.AddIcebergOrder(&IcebergOrder{
+		Exchange:      exch.Name,
+		Pair:          p,
+		Side:          order.Buy,
+		Price:         100,
+		Amount:        1,
+		DisplayAmount: 0.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A fill between the partial fill check and the cancellation is counted
+	// before the next visible order is sized
+	setTestOrderExecuted(o, exch.Name, "1", order.PartiallyFilled, 0.1)
+	exch.onCancel = func(orderID string) {
+		setTestOrderExecuted(o, exch.Name, orderID, order.PartiallyFilled, 0.2)
+	}
+	o.processIcebergs()
+	exch.onCancel = nil
+	icebergs, err := o.GetIcebergOrders(exch.Name, i.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i = icebergs[0]
+	if i.OrderID != "2" || !floatEquals(i.ExecutedAmount, 0.2) || i.VisibleAmount != 0.5 {
+		t.Errorf("expected visible order 2 of 0.5 with 0.2 executed received %+v", i)
+	}
+
+	// A fill of the cancelled visible order reported after the cancellation
+	// was confirmed is counted before the next visible order is sized
+	setTestOrderExecuted(o, exch.Name, "1", order.Cancelled, 0.3)
+	setTestOrderExecuted(o, exch.Name, "2", order.Filled, 0.5)
+	o.processIcebergs()
+	if icebergs, err = o.GetIcebergOrders(exch.Name, i.ID); err != nil {
+		t.Fatal(err)
+	}
+	i = icebergs[0]
+	if i.OrderID != "3" || !floatEquals(i.ExecutedAmount, 0.8) || !floatEquals(i.VisibleAmount, 0.2) {
+		t.Errorf("expected visible order 3 of 0.2 with 0.8 executed received %+v", i)
+	}
+}
@@ -0,0 +1,304 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// killSwitchAuditType is the audit event type of kill switch activations and
+// resets
+const killSwitchAuditType = "killswitch"
+
+// vars related to the kill switch and dead man's switch heartbeat
+var (
+	// DefaultDeadMansSwitchTimeout is the default amount of time an exchange
+	// dead man's switch waits for a heartbeat before cancelling all orders
+	DefaultDeadMansSwitchTimeout = time.Minute
+
+	errKillSwitchActive    = errors.New("order submissions and modifications halted by the kill switch")
+	errKillSwitchNotActive = errors.New("kill switch is not active")
+)
+
+// deadMansSwitchHeartbeats is the number of heartbeats sent within each dead
+// man's switch timeout so a late heartbeat does not trigger it
+const deadMansSwitchHeartbeats = 4
+
+// ActivateKillSwitch halts every new order submission and modification through
+// the order manager and cancels the open orders of every exchange with
+// authenticated API support. Submissions and modifications stay halted until
+// the kill switch is reset.
+func (o *orderManager) ActivateKillSwitch(reason string) ([]CancelAllResult, error) {
+	if !o.Started() {
+		return nil, errOrderManagerNotStarted
+	}
+	if reason == "" {
+		reason = "manually activated"
+	}
+
+	o.killSwitchMtx.Lock()
+	o.killSwitch = KillSwitchStatus{
+		Active:      true,
+		Reason:      reason,
+		ActivatedAt: time.Now(),
+	}
+	o.killSwitchMtx.Unlock()
+
+	msg := fmt.Sprintf("Order manager: Kill switch activated, order submissions and modifications halted and cancelling all open orders: %s.",
+		reason)
+	log.Warnln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+	audit.Event("", killSwitchAuditType, msg)
+	return o.CancelAllOrders("")
+}
+
+// ResetKillSwitch resumes order submissions and modifications halted by the
+// kill switch
+func (o *orderManager) ResetKillSwitch() error {
+	if !o.Started() {
+		return errOrderManagerNotStarted
+	}
+
+	o.killSwitchMtx.Lock()
+	if !o.killSwitch.Active {
+		o.killSwitchMtx.Unlock()
+		return errKillSwitchNotActive
+	}
+	o.killSwitch = KillSwitchStatus{}
+	o.killSwitchMtx.Unlock()
+
+	msg := "Order manager: Kill switch reset, order submissions resumed."
+	log.Warnln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+	audit.Event("", killSwitchAuditType, msg)
+	return nil
+}
+
+// GetKillSwitchStatus returns the state of the kill switch
+func (o *orderManager) GetKillSwitchStatus() KillSwitchStatus {
+	o.killSwitchMtx.Lock()
+	defer o.killSwitchMtx.Unlock()
+	return o.killSwitch
+}
+
+// checkKillSwitch returns an error while the kill switch is active
+func (o *orderManager) checkKillSwitch() error {
+	o.killSwitchMtx.Lock()
+	defer o.killSwitchMtx.Unlock()
+	if !o.killSwitch.Active {
+		return nil
+	}
+	return fmt.Errorf("%v: %s", errKillSwitchActive, o.killSwitch.Reason)
+}
+
+// CancelAllOrders cancels every open order on an exchange, an empty exchange
+// name cancels the open orders of every exchange with authenticated API
+// support. Stored open orders are cancelled individually before the exchange
+// is asked to cancel any orders placed outside of the order manager. Results
+// are returned in exchange name order.
+func (o *orderManager) CancelAllOrders(exchName string) ([]CancelAllResult, error) {
+	var exchNames []string
+	if exchName != "" {
+		exch := GetExchangeByName(exchName)
+		if exch == nil {
+			return nil, errors.New("unable to get exchange by name")
+		}
+		exchNames = append(exchNames, exch.GetName())
+	} else {
+		exchNames = GetAuthAPISupportedExchanges()
+	}
+	sort.Strings(exchNames)
+
+	results := make([]CancelAllResult, 0, len(exchNames))
+	for x := range exchNames {
+		exch := GetExchangeByName(exchNames[x])
+		if exch == nil {
+			continue
+		}
+		result := o.cancelAllOnExchange(exch)
+		msg := fmt.Sprintf("Order manager: Exchange %s cancelled all open orders, %d order(s) could not be cancelled.",
+			result.Exchange, len(result.Failed))
+		if result.Error != "" {
+			msg = fmt.Sprintf("Order manager: Exchange %s cancelled all open orders, %d order(s) could not be cancelled. Err: %s",
+				result.Exchange, len(result.Failed), result.Error)
+		}
+		log.Debugln(log.OrderMgr, msg)
+		Bot.CommsManager.PushEvent(base.Event{
+			Type:    "order",
+			Message: msg,
+		})
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// cancelAllOnExchange cancels the open orders of the order manager on an
+// exchange, then the orders placed outside of it for every asset type the
+// exchange supports
+func (o *orderManager) cancelAllOnExchange(exch exchange.IBotExchange) CancelAllResult {
+	result := CancelAllResult{
+		Exchange: exch.GetName(),
+		Failed:   make(map[string]string),
+	}
+
+	open := o.orderStore.openOrders(result.Exchange)
+	if len(open) > 0 {
+		batch := make([]order.Cancel, len(open))
+		for x := range open {
+			batch[x] = order.Cancel{
+				OrderID:      open[x].ID,
+				AccountID:    open[x].AccountID,
+				CurrencyPair: open[x].CurrencyPair,
+				AssetType:    open[x].AssetType,
+				Side:         open[x].OrderSide,
+			}
+		}
+		failed, err := o.CancelOrders(result.Exchange, batch)
+		if err != nil {
+			for x := range batch {
+				result.Failed[batch[x].OrderID] = err.Error()
+			}
+		}
+		for k, v := range failed {
+			result.Failed[k] = v
+		}
+	}
+
+	var errs []string
+	assets := exch.GetAssetTypes()
+	for x := range assets {
+		resp, err := exch.CancelAllOrders(&order.Cancel{AssetType: assets[x]})
+		switch {
+		case err == common.ErrFunctionNotSupported, err == common.ErrNotYetImplemented:
+		case err != nil:
+			errs = append(errs, fmt.Sprintf("%s: %s", assets[x], err))
+		default:
+			for k, v := range resp.Status {
+				result.Failed[k] = v
+			}
+		}
+	}
+	if len(errs) > 0 {
+		result.Error = "unable to cancel orders placed outside of the order manager: " +
+			strings.Join(errs, ", ")
+	}
+	return result
+}
+
+// heartbeat arms the dead man's switch of every authenticated exchange which
+// has one and refreshes it until the order manager stops
+func (o *orderManager) heartbeat() {
+	defer o.heartbeatWG.Done()
+	t := time.NewTicker(o.deadMansSwitch / deadMansSwitchHeartbeats)
+	defer t.Stop()
+
+	o.armDeadMansSwitches()
+	for {
+		select {
+		case <-o.shutdown:
+			return
+		case <-t.C:
+			o.armDeadMansSwitches()
+		}
+	}
+}
+
+// armDeadMansSwitches arms or refreshes exchange dead man's switches, changes
+// in whether an exchange switch could be armed are logged. Exchanges without
+// a native switch have their open orders cancelled by the engine on a graceful
+// shutdown instead.
+//
+// WARNING: the engine side switch offers no protection if the process
+// crashes, is killed or loses its connection as nothing is left running to
+// cancel the orders. Only exchanges with a native switch are protected then.
+func (o *orderManager) armDeadMansSwitches() {
+	exchNames := GetAuthAPISupportedExchanges()
+	o.dmsMtx.Lock()
+	defer o.dmsMtx.Unlock()
+	for x := range exchNames {
+		exch := GetExchangeByName(exchNames[x])
+		if exch == nil {
+			continue
+		}
+		state, ok := o.dms[exchNames[x]]
+		if ok && !state.native {
+			continue
+		}
+		err := exch.SetDeadMansSwitch(o.deadMansSwitch)
+		if err == common.ErrFunctionNotSupported {
+			o.dms[exchNames[x]] = &deadMansSwitchState{}
+			log.Warnf(log.OrderMgr, "Order manager: WARNING Exchange %s has no dead man's switch. Its open orders are only cancelled by the engine on a graceful shutdown and stay open if the bot crashes, is killed or loses its connection.\n",
+				exchNames[x])
+			continue
+		}
+		if !ok {
+			state = &deadMansSwitchState{native: true}
+			o.dms[exchNames[x]] = state
+		}
+		var errMsg string
+		if err != nil {
+			errMsg = err.Error()
+		}
+		if ok && errMsg == state.err {
+			continue
+		}
+		state.err = errMsg
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: Exchange %s unable to arm dead man's switch. Err: %s\n",
+				exchNames[x], err)
+			continue
+		}
+		log.Debugf(log.OrderMgr, "Order manager: Exchange %s dead man's switch armed with a %v timeout.\n",
+			exchNames[x], o.deadMansSwitch)
+	}
+}
+
+// disarmDeadMansSwitches disarms the native dead man's switches armed by the
+// heartbeat
+func (o *orderManager) disarmDeadMansSwitches() {
+	o.dmsMtx.Lock()
+	defer o.dmsMtx.Unlock()
+	for exchName, state := range o.dms {
+		if !state.native {
+			continue
+		}
+		exch := GetExchangeByName(exchName)
+		if exch == nil {
+			continue
+		}
+		if err := exch.SetDeadMansSwitch(0); err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: Exchange %s unable to disarm dead man's switch. Err: %s\n",
+				exchName, err)
+		}
+	}
+}
+
+// engineDeadMansSwitchExchanges returns the exchanges seen by the heartbeat
+// which have no native dead man's switch
+func (o *orderManager) engineDeadMansSwitchExchanges() []string {
+	o.dmsMtx.Lock()
+	defer o.dmsMtx.Unlock()
+	var exchNames []string
+	for exchName, state := range o.dms {
+		if !state.native {
+			exchNames = append(exchNames, exchName)
+		}
+	}
+	sort.Strings(exchNames)
+	return exchNames
+}
@@ -0,0 +1,160 @@
+package engine
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestKillSwitchNotStarted(t *testing.T) {
+	t.Parallel()
+	var o orderManager
+	if _, err := o.ActivateKillSwitch("test"); err != errOrderManagerNotStarted {
+		t.Errorf("expected %v received %v", errOrderManagerNotStarted, err)
+	}
+	if err := o.ResetKillSwitch(); err != errOrderManagerNotStarted {
+		t.Errorf("expected %v received %v", errOrderManagerNotStarted, err)
+	}
+}
+
+func TestCheckKillSwitch(t *testing.T) {
+	t.Parallel()
+	var o orderManager
+	if err := o.checkKillSwitch(); err != nil {
+		t.Fatalf("expected no error received %v", err)
+	}
+
+	o.killSwitch = KillSwitchStatus{
+		Active:      true,
+		Reason:      "test",
+		ActivatedAt: time.Now(),
+	}
+	err := o.checkKillSwitch()
+	if err == nil || !strings.Contains(err.Error(), errKillSwitchActive.Error()) {
+		t.Fatalf("expected %v received %v", errKillSwitchActive, err)
+	}
+
+	// Submissions are rejected before validation while the kill switch is
+	// active
+	err = o.checkSubmission(riskTestExchange, &order.Submit{
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		OrderType: order.Limit,
+		OrderSide: order.Buy,
+	}, nil)
+	if err == nil || !strings.Contains(err.Error(), errKillSwitchActive.Error()) {
+		t.Errorf("expected %v received %v", errKillSwitchActive, err)
+	}
+
+	if status := o.GetKillSwitchStatus(); !status.Active || status.Reason != "test" {
+		t.Errorf("expected active kill switch received %+v", status)
+	}
+}
+
+func TestCancelAllOnExchange(t *testing.T) {
+	exch := newTestOrderExchange("cancelAllAssets")
+	exch.CurrencyPairs.AssetTypes = asset.Items{asset.Spot, asset.Margin, asset.Futures, asset.PerpetualSwap}
+	exch.cancelAllErrs = map[asset.Item]error{
+		asset.Margin:        common.ErrFunctionNotSupported,
+		asset.PerpetualSwap: errTestExchange,
+	}
+	defer setupOrderManagerTest(t, exch)()
+	o := newTestOrderManager(order.Detail{
+		Exchange:     exch.Name,
+		ID:           "1",
+		Status:       order.Active,
+		AssetType:    asset.Futures,
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+	})
+	atomic.StoreInt32(&o.started, 1)
+
+	result := o.cancelAllOnExchange(exch)
+	if len(exch.cancelled) != 1 || exch.cancelled[0] != "1" {
+		t.Errorf("expected stored order 1 cancelled received %v", exch.cancelled)
+	}
+	if len(exch.cancelAll) != 4 {
+		t.Errorf("expected orders cancelled for every asset type received %v", exch.cancelAll)
+	}
+	if !strings.Contains(result.Error, asset.PerpetualSwap.String()) ||
+		strings.Contains(result.Error, asset.Margin.String()) {
+		t.Errorf("expected only the perpetual swap error received %v", result.Error)
+	}
+}
+
+func TestEngineDeadMansSwitchExchanges(t *testing.T) {
+	t.Parallel()
+	o := orderManager{
+		dms: map[string]*deadMansSwitchState{
+			"Bitmex":   {native: true},
+			"Kraken":   {},
+			"Bitstamp": {},
+		},
+	}
+	exchNames := o.engineDeadMansSwitchExchanges()
+	if len(exchNames) != 2 || exchNames[0] != "Bitstamp" || exchNames[1] != "Kraken" {
+		t.Errorf("expected [Bitstamp Kraken] received %v", exchNames)
+	}
+}
+
+func TestArmDeadMansSwitches(t *testing.T) {
+	native := newTestOrderExchange("dmsNative")
+	native.API.AuthenticatedSupport = true
+	engineSide := newTestOrderExchange("dmsEngine")
+	engineSide.API.AuthenticatedSupport = true
+	engineSide.dmsErr = common.ErrFunctionNotSupported
+	defer setupOrderManagerTest(t, native, engineSide)()
+	o := newTestOrderManager()
+	o.dms = make(map[string]*deadMansSwitchState)
+	o.deadMansSwitch = time.Minute
+
+	o.armDeadMansSwitches()
+	if len(native.dmsTimeouts) != 1 || native.dmsTimeouts[0] != time.Minute {
+		t.Errorf("expected the native switch armed with a 1m timeout received %v", native.dmsTimeouts)
+	}
+	if state := o.dms[native.Name]; state == nil || !state.native || state.err != "" {
+		t.Errorf("expected an armed native switch received %+v", state)
+	}
+	if state := o.dms[engineSide.Name]; state == nil || state.native {
+		t.Errorf("expected an engine side switch received %+v", state)
+	}
+
+	// Errors arming a switch are recorded when they change and cleared once
+	// it is armed again
+	native.dmsErr = errTestExchange
+	o.armDeadMansSwitches()
+	if state := o.dms[native.Name]; state.err != errTestExchange.Error() {
+		t.Errorf("expected error %v received %v", errTestExchange, state.err)
+	}
+	o.armDeadMansSwitches()
+	if state := o.dms[native.Name]; state.err != errTestExchange.Error() {
+		t.Errorf("expected error %v received %v", errTestExchange, state.err)
+	}
+	native.dmsErr = nil
+	o.armDeadMansSwitches()
+	if state := o.dms[native.Name]; state.err != "" {
+		t.Errorf("expected the error cleared received %v", state.err)
+	}
+	if len(native.dmsTimeouts) != 4 {
+		t.Errorf("expected the native switch refreshed on every heartbeat received %v", native.dmsTimeouts)
+	}
+	if len(engineSide.dmsTimeouts) != 1 {
+		t.Errorf("expected an exchange without a switch to be tried once received %v", engineSide.dmsTimeouts)
+	}
+	exchNames := o.engineDeadMansSwitchExchanges()
+	if len(exchNames) != 1 || exchNames[0] != engineSide.Name {
+		t.Errorf("expected [%s] received %v", engineSide.Name, exchNames)
+	}
+
+	o.disarmDeadMansSwitches()
+	if len(native.dmsTimeouts) != 5 || native.dmsTimeouts[4] != 0 {
+		t.Errorf("expected the native switch disarmed received %v", native.dmsTimeouts)
+	}
+	if len(engineSide.dmsTimeouts) != 1 {
+		t.Errorf("expected no disarm without a native switch received %v", engineSide.dmsTimeouts)
+	}
+}
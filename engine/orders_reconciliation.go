@@ -0,0 +1,319 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errOrderManagerNotStarted = errors.New("order manager not started")
+
+// Reconcile compares the stored open orders of an exchange with its active
+// orders and order history, applies any transitions the order manager missed
+// and returns the reports. An empty exchange name reconciles every exchange
+// with authenticated API support.
+func (o *orderManager) Reconcile(exchName string) ([]ReconciliationReport, error) {
+	if !o.Started() {
+		return nil, errOrderManagerNotStarted
+	}
+
+	var exchNames []string
+	if exchName != "" {
+		exch := GetExchangeByName(exchName)
+		if exch == nil {
+			return nil, errors.New("unable to get exchange by name")
+		}
+		exchNames = append(exchNames, exch.GetName())
+	} else {
+		exchNames = GetAuthAPISupportedExchanges()
+	}
+
+	o.reconcileMtx.Lock()
+	defer o.reconcileMtx.Unlock()
+
+	reports := make([]ReconciliationReport, 0, len(exchNames))
+	for x := range exchNames {
+		exch := GetExchangeByName(exchNames[x])
+		if exch == nil {
+			continue
+		}
+		report := o.reconcileExchange(exch)
+		o.reportsMtx.Lock()
+		o.reports[report.Exchange] = report
+		o.reportsMtx.Unlock()
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// GetReconciliationReports returns the most recent reconciliation report for
+// an exchange, an empty exchange name returns the report of every exchange
+func (o *orderManager) GetReconciliationReports(exchName string) ([]ReconciliationReport, error) {
+	if !o.Started() {
+		return nil, errOrderManagerNotStarted
+	}
+
+	o.reportsMtx.RLock()
+	defer o.reportsMtx.RUnlock()
+
+	var reports []ReconciliationReport
+	for k, v := range o.reports {
+		if exchName != "" && !strings.EqualFold(k, exchName) {
+			continue
+		}
+		reports = append(reports, v)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Exchange < reports[j].Exchange
+	})
+	return reports, nil
+}
+
+func (o *orderManager) reconcileExchange(exch exchange.IBotExchange) ReconciliationReport {
+	exchName := exch.GetName()
+	report := ReconciliationReport{
+		Exchange: exchName,
+		Started:  time.Now(),
+	}
+
+	local := o.orderStore.openOrders(exchName)
+	report.LocalOpenOrders = len(local)
+
+	active, err := exch.GetActiveOrders(&order.GetOrdersRequest{
+		OrderSide: order.AnySide,
+		OrderType: order.AnyType,
+	})
+	if err != nil {
+		report.Error = err.Error()
+		log.Warnf(log.OrderMgr, "Order reconciliation: Exchange %s unable to get active orders. Err: %s\n",
+			exchName, err)
+		report.Finished = time.Now()
+		return report
+	}
+	report.ExchangeOpenOrders = len(active)
+
+	seen := make(map[string]bool, len(active))
+	for x := range active {
+		ord := &active[x]
+		ord.Exchange = exchName
+		seen[ord.ID] = true
+
+		stored, err := o.orderStore.get(exchName, ord.ID)
+		if err != nil {
+			o.reconcileUntracked(ord, &report)
+			continue
+		}
+		report.Matched++
+
+		changed, err := o.orderStore.Update(ord)
+		if err != nil || !changed {
+			continue
+		}
+		o.updateOrder(ord)
+		o.recordChange(&report, &stored, ord, ReconcileUpdated,
+			"order status or fill amounts changed on the exchange")
+	}
+
+	var missing []order.Detail
+	for x := range local {
+		if local[x].ID == "" || seen[local[x].ID] {
+			continue
+		}
+		missing = append(missing, local[x])
+	}
+	if len(missing) > 0 {
+		o.reconcileMissing(exch, missing, &report)
+	}
+
+	report.Finished = time.Now()
+	log.Debugf(log.OrderMgr, "Order reconciliation: Exchange %s local open=%d exchange open=%d matched=%d changes=%d.\n",
+		exchName, report.LocalOpenOrders, report.ExchangeOpenOrders, report.Matched, len(report.Changes))
+	return report
+}
+
+// reconcileUntracked adds an active order which was placed outside of the
+// order manager
+func (o *orderManager) reconcileUntracked(ord *order.Detail, report *ReconciliationReport) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Warnf(log.OrderMgr,
+			"Order manager: Unable to generate UUID. Err: %s\n",
+			err)
+	}
+	ord.InternalOrderID = id.String()
+	if o.orderStore.Add(ord) != nil {
+		return
+	}
+	o.insertOrder(ord, "")
+	o.recordChange(report, &order.Detail{}, ord, ReconcileUntracked,
+		"active order was not tracked by the order manager")
+}
+
+// reconcileMissing resolves stored open orders which are no longer active on
+// the exchange against its order history
+func (o *orderManager) reconcileMissing(exch exchange.IBotExchange, missing []order.Detail, report *ReconciliationReport) {
+	req := order.GetOrdersRequest{
+		OrderSide: order.AnySide,
+		OrderType: order.AnyType,
+		EndTicks:  time.Now(),
+	}
+	for x := range missing {
+		if !missing[x].OrderDate.IsZero() &&
+			(req.StartTicks.IsZero() || missing[x].OrderDate.Before(req.StartTicks)) {
+			req.StartTicks = missing[x].OrderDate
+		}
+		if !missing[x].CurrencyPair.IsEmpty() &&
+			!currency.Pairs(req.Currencies).Contains(missing[x].CurrencyPair, true) {
+			req.Currencies = append(req.Currencies, missing[x].CurrencyPair)
+		}
+	}
+
+	history, err := exch.GetOrderHistory(&req)
+	if err != nil {
+		for x := range missing {
+			report.Changes = append(report.Changes,
+				unverifiedChange(&missing[x], fmt.Sprintf("unable to get order history: %s", err)))
+		}
+		log.Warnf(log.OrderMgr, "Order reconciliation: Exchange %s has %d order(s) missing from active orders and is unable to get order history. Err: %s\n",
+			exch.GetName(), len(missing), err)
+		return
+	}
+
+	found := make(map[string]*order.Detail, len(history))
+	for x := range history {
+		found[history[x].ID] = &history[x]
+	}
+
+	for x := range missing {
+		prev := missing[x]
+		det := missing[x]
+		kind := ReconcileClosed
+		reason := "order found in exchange order history"
+		h, ok := found[det.ID]
+		if !ok {
+			// The order can be absent from both lists while it is moving
+			// between them, it is only closed once the exchange confirms it
+			info, err := exch.GetOrderInfo(det.ID)
+			if err != nil {
+				report.Changes = append(report.Changes,
+					unverifiedChange(&missing[x], fmt.Sprintf("order missing from exchange active orders and order history, unable to get order info: %s", err)))
+				log.Warnf(log.OrderMgr, "Order reconciliation: Exchange %s order ID=%v missing from active orders and order history and unable to get order info. Err: %s\n",
+					exch.GetName(), det.ID, err)
+				continue
+			}
+			h = &info
+			reason = "order info returned by the exchange"
+		}
+
+		if h.Status != "" {
+			det.Status = h.Status
+		}
+		if h.ExecutedAmount > 0 {
+			det.ExecutedAmount = h.ExecutedAmount
+		}
+		if h.Fee > 0 {
+			det.Fee = h.Fee
+		}
+		switch {
+		case !ok && (h.Status == order.New || h.Status == order.Active ||
+			h.Status == order.PartiallyFilled):
+			// Still open on the exchange, only its fills are applied
+			kind = ReconcileUpdated
+			reason = "order info returned an open order missing from exchange active orders"
+		case !isClosedStatus(det.Status):
+			det.Status = inferClosedStatus(&det)
+			kind = ReconcileInferred
+			reason += " without a closed status"
+		}
+		switch {
+		case det.Status == order.Filled:
+			if det.ExecutedAmount == 0 {
+				det.ExecutedAmount = det.Amount
+			}
+			det.RemainingAmount = 0
+		case det.Amount > 0:
+			det.RemainingAmount = det.Amount - det.ExecutedAmount
+		}
+
+		changed, err := o.orderStore.Update(&det)
+		if err != nil || !changed {
+			continue
+		}
+		o.updateOrder(&det)
+		o.recordChange(report, &prev, &det, kind, reason)
+	}
+}
+
+// unverifiedChange returns a change for an order missing from the active
+// orders of an exchange whose state could not be confirmed
+func unverifiedChange(d *order.Detail, reason string) ReconciliationChange {
+	return ReconciliationChange{
+		Exchange:               d.Exchange,
+		ID:                     d.ID,
+		InternalOrderID:        d.InternalOrderID,
+		Pair:                   d.CurrencyPair,
+		Kind:                   ReconcileUnverified,
+		PreviousStatus:         d.Status,
+		Status:                 d.Status,
+		PreviousExecutedAmount: d.ExecutedAmount,
+		ExecutedAmount:         d.ExecutedAmount,
+		Amount:                 d.Amount,
+		Reason:                 reason,
+	}
+}
+
+// recordChange adds a change to a reconciliation report, transitions other
+// than updates to open orders were not observed by the order manager and are
+// pushed as comms events
+func (o *orderManager) recordChange(report *ReconciliationReport, prev, det *order.Detail, kind ReconciliationKind, reason string) {
+	report.Changes = append(report.Changes, ReconciliationChange{
+		Exchange:               det.Exchange,
+		ID:                     det.ID,
+		InternalOrderID:        det.InternalOrderID,
+		Pair:                   det.CurrencyPair,
+		Kind:                   kind,
+		PreviousStatus:         prev.Status,
+		Status:                 det.Status,
+		PreviousExecutedAmount: prev.ExecutedAmount,
+		ExecutedAmount:         det.ExecutedAmount,
+		Amount:                 det.Amount,
+		Reason:                 reason,
+	})
+
+	msg := fmt.Sprintf("Order reconciliation: Exchange %s order ID=%v [Ours: %v] %s status=%v->%v executed=%v->%v, %s.",
+		det.Exchange, det.ID, det.InternalOrderID, strings.ToLower(string(kind)),
+		prev.Status, det.Status, prev.ExecutedAmount, det.ExecutedAmount, reason)
+	log.Debugln(log.OrderMgr, msg)
+	if kind == ReconcileUpdated {
+		return
+	}
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// inferClosedStatus returns the closed status of an order which is no longer
+// active on its exchange from its type and fill amounts, the unfilled
+// remainder of an immediate or cancel order expires rather than being
+// cancelled
+func inferClosedStatus(d *order.Detail) order.Status {
+	switch {
+	case d.Amount > 0 && d.ExecutedAmount >= d.Amount:
+		return order.Filled
+	case d.OrderType == order.ImmediateOrCancel:
+		return order.Expired
+	case d.ExecutedAmount > 0:
+		return order.PartiallyCancelled
+	}
+	return order.Cancelled
+}
@@ -0,0 +1,255 @@
+package engine
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestInferClosedStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		oType    order.Type
+		executed float64
+		expected order.Status
+	}{
+		{order.Limit, 0, order.Cancelled},
+		{order.Limit, 0.5, order.PartiallyCancelled},
+		{order.Limit, 1, order.Filled},
+		{order.Limit, 1.5, order.Filled},
+		{order.ImmediateOrCancel, 0, order.Expired},
+		{order.ImmediateOrCancel, 0.5, order.Expired},
+		{order.ImmediateOrCancel, 1, order.Filled},
+	}
+	for x := range tests {
+		d := order.Detail{
+			OrderType:      tests[x].oType,
+			Amount:         1,
+			ExecutedAmount: tests[x].executed,
+		}
+		if s := inferClosedStatus(&d); s != tests[x].expected {
+			t.Errorf("%v executed %v expected %v received %v",
+				tests[x].oType, tests[x].executed, tests[x].expected, s)
+		}
+	}
+}
+
+func TestOrderStoreOpenOrders(t *testing.T) {
+	t.Parallel()
+	o := orderStore{Orders: map[string][]order.Detail{
+		"Bitstamp": {
+			{Exchange: "Bitstamp", ID: "1", Status: order.New},
+			{Exchange: "Bitstamp", ID: "2", Status: order.Filled},
+			{Exchange: "Bitstamp", ID: "3", Status: order.PartiallyFilled},
+			{Exchange: "Bitstamp", ID: "4", Status: order.Expired},
+		},
+	}}
+
+	open := o.openOrders("Bitstamp")
+	if len(open) != 2 || open[0].ID != "1" || open[1].ID != "3" {
+		t.Errorf("unexpected open orders %+v", open)
+	}
+	if open = o.openOrders("Bitfinex"); len(open) != 0 {
+		t.Errorf("expected no open orders received %d", len(open))
+	}
+}
+
+func reconcileChangeKinds(report *ReconciliationReport) map[string]ReconciliationKind {
+	kinds := make(map[string]ReconciliationKind, len(report.Changes))
+	for x := range report.Changes {
+		kinds[report.Changes[x].ID] = report.Changes[x].Kind
+	}
+	return kinds
+}
+
+func TestReconcileExchange(t *testing.T) {
+	exch := newTestOrderExchange("reconcileExchange")
+	defer setupOrderManagerTest(t)()
+	o := newTestOrderManager(
+		order.Detail{Exchange: exch.Name, ID: "1", Status: order.Active, Amount: 1, RemainingAmount: 1},
+		order.Detail{Exchange: exch.Name, ID: "2", Status: order.Active, Amount: 1, RemainingAmount: 1},
+		order.Detail{Exchange: exch.Name, ID: "3", Status: order.Active, Amount: 1, RemainingAmount: 1},
+		order.Detail{Exchange: exch.Name, ID: "4", Status: order.Active, Amount: 1, RemainingAmount: 1},
+		order.Detail{Exchange: exch.Name, ID: "5", Status: order.Active, Amount: 1, RemainingAmount: 1},
+		order.Detail{Exchange: exch.Name, ID: "6", Status: order.Filled, Amount: 1},
+	)
+	exch.active = []order.Detail{
+		{ID: "1", Status: order.PartiallyFilled, Amount: 1, ExecutedAmount: 0.5, RemainingAmount: 0.5},
+		{ID: "7", Status: order.Active, Amount: 2, RemainingAmount: 2},
+	}
+	exch.history = []order.Detail{
+		{ID: "2", Status: order.Filled, Amount: 1, ExecutedAmount: 1},
+	}
+	exch.info["3"] = order.Detail{ID: "3", Status: order.Cancelled}
+	exch.info["4"] = order.Detail{ID: "4", Status: order.PartiallyFilled, ExecutedAmount: 0.25}
+
+	report := o.reconcileExchange(exch)
+	if report.Error != "" {
+		t.Fatal(report.Error)
+	}
+	if report.LocalOpenOrders != 5 || report.ExchangeOpenOrders != 2 || report.Matched != 1 {
+		t.Errorf("unexpected report counts %+v", report)
+	}
+	kinds := reconcileChangeKinds(&report)
+	expected := map[string]ReconciliationKind{
+		"1": ReconcileUpdated,
+		"2": ReconcileClosed,
+		"3": ReconcileClosed,
+		"4": ReconcileUpdated,
+		"5": ReconcileUnverified,
+		"7": ReconcileUntracked,
+	}
+	if len(kinds) != len(expected) {
+		t.Errorf("expected %d changes received %+v", len(expected), report.Changes)
+	}
+	for id, kind := range expected {
+		if kinds[id] != kind {
+			t.Errorf("order %s expected %v received %v", id, kind, kinds[id])
+		}
+	}
+
+	statuses := map[string]order.Status{
+		"1": order.PartiallyFilled,
+		"2": order.Filled,
+		"3": order.Cancelled,
+		"4": order.PartiallyFilled,
+		"5": order.Active,
+		"7": order.Active,
+	}
+	for id, status := range statuses {
+		d, err := o.orderStore.get(exch.Name, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Status != status {
+			t.Errorf("order %s expected status %v received %v", id, status, d.Status)
+		}
+	}
+	if d, _ := o.orderStore.get(exch.Name, "4"); d.ExecutedAmount != 0.25 || d.RemainingAmount != 0.75 {
+		t.Errorf("expected open order fills to be applied received %+v", d)
+	}
+
+	exch.activeErr = errTestExchange
+	report = o.reconcileExchange(exch)
+	if report.Error == "" || len(report.Changes) != 0 {
+		t.Errorf("expected an error without changes received %+v", report)
+	}
+}
+
+func TestReconcileExchangeUnreportedFields(t *testing.T) {
+	exch := newTestOrderExchange("reconcileUnreported")
+	defer setupOrderManagerTest(t)()
+	o := newTestOrderManager(order.Detail{
+		Exchange:        exch.Name,
+		ID:              "1",
+		Status:          order.PartiallyFilled,
+		Amount:          1,
+		ExecutedAmount:  0.5,
+		RemainingAmount: 0.5,
+		Fee:             0.05,
+	})
+	exch.active = []order.Detail{{ID: "1", Amount: 1}}
+
+	report := o.reconcileExchange(exch)
+	if report.Error != "" {
+		t.Fatal(report.Error)
+	}
+	if len(report.Changes) != 0 {
+		t.Errorf("expected no changes received %+v", report.Changes)
+	}
+	d, err := o.orderStore.get(exch.Name, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Status != order.PartiallyFilled || d.ExecutedAmount != 0.5 ||
+		d.RemainingAmount != 0.5 || d.Fee != 0.05 {
+		t.Errorf("expected stored order state to be kept received %+v", d)
+	}
+
+	exch.active = []order.Detail{{ID: "1", Status: order.Active, Amount: 1, ExecutedAmount: 0.25}}
+	if report = o.reconcileExchange(exch); len(report.Changes) != 0 {
+		t.Errorf("expected stale state to be ignored received %+v", report.Changes)
+	}
+	if d, _ = o.orderStore.get(exch.Name, "1"); d.Status != order.PartiallyFilled || d.ExecutedAmount != 0.5 {
+		t.Errorf("expected stored order state to be kept received %+v", d)
+	}
+}
+
+func TestReconcileMissing(t *testing.T) {
+	exch := newTestOrderExchange("reconcileMissing")
+	defer setupOrderManagerTest(t)()
+	missing := []order.Detail{
+		{Exchange: exch.Name, ID: "1", Status: order.Active, OrderType: order.ImmediateOrCancel, Amount: 1, RemainingAmount: 1},
+		{Exchange: exch.Name, ID: "2", Status: order.PartiallyFilled, OrderType: order.Limit, Amount: 1, ExecutedAmount: 0.5, RemainingAmount: 0.5},
+	}
+	o := newTestOrderManager(missing...)
+
+	exch.historyErr = errTestExchange
+	var report ReconciliationReport
+	o.reconcileMissing(exch, missing, &report)
+	if len(report.Changes) != 2 ||
+		report.Changes[0].Kind != ReconcileUnverified ||
+		report.Changes[1].Kind != ReconcileUnverified {
+		t.Errorf("expected unverified changes received %+v", report.Changes)
+	}
+	if d, _ := o.orderStore.get(exch.Name, "1"); d.Status != order.Active {
+		t.Errorf("expected unverified order to remain open received %v", d.Status)
+	}
+
+	exch.historyErr = nil
+	exch.history = []order.Detail{{ID: "2", Status: order.UnknownStatus}}
+	exch.info["1"] = order.Detail{ID: "1"}
+	report = ReconciliationReport{}
+	o.reconcileMissing(exch, missing, &report)
+	kinds := reconcileChangeKinds(&report)
+	if kinds["1"] != ReconcileInferred || kinds["2"] != ReconcileInferred {
+		t.Errorf("expected inferred changes received %+v", report.Changes)
+	}
+	if d, _ := o.orderStore.get(exch.Name, "1"); d.Status != order.Expired {
+		t.Errorf("expected immediate or cancel order to expire received %v", d.Status)
+	}
+	if d, _ := o.orderStore.get(exch.Name, "2"); d.Status != order.PartiallyCancelled || d.RemainingAmount != 0.5 {
+		t.Errorf("expected partially cancelled order received %+v", d)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	exch := newTestOrderExchange("reconcile")
+	o := newTestOrderManager(
+		order.Detail{Exchange: exch.Name, ID: "1", Status: order.Active, Amount: 1, RemainingAmount: 1},
+	)
+	exch.active = []order.Detail{{ID: "1", Status: order.Active, Amount: 1, RemainingAmount: 1}}
+
+	if _, err := o.Reconcile(exch.Name); err != errOrderManagerNotStarted {
+		t.Errorf("expected %v received %v", errOrderManagerNotStarted, err)
+	}
+	if _, err := o.GetReconciliationReports(""); err != errOrderManagerNotStarted {
+		t.Errorf("expected %v received %v", errOrderManagerNotStarted, err)
+	}
+	atomic.StoreInt32(&o.started, 1)
+
+	defer setupOrderManagerTest(t)()
+	if _, err := o.Reconcile(exch.Name); err == nil {
+		t.Error("expected an error for an exchange which is not loaded")
+	}
+	defer setupOrderManagerTest(t, exch)()
+
+	reports, err := o.Reconcile(exch.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 || reports[0].Exchange != exch.Name || reports[0].Matched != 1 {
+		t.Errorf("unexpected reports %+v", reports)
+	}
+	stored, err := o.GetReconciliationReports("RECONCILE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 || stored[0].Matched != 1 {
+		t.Errorf("expected the report to be stored received %+v", stored)
+	}
+	if stored, _ = o.GetReconciliationReports("bitstamp"); len(stored) != 0 {
+		t.Errorf("expected no reports for another exchange received %+v", stored)
+	}
+}
@@ -0,0 +1,545 @@
+package engine
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+var errTestExchange = errors.New("test exchange error")
+
+// testOrderExchange is an exchange whose order endpoints return preset
+// orders and errors
+type testOrderExchange struct {
+	bitstamp.Bitstamp
+	active     []order.Detail
+	activeErr  error
+	history    []order.Detail
+	historyErr error
+	info       map[string]order.Detail
+	// submitted holds the accepted submissions, once maxSubmissions have been
+	// accepted further submissions are rejected
+	submitted      []order.Submit
+	maxSubmissions int
+	cancelled      []string
+	cancelErr      error
+	// onCancel is called when an order is cancelled before the cancellation
+	// is confirmed
+	onCancel func(orderID string)
+	// cancelAll holds the asset types orders were cancelled for outside of
+	// the order manager, cancelAllErrs is returned for an asset type
+	cancelAll     []asset.Item
+	cancelAllErrs map[asset.Item]error
+	modified      []order.Modify
+	// dmsTimeouts holds the dead man's switch timeouts set, dmsErr is
+	// returned when setting it
+	dmsTimeouts []time.Duration
+	dmsErr      error
+}
+
+func newTestOrderExchange(name string) *testOrderExchange {
+	exch := &testOrderExchange{info: make(map[string]order.Detail)}
+	exch.Name = name
+	exch.CurrencyPairs.AssetTypes = asset.Items{asset.Spot}
+	return exch
+}
+
+// setupOrderManagerTest creates the engine if no other test has and loads
+// the test exchanges, the returned func unloads them. Tests using it cannot be
+// run in parallel.
+func setupOrderManagerTest(t *testing.T, exchs ...*testOrderExchange) func() {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	for x := range exchs {
+		Bot.exchangeManager.add(exchs[x])
+	}
+	return func() {
+		for x := range exchs {
+			if err := Bot.exchangeManager.removeExchange(exchs[x].Name); err != nil {
+				t.Error(err)
+			}
+		}
+	}
+}
+
+// newTestOrderManager returns an order manager which has not been started
+// holding the orders
+func newTestOrderManager(orders ...order.Detail) *orderManager {
+	o := &orderManager{
+		orderStore: orderStore{Orders: make(map[string][]order.Detail)},
+		reports:    make(map[string]ReconciliationReport),
+		groups:     make(map[string]*storedOrderGroup),
+		icebergs:   make(map[string]*storedIcebergOrder),
+		groupCheck: make(chan struct{}, 1),
+	}
+	for x := range orders {
+		o.orderStore.Orders[orders[x].Exchange] = append(o.orderStore.Orders[orders[x].Exchange], orders[x])
+	}
+	return o
+}
+
+func (e *testOrderExchange) GetActiveOrders(_ *order.GetOrdersRequest) ([]order.Detail, error) {
+	return append([]order.Detail(nil), e.active...), e.activeErr
+}
+
+func (e *testOrderExchange) GetOrderHistory(_ *order.GetOrdersRequest) ([]order.Detail, error) {
+	return append([]order.Detail(nil), e.history...), e.historyErr
+}
+
+func (e *testOrderExchange) GetOrderInfo(orderID string) (order.Detail, error) {
+	d, ok := e.info[orderID]
+	if !ok {
+		return order.Detail{}, errTestExchange
+	}
+	return d, nil
+}
+
+func (e *testOrderExchange) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+	if e.maxSubmissions > 0 && len(e.submitted) >= e.maxSubmissions {
+		return order.SubmitResponse{}, errTestExchange
+	}
+	e.submitted = append(e.submitted, *s)
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       strconv.Itoa(len(e.submitted)),
+	}, nil
+}
+
+func (e *testOrderExchange) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	resp := make([]order.BatchSubmitResponse, len(s))
+	for x := range s {
+		resp[x].SubmitResponse, resp[x].Error = e.SubmitOrder(&s[x])
+	}
+	return resp, nil
+}
+
+func (e *testOrderExchange) CancelOrder(c *order.Cancel) error {
+	if e.cancelErr != nil {
+		return e.cancelErr
+	}
+	if e.onCancel != nil {
+		e.onCancel(c.OrderID)
+	}
+	e.cancelled = append(e.cancelled, c.OrderID)
+	return nil
+}
+
+func (e *testOrderExchange) BatchCancelOrders(c []order.Cancel) (order.CancelBatchResponse, error) {
+	resp := order.CancelBatchResponse{Status: make(map[string]string)}
+	for x := range c {
+		if err := e.CancelOrder(&c[x]); err != nil {
+			resp.Status[c[x].OrderID] = err.Error()
+		}
+	}
+	return resp, nil
+}
+
+func (e *testOrderExchange) CancelAllOrders(c *order.Cancel) (order.CancelAllResponse, error) {
+	e.cancelAll = append(e.cancelAll, c.AssetType)
+	return order.CancelAllResponse{}, e.cancelAllErrs[c.AssetType]
+}
+
+func (e *testOrderExchange) ModifyOrder(m *order.Modify) (string, error) {
+	e.modified = append(e.modified, *m)
+	return "", nil
+}
+
+func (e *testOrderExchange) SetDeadMansSwitch(timeout time.Duration) error {
+	e.dmsTimeouts = append(e.dmsTimeouts, timeout)
+	return e.dmsErr
+}
+
+func TestSubmissionAsset(t *testing.T) {
+	t.Parallel()
+	if a := submissionAsset(&order.Submit{}); a != asset.Spot {
+		t.Errorf("expected unset asset type to default to spot received %v", a)
+	}
+	if a := submissionAsset(&order.Submit{AssetType: asset.Futures}); a != asset.Futures {
+		t.Errorf("expected futures received %v", a)
+	}
+}
+
+// orderStep is a websocket order update or fill applied to a stored order
+type orderStep struct {
+	update  *wshandler.OrderUpdate
+	fill    *wshandler.FillUpdate
+	changed bool
+}
+
+func orderUpdateStep(s order.Status, executed float64, changed bool) orderStep {
+	return orderStep{
+		update: &wshandler.OrderUpdate{
+			Exchange:       "test",
+			OrderID:        "1",
+			Status:         s,
+			ExecutedAmount: executed,
+		},
+		changed: changed,
+	}
+}
+
+func orderFillStep(tradeID string, amount, fee float64, changed bool) orderStep {
+	return orderStep{
+		fill: &wshandler.FillUpdate{
+			Exchange: "test",
+			OrderID:  "1",
+			TradeID:  tradeID,
+			Price:    100,
+			Amount:   amount,
+			Fee:      fee,
+		},
+		changed: changed,
+	}
+}
+
+func TestOrderStoreApplyUpdatesAndFills(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name      string
+		stored    order.Detail
+		steps     []orderStep
+		status    order.Status
+		executed  float64
+		remaining float64
+		fee       float64
+		trades    int
+	}{
+		{
+			name:   "out of order updates",
+			stored: order.Detail{Status: order.Active},
+			steps: []orderStep{
+				orderUpdateStep(order.PartiallyFilled, 0.5, true),
+				orderUpdateStep(order.Active, 0, false),
+				orderUpdateStep(order.Filled, 1, true),
+				orderUpdateStep(order.PartiallyFilled, 0.5, false),
+			},
+			status: order.Filled, executed: 1, remaining: 0,
+		},
+		{
+			name:   "fills and cumulative updates",
+			stored: order.Detail{Status: order.Active},
+			steps: []orderStep{
+				orderFillStep("1", 0.2, 0.02, true),
+				orderUpdateStep(order.PartiallyFilled, 0.2, false),
+				orderUpdateStep(order.PartiallyFilled, 0.5, true),
+				orderFillStep("2", 0.3, 0.03, true),
+			},
+			status: order.PartiallyFilled, executed: 0.5, remaining: 0.5, fee: 0.05, trades: 2,
+		},
+		{
+			name:   "duplicate trade IDs",
+			stored: order.Detail{Status: order.Active},
+			steps: []orderStep{
+				orderFillStep("1", 0.4, 0.04, true),
+				orderFillStep("1", 0.4, 0.04, false),
+				orderFillStep("2", 0.6, 0.06, true),
+				orderFillStep("2", 0.6, 0.06, false),
+			},
+			status: order.Filled, executed: 1, remaining: 0, fee: 0.1, trades: 2,
+		},
+		{
+			name: "snapshot replay of a reloaded order",
+			stored: order.Detail{
+				Status:          order.PartiallyFilled,
+				ExecutedAmount:  0.6,
+				RemainingAmount: 0.4,
+				Fee:             0.06,
+			},
+			steps: []orderStep{
+				orderFillStep("1", 0.3, 0.03, true),
+				orderFillStep("2", 0.3, 0.03, true),
+				orderFillStep("1", 0.3, 0.03, false),
+				orderFillStep("2", 0.3, 0.03, false),
+			},
+			status: order.PartiallyFilled, executed: 0.6, remaining: 0.4, fee: 0.06, trades: 2,
+		},
+		{
+			name:   "fill after cancellation",
+			stored: order.Detail{Status: order.Active},
+			steps: []orderStep{
+				orderUpdateStep(order.Cancelled, 0, true),
+				orderFillStep("1", 0.2, 0, true),
+			},
+			status: order.Cancelled, executed: 0.2, remaining: 0.8, trades: 1,
+		},
+	}
+
+	for x := range testCases {
+		tc := testCases[x]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			stored := tc.stored
+			stored.Exchange = "test"
+			stored.ID = "1"
+			stored.Amount = 1
+			if stored.RemainingAmount == 0 {
+				stored.RemainingAmount = 1
+			}
+			o := orderStore{Orders: map[string][]order.Detail{"test": {stored}}}
+			var det order.Detail
+			for y := range tc.steps {
+				var changed bool
+				var err error
+				if tc.steps[y].update != nil {
+					det, changed, err = o.applyUpdate(tc.steps[y].update)
+				} else {
+					det, changed, err = o.applyFill(tc.steps[y].fill)
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if changed != tc.steps[y].changed {
+					t.Errorf("step %d expected changed %v received %v", y, tc.steps[y].changed, changed)
+				}
+			}
+			if det.Status != tc.status {
+				t.Errorf("expected status %v received %v", tc.status, det.Status)
+			}
+			if !floatEquals(det.ExecutedAmount, tc.executed) {
+				t.Errorf("expected executed amount %v received %v", tc.executed, det.ExecutedAmount)
+			}
+			if !floatEquals(det.RemainingAmount, tc.remaining) {
+				t.Errorf("expected remaining amount %v received %v", tc.remaining, det.RemainingAmount)
+			}
+			if !floatEquals(det.Fee, tc.fee) {
+				t.Errorf("expected fee %v received %v", tc.fee, det.Fee)
+			}
+			if len(det.Trades) != tc.trades {
+				t.Errorf("expected %d trades received %d", tc.trades, len(det.Trades))
+			}
+		})
+	}
+}
+
+func TestOrderStorePendingFills(t *testing.T) {
+	t.Parallel()
+	o := orderStore{Orders: make(map[string][]order.Detail)}
+	f := &wshandler.FillUpdate{Exchange: "test", OrderID: "1", TradeID: "1", Amount: 0.5}
+	if _, _, err := o.applyFill(f); err != ErrOrderNotFound {
+		t.Fatalf("expected %v received %v", ErrOrderNotFound, err)
+	}
+	if _, _, err := o.applyFill(f); err != ErrOrderNotFound {
+		t.Fatalf("expected %v received %v", ErrOrderNotFound, err)
+	}
+	if fills := o.takePendingFills("test", "2"); len(fills) != 0 {
+		t.Errorf("expected no fills for another order received %d", len(fills))
+	}
+	fills := o.takePendingFills("test", "1")
+	if len(fills) != 1 || fills[0].TradeID != "1" {
+		t.Errorf("expected the duplicate fill to be held once received %+v", fills)
+	}
+	if fills = o.takePendingFills("test", "1"); len(fills) != 0 {
+		t.Errorf("expected held fills to be removed received %d", len(fills))
+	}
+
+	o.pending["test 2"] = []pendingFill{{
+		fill:     wshandler.FillUpdate{Exchange: "test", OrderID: "2"},
+		received: time.Now().Add(-pendingFillTimeout * 2),
+	}}
+	if _, _, err := o.applyFill(f); err != ErrOrderNotFound {
+		t.Fatalf("expected %v received %v", ErrOrderNotFound, err)
+	}
+	if fills = o.takePendingFills("test", "2"); len(fills) != 0 {
+		t.Errorf("expected expired fills to be dropped received %d", len(fills))
+	}
+}
+
+func TestIsStaleStatus(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		current, next order.Status
+		stale         bool
+	}{
+		{order.Active, order.PartiallyFilled, false},
+		{order.PartiallyFilled, order.Active, true},
+		{order.PartiallyFilled, order.New, true},
+		{order.PartiallyFilled, order.Filled, false},
+		{order.Filled, order.PartiallyFilled, true},
+		{order.Cancelled, order.Active, true},
+		{order.Filled, order.Cancelled, false},
+	}
+	for x := range testCases {
+		if s := isStaleStatus(testCases[x].current, testCases[x].next); s != testCases[x].stale {
+			t.Errorf("%v to %v expected stale %v received %v",
+				testCases[x].current, testCases[x].next, testCases[x].stale, s)
+		}
+	}
+}
+
+func floatEquals(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestGetOrder(t *testing.T) {
+	exch := newTestOrderExchange("getOrder")
+	defer setupOrderManagerTest(t, exch)()
+	o := newTestOrderManager(order.Detail{
+		Exchange:     exch.Name,
+		ID:           "1",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+		OrderSide:    order.Sell,
+	})
+
+	det, err := o.GetOrder("GETORDER", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if det.OrderSide != order.Sell {
+		t.Errorf("expected the stored order received %+v", det)
+	}
+	if _, err = o.GetOrder(exch.Name, "2"); err != ErrOrderNotFound {
+		t.Errorf("expected %v received %v", ErrOrderNotFound, err)
+	}
+	if _, err = o.GetOrder("unknown", "1"); err == nil {
+		t.Error("expected an error for an exchange which is not loaded")
+	}
+}
+
+func TestModify(t *testing.T) {
+	exch := newTestOrderExchange("modify")
+	defer setupOrderManagerTest(t, exch)()
+	btcusd := currency.NewPair(currency.BTC, currency.USD)
+	o := newTestOrderManager(
+		order.Detail{
+			Exchange:     exch.Name,
+			ID:           "1",
+			CurrencyPair: btcusd,
+			AssetType:    asset.Spot,
+			OrderSide:    order.Buy,
+			OrderType:    order.Limit,
+			Price:        100,
+			Amount:       1,
+			Status:       order.Active,
+		},
+		order.Detail{
+			Exchange:     exch.Name,
+			ID:           "2",
+			CurrencyPair: currency.NewPair(currency.ETH, currency.USD),
+			AssetType:    asset.Spot,
+			OrderSide:    order.Buy,
+			OrderType:    order.Limit,
+			Price:        10,
+			Amount:       1,
+			Status:       order.Active,
+		})
+	o.cfg.EnforceLimitConfig = true
+	o.cfg.LimitAmount = 2
+	o.cfg.AllowedPairs = currency.Pairs{btcusd}
+
+	if _, err := o.Modify(exch.Name, &order.Modify{OrderID: "1", Price: 110}); err != nil {
+		t.Fatal(err)
+	}
+	det, err := o.orderStore.get(exch.Name, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if det.Price != 110 || len(exch.modified) != 1 {
+		t.Errorf("expected the order price to be modified to 110 received %v", det.Price)
+	}
+
+	if _, err = o.Modify(exch.Name, &order.Modify{OrderID: "1", Amount: 3}); err == nil {
+		t.Error("expected an error modifying an order above the limit amount")
+	}
+	if _, err = o.Modify(exch.Name, &order.Modify{OrderID: "2", Price: 11}); err == nil {
+		t.Error("expected an error modifying an order of a pair which is not allowed")
+	}
+	if len(exch.modified) != 1 {
+		t.Errorf("expected rejected modifications not to be sent received %v", exch.modified)
+	}
+
+	// The modified order replaces the stored order in the risk checks
+	o.risk.load(&config.RiskConfig{
+		Enabled:              true,
+		BaseCurrency:         currency.USD,
+		MaxOrderNotional:     150,
+		MaxOpenOrdersPerPair: 1,
+	})
+	if _, err = o.Modify(exch.Name, &order.Modify{OrderID: "1", Price: 120}); err != nil {
+		t.Error(err)
+	}
+	if _, err = o.Modify(exch.Name, &order.Modify{OrderID: "1", Price: 200}); err == nil {
+		t.Error("expected an error modifying an order above the maximum order notional")
+	}
+	if len(exch.modified) != 2 {
+		t.Errorf("expected the modification rejected by the risk checks not to be sent received %v", exch.modified)
+	}
+
+	// Modifications are rounded to the trading rules of the pair
+	err = exch.LoadTradingRules(asset.Spot, []order.TradingRules{
+		{Pair: btcusd, PriceTickSize: 0.5, AmountStepSize: 0.1, MinNotional: 20},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = o.Modify(exch.Name, &order.Modify{OrderID: "1", Price: 110.7, Amount: 1.27}); err != nil {
+		t.Fatal(err)
+	}
+	if m := exch.modified[len(exch.modified)-1]; m.Price != 110.5 || m.Amount != 1.2 {
+		t.Errorf("expected the modification rounded to price 110.5 amount 1.2 received %v %v",
+			m.Price, m.Amount)
+	}
+	if _, err = o.Modify(exch.Name, &order.Modify{OrderID: "1", Amount: 0.1}); err == nil {
+		t.Error("expected an error modifying an order below the minimum notional")
+	}
+	if len(exch.modified) != 3 {
+		t.Errorf("expected the modification rejected by the trading rules not to be sent received %v", exch.modified)
+	}
+
+	o.killSwitch = KillSwitchStatus{Active: true, Reason: "test"}
+	if _, err = o.Modify(exch.Name, &order.Modify{OrderID: "1", Price: 110}); err == nil ||
+		!strings.Contains(err.Error(), errKillSwitchActive.Error()) {
+		t.Errorf("expected %v received %v", errKillSwitchActive, err)
+	}
+	if len(exch.modified) != 3 {
+		t.Errorf("expected modifications to be halted by the kill switch received %v", exch.modified)
+	}
+}
+
+func TestModifyUnstoredOrder(t *testing.T) {
+	exch := newTestOrderExchange("modifyUnstored")
+	defer setupOrderManagerTest(t, exch)()
+	btcusd := currency.NewPair(currency.BTC, currency.USD)
+	err := exch.LoadTradingRules(asset.Spot, []order.TradingRules{
+		{Pair: btcusd, PriceTickSize: 0.5, AmountStepSize: 0.1, MinAmount: 0.1, MinNotional: 20},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := newTestOrderManager()
+
+	// The amount of an order which is not stored is not known so only the
+	// price of a price modification is checked
+	_, err = o.Modify(exch.Name, &order.Modify{
+		OrderID:      "1",
+		CurrencyPair: btcusd,
+		Type:         order.Limit,
+		Side:         order.Buy,
+		Price:        110.7,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exch.modified) != 1 || exch.modified[0].Price != 110.5 || exch.modified[0].Amount != 0 {
+		t.Errorf("expected the price modification rounded to 110.5 received %+v", exch.modified)
+	}
+
+	_, err = o.Modify(exch.Name, &order.Modify{
+		OrderID:      "1",
+		CurrencyPair: btcusd,
+		Amount:       0.05,
+	})
+	if err == nil {
+		t.Error("expected an error modifying an order below the minimum amount")
+	}
+}
@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"fmt"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// conformTradingRules rounds an order submission to the price and amount
+// increments of its pair and rejects it if it breaches the exchange order size
+// limits. Submissions are left unchanged when the exchange has not published
+// trading rules for the pair, which is warned once per exchange asset type,
+// and left unchanged when the submission is rejected.
+func (o *orderManager) conformTradingRules(exch exchange.IBotExchange, newOrder *order.Submit) error {
+	a := submissionAsset(newOrder)
+	rules, err := exch.GetTradingRules(newOrder.Pair, a)
+	if err != nil {
+		if o.warnMissingRules(exch.GetName(), a) {
+			log.Warnf(log.OrderMgr, "Order manager: Exchange %s %v orders are not checked against the exchange trading rules: %s\n",
+				exch.GetName(),
+				a,
+				err)
+		}
+		return nil
+	}
+
+	conformed := *newOrder
+	if newOrder.Amount == 0 {
+		// Modifications of orders which are not stored only carry the amount
+		// when it is changed so only the price is checked
+		err = rules.ConformPrice(&conformed)
+	} else {
+		err = rules.Conform(&conformed)
+	}
+	if err != nil {
+		return fmt.Errorf("%v for %s %v", err, exch.GetName(), newOrder.Pair)
+	}
+
+	if conformed.Price != newOrder.Price || conformed.Amount != newOrder.Amount {
+		log.Debugf(log.OrderMgr, "Order manager: Exchange %s order pair=%v price=%v amount=%v rounded to price=%v amount=%v by the exchange trading rules.\n",
+			exch.GetName(),
+			newOrder.Pair,
+			newOrder.Price,
+			newOrder.Amount,
+			conformed.Price,
+			conformed.Amount)
+	}
+	*newOrder = conformed
+	return nil
+}
+
+// warnMissingRules returns true the first time an exchange asset type is seen
+// without trading rules
+func (o *orderManager) warnMissingRules(exchName string, a asset.Item) bool {
+	key := exchName + "-" + a.String()
+	o.missingRulesMtx.Lock()
+	defer o.missingRulesMtx.Unlock()
+	if o.missingRules[key] {
+		return false
+	}
+	if o.missingRules == nil {
+		o.missingRules = make(map[string]bool)
+	}
+	o.missingRules[key] = true
+	return true
+}
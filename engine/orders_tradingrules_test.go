@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestConformTradingRules(t *testing.T) {
+	t.Parallel()
+	var o orderManager
+	exch := new(bitstamp.Bitstamp)
+	exch.Name = "Bitstamp"
+	p := currency.NewPair(currency.BTC, currency.USD)
+	submit := &order.Submit{
+		Pair:      p,
+		OrderType: order.Limit,
+		OrderSide: order.Buy,
+		Price:     100.019,
+		Amount:    1.0009,
+	}
+	if err := o.conformTradingRules(exch, submit); err != nil {
+		t.Fatal(err)
+	}
+	if submit.Price != 100.019 || submit.Amount != 1.0009 {
+		t.Errorf("expected submission unchanged without trading rules received %v %v",
+			submit.Price, submit.Amount)
+	}
+
+	if o.warnMissingRules(exch.Name, asset.Spot) {
+		t.Error("expected missing trading rules to be warned once")
+	}
+
+	err := exch.LoadTradingRules(asset.Spot, []order.TradingRules{
+		{
+			Pair:           p,
+			PriceTickSize:  0.01,
+			AmountStepSize: 0.001,
+			MinNotional:    50,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	submit.AssetType = asset.Margin
+	if err = o.conformTradingRules(exch, submit); err != nil {
+		t.Fatal(err)
+	}
+	if submit.Price != 100.019 || submit.Amount != 1.0009 {
+		t.Errorf("expected submission unchanged without margin trading rules received %v %v",
+			submit.Price, submit.Amount)
+	}
+
+	submit.AssetType = asset.Spot
+	if err = o.conformTradingRules(exch, submit); err != nil {
+		t.Fatal(err)
+	}
+	if submit.Price != 100.01 || submit.Amount != 1 {
+		t.Errorf("expected price 100.01 amount 1 received %v %v",
+			submit.Price, submit.Amount)
+	}
+
+	submit.Amount = 0.4999
+	if err = o.conformTradingRules(exch, submit); err == nil {
+		t.Error("expected an error for an order below the minimum notional")
+	}
+	if submit.Amount != 0.4999 {
+		t.Errorf("expected rejected submission unchanged received amount %v",
+			submit.Amount)
+	}
+}
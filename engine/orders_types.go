@@ -2,9 +2,12 @@ package engine
 
 import (
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
 
 type orderManagerConfig struct {
@@ -20,6 +23,15 @@ type orderManagerConfig struct {
 type orderStore struct {
 	m      sync.Mutex
 	Orders map[string][]order.Detail
+	// pending holds websocket fills received before their order is stored,
+	// keyed by exchange name and order ID
+	pending map[string][]pendingFill
+}
+
+// pendingFill is a websocket fill waiting for its order to be stored
+type pendingFill struct {
+	fill     wshandler.FillUpdate
+	received time.Time
 }
 
 type orderManager struct {
@@ -28,9 +40,279 @@ type orderManager struct {
 	shutdown   chan struct{}
 	orderStore orderStore
 	cfg        orderManagerConfig
+	// persist is set on start when the database manager is running, order
+	// submissions, status transitions, fills and cancellations are then
+	// written to the order repository
+	persist bool
+
+	reconcileInterval time.Duration
+	reconcileMtx      sync.Mutex
+	reportsMtx        sync.RWMutex
+	reports           map[string]ReconciliationReport
+
+	// groupsMtx guards the groups map and the stored state of each group, it
+	// is not held while legs are submitted or cancelled
+	groupsMtx sync.Mutex
+	groups    map[string]*storedOrderGroup
+	// groupCheck is signalled when an order changes so linked orders and
+	// iceberg orders are checked without waiting for the next order manager
+	// tick
+	groupCheck chan struct{}
+
+	// icebergsMtx guards the icebergs map and the stored state of each
+	// iceberg order, it is not held while visible orders are submitted or
+	// cancelled
+	icebergsMtx sync.Mutex
+	icebergs    map[string]*storedIcebergOrder
+
+	// risk runs the pre-trade risk checks in front of every submission
+	risk riskEngine
+
+	// killSwitch halts new order submissions until it is reset, it is kept
+	// across order manager restarts
+	killSwitchMtx sync.Mutex
+	killSwitch    KillSwitchStatus
+
+	// deadMansSwitch is the timeout the heartbeat arms exchange dead man's
+	// switches with, zero when the heartbeat is disabled. dms holds the state
+	// of each authenticated exchange seen by the heartbeat.
+	deadMansSwitch time.Duration
+	dmsMtx         sync.Mutex
+	dms            map[string]*deadMansSwitchState
+	heartbeatWG    sync.WaitGroup
+
+	// missingRules holds the exchange asset types which have been warned as
+	// having no trading rules so the warning is logged once
+	missingRulesMtx sync.Mutex
+	missingRules    map[string]bool
+}
+
+// KillSwitchStatus is the state of the order manager kill switch, while
+// active every new order submission is rejected
+type KillSwitchStatus struct {
+	Active      bool
+	Reason      string
+	ActivatedAt time.Time
+}
+
+// CancelAllResult is the outcome of cancelling every open order on an
+// exchange. Orders which could not be cancelled are keyed by order ID with the
+// reason, Error is set when orders placed outside of the order manager could
+// not be swept.
+type CancelAllResult struct {
+	Exchange string
+	Failed   map[string]string
+	Error    string
+}
+
+// deadMansSwitchState is whether an exchange has a native dead man's switch
+// and the last error arming it
+type deadMansSwitchState struct {
+	native bool
+	err    string
+}
+
+// ReconciliationKind describes how a stored order differed from its exchange
+type ReconciliationKind string
+
+// Order reconciliation kinds
+const (
+	// ReconcileUpdated is an open order whose status or fill amounts changed
+	ReconcileUpdated ReconciliationKind = "UPDATED"
+	// ReconcileClosed is an order which was found closed in the exchange
+	// order history or by querying the order
+	ReconcileClosed ReconciliationKind = "CLOSED"
+	// ReconcileInferred is an order no longer active on the exchange which
+	// was returned without a closed status, its closed status is inferred
+	// from its type and fill amounts
+	ReconcileInferred ReconciliationKind = "INFERRED"
+	// ReconcileUntracked is an active order which was placed outside of the
+	// order manager
+	ReconcileUntracked ReconciliationKind = "UNTRACKED"
+	// ReconcileUnverified is an order missing from the active orders of an
+	// exchange whose order history or order info could not be retrieved, it
+	// is left open until the exchange confirms its state
+	ReconcileUnverified ReconciliationKind = "UNVERIFIED"
+)
+
+// ReconciliationChange is an order whose stored state did not match its
+// exchange
+type ReconciliationChange struct {
+	Exchange               string
+	ID                     string
+	InternalOrderID        string
+	Pair                   currency.Pair
+	Kind                   ReconciliationKind
+	PreviousStatus         order.Status
+	Status                 order.Status
+	PreviousExecutedAmount float64
+	ExecutedAmount         float64
+	Amount                 float64
+	Reason                 string
+}
+
+// ReconciliationReport is the result of comparing the stored open orders of an
+// exchange with its active orders and order history
+type ReconciliationReport struct {
+	Exchange           string
+	Started            time.Time
+	Finished           time.Time
+	LocalOpenOrders    int
+	ExchangeOpenOrders int
+	Matched            int
+	Changes            []ReconciliationChange
+	Error              string
 }
 
 type orderSubmitResponse struct {
 	order.SubmitResponse
 	OurOrderID string
 }
+
+type orderBatchSubmitResponse struct {
+	orderSubmitResponse
+	Error error
+}
+
+// storedOrderGroup is an order group held by the order manager, mtx
+// serialises the checks, submissions and cancellations of its legs which are
+// made on a copy of the group outside of groupsMtx
+type storedOrderGroup struct {
+	mtx   sync.Mutex
+	group OrderGroup
+}
+
+// OrderGroupType is how the orders of an order group are linked
+type OrderGroupType string
+
+// Order group types
+const (
+	// OrderGroupOCO submits every leg at once, a fill or cancel on one leg
+	// cancels the others
+	OrderGroupOCO OrderGroupType = "OCO"
+	// OrderGroupBracket submits an entry leg and once it fills submits its
+	// take-profit and stop-loss legs as a one-cancels-other pair
+	OrderGroupBracket OrderGroupType = "BRACKET"
+)
+
+// OrderGroupStatus is the state of an order group
+type OrderGroupStatus string
+
+// Order group statuses
+const (
+	OrderGroupActive    OrderGroupStatus = "ACTIVE"
+	OrderGroupCompleted OrderGroupStatus = "COMPLETED"
+	OrderGroupCancelled OrderGroupStatus = "CANCELLED"
+	OrderGroupFailed    OrderGroupStatus = "FAILED"
+)
+
+// OrderLegRole is the purpose of a leg in a bracket order group
+type OrderLegRole string
+
+// Order group leg roles
+const (
+	OrderLegEntry      OrderLegRole = "ENTRY"
+	OrderLegTakeProfit OrderLegRole = "TAKE_PROFIT"
+	OrderLegStopLoss   OrderLegRole = "STOP_LOSS"
+)
+
+// OrderLegStatus is the state of a leg in an order group
+type OrderLegStatus string
+
+// Order group leg statuses
+const (
+	// OrderLegPending has not been submitted yet
+	OrderLegPending   OrderLegStatus = "PENDING"
+	OrderLegOpen      OrderLegStatus = "OPEN"
+	OrderLegFilled    OrderLegStatus = "FILLED"
+	OrderLegCancelled OrderLegStatus = "CANCELLED"
+	OrderLegFailed    OrderLegStatus = "FAILED"
+)
+
+// OrderGroupLeg is an order in an order group. Legs with a trigger price are
+// held by the conditional order manager as stop-loss orders until triggered,
+// others are submitted to the exchange as market or limit orders.
+type OrderGroupLeg struct {
+	Role               OrderLegRole
+	Side               order.Side
+	OrderType          order.Type
+	Price              float64
+	TriggerPrice       float64
+	Amount             float64
+	ExecutedAmount     float64
+	Status             OrderLegStatus
+	OrderID            string
+	InternalOrderID    string
+	ConditionalOrderID string
+	Error              string
+}
+
+// OrderGroup is a set of orders on an exchange pair which are cancelled or
+// submitted as the other orders in the group fill or are cancelled
+type OrderGroup struct {
+	ID        string
+	Type      OrderGroupType
+	Exchange  string
+	Pair      currency.Pair
+	Asset     asset.Item
+	Status    OrderGroupStatus
+	Reason    string
+	Legs      []OrderGroupLeg
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// storedIcebergOrder is an iceberg order held by the order manager, mtx
+// serialises the checks, submissions and cancellations of its visible orders
+// which are made on a copy of the iceberg order outside of icebergsMtx
+type storedIcebergOrder struct {
+	mtx   sync.Mutex
+	order IcebergOrder
+}
+
+// IcebergStatus is the state of an iceberg order
+type IcebergStatus string
+
+// Iceberg order statuses
+const (
+	IcebergActive    IcebergStatus = "ACTIVE"
+	IcebergCompleted IcebergStatus = "COMPLETED"
+	IcebergCancelled IcebergStatus = "CANCELLED"
+	IcebergFailed    IcebergStatus = "FAILED"
+)
+
+// IcebergOrder is a limit order managed by the order manager which only shows
+// part of its amount on the book. A visible limit order of the display amount
+// is submitted and replaced once it fills or partially fills until the whole
+// amount has been executed. A size variance randomises the amount of each
+// visible order by up to that fraction of the display amount.
+type IcebergOrder struct {
+	ID             string
+	Exchange       string
+	Pair           currency.Pair
+	Asset          asset.Item
+	Side           order.Side
+	Price          float64
+	Amount         float64
+	DisplayAmount  float64
+	SizeVariance   float64
+	ExecutedAmount float64
+	Status         IcebergStatus
+	Reason         string
+	// OrderID, InternalOrderID and VisibleAmount describe the visible order
+	// currently resting on the book
+	OrderID         string
+	InternalOrderID string
+	VisibleAmount   float64
+	Slices          int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	// filled is the amount executed by visible orders which have closed
+	filled float64
+	// closedOrderID is the last visible order to close and closedExecuted
+	// the amount of it counted in filled. Fills of it stored after it closed
+	// are added to filled before the next visible order is sized.
+	closedOrderID  string
+	closedExecuted float64
+}
@@ -0,0 +1,673 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orders"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Error implements the error interface
+func (r *RiskRejection) Error() string {
+	return fmt.Sprintf("order rejected by %s risk check: %s", r.Reason, r.Message)
+}
+
+// load replaces the risk checks built from the risk config, checks added at
+// runtime are kept
+func (e *riskEngine) load(cfg *config.RiskConfig) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.cfg = *cfg
+	e.checks = nil
+	if !cfg.Enabled {
+		return
+	}
+	if cfg.MaxOrderNotional > 0 {
+		e.checks = append(e.checks, &MaxOrderNotionalCheck{Limit: cfg.MaxOrderNotional})
+	}
+	if cfg.MaxExchangeNotional > 0 {
+		e.checks = append(e.checks, &MaxExchangeNotionalCheck{Limit: cfg.MaxExchangeNotional})
+	}
+	if cfg.MaxOpenOrdersPerPair > 0 {
+		e.checks = append(e.checks, &MaxOpenOrdersCheck{Limit: cfg.MaxOpenOrdersPerPair})
+	}
+	if len(cfg.MaxPositions) > 0 {
+		limits := make(map[string]float64, len(cfg.MaxPositions))
+		for k, v := range cfg.MaxPositions {
+			limits[strings.ToUpper(k)] = v
+		}
+		e.checks = append(e.checks, &MaxPositionCheck{Limits: limits})
+	}
+	if cfg.PriceBand > 0 {
+		e.checks = append(e.checks, &PriceBandCheck{Band: cfg.PriceBand})
+	}
+	if cfg.MaxDailyLoss > 0 {
+		e.checks = append(e.checks, &DailyLossCheck{
+			Limit:     cfg.MaxDailyLoss,
+			StateFile: e.stateFile,
+		})
+	}
+}
+
+// setPersistence sets whether executions are read from the order repository
+// and the file risk check state is kept in across restarts, it applies to
+// checks built by the next load
+func (e *riskEngine) setPersistence(persist bool, stateFile string) {
+	e.m.Lock()
+	e.persist = persist
+	e.stateFile = stateFile
+	e.m.Unlock()
+}
+
+// add adds a risk check which is run after the configured checks
+func (e *riskEngine) add(c RiskCheck) {
+	e.m.Lock()
+	e.custom = append(e.custom, c)
+	e.m.Unlock()
+}
+
+// check runs the risk checks against an order submission and returns the
+// first rejection
+func (e *riskEngine) check(exchName string, s *order.Submit, orders []order.Detail) *RiskRejection {
+	e.m.Lock()
+	defer e.m.Unlock()
+	if len(e.checks) == 0 && len(e.custom) == 0 {
+		return nil
+	}
+
+	o := &RiskOrder{
+		Exchange: exchName,
+		Submit:   s,
+		Price:    s.Price,
+		Ticker:   e.ticker(exchName, s.Pair, submissionAsset(s)),
+	}
+	if s.OrderType == order.Market || o.Price <= 0 {
+		o.Price = 0
+		if o.Ticker != nil {
+			o.Price = o.Ticker.Last
+		}
+	}
+	state := &RiskState{
+		BaseCurrency: e.cfg.BaseCurrency,
+		Orders:       orders,
+		Time:         time.Now(),
+		risk:         e,
+	}
+	if o.Price > 0 {
+		if v, err := state.Value(exchName, s.Pair.Quote, s.Amount*o.Price); err == nil {
+			o.Notional = v
+		}
+	}
+
+	for _, checks := range [][]RiskCheck{e.checks, e.custom} {
+		for x := range checks {
+			r := checks[x].Check(o, state)
+			if r == nil {
+				continue
+			}
+			if r.Exchange == "" {
+				r.Exchange = exchName
+			}
+			if r.Pair.IsEmpty() {
+				r.Pair = s.Pair
+			}
+			return r
+		}
+	}
+	return nil
+}
+
+// ticker returns a copy of the latest ticker of an exchange pair and asset
+// type, nil is returned when there is none within the maximum ticker age
+func (e *riskEngine) ticker(exchName string, p currency.Pair, a asset.Item) *ticker.Price {
+	t, err := ticker.GetTicker(exchName, p, a)
+	if err != nil || t.Last <= 0 {
+		return nil
+	}
+	if e.cfg.MaxTickerAge > 0 && time.Since(t.LastUpdated) > e.cfg.MaxTickerAge {
+		return nil
+	}
+	price := *t
+	return &price
+}
+
+// Value converts an amount of a currency to the base currency using forex
+// rates between fiat currencies, otherwise the latest ticker of the exchange
+// pair between the currency and the base currency
+func (s *RiskState) Value(exchName string, c currency.Code, amount float64) (float64, error) {
+	if c.Match(s.BaseCurrency) || amount == 0 {
+		return amount, nil
+	}
+	if c.IsFiatCurrency() && s.BaseCurrency.IsFiatCurrency() {
+		return currency.ConvertCurrency(amount, c, s.BaseCurrency)
+	}
+	if s.risk != nil {
+		if t := s.risk.ticker(exchName, currency.NewPair(c, s.BaseCurrency), asset.Spot); t != nil {
+			return amount * t.Last, nil
+		}
+		if t := s.risk.ticker(exchName, currency.NewPair(s.BaseCurrency, c), asset.Spot); t != nil {
+			return amount / t.Last, nil
+		}
+	}
+	return 0, fmt.Errorf("no %s %s price available to value %s", exchName, s.BaseCurrency, c)
+}
+
+// OpenOrders returns the open orders of an exchange, an empty pair returns
+// the open orders of every pair
+func (s *RiskState) OpenOrders(exchName string, p currency.Pair) []order.Detail {
+	var open []order.Detail
+	for x := range s.Orders {
+		if isClosedStatus(s.Orders[x].Status) ||
+			!strings.EqualFold(s.Orders[x].Exchange, exchName) {
+			continue
+		}
+		if !p.IsEmpty() && !s.Orders[x].CurrencyPair.Equal(p) {
+			continue
+		}
+		open = append(open, s.Orders[x])
+	}
+	return open
+}
+
+// Position returns the net amount of a currency executed through the order
+// manager and the amounts the open orders would add and remove on execution
+func (s *RiskState) Position(c currency.Code) (net, long, short float64) {
+	for x := range s.Orders {
+		det := &s.Orders[x]
+		if det.ExecutedAmount > 0 {
+			net += orderDelta(det, c, det.ExecutedAmount, executedPrice(det))
+		}
+		if isClosedStatus(det.Status) {
+			continue
+		}
+		d := orderDelta(det, c, remainingAmount(det), det.Price)
+		if d > 0 {
+			long += d
+		} else {
+			short += d
+		}
+	}
+	return net, long, short
+}
+
+// RealisedPnL returns the profit realised in the base currency by executions
+// since a time. The executions of each exchange pair traded are replayed in
+// order so positions opened before the time carry over at their average price.
+// When the order repository is in use executions since the time are read from
+// it, positions built from earlier executions are cached, and stored orders
+// without persisted fills are replayed from their trades. Pairs which cannot
+// be valued are skipped.
+func (s *RiskState) RealisedPnL(since time.Time) float64 {
+	var persisted []orders.Fill
+	if s.risk != nil && s.risk.persist {
+		var err error
+		persisted, err = orders.GetFillsSince(since)
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: Unable to load fills for the risk checks. Err: %s\n", err)
+		}
+	}
+
+	var pnl float64
+	for _, k := range s.tradedPairs(persisted) {
+		var basis riskBasis
+		if s.risk != nil && s.risk.persist {
+			basis = s.risk.positionBefore(k, since)
+		}
+		position := basis.position
+		realised := position.replay(s.pairFills(k, persisted, basis.orderIDs), since)
+		if realised == 0 {
+			continue
+		}
+		v, err := s.Value(k.exchange, k.pair.Quote, realised)
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: Unable to value realised profit for the risk checks. Err: %s\n", err)
+			continue
+		}
+		pnl += v
+	}
+	return pnl
+}
+
+// tradedPairs returns the exchange pairs and asset types of the persisted
+// fills and the stored orders which have executed
+func (s *RiskState) tradedPairs(persisted []orders.Fill) []riskPair {
+	var pairs []riskPair
+	add := func(k *riskPair) {
+		for y := range pairs {
+			if pairs[y].matches(k) {
+				return
+			}
+		}
+		pairs = append(pairs, *k)
+	}
+	for x := range persisted {
+		k := fillRiskPair(&persisted[x])
+		add(&k)
+	}
+	for x := range s.Orders {
+		if s.Orders[x].ExecutedAmount <= 0 {
+			continue
+		}
+		k := orderRiskPair(&s.Orders[x])
+		add(&k)
+	}
+	return pairs
+}
+
+// pairFills returns the persisted fills of an exchange pair in execution
+// order with the trades of stored orders which have no persisted fills, either
+// in persisted or in the earlier fills of the orders in before
+func (s *RiskState) pairFills(k riskPair, persisted []orders.Fill, before map[string]bool) []riskFill {
+	var fills []riskFill
+	ids := make(map[string]bool)
+	for x := range persisted {
+		if k2 := fillRiskPair(&persisted[x]); !k.matches(&k2) {
+			continue
+		}
+		ids[persisted[x].OrderID] = true
+		fills = append(fills, persistedFill(&persisted[x]))
+	}
+
+	for x := range s.Orders {
+		det := &s.Orders[x]
+		if det.ExecutedAmount <= 0 || ids[det.InternalOrderID] || before[det.InternalOrderID] {
+			continue
+		}
+		if k2 := orderRiskPair(det); !k.matches(&k2) {
+			continue
+		}
+		fills = append(fills, orderFills(det)...)
+	}
+	sort.SliceStable(fills, func(i, j int) bool {
+		return fills[i].time.Before(fills[j].time)
+	})
+	return fills
+}
+
+// positionBefore returns the position of an exchange pair built from the fills
+// persisted before a time. Positions are cached until the time changes as
+// earlier fills are not expected to change. The lock must be held.
+func (e *riskEngine) positionBefore(k riskPair, before time.Time) riskBasis {
+	if e.basis == nil || !e.basisTime.Equal(before) {
+		e.basis = make(map[string]*riskBasis)
+		e.basisTime = before
+	}
+	key := k.key()
+	if b, ok := e.basis[key]; ok {
+		return *b
+	}
+
+	stored, err := orders.GetPairFillsBefore(k.exchange, k.pair, k.asset, before)
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Order manager: Unable to load %s %v fills for the risk checks. Err: %s\n",
+			k.exchange, k.pair, err)
+		return riskBasis{}
+	}
+	b := &riskBasis{orderIDs: make(map[string]bool)}
+	fills := make([]riskFill, len(stored))
+	for x := range stored {
+		b.orderIDs[stored[x].OrderID] = true
+		fills[x] = persistedFill(&stored[x])
+	}
+	b.position.replay(fills, before)
+	e.basis[key] = b
+	return *b
+}
+
+// persistedFill returns a fill read from the order repository as an execution
+func persistedFill(f *orders.Fill) riskFill {
+	return riskFill{
+		side:   f.Side,
+		price:  f.Price,
+		amount: f.Amount,
+		time:   f.Timestamp,
+	}
+}
+
+// fillRiskPair returns the exchange pair and asset type of a persisted fill
+func fillRiskPair(f *orders.Fill) riskPair {
+	a := f.AssetType
+	if a == "" {
+		a = asset.Spot
+	}
+	return riskPair{exchange: f.Exchange, pair: f.Pair, asset: a}
+}
+
+// orderRiskPair returns the exchange pair and asset type of an order
+func orderRiskPair(det *order.Detail) riskPair {
+	a := det.AssetType
+	if a == "" {
+		a = asset.Spot
+	}
+	return riskPair{exchange: det.Exchange, pair: det.CurrencyPair, asset: a}
+}
+
+func (k *riskPair) matches(other *riskPair) bool {
+	return strings.EqualFold(k.exchange, other.exchange) &&
+		k.pair.Equal(other.pair) &&
+		k.asset == other.asset
+}
+
+// key returns a key which is the same for matching exchange pairs
+func (k *riskPair) key() string {
+	return strings.ToLower(k.exchange) + " " +
+		k.pair.Base.Upper().String() + "-" + k.pair.Quote.Upper().String() + " " +
+		k.asset.String()
+}
+
+// orderFills returns the trades of an order as executions, an order without
+// trades is treated as executed at its average price when it was placed
+func orderFills(det *order.Detail) []riskFill {
+	if len(det.Trades) == 0 {
+		return []riskFill{{
+			side:   det.OrderSide,
+			price:  executedPrice(det),
+			amount: det.ExecutedAmount,
+			time:   det.OrderDate,
+		}}
+	}
+	fills := make([]riskFill, len(det.Trades))
+	for x := range det.Trades {
+		fills[x] = riskFill{
+			side:   det.OrderSide,
+			price:  det.Trades[x].Price,
+			amount: det.Trades[x].Amount,
+			time:   det.Trades[x].Timestamp,
+		}
+	}
+	return fills
+}
+
+// realisedProfit replays executions in order against a position held at its
+// average price and returns the profit in the quote currency realised by the
+// executions since a time which reduced the position
+func realisedProfit(fills []riskFill, since time.Time) float64 {
+	var p riskPosition
+	return p.replay(fills, since)
+}
+
+// replay applies executions in order to the position and returns the profit in
+// the quote currency realised by the executions since a time which reduced it
+func (p *riskPosition) replay(fills []riskFill, since time.Time) float64 {
+	var realised float64
+	for x := range fills {
+		amount := fills[x].amount
+		switch fills[x].side {
+		case order.Buy, order.Bid:
+		case order.Sell, order.Ask:
+			amount = -amount
+		default:
+			continue
+		}
+		if amount == 0 || fills[x].price <= 0 {
+			continue
+		}
+
+		if p.amount == 0 || (p.amount > 0) == (amount > 0) {
+			p.cost = (p.cost*math.Abs(p.amount) + fills[x].price*math.Abs(amount)) /
+				math.Abs(p.amount+amount)
+			p.amount += amount
+			continue
+		}
+
+		closed := math.Min(math.Abs(amount), math.Abs(p.amount))
+		if !fills[x].time.Before(since) {
+			if p.amount > 0 {
+				realised += closed * (fills[x].price - p.cost)
+			} else {
+				realised += closed * (p.cost - fills[x].price)
+			}
+		}
+		before := p.amount
+		p.amount += amount
+		switch {
+		case math.Abs(p.amount) <= math.Abs(amount)*1e-9:
+			p.amount, p.cost = 0, 0
+		case (p.amount > 0) != (before > 0):
+			// The execution closed the position and opened one the other way
+			p.cost = fills[x].price
+		}
+	}
+	return realised
+}
+
+// Check implements the RiskCheck interface
+func (c *MaxOrderNotionalCheck) Check(o *RiskOrder, s *RiskState) *RiskRejection {
+	if o.Notional <= 0 {
+		return noValuation(o, s)
+	}
+	if o.Notional > c.Limit {
+		return &RiskRejection{
+			Reason: RiskMaxOrderNotional,
+			Message: fmt.Sprintf("order notional %v %s exceeds limit %v %s",
+				o.Notional, s.BaseCurrency, c.Limit, s.BaseCurrency),
+		}
+	}
+	return nil
+}
+
+// Check implements the RiskCheck interface
+func (c *MaxExchangeNotionalCheck) Check(o *RiskOrder, s *RiskState) *RiskRejection {
+	if o.Notional <= 0 {
+		return noValuation(o, s)
+	}
+	total := o.Notional
+	open := s.OpenOrders(o.Exchange, currency.Pair{})
+	for x := range open {
+		if open[x].Price <= 0 {
+			continue
+		}
+		v, err := s.Value(o.Exchange, open[x].CurrencyPair.Quote,
+			remainingAmount(&open[x])*open[x].Price)
+		if err != nil {
+			return &RiskRejection{
+				Reason:  RiskNoValuation,
+				Message: fmt.Sprintf("unable to value open order ID=%v: %v", open[x].ID, err),
+			}
+		}
+		total += v
+	}
+	if total > c.Limit {
+		return &RiskRejection{
+			Reason: RiskMaxExchangeNotional,
+			Message: fmt.Sprintf("exchange open order notional %v %s would exceed limit %v %s",
+				total, s.BaseCurrency, c.Limit, s.BaseCurrency),
+		}
+	}
+	return nil
+}
+
+// Check implements the RiskCheck interface
+func (c *MaxOpenOrdersCheck) Check(o *RiskOrder, s *RiskState) *RiskRejection {
+	open := len(s.OpenOrders(o.Exchange, o.Submit.Pair))
+	if open >= c.Limit {
+		return &RiskRejection{
+			Reason: RiskMaxOpenOrders,
+			Message: fmt.Sprintf("%d open orders for %v reached limit %d",
+				open, o.Submit.Pair, c.Limit),
+		}
+	}
+	return nil
+}
+
+// Check implements the RiskCheck interface
+func (c *MaxPositionCheck) Check(o *RiskOrder, s *RiskState) *RiskRejection {
+	det := &order.Detail{
+		CurrencyPair: o.Submit.Pair,
+		OrderSide:    o.Submit.OrderSide,
+	}
+	for _, code := range []currency.Code{o.Submit.Pair.Base, o.Submit.Pair.Quote} {
+		limit, ok := c.Limits[code.Upper().String()]
+		if !ok {
+			continue
+		}
+		if code.Match(o.Submit.Pair.Quote) && o.Price <= 0 {
+			return noValuation(o, s)
+		}
+		delta := orderDelta(det, code, o.Submit.Amount, o.Price)
+		net, long, short := s.Position(code)
+		exposure := net + short
+		if delta > 0 {
+			exposure = net + long
+		}
+		if after := exposure + delta; math.Abs(after) > limit && math.Abs(after) > math.Abs(exposure) {
+			return &RiskRejection{
+				Reason: RiskMaxPosition,
+				Message: fmt.Sprintf("%s position %v would exceed limit %v",
+					code, after, limit),
+			}
+		}
+	}
+	return nil
+}
+
+// Check implements the RiskCheck interface
+func (c *PriceBandCheck) Check(o *RiskOrder, s *RiskState) *RiskRejection {
+	if o.Submit.OrderType == order.Market {
+		return nil
+	}
+	if o.Ticker == nil {
+		return &RiskRejection{
+			Reason:  RiskNoValuation,
+			Message: fmt.Sprintf("no recent %v ticker to check the price band against", o.Submit.Pair),
+		}
+	}
+	deviation := math.Abs(o.Submit.Price-o.Ticker.Last) / o.Ticker.Last * 100
+	if deviation > c.Band {
+		return &RiskRejection{
+			Reason: RiskPriceBand,
+			Message: fmt.Sprintf("price %v is %.2f%% from the last price %v, outside the %v%% band",
+				o.Submit.Price, deviation, o.Ticker.Last, c.Band),
+		}
+	}
+	return nil
+}
+
+// Check implements the RiskCheck interface
+func (c *DailyLossCheck) Check(o *RiskOrder, s *RiskState) *RiskRejection {
+	day := s.Time.UTC().Truncate(time.Hour * 24)
+	c.m.Lock()
+	defer c.m.Unlock()
+	if !c.loaded {
+		c.loadState()
+	}
+	if c.tripped.Equal(day) {
+		return &RiskRejection{
+			Reason: RiskDailyLoss,
+			Message: fmt.Sprintf("daily loss circuit breaker tripped, order submission halted until %v",
+				day.Add(time.Hour*24)),
+		}
+	}
+	loss := -s.RealisedPnL(day)
+	if loss >= c.Limit {
+		c.tripped = day
+		c.saveState()
+		return &RiskRejection{
+			Reason: RiskDailyLoss,
+			Message: fmt.Sprintf("realised loss %v %s reached limit %v %s, order submission halted until %v",
+				loss, s.BaseCurrency, c.Limit, s.BaseCurrency, day.Add(time.Hour*24)),
+		}
+	}
+	return nil
+}
+
+// loadState reads the day the circuit breaker last tripped from the state file
+func (c *DailyLossCheck) loadState() {
+	c.loaded = true
+	if c.StateFile == "" || !file.Exists(c.StateFile) {
+		return
+	}
+	data, err := ioutil.ReadFile(c.StateFile)
+	if err == nil {
+		var state dailyLossState
+		err = json.Unmarshal(data, &state)
+		if err == nil {
+			c.tripped = state.Tripped.UTC()
+			return
+		}
+	}
+	log.Errorf(log.OrderMgr, "Order manager: Unable to load daily loss circuit breaker state from %s. Err: %s\n",
+		c.StateFile, err)
+}
+
+// saveState writes the day the circuit breaker tripped to the state file so it
+// stays tripped across restarts
+func (c *DailyLossCheck) saveState() {
+	if c.StateFile == "" {
+		return
+	}
+	data, err := json.Marshal(dailyLossState{Tripped: c.tripped})
+	if err == nil {
+		err = file.Write(c.StateFile, data)
+	}
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to save daily loss circuit breaker state to %s. Err: %s\n",
+			c.StateFile, err)
+	}
+}
+
+func noValuation(o *RiskOrder, s *RiskState) *RiskRejection {
+	return &RiskRejection{
+		Reason: RiskNoValuation,
+		Message: fmt.Sprintf("unable to value %v order in %s without a recent ticker",
+			o.Submit.Pair, s.BaseCurrency),
+	}
+}
+
+// orderDelta returns the signed change in a currency an amount of an order
+// makes on execution, zero is returned when the currency is not in its pair
+func orderDelta(det *order.Detail, c currency.Code, amount, price float64) float64 {
+	var sign float64
+	switch det.OrderSide {
+	case order.Buy, order.Bid:
+		sign = 1
+	case order.Sell, order.Ask:
+		sign = -1
+	default:
+		return 0
+	}
+	switch {
+	case c.Match(det.CurrencyPair.Base):
+		return sign * amount
+	case c.Match(det.CurrencyPair.Quote):
+		return -sign * amount * price
+	}
+	return 0
+}
+
+// executedPrice returns the average price of the trades of an order, or its
+// price when it has none
+func executedPrice(det *order.Detail) float64 {
+	var amount, cost float64
+	for x := range det.Trades {
+		amount += det.Trades[x].Amount
+		cost += det.Trades[x].Amount * det.Trades[x].Price
+	}
+	if amount > 0 {
+		return cost / amount
+	}
+	return det.Price
+}
+
+// remainingAmount returns the amount of an order left to execute
+func remainingAmount(det *order.Detail) float64 {
+	if det.RemainingAmount > 0 {
+		return det.RemainingAmount
+	}
+	if r := det.Amount - det.ExecutedAmount; r > 0 {
+		return r
+	}
+	return 0
+}
@@ -0,0 +1,422 @@
+package engine
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orders"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/goose"
+)
+
+const riskTestExchange = "Bitstamp"
+
+var riskTestPair = currency.NewPair(currency.BTC, currency.USD)
+
+func riskTestOrder(side order.Side, price, amount float64) *RiskOrder {
+	return &RiskOrder{
+		Exchange: riskTestExchange,
+		Submit: &order.Submit{
+			Pair:      riskTestPair,
+			OrderType: order.Limit,
+			OrderSide: side,
+			Price:     price,
+			Amount:    amount,
+		},
+		Price:    price,
+		Notional: price * amount,
+	}
+}
+
+func riskTestDetail(side order.Side, status order.Status, price, amount, executed float64) order.Detail {
+	return order.Detail{
+		Exchange:        riskTestExchange,
+		ID:              "1",
+		CurrencyPair:    riskTestPair,
+		OrderSide:       side,
+		OrderType:       order.Limit,
+		OrderDate:       time.Now(),
+		Status:          status,
+		Price:           price,
+		Amount:          amount,
+		ExecutedAmount:  executed,
+		RemainingAmount: amount - executed,
+	}
+}
+
+func expectRejection(t *testing.T, r *RiskRejection, reason RiskRejectionReason) {
+	t.Helper()
+	if reason == "" {
+		if r != nil {
+			t.Errorf("expected no rejection received %v", r)
+		}
+		return
+	}
+	if r == nil {
+		t.Errorf("expected %v rejection received none", reason)
+		return
+	}
+	if r.Reason != reason {
+		t.Errorf("expected %v rejection received %v", reason, r)
+	}
+}
+
+func TestMaxOrderNotionalCheck(t *testing.T) {
+	t.Parallel()
+	c := &MaxOrderNotionalCheck{Limit: 1000}
+	s := &RiskState{BaseCurrency: currency.USD}
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 10), s), "")
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 11), s), RiskMaxOrderNotional)
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 0, 1), s), RiskNoValuation)
+}
+
+func TestMaxExchangeNotionalCheck(t *testing.T) {
+	t.Parallel()
+	c := &MaxExchangeNotionalCheck{Limit: 1000}
+	s := &RiskState{
+		BaseCurrency: currency.USD,
+		Orders: []order.Detail{
+			riskTestDetail(order.Buy, order.New, 100, 6, 0),
+			riskTestDetail(order.Sell, order.Filled, 100, 50, 50),
+			riskTestDetail(order.Sell, order.PartiallyFilled, 100, 4, 2),
+		},
+	}
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 2), s), "")
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 3), s), RiskMaxExchangeNotional)
+
+	o := riskTestOrder(order.Buy, 100, 3)
+	o.Exchange = "Kraken"
+	expectRejection(t, c.Check(o, s), "")
+}
+
+func TestMaxOpenOrdersCheck(t *testing.T) {
+	t.Parallel()
+	c := &MaxOpenOrdersCheck{Limit: 2}
+	s := &RiskState{
+		Orders: []order.Detail{
+			riskTestDetail(order.Buy, order.New, 100, 1, 0),
+			riskTestDetail(order.Buy, order.Cancelled, 100, 1, 0),
+		},
+	}
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 1), s), "")
+	s.Orders = append(s.Orders, riskTestDetail(order.Sell, order.Active, 100, 1, 0))
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 1), s), RiskMaxOpenOrders)
+}
+
+func TestMaxPositionCheck(t *testing.T) {
+	t.Parallel()
+	c := &MaxPositionCheck{Limits: map[string]float64{"BTC": 5}}
+	s := &RiskState{
+		Orders: []order.Detail{
+			riskTestDetail(order.Buy, order.Filled, 100, 3, 3),
+			riskTestDetail(order.Buy, order.New, 100, 1, 0),
+		},
+	}
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 1), s), "")
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 2), s), RiskMaxPosition)
+	// Selling reduces the position
+	expectRejection(t, c.Check(riskTestOrder(order.Sell, 100, 7), s), "")
+	expectRejection(t, c.Check(riskTestOrder(order.Sell, 100, 9), s), RiskMaxPosition)
+
+	c.Limits = map[string]float64{"USD": 100}
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 1), s), RiskMaxPosition)
+	expectRejection(t, c.Check(riskTestOrder(order.Sell, 100, 1), s), "")
+}
+
+func TestPriceBandCheck(t *testing.T) {
+	t.Parallel()
+	c := &PriceBandCheck{Band: 5}
+	s := &RiskState{}
+	o := riskTestOrder(order.Buy, 104, 1)
+	expectRejection(t, c.Check(o, s), RiskNoValuation)
+
+	o.Ticker = &ticker.Price{Last: 100}
+	expectRejection(t, c.Check(o, s), "")
+	o.Submit.Price = 106
+	expectRejection(t, c.Check(o, s), RiskPriceBand)
+	o.Submit.Price = 94
+	expectRejection(t, c.Check(o, s), RiskPriceBand)
+	o.Submit.OrderType = order.Market
+	expectRejection(t, c.Check(o, s), "")
+}
+
+func TestDailyLossCheck(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "risk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := &DailyLossCheck{Limit: 100, StateFile: filepath.Join(dir, riskStateFile)}
+	s := &RiskState{
+		BaseCurrency: currency.USD,
+		Time:         time.Now(),
+		Orders: []order.Detail{
+			riskTestDetail(order.Buy, order.Filled, 100, 2, 2),
+			riskTestDetail(order.Sell, order.Filled, 50, 1, 1),
+		},
+	}
+	if pnl := s.RealisedPnL(time.Now().Add(-time.Hour)); pnl != -50 {
+		t.Errorf("expected realised PnL -50 received %v", pnl)
+	}
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 1), s), "")
+
+	s.Orders = append(s.Orders, riskTestDetail(order.Sell, order.Filled, 40, 1, 1))
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 1), s), RiskDailyLoss)
+
+	// The circuit breaker stays tripped for the rest of the day and across
+	// restarts
+	s.Orders = nil
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 1), s), RiskDailyLoss)
+	restarted := &DailyLossCheck{Limit: 100, StateFile: c.StateFile}
+	expectRejection(t, restarted.Check(riskTestOrder(order.Buy, 100, 1), s), RiskDailyLoss)
+	s.Time = s.Time.Add(time.Hour * 24)
+	expectRejection(t, c.Check(riskTestOrder(order.Buy, 100, 1), s), "")
+	expectRejection(t, restarted.Check(riskTestOrder(order.Buy, 100, 1), s), "")
+}
+
+func TestRealisedPnL(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	yesterday := riskTestDetail(order.Buy, order.Filled, 100, 2, 2)
+	yesterday.OrderDate = now.Add(-time.Hour * 24)
+	today := riskTestDetail(order.Sell, order.Filled, 90, 1, 1)
+	today.InternalOrderID = "2"
+	today.Trades = []order.TradeHistory{
+		{Price: 95, Amount: 0.5, Timestamp: now},
+		{Price: 85, Amount: 0.5, Timestamp: now},
+	}
+	s := &RiskState{
+		BaseCurrency: currency.USD,
+		Orders:       []order.Detail{yesterday, today},
+	}
+	// Positions opened before the time carry over at their average price
+	if pnl := s.RealisedPnL(now.Add(-time.Hour)); pnl != -10 {
+		t.Errorf("expected realised PnL -10 received %v", pnl)
+	}
+	if pnl := s.RealisedPnL(now.Add(time.Hour)); pnl != 0 {
+		t.Errorf("expected no realised PnL received %v", pnl)
+	}
+}
+
+func TestRealisedProfit(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	since := now.Add(-time.Hour)
+	before := now.Add(-time.Hour * 2)
+	testCases := []struct {
+		name  string
+		fills []riskFill
+		pnl   float64
+	}{
+		{
+			name: "same day",
+			fills: []riskFill{
+				{side: order.Buy, price: 100, amount: 1, time: now},
+				{side: order.Sell, price: 110, amount: 1, time: now},
+			},
+			pnl: 10,
+		},
+		{
+			name: "carried over position",
+			fills: []riskFill{
+				{side: order.Buy, price: 100, amount: 1, time: before},
+				{side: order.Buy, price: 120, amount: 1, time: before},
+				{side: order.Ask, price: 100, amount: 1, time: now},
+			},
+			pnl: -10,
+		},
+		{
+			name: "closed before the time",
+			fills: []riskFill{
+				{side: order.Buy, price: 100, amount: 1, time: before},
+				{side: order.Sell, price: 50, amount: 1, time: before},
+				{side: order.Buy, price: 100, amount: 1, time: now},
+			},
+		},
+		{
+			name: "position reversed",
+			fills: []riskFill{
+				{side: order.Buy, price: 100, amount: 1, time: now},
+				{side: order.Sell, price: 110, amount: 2, time: now},
+				{side: order.Bid, price: 100, amount: 1, time: now},
+			},
+			pnl: 20,
+		},
+		{
+			name: "short position",
+			fills: []riskFill{
+				{side: order.Sell, price: 100, amount: 2, time: before},
+				{side: order.Buy, price: 110, amount: 1, time: now},
+			},
+			pnl: -10,
+		},
+	}
+	for x := range testCases {
+		tc := testCases[x]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if pnl := realisedProfit(tc.fills, since); !floatEquals(pnl, tc.pnl) {
+				t.Errorf("expected realised profit %v received %v", tc.pnl, pnl)
+			}
+		})
+	}
+}
+
+func TestRiskEngineTickerAsset(t *testing.T) {
+	t.Parallel()
+	const exchName = "riskTickerAsset"
+	err := ticker.ProcessTicker(exchName, &ticker.Price{Pair: riskTestPair, Last: 100}, asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e riskEngine
+	if e.ticker(exchName, riskTestPair, asset.Spot) != nil {
+		t.Error("expected no spot ticker")
+	}
+	e.load(&config.RiskConfig{
+		Enabled:      true,
+		BaseCurrency: currency.USD,
+		PriceBand:    5,
+	})
+	expectRejection(t, e.check(exchName, &order.Submit{
+		Pair:      riskTestPair,
+		AssetType: asset.Futures,
+		OrderType: order.Limit,
+		OrderSide: order.Buy,
+		Price:     101,
+		Amount:    1,
+	}, nil), "")
+}
+
+func TestRiskEngineCheck(t *testing.T) {
+	t.Parallel()
+	var e riskEngine
+	submit := &order.Submit{
+		Pair:      riskTestPair,
+		OrderType: order.Limit,
+		OrderSide: order.Buy,
+		Price:     100,
+		Amount:    20,
+	}
+	if r := e.check(riskTestExchange, submit, nil); r != nil {
+		t.Fatalf("expected no rejection without checks received %v", r)
+	}
+
+	e.load(&config.RiskConfig{
+		BaseCurrency:     currency.USD,
+		MaxOrderNotional: 1000,
+	})
+	if r := e.check(riskTestExchange, submit, nil); r != nil {
+		t.Fatalf("expected no rejection while disabled received %v", r)
+	}
+
+	e.load(&config.RiskConfig{
+		Enabled:          true,
+		BaseCurrency:     currency.USD,
+		MaxOrderNotional: 1000,
+	})
+	r := e.check(riskTestExchange, submit, nil)
+	expectRejection(t, r, RiskMaxOrderNotional)
+	if r != nil && (r.Exchange != riskTestExchange || !r.Pair.Equal(riskTestPair)) {
+		t.Errorf("expected rejection for %s %v received %s %v",
+			riskTestExchange, riskTestPair, r.Exchange, r.Pair)
+	}
+
+	submit.Amount = 5
+	e.add(&MaxOpenOrdersCheck{Limit: 1})
+	expectRejection(t, e.check(riskTestExchange, submit, nil), "")
+	expectRejection(t, e.check(riskTestExchange, submit, []order.Detail{
+		riskTestDetail(order.Buy, order.New, 100, 1, 0),
+	}), RiskMaxOpenOrders)
+}
+
+func TestDailyLossCheckPersistedFills(t *testing.T) {
+	dir, err := ioutil.TempDir("", "risk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg, dataPath := database.DB.Config, database.DB.DataPath
+	database.DB.Config = &database.Config{
+		Driver:            database.DBSQLite3,
+		ConnectionDetails: drivers.ConnectionDetails{Database: "risk.db"},
+	}
+	database.DB.DataPath = dir
+	dbConn, err := dbsqlite3.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = dbConn.SQL.Close(); err != nil {
+			t.Error(err)
+		}
+		database.DB.SQL = nil
+		database.DB.Config, database.DB.DataPath = cfg, dataPath
+	}()
+	err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(),
+		filepath.Join("..", "database", "migrations"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The fills of a restarted engine are only in the database, no orders
+	// are stored
+	const exchName = "riskPersisted"
+	day := time.Now().UTC().Truncate(time.Hour * 24)
+	insertFill := func(id string, side order.Side, price, amount float64, executed time.Time) {
+		t.Helper()
+		err = orders.InsertFill(&orders.Fill{
+			OrderID:   id,
+			Exchange:  exchName,
+			TradeID:   id,
+			Pair:      riskTestPair,
+			AssetType: asset.Spot,
+			Side:      side,
+			Price:     price,
+			Amount:    amount,
+			Timestamp: executed,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	insertFill("1", order.Buy, 100, 2, day.Add(-time.Hour))
+	insertFill("2", order.Sell, 50, 1, day)
+
+	var e riskEngine
+	e.setPersistence(true, "")
+	e.load(&config.RiskConfig{
+		Enabled:      true,
+		BaseCurrency: currency.USD,
+		MaxDailyLoss: 100,
+	})
+	submit := &order.Submit{
+		Pair:      riskTestPair,
+		OrderType: order.Limit,
+		OrderSide: order.Buy,
+		Price:     100,
+		Amount:    1,
+	}
+	expectRejection(t, e.check(exchName, submit, nil), "")
+	if b := e.basis[(&riskPair{exchange: exchName, pair: riskTestPair, asset: asset.Spot}).key()]; b == nil ||
+		b.position.amount != 2 || b.position.cost != 100 || !b.orderIDs["1"] {
+		t.Errorf("expected a cached position of 2 at 100 received %+v", b)
+	}
+
+	insertFill("3", order.Sell, 40, 1, day.Add(time.Millisecond))
+	expectRejection(t, e.check(exchName, submit, nil), RiskDailyLoss)
+}
@@ -0,0 +1,169 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const (
+	// riskAuditType is the audit event type of pre-trade risk rejections
+	riskAuditType = "risk"
+	// riskStateFile is the file in the data directory the state of the risk
+	// checks is kept in across restarts
+	riskStateFile = "risk.json"
+)
+
+// RiskRejectionReason is the typed reason a pre-trade risk check rejected an
+// order
+type RiskRejectionReason string
+
+// Pre-trade risk rejection reasons
+const (
+	RiskMaxOrderNotional    RiskRejectionReason = "MAX_ORDER_NOTIONAL"
+	RiskMaxExchangeNotional RiskRejectionReason = "MAX_EXCHANGE_NOTIONAL"
+	RiskMaxOpenOrders       RiskRejectionReason = "MAX_OPEN_ORDERS"
+	RiskMaxPosition         RiskRejectionReason = "MAX_POSITION"
+	RiskPriceBand           RiskRejectionReason = "PRICE_BAND"
+	RiskDailyLoss           RiskRejectionReason = "DAILY_LOSS"
+	// RiskNoValuation is an order which could not be priced or valued in the
+	// base currency by a check that requires it
+	RiskNoValuation RiskRejectionReason = "NO_VALUATION"
+)
+
+// RiskRejection is the error returned when an order fails a pre-trade risk
+// check
+type RiskRejection struct {
+	Reason   RiskRejectionReason
+	Exchange string
+	Pair     currency.Pair
+	Message  string
+}
+
+// RiskCheck is a pre-trade risk check run against every order submitted
+// through the order manager, a rejection stops the order being sent to the
+// exchange
+type RiskCheck interface {
+	Check(o *RiskOrder, s *RiskState) *RiskRejection
+}
+
+// RiskOrder is an order submission presented to the pre-trade risk checks.
+// Price is the limit price, or the latest ticker price for market orders, and
+// Notional is the order value in the risk base currency. Both are zero when
+// they could not be determined. Ticker is nil when no recent ticker exists.
+type RiskOrder struct {
+	Exchange string
+	Submit   *order.Submit
+	Price    float64
+	Notional float64
+	Ticker   *ticker.Price
+}
+
+// RiskState is the order manager state an order is checked against. Orders
+// holds the stored orders of every exchange along with orders accepted earlier
+// in the same batch.
+type RiskState struct {
+	BaseCurrency currency.Code
+	Orders       []order.Detail
+	Time         time.Time
+	risk         *riskEngine
+}
+
+// MaxOrderNotionalCheck rejects orders valued above Limit in the base currency
+type MaxOrderNotionalCheck struct {
+	Limit float64
+}
+
+// MaxExchangeNotionalCheck rejects orders which would take the value of the
+// open orders on an exchange above Limit in the base currency
+type MaxExchangeNotionalCheck struct {
+	Limit float64
+}
+
+// MaxOpenOrdersCheck rejects orders which would take the open orders of an
+// exchange pair above Limit
+type MaxOpenOrdersCheck struct {
+	Limit int
+}
+
+// MaxPositionCheck rejects orders which would take the position in a currency
+// beyond its limit either side of zero. Limits are keyed by upper case
+// currency code. A position is the net amount executed through the order
+// manager plus the open orders which move it in the same direction.
+type MaxPositionCheck struct {
+	Limits map[string]float64
+}
+
+// PriceBandCheck is a fat finger check which rejects limit orders priced more
+// than Band percent away from the latest ticker price
+type PriceBandCheck struct {
+	Band float64
+}
+
+// DailyLossCheck is a circuit breaker which halts order submissions for the
+// rest of the UTC day once the loss realised since its start reaches Limit in
+// the base currency. The day it trips is written to StateFile, when set, so it
+// stays tripped across restarts.
+type DailyLossCheck struct {
+	Limit     float64
+	StateFile string
+	m         sync.Mutex
+	loaded    bool
+	tripped   time.Time
+}
+
+// dailyLossState is the persisted state of a daily loss circuit breaker
+type dailyLossState struct {
+	Tripped time.Time `json:"tripped"`
+}
+
+// riskPair is an exchange pair and asset type executions are grouped by to
+// work out realised profit
+type riskPair struct {
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
+}
+
+// riskFill is an execution replayed to work out realised profit
+type riskFill struct {
+	side   order.Side
+	price  float64
+	amount float64
+	time   time.Time
+}
+
+// riskPosition is a position held at its average price
+type riskPosition struct {
+	amount float64
+	cost   float64
+}
+
+// riskBasis is the position of an exchange pair and asset type built from the
+// executions persisted before a time, orderIDs holds the internal order IDs
+// the executions belong to
+type riskBasis struct {
+	position riskPosition
+	orderIDs map[string]bool
+}
+
+// riskEngine holds the pre-trade risk checks built from the risk config and
+// any added at runtime. When persist is set executions are read from the order
+// repository and stateFile keeps the state of the checks across restarts.
+// Positions built from executions persisted before basisTime are cached in
+// basis.
+type riskEngine struct {
+	m         sync.Mutex
+	cfg       config.RiskConfig
+	checks    []RiskCheck
+	custom    []RiskCheck
+	persist   bool
+	stateFile string
+	basis     map[string]*riskBasis
+	basisTime time.Time
+}
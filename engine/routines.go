@@ -305,6 +305,9 @@ func WebsocketDataHandler(ws *wshandler.Websocket) {
 				log.Errorf(log.WebsocketMgr, "routines.go exchange %s websocket error - %s", ws.GetName(), data)
 			case wshandler.TradeData:
 				// Websocket Trade Data
+				Bot.TradePersistenceManager.Add(&d)
+				Bot.CandleBuilderManager.Add(&d)
+				Bot.ExecutionManager.AddTrade(&d)
 				if Bot.Settings.Verbose {
 					log.Infof(log.WebsocketMgr, "%s websocket %s %s trade updated %+v\n",
 						ws.GetName(),
@@ -341,6 +344,26 @@ func WebsocketDataHandler(ws *wshandler.Websocket) {
 						d.AssetType,
 						d)
 				}
+			case wshandler.OrderUpdate:
+				// Websocket private order data
+				Bot.OrderManager.processOrderUpdate(&d)
+				if Bot.Settings.Verbose {
+					log.Infof(log.WebsocketMgr, "%s websocket %s %s order updated %+v\n",
+						ws.GetName(),
+						FormatCurrency(d.CurrencyPair),
+						d.AssetType,
+						d)
+				}
+			case wshandler.FillUpdate:
+				// Websocket private fill data
+				Bot.OrderManager.processFillUpdate(&d)
+				if Bot.Settings.Verbose {
+					log.Infof(log.WebsocketMgr, "%s websocket %s %s order filled %+v\n",
+						ws.GetName(),
+						FormatCurrency(d.CurrencyPair),
+						d.AssetType,
+						d)
+				}
 			case wshandler.WebsocketOrderbookUpdate:
 				// Websocket Orderbook Data
 				result := data.(wshandler.WebsocketOrderbookUpdate)
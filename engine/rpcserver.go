@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -24,8 +25,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -519,13 +522,11 @@ func (s *RPCServer) GetAccountInfoStream(r *gctrpc.GetAccountInfoRequest, stream
 	defer pipe.Release()
 
 	for {
-		data, ok := <-pipe.C
+		acc, ok := <-pipe.C
 		if !ok {
 			return errors.New(errDispatchSystem)
 		}
 
-		acc := (*data.(*interface{})).(account.Holdings)
-
 		var accounts []*gctrpc.Account
 		for x := range acc.Accounts {
 			var subAccounts []*gctrpc.AccountCurrencyInfo
@@ -746,13 +747,17 @@ func (s *RPCServer) GetOrder(ctx context.Context, r *gctrpc.GetOrderRequest) (*g
 }
 
 // SubmitOrder submits an order specified by exchange, currency pair and asset
-// type
+// type through the order manager
 func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderRequest) (*gctrpc.SubmitOrderResponse, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errors.New("exchange is not loaded/doesn't exist")
 	}
 
+	if r.Pair == nil {
+		return nil, order.ErrPairIsEmpty
+	}
+
 	p := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
 	submission := &order.Submit{
 		Pair:      p,
@@ -762,11 +767,62 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 		Price:     r.Price,
 		ClientID:  r.ClientId,
 	}
-	result, err := exch.SubmitOrder(submission)
+	result, err := Bot.OrderManager.Submit(exch.GetName(), submission)
+	if err != nil {
+		return nil, err
+	}
 	return &gctrpc.SubmitOrderResponse{
 		OrderId:     result.OrderID,
-		OrderPlaced: result.IsOrderPlaced,
-	}, err
+		OrderPlaced: true,
+	}, nil
+}
+
+// SubmitOrders submits a batch of orders to an exchange through the order
+// manager, results are returned in the order of the submissions
+func (s *RPCServer) SubmitOrders(ctx context.Context, r *gctrpc.SubmitOrdersRequest) (*gctrpc.SubmitOrdersResponse, error) {
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+
+	submissions := make([]order.Submit, len(r.Orders))
+	for x := range r.Orders {
+		if r.Orders[x].Pair == nil {
+			return nil, fmt.Errorf("order %d: %v", x, order.ErrPairIsEmpty)
+		}
+		a := asset.Item(r.Orders[x].AssetType)
+		if a != "" && !exch.GetAssetTypes().Contains(a) {
+			return nil, fmt.Errorf("order %d: order asset type not supported by exchange", x)
+		}
+		submissions[x] = order.Submit{
+			Pair:      currency.NewPairFromStrings(r.Orders[x].Pair.Base, r.Orders[x].Pair.Quote),
+			AssetType: a,
+			OrderSide: order.Side(r.Orders[x].Side),
+			OrderType: order.Type(r.Orders[x].OrderType),
+			Amount:    r.Orders[x].Amount,
+			Price:     r.Orders[x].Price,
+			ClientID:  r.Orders[x].ClientId,
+		}
+	}
+
+	results, err := Bot.OrderManager.SubmitOrders(exch.GetName(), submissions)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp gctrpc.SubmitOrdersResponse
+	for x := range results {
+		result := &gctrpc.BatchOrderResult{
+			OrderPlaced:     results[x].IsOrderPlaced,
+			OrderId:         results[x].OrderID,
+			InternalOrderId: results[x].OurOrderID,
+		}
+		if results[x].Error != nil {
+			result.Error = results[x].Error.Error()
+		}
+		resp.Orders = append(resp.Orders, result)
+	}
+	return &resp, nil
 }
 
 // SimulateOrder simulates an order specified by exchange, currency pair and asset
@@ -844,26 +900,186 @@ func (s *RPCServer) WhaleBomb(ctx context.Context, r *gctrpc.WhaleBombRequest) (
 }
 
 // CancelOrder cancels an order specified by exchange, currency pair and asset
-// type
+// type through the order manager
 func (s *RPCServer) CancelOrder(ctx context.Context, r *gctrpc.CancelOrderRequest) (*gctrpc.CancelOrderResponse, error) {
 	exch := GetExchangeByName(r.Exchange)
 	if exch == nil {
 		return nil, errors.New("exchange is not loaded/doesn't exist")
 	}
 
-	err := exch.CancelOrder(&order.Cancel{
+	cancel := &order.Cancel{
 		AccountID:     r.AccountId,
 		OrderID:       r.OrderId,
+		AssetType:     asset.Item(r.AssetType),
 		Side:          order.Side(r.Side),
 		WalletAddress: r.WalletAddress,
-	})
+	}
+	if r.Pair != nil {
+		cancel.CurrencyPair = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	}
+
+	err := Bot.OrderManager.Cancel(exch.GetName(), cancel)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.CancelOrderResponse{}, nil
+}
+
+// CancelOrders cancels a batch of orders on an exchange through the order
+// manager, orders which could not be cancelled are returned with the reason
+func (s *RPCServer) CancelOrders(ctx context.Context, r *gctrpc.CancelOrdersRequest) (*gctrpc.CancelOrdersResponse, error) {
+	cancellations := make([]order.Cancel, len(r.Orders))
+	for x := range r.Orders {
+		cancellations[x] = order.Cancel{
+			OrderID:   r.Orders[x].OrderId,
+			AssetType: asset.Item(r.Orders[x].AssetType),
+			Side:      order.Side(r.Orders[x].Side),
+		}
+		if r.Orders[x].Pair != nil {
+			cancellations[x].CurrencyPair = currency.NewPairFromStrings(r.Orders[x].Pair.Base,
+				r.Orders[x].Pair.Quote)
+		}
+	}
 
-	return &gctrpc.CancelOrderResponse{}, err
+	status, err := Bot.OrderManager.CancelOrders(r.Exchange, cancellations)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.CancelOrdersResponse{OrderStatus: status}, nil
+}
+
+// ModifyOrder amends the price or amount of an open order through the order
+// manager, the order ID after the modification is returned
+func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderRequest) (*gctrpc.ModifyOrderResponse, error) {
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+
+	a := asset.Item(r.AssetType)
+	if a != "" && !exch.GetAssetTypes().Contains(a) {
+		return nil, errors.New("order asset type not supported by exchange")
+	}
+
+	mod := &order.Modify{
+		OrderID: r.OrderId,
+		Type:    order.Type(r.OrderType),
+		Side:    order.Side(r.Side),
+		Price:   r.Price,
+		Amount:  r.Amount,
+	}
+	if r.Pair != nil {
+		mod.CurrencyPair = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	}
+
+	id, err := Bot.OrderManager.Modify(r.Exchange, mod)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ModifyOrderResponse{OrderId: id}, nil
 }
 
 // CancelAllOrders cancels all orders, filterable by exchange
 func (s *RPCServer) CancelAllOrders(ctx context.Context, r *gctrpc.CancelAllOrdersRequest) (*gctrpc.CancelAllOrdersResponse, error) {
-	return &gctrpc.CancelAllOrdersResponse{}, common.ErrNotYetImplemented
+	results, err := Bot.OrderManager.CancelAllOrders(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.CancelAllOrdersResponse{Orders: cancelAllResultsToRPC(results)}, nil
+}
+
+// ActivateKillSwitch halts new order submissions and modifications and cancels
+// all open orders on every authenticated exchange
+func (s *RPCServer) ActivateKillSwitch(ctx context.Context, r *gctrpc.ActivateKillSwitchRequest) (*gctrpc.ActivateKillSwitchResponse, error) {
+	results, err := Bot.OrderManager.ActivateKillSwitch(r.Reason)
+	if err != nil {
+		return nil, err
+	}
+	status := Bot.OrderManager.GetKillSwitchStatus()
+	return &gctrpc.ActivateKillSwitchResponse{
+		Status: killSwitchStatusToRPC(&status),
+		Orders: cancelAllResultsToRPC(results),
+	}, nil
+}
+
+// ResetKillSwitch resumes order submissions and modifications halted by the
+// kill switch
+func (s *RPCServer) ResetKillSwitch(ctx context.Context, r *gctrpc.ResetKillSwitchRequest) (*gctrpc.KillSwitchStatus, error) {
+	err := Bot.OrderManager.ResetKillSwitch()
+	if err != nil {
+		return nil, err
+	}
+	status := Bot.OrderManager.GetKillSwitchStatus()
+	return killSwitchStatusToRPC(&status), nil
+}
+
+// GetKillSwitchStatus returns whether the kill switch is halting order
+// submissions
+func (s *RPCServer) GetKillSwitchStatus(ctx context.Context, r *gctrpc.GetKillSwitchStatusRequest) (*gctrpc.KillSwitchStatus, error) {
+	if !Bot.OrderManager.Started() {
+		return nil, errOrderManagerNotStarted
+	}
+	status := Bot.OrderManager.GetKillSwitchStatus()
+	return killSwitchStatusToRPC(&status), nil
+}
+
+func killSwitchStatusToRPC(k *KillSwitchStatus) *gctrpc.KillSwitchStatus {
+	resp := &gctrpc.KillSwitchStatus{
+		Active: k.Active,
+		Reason: k.Reason,
+	}
+	if !k.ActivatedAt.IsZero() {
+		resp.ActivatedAt = k.ActivatedAt.UTC().Format(audit.TableTimeFormat)
+	}
+	return resp
+}
+
+func cancelAllResultsToRPC(results []CancelAllResult) []*gctrpc.CancelAllOrdersResponse_Orders {
+	resp := make([]*gctrpc.CancelAllOrdersResponse_Orders, len(results))
+	for x := range results {
+		resp[x] = &gctrpc.CancelAllOrdersResponse_Orders{
+			Exchange:    results[x].Exchange,
+			OrderStatus: results[x].Failed,
+			Error:       results[x].Error,
+		}
+	}
+	return resp
+}
+
+// GetTradingRules returns the price and amount increments and order size
+// limits an exchange enforces on a pair, the asset type defaults to spot
+func (s *RPCServer) GetTradingRules(ctx context.Context, r *gctrpc.GetTradingRulesRequest) (*gctrpc.TradingRules, error) {
+	if r.Pair == nil {
+		return nil, errors.New("currency pair not set")
+	}
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+	a := asset.Spot
+	if r.AssetType != "" {
+		a = asset.Item(strings.ToLower(r.AssetType))
+	}
+	rules, err := exch.GetTradingRules(currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote), a)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.TradingRules{
+		Exchange: exch.GetName(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: rules.Pair.Delimiter,
+			Base:      rules.Pair.Base.String(),
+			Quote:     rules.Pair.Quote.String(),
+		},
+		AssetType:      rules.Asset.String(),
+		PriceTickSize:  rules.PriceTickSize,
+		MinPrice:       rules.MinPrice,
+		MaxPrice:       rules.MaxPrice,
+		AmountStepSize: rules.AmountStepSize,
+		MinAmount:      rules.MinAmount,
+		MaxAmount:      rules.MaxAmount,
+		MinNotional:    rules.MinNotional,
+	}, nil
 }
 
 // GetEvents returns the stored events list
@@ -1075,7 +1291,13 @@ func (s *RPCServer) GetOrderbookStream(r *gctrpc.GetOrderbookStreamRequest, stre
 
 	p := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
 
-	pipe, err := orderbook.SubscribeOrderbook(r.Exchange, p, asset.Item(r.AssetType))
+	// Streams only need the latest orderbook so a slow client cannot fall
+	// behind
+	pipe, err := orderbook.SubscribeOrderbookWithOptions(r.Exchange, p, asset.Item(r.AssetType),
+		dispatch.SubscribeOptions{
+			Name:   fmt.Sprintf("gRPC orderbook stream %s %s %s", r.Exchange, p, r.AssetType),
+			Policy: dispatch.PolicyConflate,
+		})
 	if err != nil {
 		return err
 	}
@@ -1083,12 +1305,11 @@ func (s *RPCServer) GetOrderbookStream(r *gctrpc.GetOrderbookStreamRequest, stre
 	defer pipe.Release()
 
 	for {
-		data, ok := <-pipe.C
+		ob, ok := <-pipe.C
 		if !ok {
 			return errors.New(errDispatchSystem)
 		}
 
-		ob := (*data.(*interface{})).(orderbook.Base)
 		var bids, asks []*gctrpc.OrderbookItem
 		for i := range ob.Bids {
 			bids = append(bids, &gctrpc.OrderbookItem{
@@ -1131,12 +1352,11 @@ func (s *RPCServer) GetExchangeOrderbookStream(r *gctrpc.GetExchangeOrderbookStr
 	defer pipe.Release()
 
 	for {
-		data, ok := <-pipe.C
+		ob, ok := <-pipe.C
 		if !ok {
 			return errors.New(errDispatchSystem)
 		}
 
-		ob := (*data.(*interface{})).(orderbook.Base)
 		var bids, asks []*gctrpc.OrderbookItem
 		for i := range ob.Bids {
 			bids = append(bids, &gctrpc.OrderbookItem{
@@ -1181,7 +1401,11 @@ func (s *RPCServer) GetTickerStream(r *gctrpc.GetTickerStreamRequest, stream gct
 
 	p := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
 
-	pipe, err := ticker.SubscribeTicker(r.Exchange, p, asset.Item(r.AssetType))
+	pipe, err := ticker.SubscribeTickerWithOptions(r.Exchange, p, asset.Item(r.AssetType),
+		dispatch.SubscribeOptions{
+			Name:   fmt.Sprintf("gRPC ticker stream %s %s %s", r.Exchange, p, r.AssetType),
+			Policy: dispatch.PolicyConflate,
+		})
 	if err != nil {
 		return err
 	}
@@ -1189,11 +1413,10 @@ func (s *RPCServer) GetTickerStream(r *gctrpc.GetTickerStreamRequest, stream gct
 	defer pipe.Release()
 
 	for {
-		data, ok := <-pipe.C
+		t, ok := <-pipe.C
 		if !ok {
 			return errors.New(errDispatchSystem)
 		}
-		t := (*data.(*interface{})).(ticker.Price)
 
 		err := stream.Send(&gctrpc.TickerResponse{
 			Pair: &gctrpc.CurrencyPair{
@@ -1229,11 +1452,10 @@ func (s *RPCServer) GetExchangeTickerStream(r *gctrpc.GetExchangeTickerStreamReq
 	defer pipe.Release()
 
 	for {
-		data, ok := <-pipe.C
+		t, ok := <-pipe.C
 		if !ok {
 			return errors.New(errDispatchSystem)
 		}
-		t := (*data.(*interface{})).(ticker.Price)
 
 		err := stream.Send(&gctrpc.TickerResponse{
 			Pair: &gctrpc.CurrencyPair{
@@ -1313,6 +1535,25 @@ func (s *RPCServer) GetHistoricCandles(ctx context.Context, req *gctrpc.GetHisto
 		return nil, errors.New(errCurrencyPairUnset)
 	}
 
+	if req.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	start, err := time.Parse(audit.TableTimeFormat, req.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := time.Parse(audit.TableTimeFormat, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := kline.ParseInterval(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
 	exchange := GetExchangeByName(req.Exchange)
 	if exchange == nil {
 		return nil, errors.New("Exchange " + req.Exchange + " not found")
@@ -1322,25 +1563,854 @@ func (s *RPCServer) GetHistoricCandles(ctx context.Context, req *gctrpc.GetHisto
 		Delimiter: req.Pair.Delimiter,
 		Base:      currency.NewCode(req.Pair.Base),
 		Quote:     currency.NewCode(req.Pair.Quote),
-	}, req.Rangesize, req.Granularity)
+	},
+		asset.Item(strings.ToLower(req.AssetType)),
+		start,
+		end,
+		interval)
 	if err != nil {
 		return nil, err
 	}
-	resp := gctrpc.GetHistoricCandlesResponse{}
-	for _, candle := range candles {
-		tempCandle := &gctrpc.Candle{
-			Time:   candle.Time,
-			Low:    candle.Low,
-			High:   candle.High,
-			Open:   candle.Open,
-			Close:  candle.Close,
-			Volume: candle.Volume,
+
+	resp := gctrpc.GetHistoricCandlesResponse{
+		Exchange: candles.Exchange,
+		Pair:     req.Pair,
+		Start:    req.Start,
+		End:      req.End,
+		Interval: candles.Interval.Short(),
+	}
+	for x := range candles.Candles {
+		resp.Candle = append(resp.Candle, &gctrpc.Candle{
+			Time:   candles.Candles[x].Time.UTC().Format(audit.TableTimeFormat),
+			Low:    candles.Candles[x].Low,
+			High:   candles.Candles[x].High,
+			Open:   candles.Candles[x].Open,
+			Close:  candles.Candles[x].Close,
+			Volume: candles.Candles[x].Volume,
+		})
+	}
+	return &resp, nil
+}
+
+// AddDataHistoryJob adds a candle backfill job to the data history manager
+func (s *RPCServer) AddDataHistoryJob(ctx context.Context, req *gctrpc.AddDataHistoryJobRequest) (*gctrpc.DataHistoryJob, error) {
+	if req.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	if req.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	if req.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	interval, err := kline.ParseInterval(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse(audit.TableTimeFormat, req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var end time.Time
+	if req.EndDate != "" {
+		end, err = time.Parse(audit.TableTimeFormat, req.EndDate)
+		if err != nil {
+			return nil, err
 		}
-		resp.Candle = append(resp.Candle, tempCandle)
+	}
+
+	job, err := Bot.DataHistoryManager.AddJob(req.Exchange,
+		currency.Pair{
+			Delimiter: req.Pair.Delimiter,
+			Base:      currency.NewCode(req.Pair.Base),
+			Quote:     currency.NewCode(req.Pair.Quote),
+		},
+		asset.Item(strings.ToLower(req.AssetType)),
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+	return dataHistoryJobToRPC(job), nil
+}
+
+// GetDataHistoryJob returns a data history job and its progress
+func (s *RPCServer) GetDataHistoryJob(ctx context.Context, req *gctrpc.GetDataHistoryJobRequest) (*gctrpc.DataHistoryJob, error) {
+	job, err := Bot.DataHistoryManager.GetJob(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return dataHistoryJobToRPC(job), nil
+}
+
+// GetDataHistoryJobs returns all data history jobs and their progress
+func (s *RPCServer) GetDataHistoryJobs(ctx context.Context, req *gctrpc.GetDataHistoryJobsRequest) (*gctrpc.GetDataHistoryJobsResponse, error) {
+	jobs := Bot.DataHistoryManager.GetJobs()
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartDate.Before(jobs[j].StartDate)
+	})
+
+	var resp gctrpc.GetDataHistoryJobsResponse
+	for x := range jobs {
+		resp.Jobs = append(resp.Jobs, dataHistoryJobToRPC(&jobs[x]))
 	}
 	return &resp, nil
 }
 
+// RemoveDataHistoryJob removes a data history job
+func (s *RPCServer) RemoveDataHistoryJob(ctx context.Context, req *gctrpc.RemoveDataHistoryJobRequest) (*gctrpc.GenericSubsystemResponse, error) {
+	err := Bot.DataHistoryManager.RemoveJob(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericSubsystemResponse{}, nil
+}
+
+func dataHistoryJobToRPC(job *DataHistoryJob) *gctrpc.DataHistoryJob {
+	resp := &gctrpc.DataHistoryJob{
+		Id:       job.ID,
+		Exchange: job.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: job.Pair.Delimiter,
+			Base:      job.Pair.Base.String(),
+			Quote:     job.Pair.Quote.String(),
+		},
+		AssetType:       job.Asset.String(),
+		Interval:        job.Interval.Short(),
+		StartDate:       job.StartDate.UTC().Format(audit.TableTimeFormat),
+		Status:          job.Status,
+		CandlesExpected: job.CandlesExpected,
+		CandlesStored:   job.CandlesStored,
+		MissingRanges:   int64(job.MissingRanges),
+		Progress:        job.Progress(),
+		Error:           job.Error,
+	}
+	if !job.EndDate.IsZero() {
+		resp.EndDate = job.EndDate.UTC().Format(audit.TableTimeFormat)
+	}
+	if !job.LastRun.IsZero() {
+		resp.LastRun = job.LastRun.UTC().Format(audit.TableTimeFormat)
+	}
+	return resp
+}
+
+// GetOrderbookDepth returns depth analytics for an orderbook including the
+// spread, cumulative depth and imbalance within a percentage of the mid price
+// and the VWAP and price impact for each requested amount
+func (s *RPCServer) GetOrderbookDepth(ctx context.Context, req *gctrpc.GetOrderbookDepthRequest) (*gctrpc.GetOrderbookDepthResponse, error) {
+	if req.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	if req.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	if req.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	exch := GetExchangeByName(req.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+
+	p := currency.Pair{
+		Delimiter: req.Pair.Delimiter,
+		Base:      currency.NewCode(req.Pair.Base),
+		Quote:     currency.NewCode(req.Pair.Quote),
+	}
+	o, err := exch.FetchOrderbook(p, asset.Item(strings.ToLower(req.AssetType)))
+	if err != nil {
+		return nil, err
+	}
+
+	var buy bool
+	switch {
+	case strings.EqualFold(req.Side, order.Buy.String()),
+		strings.EqualFold(req.Side, order.Bid.String()):
+		buy = true
+	case strings.EqualFold(req.Side, order.Sell.String()),
+		strings.EqualFold(req.Side, order.Ask.String()):
+	case len(req.Amounts) > 0:
+		return nil, errors.New("order side must be buy or sell")
+	}
+
+	mid, err := o.MidPrice()
+	if err != nil {
+		return nil, err
+	}
+	spread, spreadBPS, err := o.Spread()
+	if err != nil {
+		return nil, err
+	}
+
+	depthPercent := req.DepthPercent
+	if depthPercent == 0 {
+		depthPercent = 1
+	}
+	depth, err := o.DepthWithin(depthPercent)
+	if err != nil {
+		return nil, err
+	}
+
+	slippage, err := o.SlippageCurve(req.Amounts, buy, req.QuoteAmounts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetOrderbookDepthResponse{
+		BestBid:           o.Bids[0].Price,
+		BestAsk:           o.Asks[0].Price,
+		MidPrice:          mid,
+		Spread:            spread,
+		SpreadBasisPoints: spreadBPS,
+		DepthPercent:      depth.Percent,
+		BidAmount:         depth.BidAmount,
+		BidValue:          depth.BidValue,
+		AskAmount:         depth.AskAmount,
+		AskValue:          depth.AskValue,
+		Imbalance:         depth.Imbalance,
+		LastUpdated:       o.LastUpdated.UTC().Format(audit.TableTimeFormat),
+	}
+	for x := range slippage {
+		resp.Slippage = append(resp.Slippage, &gctrpc.OrderbookVWAP{
+			Amount:      req.Amounts[x],
+			Vwap:        slippage[x].VWAP,
+			BaseAmount:  slippage[x].BaseAmount,
+			QuoteAmount: slippage[x].QuoteAmount,
+			BestPrice:   slippage[x].BestPrice,
+			WorstPrice:  slippage[x].WorstPrice,
+			PriceImpact: slippage[x].PriceImpact,
+			MidSlippage: slippage[x].MidSlippage,
+			Levels:      int64(slippage[x].Levels),
+			FullyFilled: slippage[x].FullyFilled,
+		})
+	}
+	return resp, nil
+}
+
+// GetConsolidatedOrderbookStream streams the orderbooks of every enabled
+// exchange trading a currency pair merged into a single price level view
+func (s *RPCServer) GetConsolidatedOrderbookStream(r *gctrpc.GetConsolidatedOrderbookStreamRequest, stream gctrpc.GoCryptoTrader_GetConsolidatedOrderbookStreamServer) error {
+	if r.Pair.String() == "" {
+		return errors.New(errCurrencyPairUnset)
+	}
+
+	if r.AssetType == "" {
+		return errors.New(errAssetTypeUnset)
+	}
+
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	c, err := SubscribeConsolidatedOrderbook(p, asset.Item(strings.ToLower(r.AssetType)), r.Exchanges)
+	if err != nil {
+		return err
+	}
+	defer c.Release()
+
+	for {
+		var cons *orderbook.Consolidated
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case cons = <-c.C:
+		}
+
+		resp := &gctrpc.ConsolidatedOrderbookResponse{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: cons.Pair.Delimiter,
+				Base:      cons.Pair.Base.String(),
+				Quote:     cons.Pair.Quote.String(),
+			},
+			AssetType:   cons.AssetType.String(),
+			LastUpdated: cons.LastUpdated.UTC().Format(audit.TableTimeFormat),
+		}
+		for x := range cons.Bids {
+			resp.Bids = append(resp.Bids, &gctrpc.ConsolidatedOrderbookItem{
+				Exchange:      cons.Bids[x].Exchange,
+				Amount:        cons.Bids[x].Amount,
+				Price:         cons.Bids[x].Price,
+				OriginalPrice: cons.Bids[x].OriginalPrice,
+			})
+		}
+		for x := range cons.Asks {
+			resp.Asks = append(resp.Asks, &gctrpc.ConsolidatedOrderbookItem{
+				Exchange:      cons.Asks[x].Exchange,
+				Amount:        cons.Asks[x].Amount,
+				Price:         cons.Asks[x].Price,
+				OriginalPrice: cons.Asks[x].OriginalPrice,
+			})
+		}
+		for x := range cons.Sources {
+			resp.Sources = append(resp.Sources, &gctrpc.ConsolidatedOrderbookSource{
+				Exchange: cons.Sources[x].Exchange,
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: cons.Sources[x].Pair.Delimiter,
+					Base:      cons.Sources[x].Pair.Base.String(),
+					Quote:     cons.Sources[x].Pair.Quote.String(),
+				},
+				Rate:        cons.Sources[x].Rate,
+				LastUpdated: cons.Sources[x].LastUpdated.UTC().Format(audit.TableTimeFormat),
+			})
+		}
+		err = stream.Send(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetArbitrageOpportunities returns the current cross exchange arbitrage
+// opportunities and the most recent alerts
+func (s *RPCServer) GetArbitrageOpportunities(ctx context.Context, r *gctrpc.GetArbitrageOpportunitiesRequest) (*gctrpc.GetArbitrageOpportunitiesResponse, error) {
+	var p currency.Pair
+	if r.Pair != nil && r.Pair.Base != "" && r.Pair.Quote != "" {
+		p = currency.NewPair(currency.NewCode(r.Pair.Base), currency.NewCode(r.Pair.Quote))
+	}
+
+	opportunities, err := Bot.ArbitrageMonitor.GetOpportunities(asset.Item(strings.ToLower(r.AssetType)), p)
+	if err != nil {
+		return nil, err
+	}
+	alerts, err := Bot.ArbitrageMonitor.GetAlerts()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetArbitrageOpportunitiesResponse{
+		AlertThreshold: Bot.ArbitrageMonitor.AlertThreshold(),
+	}
+	for x := range opportunities {
+		resp.Opportunities = append(resp.Opportunities, arbitrageOpportunityToRPC(&opportunities[x]))
+	}
+	for x := range alerts {
+		if r.AssetType != "" && !strings.EqualFold(alerts[x].Asset.String(), r.AssetType) {
+			continue
+		}
+		if !p.IsEmpty() && !alerts[x].Pair.Equal(p) {
+			continue
+		}
+		resp.Alerts = append(resp.Alerts, arbitrageOpportunityToRPC(&alerts[x]))
+	}
+	return resp, nil
+}
+
+func arbitrageOpportunityToRPC(o *ArbitrageOpportunity) *gctrpc.ArbitrageOpportunity {
+	return &gctrpc.ArbitrageOpportunity{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Pair.Delimiter,
+			Base:      o.Pair.Base.String(),
+			Quote:     o.Pair.Quote.String(),
+		},
+		AssetType:              o.Asset.String(),
+		BuyExchange:            o.BuyExchange,
+		BuyPrice:               o.BuyPrice,
+		BuyFee:                 o.BuyFee,
+		SellExchange:           o.SellExchange,
+		SellPrice:              o.SellPrice,
+		SellFee:                o.SellFee,
+		Amount:                 o.Amount,
+		GrossSpread:            o.GrossSpread,
+		GrossSpreadBasisPoints: o.GrossSpreadBasisPoints,
+		NetSpread:              o.NetSpread,
+		NetSpreadBasisPoints:   o.NetSpreadBasisPoints,
+		NetProfit:              o.NetProfit,
+		Updated:                o.Updated.UTC().Format(audit.TableTimeFormat),
+	}
+}
+
+// GetDispatchStats returns the state of the dispatch system and the relay
+// counters of every subscribed pipe
+func (s *RPCServer) GetDispatchStats(ctx context.Context, r *gctrpc.GetDispatchStatsRequest) (*gctrpc.GetDispatchStatsResponse, error) {
+	stats, err := dispatch.GetStats()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetDispatchStatsResponse{
+		Running:     stats.Running,
+		Workers:     stats.Workers,
+		Jobs:        int64(stats.Jobs),
+		JobsLimit:   int64(stats.JobsLimit),
+		DroppedJobs: stats.DroppedJobs,
+	}
+	for x := range stats.Pipes {
+		if r.LaggingOnly && stats.Pipes[x].Lag == 0 && stats.Pipes[x].Dropped == 0 {
+			continue
+		}
+		resp.Pipes = append(resp.Pipes, &gctrpc.DispatchPipeStats{
+			Id:         stats.Pipes[x].ID.String(),
+			Name:       stats.Pipes[x].Name,
+			Policy:     stats.Pipes[x].Policy.String(),
+			BufferSize: int64(stats.Pipes[x].BufferSize),
+			Published:  stats.Pipes[x].Published,
+			Dropped:    stats.Pipes[x].Dropped,
+			Lag:        int64(stats.Pipes[x].Lag),
+			MaxLag:     stats.Pipes[x].MaxLag,
+			Subscribed: stats.Pipes[x].Subscribed.UTC().Format(audit.TableTimeFormat),
+		})
+	}
+	sort.Slice(resp.Pipes, func(i, j int) bool {
+		return resp.Pipes[i].Name < resp.Pipes[j].Name
+	})
+	return resp, nil
+}
+
+// GetOrderReconciliation returns the most recent order reconciliation report
+// of each exchange, reconciling them first if requested
+func (s *RPCServer) GetOrderReconciliation(ctx context.Context, r *gctrpc.GetOrderReconciliationRequest) (*gctrpc.GetOrderReconciliationResponse, error) {
+	var reports []ReconciliationReport
+	var err error
+	if r.Reconcile {
+		reports, err = Bot.OrderManager.Reconcile(r.Exchange)
+	} else {
+		reports, err = Bot.OrderManager.GetReconciliationReports(r.Exchange)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetOrderReconciliationResponse{}
+	for x := range reports {
+		report := &gctrpc.OrderReconciliationReport{
+			Exchange:           reports[x].Exchange,
+			Started:            reports[x].Started.UTC().Format(audit.TableTimeFormat),
+			Finished:           reports[x].Finished.UTC().Format(audit.TableTimeFormat),
+			LocalOpenOrders:    int64(reports[x].LocalOpenOrders),
+			ExchangeOpenOrders: int64(reports[x].ExchangeOpenOrders),
+			Matched:            int64(reports[x].Matched),
+			Error:              reports[x].Error,
+		}
+		for y := range reports[x].Changes {
+			c := &reports[x].Changes[y]
+			report.Changes = append(report.Changes, &gctrpc.OrderReconciliationChange{
+				Exchange:   c.Exchange,
+				Id:         c.ID,
+				InternalId: c.InternalOrderID,
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: c.Pair.Delimiter,
+					Base:      c.Pair.Base.String(),
+					Quote:     c.Pair.Quote.String(),
+				},
+				Kind:                   string(c.Kind),
+				PreviousStatus:         c.PreviousStatus.String(),
+				Status:                 c.Status.String(),
+				PreviousExecutedAmount: c.PreviousExecutedAmount,
+				ExecutedAmount:         c.ExecutedAmount,
+				Amount:                 c.Amount,
+				Reason:                 c.Reason,
+			})
+		}
+		resp.Reports = append(resp.Reports, report)
+	}
+	return resp, nil
+}
+
+// AddConditionalOrder adds an engine managed stop-loss, take-profit or
+// trailing stop order which is submitted when its condition is met
+func (s *RPCServer) AddConditionalOrder(ctx context.Context, r *gctrpc.AddConditionalOrderRequest) (*gctrpc.ConditionalOrder, error) {
+	if r.Pair == nil {
+		return nil, errors.New("currency pair not set")
+	}
+	a := asset.Spot
+	if r.AssetType != "" {
+		a = asset.Item(strings.ToLower(r.AssetType))
+	}
+	o, err := Bot.ConditionalOrderManager.Add(&ConditionalOrder{
+		Exchange:        r.Exchange,
+		Pair:            currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote),
+		Asset:           a,
+		Side:            order.Side(strings.ToUpper(r.Side)),
+		Condition:       ConditionType(strings.ToUpper(r.Condition)),
+		OrderType:       order.Type(strings.ToUpper(r.OrderType)),
+		TriggerPrice:    r.TriggerPrice,
+		TrailingAmount:  r.TrailingAmount,
+		TrailingPercent: r.TrailingPercent,
+		LimitPrice:      r.LimitPrice,
+		Amount:          r.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conditionalOrderToRPC(&o), nil
+}
+
+// CancelConditionalOrder cancels an active conditional order
+func (s *RPCServer) CancelConditionalOrder(ctx context.Context, r *gctrpc.CancelConditionalOrderRequest) (*gctrpc.ConditionalOrder, error) {
+	o, err := Bot.ConditionalOrderManager.Cancel(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return conditionalOrderToRPC(&o), nil
+}
+
+// GetConditionalOrders returns the conditional orders held by the engine
+// filtered by exchange and status
+func (s *RPCServer) GetConditionalOrders(ctx context.Context, r *gctrpc.GetConditionalOrdersRequest) (*gctrpc.GetConditionalOrdersResponse, error) {
+	orders, err := Bot.ConditionalOrderManager.Get(r.Exchange,
+		ConditionalOrderStatus(strings.ToUpper(r.Status)))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetConditionalOrdersResponse{}
+	for x := range orders {
+		resp.Orders = append(resp.Orders, conditionalOrderToRPC(&orders[x]))
+	}
+	return resp, nil
+}
+
+func conditionalOrderToRPC(o *ConditionalOrder) *gctrpc.ConditionalOrder {
+	return &gctrpc.ConditionalOrder{
+		Id:       o.ID,
+		Exchange: o.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Pair.Delimiter,
+			Base:      o.Pair.Base.String(),
+			Quote:     o.Pair.Quote.String(),
+		},
+		AssetType:       o.Asset.String(),
+		Side:            o.Side.String(),
+		Condition:       string(o.Condition),
+		OrderType:       o.OrderType.String(),
+		TriggerPrice:    o.TriggerPrice,
+		TrailingAmount:  o.TrailingAmount,
+		TrailingPercent: o.TrailingPercent,
+		LimitPrice:      o.LimitPrice,
+		Amount:          o.Amount,
+		Watermark:       o.Watermark,
+		Status:          string(o.Status),
+		OrderId:         o.ExchangeOrderID,
+		InternalOrderId: o.OurOrderID,
+		Error:           o.Error,
+		CreatedAt:       o.CreatedAt.UTC().Format(audit.TableTimeFormat),
+		UpdatedAt:       o.UpdatedAt.UTC().Format(audit.TableTimeFormat),
+	}
+}
+
+// AddOCOOrderGroup submits a one-cancels-other order group, a fill or cancel
+// on one leg cancels the others
+func (s *RPCServer) AddOCOOrderGroup(ctx context.Context, r *gctrpc.AddOCOOrderGroupRequest) (*gctrpc.OrderGroup, error) {
+	if r.Pair == nil {
+		return nil, errors.New("currency pair not set")
+	}
+	g := &OrderGroup{
+		Type:     OrderGroupOCO,
+		Exchange: r.Exchange,
+		Pair:     currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote),
+		Asset:    asset.Item(strings.ToLower(r.AssetType)),
+	}
+	for x := range r.Legs {
+		g.Legs = append(g.Legs, orderGroupLegFromRPC(r.Legs[x]))
+	}
+	resp, err := Bot.OrderManager.AddOrderGroup(g)
+	if err != nil {
+		return nil, err
+	}
+	return orderGroupToRPC(&resp), nil
+}
+
+// AddBracketOrderGroup submits the entry of a bracket order group, its
+// take-profit and stop-loss legs are submitted once the entry fills
+func (s *RPCServer) AddBracketOrderGroup(ctx context.Context, r *gctrpc.AddBracketOrderGroupRequest) (*gctrpc.OrderGroup, error) {
+	if r.Pair == nil {
+		return nil, errors.New("currency pair not set")
+	}
+	if r.Entry == nil || r.TakeProfit == nil || r.StopLoss == nil {
+		return nil, errBracketLegsRequired
+	}
+	resp, err := Bot.OrderManager.AddOrderGroup(&OrderGroup{
+		Type:     OrderGroupBracket,
+		Exchange: r.Exchange,
+		Pair:     currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote),
+		Asset:    asset.Item(strings.ToLower(r.AssetType)),
+		Legs: []OrderGroupLeg{
+			orderGroupLegFromRPC(r.Entry),
+			orderGroupLegFromRPC(r.TakeProfit),
+			orderGroupLegFromRPC(r.StopLoss),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orderGroupToRPC(&resp), nil
+}
+
+// CancelOrderGroup cancels the open legs of an active order group
+func (s *RPCServer) CancelOrderGroup(ctx context.Context, r *gctrpc.CancelOrderGroupRequest) (*gctrpc.OrderGroup, error) {
+	resp, err := Bot.OrderManager.CancelOrderGroup(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return orderGroupToRPC(&resp), nil
+}
+
+// GetOrderGroups returns the order groups tracked by the order manager
+// filtered by exchange or ID
+func (s *RPCServer) GetOrderGroups(ctx context.Context, r *gctrpc.GetOrderGroupsRequest) (*gctrpc.GetOrderGroupsResponse, error) {
+	groups, err := Bot.OrderManager.GetOrderGroups(r.Exchange, r.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetOrderGroupsResponse{}
+	for x := range groups {
+		resp.Groups = append(resp.Groups, orderGroupToRPC(&groups[x]))
+	}
+	return resp, nil
+}
+
+func orderGroupLegFromRPC(l *gctrpc.OrderGroupLegRequest) OrderGroupLeg {
+	if l == nil {
+		return OrderGroupLeg{}
+	}
+	return OrderGroupLeg{
+		Side:         order.Side(strings.ToUpper(l.Side)),
+		OrderType:    order.Type(strings.ToUpper(l.OrderType)),
+		Price:        l.Price,
+		TriggerPrice: l.TriggerPrice,
+		Amount:       l.Amount,
+	}
+}
+
+func orderGroupToRPC(g *OrderGroup) *gctrpc.OrderGroup {
+	resp := &gctrpc.OrderGroup{
+		Id:       g.ID,
+		Type:     string(g.Type),
+		Exchange: g.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: g.Pair.Delimiter,
+			Base:      g.Pair.Base.String(),
+			Quote:     g.Pair.Quote.String(),
+		},
+		AssetType: g.Asset.String(),
+		Status:    string(g.Status),
+		Reason:    g.Reason,
+		CreatedAt: g.CreatedAt.UTC().Format(audit.TableTimeFormat),
+		UpdatedAt: g.UpdatedAt.UTC().Format(audit.TableTimeFormat),
+	}
+	for x := range g.Legs {
+		l := &g.Legs[x]
+		resp.Legs = append(resp.Legs, &gctrpc.OrderGroupLeg{
+			Role:               string(l.Role),
+			Side:               l.Side.String(),
+			OrderType:          l.OrderType.String(),
+			Price:              l.Price,
+			TriggerPrice:       l.TriggerPrice,
+			Amount:             l.Amount,
+			ExecutedAmount:     l.ExecutedAmount,
+			Status:             string(l.Status),
+			OrderId:            l.OrderID,
+			InternalOrderId:    l.InternalOrderID,
+			ConditionalOrderId: l.ConditionalOrderID,
+			Error:              l.Error,
+		})
+	}
+	return resp
+}
+
+// AddIcebergOrder submits an iceberg order which shows part of its amount on
+// the book and replaces the visible order as it fills
+func (s *RPCServer) AddIcebergOrder(ctx context.Context, r *gctrpc.AddIcebergOrderRequest) (*gctrpc.IcebergOrder, error) {
+	if r.Pair == nil {
+		return nil, errors.New("currency pair not set")
+	}
+	resp, err := Bot.OrderManager.AddIcebergOrder(&IcebergOrder{
+		Exchange:      r.Exchange,
+		Pair:          currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote),
+		Asset:         asset.Item(strings.ToLower(r.AssetType)),
+		Side:          order.Side(strings.ToUpper(r.Side)),
+		Price:         r.Price,
+		Amount:        r.Amount,
+		DisplayAmount: r.DisplayAmount,
+		SizeVariance:  r.SizeVariance,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return icebergOrderToRPC(&resp), nil
+}
+
+// CancelIcebergOrder cancels an active iceberg order and its visible order
+func (s *RPCServer) CancelIcebergOrder(ctx context.Context, r *gctrpc.CancelIcebergOrderRequest) (*gctrpc.IcebergOrder, error) {
+	resp, err := Bot.OrderManager.CancelIcebergOrder(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return icebergOrderToRPC(&resp), nil
+}
+
+// GetIcebergOrders returns the iceberg orders tracked by the order manager
+// filtered by exchange or ID
+func (s *RPCServer) GetIcebergOrders(ctx context.Context, r *gctrpc.GetIcebergOrdersRequest) (*gctrpc.GetIcebergOrdersResponse, error) {
+	orders, err := Bot.OrderManager.GetIcebergOrders(r.Exchange, r.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetIcebergOrdersResponse{}
+	for x := range orders {
+		resp.Orders = append(resp.Orders, icebergOrderToRPC(&orders[x]))
+	}
+	return resp, nil
+}
+
+func icebergOrderToRPC(i *IcebergOrder) *gctrpc.IcebergOrder {
+	return &gctrpc.IcebergOrder{
+		Id:       i.ID,
+		Exchange: i.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: i.Pair.Delimiter,
+			Base:      i.Pair.Base.String(),
+			Quote:     i.Pair.Quote.String(),
+		},
+		AssetType:       i.Asset.String(),
+		Side:            i.Side.String(),
+		Price:           i.Price,
+		Amount:          i.Amount,
+		DisplayAmount:   i.DisplayAmount,
+		SizeVariance:    i.SizeVariance,
+		ExecutedAmount:  i.ExecutedAmount,
+		Status:          string(i.Status),
+		Reason:          i.Reason,
+		OrderId:         i.OrderID,
+		InternalOrderId: i.InternalOrderID,
+		VisibleAmount:   i.VisibleAmount,
+		Slices:          int64(i.Slices),
+		CreatedAt:       i.CreatedAt.UTC().Format(audit.TableTimeFormat),
+		UpdatedAt:       i.UpdatedAt.UTC().Format(audit.TableTimeFormat),
+	}
+}
+
+// AddExecutionOrder adds a TWAP or VWAP execution order which is sliced into
+// child orders submitted through the order manager over its duration
+func (s *RPCServer) AddExecutionOrder(ctx context.Context, r *gctrpc.AddExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+	if r.Pair == nil {
+		return nil, errors.New("currency pair not set")
+	}
+	duration, err := time.ParseDuration(r.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration %q: %v", r.Duration, err)
+	}
+	var interval time.Duration
+	if r.SliceInterval != "" {
+		interval, err = time.ParseDuration(r.SliceInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slice interval %q: %v", r.SliceInterval, err)
+		}
+	}
+	o, err := Bot.ExecutionManager.Add(&ExecutionOrder{
+		Exchange:          r.Exchange,
+		Pair:              currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote),
+		Asset:             asset.Item(strings.ToLower(r.AssetType)),
+		Side:              order.Side(strings.ToUpper(r.Side)),
+		Algorithm:         ExecutionAlgorithm(strings.ToUpper(r.Algorithm)),
+		Amount:            r.Amount,
+		LimitPrice:        r.LimitPrice,
+		ParticipationRate: r.ParticipationRate,
+		Duration:          duration,
+		SliceInterval:     interval,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return executionOrderToRPC(&o), nil
+}
+
+// PauseExecutionOrder stops a running execution order from submitting child
+// orders and cancels its working child order
+func (s *RPCServer) PauseExecutionOrder(ctx context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+	o, err := Bot.ExecutionManager.Pause(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return executionOrderToRPC(&o), nil
+}
+
+// ResumeExecutionOrder resumes a paused execution order
+func (s *RPCServer) ResumeExecutionOrder(ctx context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+	o, err := Bot.ExecutionManager.Resume(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return executionOrderToRPC(&o), nil
+}
+
+// CancelExecutionOrder cancels an execution order and its working child order
+func (s *RPCServer) CancelExecutionOrder(ctx context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+	o, err := Bot.ExecutionManager.Cancel(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return executionOrderToRPC(&o), nil
+}
+
+// GetExecutionOrders returns the execution orders held by the engine filtered
+// by exchange or ID
+func (s *RPCServer) GetExecutionOrders(ctx context.Context, r *gctrpc.GetExecutionOrdersRequest) (*gctrpc.GetExecutionOrdersResponse, error) {
+	orders, err := Bot.ExecutionManager.Get(r.Exchange, r.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetExecutionOrdersResponse{}
+	for x := range orders {
+		resp.Orders = append(resp.Orders, executionOrderToRPC(&orders[x]))
+	}
+	return resp, nil
+}
+
+func executionOrderToRPC(o *ExecutionOrder) *gctrpc.ExecutionOrder {
+	resp := &gctrpc.ExecutionOrder{
+		Id:       o.ID,
+		Exchange: o.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Pair.Delimiter,
+			Base:      o.Pair.Base.String(),
+			Quote:     o.Pair.Quote.String(),
+		},
+		AssetType:         o.Asset.String(),
+		Side:              o.Side.String(),
+		Algorithm:         string(o.Algorithm),
+		Amount:            o.Amount,
+		LimitPrice:        o.LimitPrice,
+		ParticipationRate: o.ParticipationRate,
+		Duration:          o.Duration.String(),
+		SliceInterval:     o.SliceInterval.String(),
+		Status:            string(o.Status),
+		Reason:            o.Reason,
+		ExecutedAmount:    o.ExecutedAmount,
+		StartTime:         o.StartTime.UTC().Format(audit.TableTimeFormat),
+		EndTime:           o.EndTime.UTC().Format(audit.TableTimeFormat),
+		CreatedAt:         o.CreatedAt.UTC().Format(audit.TableTimeFormat),
+		UpdatedAt:         o.UpdatedAt.UTC().Format(audit.TableTimeFormat),
+	}
+	for x := range o.Children {
+		c := &o.Children[x]
+		resp.Children = append(resp.Children, &gctrpc.ExecutionChild{
+			OrderId:         c.OrderID,
+			InternalOrderId: c.InternalOrderID,
+			Amount:          c.Amount,
+			Price:           c.Price,
+			ExecutedAmount:  c.ExecutedAmount,
+			Status:          c.Status.String(),
+			Submitted:       c.Submitted.UTC().Format(audit.TableTimeFormat),
+			Error:           c.Error,
+		})
+	}
+	return resp
+}
+
 // GCTScriptStatus returns a slice of current running scripts that includes next run time and uuid
 func (s *RPCServer) GCTScriptStatus(ctx context.Context, r *gctrpc.GCTScriptStatusRequest) (*gctrpc.GCTScriptStatusResponse, error) {
 	if !gctscript.GCTScriptConfig.Enabled {
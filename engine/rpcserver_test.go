@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+)
+
+func TestRPCServerSubmitOrderKillSwitch(t *testing.T) {
+	exch := newTestOrderExchange("rpcKillSwitch")
+	defer setupOrderManagerTest(t, exch)()
+	Bot.OrderManager.killSwitchMtx.Lock()
+	Bot.OrderManager.killSwitch = KillSwitchStatus{Active: true, Reason: "test"}
+	Bot.OrderManager.killSwitchMtx.Unlock()
+	defer func() {
+		Bot.OrderManager.killSwitchMtx.Lock()
+		Bot.OrderManager.killSwitch = KillSwitchStatus{}
+		Bot.OrderManager.killSwitchMtx.Unlock()
+	}()
+
+	var s RPCServer
+	_, err := s.SubmitOrder(context.Background(), &gctrpc.SubmitOrderRequest{
+		Exchange:  exch.Name,
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Side:      "BUY",
+		OrderType: "LIMIT",
+		Amount:    1,
+		Price:     100,
+	})
+	if err == nil || !strings.Contains(err.Error(), errKillSwitchActive.Error()) {
+		t.Errorf("expected %v received %v", errKillSwitchActive, err)
+	}
+	if len(exch.submitted) != 0 {
+		t.Errorf("expected no orders sent to the exchange received %v", exch.submitted)
+	}
+}
+
+func TestRPCServerSubmitOrderRiskCheck(t *testing.T) {
+	exch := newTestOrderExchange("rpcRiskCheck")
+	defer setupOrderManagerTest(t, exch)()
+	Bot.OrderManager.risk.load(&config.RiskConfig{
+		Enabled:          true,
+		BaseCurrency:     currency.USD,
+		MaxOrderNotional: 1000,
+	})
+	defer Bot.OrderManager.risk.load(&config.RiskConfig{})
+
+	var s RPCServer
+	r := &gctrpc.SubmitOrderRequest{
+		Exchange:  exch.Name,
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Side:      "BUY",
+		OrderType: "LIMIT",
+		Amount:    20,
+		Price:     100,
+	}
+	_, err := s.SubmitOrder(context.Background(), r)
+	if rejection, ok := err.(*RiskRejection); !ok || rejection.Reason != RiskMaxOrderNotional {
+		t.Errorf("expected %v rejection received %v", RiskMaxOrderNotional, err)
+	}
+	if len(exch.submitted) != 0 {
+		t.Errorf("expected no orders sent to the exchange received %v", exch.submitted)
+	}
+
+	r.Amount = 5
+	resp, err := s.SubmitOrder(context.Background(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.OrderPlaced || resp.OrderId != "1" || len(exch.submitted) != 1 {
+		t.Errorf("expected order 1 placed received %+v", resp)
+	}
+}
+
+func TestRPCServerSubmitOrdersAssetType(t *testing.T) {
+	exch := newTestOrderExchange("rpcBatchAsset")
+	exch.CurrencyPairs.AssetTypes = asset.Items{asset.Spot, asset.Margin}
+	defer setupOrderManagerTest(t, exch)()
+
+	var s RPCServer
+	r := &gctrpc.SubmitOrdersRequest{
+		Exchange: exch.Name,
+		Orders: []*gctrpc.BatchOrder{
+			{
+				Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+				Side:      "BUY",
+				OrderType: "LIMIT",
+				Amount:    1,
+				Price:     100,
+				AssetType: asset.Futures.String(),
+			},
+		},
+	}
+	if _, err := s.SubmitOrders(context.Background(), r); err == nil {
+		t.Error("expected an error for an asset type the exchange does not support")
+	}
+	if len(exch.submitted) != 0 {
+		t.Errorf("expected no orders sent to the exchange received %v", exch.submitted)
+	}
+
+	r.Orders[0].AssetType = asset.Margin.String()
+	resp, err := s.SubmitOrders(context.Background(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Orders) != 1 || !resp.Orders[0].OrderPlaced {
+		t.Errorf("expected the order placed received %+v", resp.Orders)
+	}
+	if len(exch.submitted) != 1 || exch.submitted[0].AssetType != asset.Margin {
+		t.Errorf("expected a margin order submitted received %+v", exch.submitted)
+	}
+}
+
+func TestRPCServerCancelOrder(t *testing.T) {
+	exch := newTestOrderExchange("rpcCancel")
+	defer setupOrderManagerTest(t, exch)()
+	Bot.OrderManager.orderStore.m.Lock()
+	if Bot.OrderManager.orderStore.Orders == nil {
+		Bot.OrderManager.orderStore.Orders = make(map[string][]order.Detail)
+	}
+	Bot.OrderManager.orderStore.Orders[exch.Name] = []order.Detail{
+		{Exchange: exch.Name, ID: "1", Status: order.Active, Amount: 1},
+	}
+	Bot.OrderManager.orderStore.m.Unlock()
+	defer func() {
+		Bot.OrderManager.orderStore.m.Lock()
+		delete(Bot.OrderManager.orderStore.Orders, exch.Name)
+		Bot.OrderManager.orderStore.m.Unlock()
+	}()
+
+	var s RPCServer
+	_, err := s.CancelOrder(context.Background(), &gctrpc.CancelOrderRequest{
+		Exchange:  exch.Name,
+		OrderId:   "1",
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		AssetType: asset.Spot.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exch.cancelled) != 1 || exch.cancelled[0] != "1" {
+		t.Errorf("expected order 1 cancelled received %v", exch.cancelled)
+	}
+	if d, _ := Bot.OrderManager.orderStore.get(exch.Name, "1"); d.Status != order.Cancelled {
+		t.Errorf("expected the stored order cancelled received %v", d.Status)
+	}
+}
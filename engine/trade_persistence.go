@@ -0,0 +1,179 @@
+package engine
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	tradePersistenceManagerName = "Trade persistence"
+
+	// DefaultTradePersistenceBatchSize is the amount of trades buffered before
+	// they are written to the database
+	DefaultTradePersistenceBatchSize = 100
+	// DefaultTradePersistenceFlushInterval is the maximum amount of time
+	// buffered trades are held before they are written to the database
+	DefaultTradePersistenceFlushInterval = time.Second * 10
+)
+
+// tradePersistenceManager batches websocket trade prints and writes them to
+// the trade repository
+type tradePersistenceManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	flush    chan struct{}
+
+	batchSize     int
+	flushInterval time.Duration
+	insert        func(...trade.Data) error
+
+	m      sync.Mutex
+	buffer []trade.Data
+}
+
+// Started returns if the trade persistence manager subsystem is started
+func (t *tradePersistenceManager) Started() bool {
+	return atomic.LoadInt32(&t.started) == 1
+}
+
+// Start starts the trade persistence manager subsystem, a database connection
+// is required
+func (t *tradePersistenceManager) Start() (err error) {
+	if atomic.AddInt32(&t.started, 1) != 1 {
+		return fmt.Errorf("%s %s", tradePersistenceManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	defer func() {
+		if err != nil {
+			atomic.CompareAndSwapInt32(&t.started, 1, 0)
+		}
+	}()
+
+	log.Debugln(log.DatabaseMgr, tradePersistenceManagerName, MsgSubSystemStarting)
+
+	if !Bot.DatabaseManager.Started() {
+		return fmt.Errorf("%s requires the database manager to be running",
+			tradePersistenceManagerName)
+	}
+
+	t.batchSize = Bot.Settings.TradePersistenceBatchSize
+	if t.batchSize <= 0 {
+		t.batchSize = DefaultTradePersistenceBatchSize
+	}
+	t.flushInterval = Bot.Settings.TradePersistenceFlushInterval
+	if t.flushInterval <= 0 {
+		t.flushInterval = DefaultTradePersistenceFlushInterval
+	}
+	if t.insert == nil {
+		t.insert = trade.Insert
+	}
+
+	t.shutdown = make(chan struct{})
+	t.flush = make(chan struct{}, 1)
+	go t.run()
+	return nil
+}
+
+// Stop stops the trade persistence manager subsystem, any buffered trades are
+// written before shutdown completes
+func (t *tradePersistenceManager) Stop() error {
+	if atomic.LoadInt32(&t.started) == 0 {
+		return fmt.Errorf("%s %s", tradePersistenceManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&t.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", tradePersistenceManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.DatabaseMgr, tradePersistenceManagerName, MsgSubSystemShuttingDown)
+	close(t.shutdown)
+	return nil
+}
+
+func (t *tradePersistenceManager) run() {
+	log.Debugln(log.DatabaseMgr, tradePersistenceManagerName, MsgSubSystemStarted)
+	Bot.ServicesWG.Add(1)
+
+	tick := time.NewTicker(t.flushInterval)
+
+	defer func() {
+		tick.Stop()
+		t.writeBuffer()
+		atomic.CompareAndSwapInt32(&t.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&t.started, 1, 0)
+		Bot.ServicesWG.Done()
+		log.Debugln(log.DatabaseMgr, tradePersistenceManagerName, MsgSubSystemShutdown)
+	}()
+
+	for {
+		select {
+		case <-t.shutdown:
+			return
+		case <-tick.C:
+			t.writeBuffer()
+		case <-t.flush:
+			t.writeBuffer()
+		}
+	}
+}
+
+// Add buffers a websocket trade print, signalling a write once the configured
+// batch size has been reached
+func (t *tradePersistenceManager) Add(d *wshandler.TradeData) {
+	if d == nil || atomic.LoadInt32(&t.started) == 0 {
+		return
+	}
+
+	ts := d.Timestamp
+	if ts.IsZero() {
+		// Not all exchanges supply a trade time so the time of receipt is used
+		ts = time.Now()
+	}
+
+	t.m.Lock()
+	t.buffer = append(t.buffer, trade.Data{
+		Exchange:  d.Exchange,
+		Pair:      d.CurrencyPair,
+		AssetType: d.AssetType,
+		Price:     d.Price,
+		Amount:    d.Amount,
+		Side:      d.Side,
+		TID:       d.TID,
+		Timestamp: ts,
+	})
+	full := len(t.buffer) >= t.batchSize
+	t.m.Unlock()
+
+	if full {
+		select {
+		case t.flush <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// writeBuffer writes all buffered trades to the database, trades which fail
+// to write are logged and discarded so the buffer cannot grow unbounded
+func (t *tradePersistenceManager) writeBuffer() {
+	t.m.Lock()
+	trades := t.buffer
+	t.buffer = nil
+	t.m.Unlock()
+
+	if len(trades) == 0 {
+		return
+	}
+
+	err := t.insert(trades...)
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "%s failed to write %d trades: %v",
+			tradePersistenceManagerName, len(trades), err)
+	}
+}
@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+func TestTradePersistenceManagerStart(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+
+	var m tradePersistenceManager
+	if err := m.Start(); err == nil {
+		t.Error("expected error when the database manager is not running")
+	}
+	if m.Started() {
+		t.Error("manager should not be started after a failed start")
+	}
+	if err := m.Stop(); err == nil {
+		t.Error("expected error when stopping a manager which is not started")
+	}
+}
+
+func TestTradePersistenceManagerBatching(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+
+	var mtx sync.Mutex
+	var written []trade.Data
+	m := tradePersistenceManager{
+		started:       1,
+		batchSize:     2,
+		flushInterval: time.Hour,
+		flush:         make(chan struct{}, 1),
+		shutdown:      make(chan struct{}),
+		insert: func(d ...trade.Data) error {
+			mtx.Lock()
+			written = append(written, d...)
+			mtx.Unlock()
+			return nil
+		},
+	}
+
+	d := wshandler.TradeData{
+		Exchange:     "Binance",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+		AssetType:    asset.Spot,
+		Price:        1337,
+		Amount:       1,
+		TID:          "1",
+	}
+	m.Add(&d)
+	select {
+	case <-m.flush:
+		t.Fatal("write should not be signalled before the batch is full")
+	default:
+	}
+
+	m.Add(&d)
+	select {
+	case <-m.flush:
+	default:
+		t.Fatal("expected a write to be signalled once the batch is full")
+	}
+
+	m.writeBuffer()
+	if len(written) != 2 {
+		t.Fatalf("expected 2 trades written received %d", len(written))
+	}
+	if written[0].Timestamp.IsZero() {
+		t.Error("trades without a timestamp should be set to the time received")
+	}
+	if len(m.buffer) != 0 {
+		t.Error("buffer should be empty after a write")
+	}
+
+	m.started = 0
+	m.Add(&d)
+	if len(m.buffer) != 0 {
+		t.Error("trades should not be buffered when the manager is not started")
+	}
+}
@@ -16,19 +16,31 @@ func init() {
 }
 
 // SubscribeToExchangeAccount subcribes to your exchange account
-func SubscribeToExchangeAccount(exchange string) (dispatch.Pipe, error) {
+func SubscribeToExchangeAccount(exchange string) (Pipe, error) {
 	exchange = strings.ToLower(exchange)
 	service.Lock()
 
 	acc, ok := service.accounts[exchange]
 	if !ok {
 		service.Unlock()
-		return dispatch.Pipe{},
+		return Pipe{},
 			fmt.Errorf("%s exchange account holdings not found", exchange)
 	}
 
 	defer service.Unlock()
-	return service.mux.Subscribe(acc.ID)
+	return subscribe(acc.ID, dispatch.SubscribeOptions{
+		Name: "account " + exchange,
+	})
+}
+
+// subscribe returns a pipe relaying the account holdings published to the ID
+func subscribe(id uuid.UUID, opts dispatch.SubscribeOptions) (Pipe, error) {
+	c := make(chan *Holdings)
+	r, err := service.mux.SubscribeRelay(id, opts, c)
+	if err != nil {
+		return Pipe{}, err
+	}
+	return Pipe{C: c, Relay: r}, nil
 }
 
 // Process processes new account holdings updates
@@ -96,7 +96,7 @@ func TestHoldings(t *testing.T) {
 
 	var wg sync.WaitGroup
 	wg.Add(1)
-	go func(p dispatch.Pipe, wg *sync.WaitGroup) {
+	go func(p Pipe, wg *sync.WaitGroup) {
 		for i := 0; i < 2; i++ {
 			c := time.NewTimer(time.Second)
 			select {
@@ -13,6 +13,12 @@ var (
 	service *Service
 )
 
+// Pipe is an account subscription, C receives each holdings update
+type Pipe struct {
+	C <-chan *Holdings
+	*dispatch.Relay
+}
+
 // Service holds ticker information for each individual exchange
 type Service struct {
 	accounts map[string]*Account
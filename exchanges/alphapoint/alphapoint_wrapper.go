@@ -265,6 +265,28 @@ func (a *Alphapoint) CancelAllOrders(orderCancellation *order.Cancel) (order.Can
 		a.CancelAllExistingOrders(orderCancellation.AccountID)
 }
 
+// SubmitOrders submits a batch of orders
+func (a *Alphapoint) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	return exchange.SubmitOrdersConcurrently(s, a.SubmitOrder)
+}
+
+// BatchCancelOrders cancels a batch of orders
+func (a *Alphapoint) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	return exchange.CancelOrdersConcurrently(orders, a.CancelOrder)
+}
+
+// SetDeadMansSwitch arms a timer on the exchange which cancels all open orders
+// unless it is refreshed within the timeout
+func (a *Alphapoint) SetDeadMansSwitch(timeout time.Duration) error {
+	return common.ErrFunctionNotSupported
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (a *Alphapoint) UpdateTradingRules() error {
+	return common.ErrFunctionNotSupported
+}
+
 // GetOrderInfo returns information on a current open order
 func (a *Alphapoint) GetOrderInfo(orderID string) (float64, error) {
 	orders, err := a.GetOrders()
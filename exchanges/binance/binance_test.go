@@ -2,13 +2,16 @@ package binance
 
 import (
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 )
 
@@ -51,6 +54,30 @@ func TestFetchTradablePairs(t *testing.T) {
 	}
 }
 
+func TestFetchTradingRules(t *testing.T) {
+	t.Parallel()
+
+	rules, err := b.FetchTradingRules(asset.Spot)
+	if err != nil {
+		t.Error("Binance FetchTradingRules() error", err)
+	}
+	for x := range rules {
+		if rules[x].PriceTickSize <= 0 || rules[x].AmountStepSize <= 0 {
+			t.Errorf("Binance FetchTradingRules() %v missing price or lot size filter",
+				rules[x].Pair)
+		}
+	}
+}
+
+func TestUpdateTradingRules(t *testing.T) {
+	t.Parallel()
+
+	err := b.UpdateTradingRules()
+	if err != nil {
+		t.Error("Binance UpdateTradingRules() error", err)
+	}
+}
+
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
 
@@ -516,3 +543,71 @@ func TestGetDepositAddress(t *testing.T) {
 		t.Error("Mock GetDepositAddress() error", err)
 	}
 }
+
+func TestGetHistoricCandles(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1560289800, 0)
+	end := start.Add(time.Hour * 2)
+	p := currency.NewPairFromString("BTC-USDT")
+	candles, err := b.GetHistoricCandles(p, asset.Spot, start, end, kline.FiveMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mockTests && len(candles.Candles) != 24 {
+		t.Errorf("expected 24 candles received %d", len(candles.Candles))
+	}
+
+	_, err = b.GetHistoricCandles(p, asset.Spot, start, end, kline.Interval(time.Hour*3))
+	if err == nil {
+		t.Error("expected error on unsupported interval")
+	}
+}
+
+func TestUpdateLocalCacheSequence(t *testing.T) {
+	p := b.GetEnabledPairs(asset.Spot)[0]
+	err := b.Websocket.Orderbook.LoadSnapshot(&orderbook.Base{
+		Pair:         p,
+		AssetType:    asset.Spot,
+		ExchangeName: b.Name,
+		LastUpdateID: 100,
+		Bids:         []orderbook.Item{{Price: 99, Amount: 1}},
+		Asks:         []orderbook.Item{{Price: 101, Amount: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	update := func(first, last int64, amount string) error {
+		return b.UpdateLocalCache(&WebsocketDepthStream{
+			Pair:          b.FormatExchangeCurrency(p, asset.Spot).String(),
+			FirstUpdateID: first,
+			LastUpdateID:  last,
+			UpdateBids:    [][]interface{}{{"99", amount}},
+		})
+	}
+
+	// Updates sent before the snapshot was taken are dropped
+	if err = update(90, 100, "5"); err != nil {
+		t.Fatal(err)
+	}
+	if ob := b.Websocket.Orderbook.GetOrderbook(p, asset.Spot); ob.Bids[0].Amount != 1 {
+		t.Errorf("expected stale update to be dropped received %v", ob.Bids[0].Amount)
+	}
+
+	// The first update spans the snapshot and each update follows on
+	if err = update(95, 105, "2"); err != nil {
+		t.Fatal(err)
+	}
+	if err = update(106, 110, "3"); err != nil {
+		t.Fatal(err)
+	}
+	ob := b.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+	if ob.LastUpdateID != 110 || ob.Bids[0].Amount != 3 {
+		t.Errorf("expected sequenced updates to be applied received %+v", ob)
+	}
+
+	if err = update(112, 115, "4"); err == nil {
+		t.Error("expected a sequence gap to invalidate the orderbook")
+	}
+}
@@ -55,14 +55,6 @@ func (b *Binance) WsConnect() error {
 		kline +
 		"/" +
 		depth
-	enabledPairs := b.GetEnabledPairs(asset.Spot)
-	for i := range enabledPairs {
-		err = b.SeedLocalCache(enabledPairs[i])
-		if err != nil {
-			return err
-		}
-	}
-
 	b.WebsocketConn.URL = wsurl
 	b.WebsocketConn.Verbose = b.Verbose
 
@@ -77,6 +69,16 @@ func (b *Binance) WsConnect() error {
 		MessageType:       websocket.PongMessage,
 		Delay:             pingDelay,
 	})
+
+	// Snapshots are seeded once connected so no depth update is missed, any
+	// update received before a snapshot was taken is dropped as stale
+	enabledPairs := b.GetEnabledPairs(asset.Spot)
+	for i := range enabledPairs {
+		err = b.SeedLocalCache(enabledPairs[i])
+		if err != nil {
+			return err
+		}
+	}
 	go b.WsHandleData()
 
 	return nil
@@ -145,6 +147,7 @@ func (b *Binance) WsHandleData() {
 					Exchange:  b.Name,
 					AssetType: asset.Spot,
 					Side:      trade.EventType,
+					TID:       strconv.FormatInt(trade.TradeID, 10),
 				}
 				continue
 			case "ticker":
@@ -234,16 +237,32 @@ func (b *Binance) WsHandleData() {
 
 // SeedLocalCache seeds depth data
 func (b *Binance) SeedLocalCache(p currency.Pair) error {
-	var newOrderBook orderbook.Base
+	newOrderBook, err := b.fetchDepthSnapshot(p, asset.Spot)
+	if err != nil {
+		return err
+	}
+	return b.Websocket.Orderbook.LoadSnapshot(newOrderBook)
+}
+
+// fetchDepthSnapshot fetches an orderbook snapshot holding the ID of the last
+// update it reflects so depth updates are sequenced against it, it is also
+// used to resynchronise an orderbook that fails verification
+func (b *Binance) fetchDepthSnapshot(p currency.Pair, a asset.Item) (*orderbook.Base, error) {
 	orderbookNew, err := b.GetOrderBook(
 		OrderBookDataRequestParams{
-			Symbol: b.FormatExchangeCurrency(p, asset.Spot).String(),
+			Symbol: b.FormatExchangeCurrency(p, a).String(),
 			Limit:  1000,
 		})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	newOrderBook := &orderbook.Base{
+		Pair:         p,
+		AssetType:    a,
+		ExchangeName: b.Name,
+		LastUpdateID: orderbookNew.LastUpdateID,
+	}
 	for i := range orderbookNew.Bids {
 		newOrderBook.Bids = append(newOrderBook.Bids, orderbook.Item{
 			Amount: orderbookNew.Bids[i].Quantity,
@@ -256,12 +275,7 @@ func (b *Binance) SeedLocalCache(p currency.Pair) error {
 			Price:  orderbookNew.Asks[i].Price,
 		})
 	}
-
-	newOrderBook.Pair = p
-	newOrderBook.AssetType = asset.Spot
-	newOrderBook.ExchangeName = b.Name
-
-	return b.Websocket.Orderbook.LoadSnapshot(&newOrderBook)
+	return newOrderBook, nil
 }
 
 // UpdateLocalCache updates and returns the most recent iteration of the orderbook
@@ -296,10 +310,11 @@ func (b *Binance) UpdateLocalCache(wsdp *WebsocketDepthStream) error {
 		b.GetPairFormat(asset.Spot, true))
 
 	return b.Websocket.Orderbook.Update(&wsorderbook.WebsocketOrderbookUpdate{
-		Bids:     updateBid,
-		Asks:     updateAsk,
-		Pair:     currencyPair,
-		UpdateID: wsdp.LastUpdateID,
-		Asset:    asset.Spot,
+		Bids:          updateBid,
+		Asks:          updateAsk,
+		Pair:          currencyPair,
+		FirstUpdateID: wsdp.FirstUpdateID,
+		UpdateID:      wsdp.LastUpdateID,
+		Asset:         asset.Spot,
 	})
 }
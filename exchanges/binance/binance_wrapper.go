@@ -13,12 +13,14 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -103,6 +105,18 @@ func (b *Binance) SetDefaults() {
 			},
 			WithdrawPermissions: exchange.AutoWithdrawCrypto |
 				exchange.NoFiatWithdrawals,
+			Kline: kline.ExchangeCapabilities{
+				Intervals: map[kline.Interval]string{
+					kline.OneMin:     string(TimeIntervalMinute),
+					kline.FiveMin:    string(TimeIntervalFiveMinutes),
+					kline.FifteenMin: string(TimeIntervalFifteenMinutes),
+					kline.OneHour:    string(TimeIntervalHour),
+					kline.FourHour:   string(TimeIntervalFourHours),
+					kline.OneDay:     string(TimeIntervalDay),
+					kline.OneWeek:    string(TimeIntervalWeek),
+				},
+				ResultLimit: 1000,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -167,6 +181,10 @@ func (b *Binance) Setup(exch *config.ExchangeConfig) error {
 		true,
 		false,
 		exch.Name)
+	// Depth updates span the update IDs U to u which must follow on from the
+	// lastUpdateId of the REST snapshot
+	b.Websocket.Orderbook.SetVerifiers(wsorderbook.SequenceVerifier{})
+	b.Websocket.Orderbook.SetResync(b.fetchDepthSnapshot)
 	return nil
 }
 
@@ -225,35 +243,97 @@ func (b *Binance) Run() {
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
 func (b *Binance) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	var validCurrencyPairs []string
-
 	info, err := b.GetExchangeInfo()
 	if err != nil {
 		return nil, err
 	}
+	return b.tradablePairs(&info, asset), nil
+}
 
+// tradablePairs returns the pairs of the exchange info which are trading
+func (b *Binance) tradablePairs(info *ExchangeInfo, a asset.Item) []string {
+	var validCurrencyPairs []string
 	for x := range info.Symbols {
 		if info.Symbols[x].Status == "TRADING" {
 			validCurrencyPairs = append(validCurrencyPairs, info.Symbols[x].BaseAsset+
-				b.GetPairFormat(asset, false).Delimiter+
+				b.GetPairFormat(a, false).Delimiter+
 				info.Symbols[x].QuoteAsset)
 		}
 	}
-	return validCurrencyPairs, nil
+	return validCurrencyPairs
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are updated
+// from the same response
 func (b *Binance) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+	info, err := b.GetExchangeInfo()
 	if err != nil {
 		return err
 	}
 
-	return b.UpdatePairs(currency.NewPairsFromStrings(pairs),
+	err = b.UpdatePairs(currency.NewPairsFromStrings(b.tradablePairs(&info, asset.Spot)),
 		asset.Spot,
 		false,
 		forceUpdate)
+	if err != nil {
+		return err
+	}
+	return b.LoadTradingRules(asset.Spot, b.tradingRules(&info, asset.Spot))
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (b *Binance) UpdateTradingRules() error {
+	rules, err := b.FetchTradingRules(asset.Spot)
+	if err != nil {
+		return err
+	}
+	return b.LoadTradingRules(asset.Spot, rules)
+}
+
+// FetchTradingRules returns the price and amount filters of each pair which
+// is trading
+func (b *Binance) FetchTradingRules(a asset.Item) ([]order.TradingRules, error) {
+	info, err := b.GetExchangeInfo()
+	if err != nil {
+		return nil, err
+	}
+	return b.tradingRules(&info, a), nil
+}
+
+// tradingRules returns the price and amount filters of each pair of the
+// exchange info which is trading
+func (b *Binance) tradingRules(info *ExchangeInfo, a asset.Item) []order.TradingRules {
+	var rules []order.TradingRules
+	for x := range info.Symbols {
+		if info.Symbols[x].Status != "TRADING" {
+			continue
+		}
+		r := order.TradingRules{
+			Pair: currency.NewPairWithDelimiter(info.Symbols[x].BaseAsset,
+				info.Symbols[x].QuoteAsset,
+				b.GetPairFormat(a, false).Delimiter),
+			Asset: a,
+		}
+		for y := range info.Symbols[x].Filters {
+			f := &info.Symbols[x].Filters[y]
+			switch f.FilterType {
+			case "PRICE_FILTER":
+				r.PriceTickSize = f.TickSize
+				r.MinPrice = f.MinPrice
+				r.MaxPrice = f.MaxPrice
+			case "LOT_SIZE":
+				r.AmountStepSize = f.StepSize
+				r.MinAmount = f.MinQty
+				r.MaxAmount = f.MaxQty
+			case "MIN_NOTIONAL":
+				r.MinNotional = f.MinNotional
+			}
+		}
+		rules = append(rules, r)
+	}
+	return rules
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -498,6 +578,22 @@ func (b *Binance) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, err
 	return cancelAllOrdersResponse, nil
 }
 
+// SubmitOrders submits a batch of orders
+func (b *Binance) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	return exchange.SubmitOrdersConcurrently(s, b.SubmitOrder)
+}
+
+// BatchCancelOrders cancels a batch of orders
+func (b *Binance) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	return exchange.CancelOrdersConcurrently(orders, b.CancelOrder)
+}
+
+// SetDeadMansSwitch arms a timer on the exchange which cancels all open orders
+// unless it is refreshed within the timeout
+func (b *Binance) SetDeadMansSwitch(timeout time.Duration) error {
+	return common.ErrFunctionNotSupported
+}
+
 // GetOrderInfo returns information on a current open order
 func (b *Binance) GetOrderInfo(orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
@@ -658,3 +754,51 @@ func (b *Binance) ValidateCredentials() error {
 	_, err := b.UpdateAccountInfo()
 	return b.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time interval
+func (b *Binance) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := b.ValidateKline(pair, a, start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+
+	intervalString, err := b.FormatExchangeKlineInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	ret := kline.Item{
+		Exchange: b.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+
+	limit := b.Features.Supports.Kline.ResultLimit
+	dates := kline.CalculateCandleDateRanges(start, end, interval, limit)
+	for x := range dates {
+		candles, err := b.GetSpotKline(KlinesRequestParams{
+			Symbol:    b.FormatExchangeCurrency(pair, a).String(),
+			Interval:  TimeInterval(intervalString),
+			Limit:     int(limit),
+			StartTime: dates[x].Start.Unix() * 1000,
+			EndTime:   dates[x].End.Unix() * 1000,
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   time.Unix(0, int64(candles[i].OpenTime)*int64(time.Millisecond)),
+				Open:   candles[i].Open,
+				High:   candles[i].High,
+				Low:    candles[i].Low,
+				Close:  candles[i].Close,
+				Volume: candles[i].Volume,
+			})
+		}
+	}
+
+	ret.Tidy(start, end)
+	return ret, nil
+}
@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -26,6 +27,7 @@ const (
 	// Version 1 API endpoints
 	bitfinexAPIVersion         = "/v1/"
 	bitfinexStats              = "stats/"
+	bitfinexSymbolsDetails     = "symbols_details"
 	bitfinexAccountInfo        = "account_infos"
 	bitfinexAccountFees        = "account_fees"
 	bitfinexAccountSummary     = "summary"
@@ -84,11 +86,13 @@ type Bitfinex struct {
 	WebsocketConn              *wshandler.WebsocketConnection
 	AuthenticatedWebsocketConn *wshandler.WebsocketConnection
 	WebsocketSubdChannels      map[int]WebsocketChanInfo
-}
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Bitfinex) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
+	// deadMansSwitch requests the exchange cancel all open orders when the
+	// authenticated websocket closes, dmsArmed is whether the current
+	// authenticated connection was authenticated with it
+	dmsMtx         sync.Mutex
+	deadMansSwitch bool
+	dmsArmed       bool
 }
 
 // GetPlatformStatus returns the Bifinex platform status
@@ -377,6 +381,14 @@ func (b *Bitfinex) GetStats(symbol string) ([]Stat, error) {
 	return response, b.SendHTTPRequest(path, &response, statsV1)
 }
 
+// GetSymbolsDetails returns the price precision and order size limits of
+// every pair
+func (b *Bitfinex) GetSymbolsDetails() ([]SymbolDetails, error) {
+	var response []SymbolDetails
+	path := b.API.Endpoints.URL + bitfinexAPIVersion + bitfinexSymbolsDetails
+	return response, b.SendHTTPRequest(path, &response, symbolsDetailsV1)
+}
+
 // GetFundingBook the entire margin funding book for both bids and asks sides
 // per currency string
 // symbol - example "USD"
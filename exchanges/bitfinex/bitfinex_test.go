@@ -1,6 +1,8 @@
 package bitfinex
 
 import (
+	"encoding/json"
+	"hash/crc32"
 	"log"
 	"net/http"
 	"os"
@@ -13,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -131,6 +134,14 @@ func TestGetStats(t *testing.T) {
 	}
 }
 
+func TestGetSymbolsDetails(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetSymbolsDetails()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetFundingBook(t *testing.T) {
 	t.Parallel()
 	_, err := b.GetFundingBook("usd")
@@ -155,6 +166,16 @@ func TestGetCandles(t *testing.T) {
 	}
 }
 
+func TestGetHistoricCandles(t *testing.T) {
+	t.Parallel()
+	end := time.Now().Truncate(time.Hour)
+	_, err := b.GetHistoricCandles(currency.NewPairFromString("BTCUSD"),
+		asset.Spot, end.Add(-time.Hour*24), end, kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestGetAccountFees(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.SkipNow()
@@ -1121,3 +1142,167 @@ func TestUpdateTradablePairs(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestFetchTradingRules(t *testing.T) {
+	_, err := b.FetchTradingRules(asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWsChecksumNumber(t *testing.T) {
+	t.Parallel()
+	for value, expected := range map[float64]string{
+		1:         "1",
+		-0.5:      "-0.5",
+		0.000001:  "0.000001",
+		1e-7:      "1e-7",
+		-2.5e-8:   "-2.5e-8",
+		123.45678: "123.45678",
+	} {
+		if s := wsChecksumNumber(value); s != expected {
+			t.Errorf("expected %s received %s", expected, s)
+		}
+	}
+}
+
+func TestWsVerifyOrderbook(t *testing.T) {
+	b.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	p := currency.NewPairFromString("CHKUSD")
+	err := b.WsInsertSnapshot(p, asset.Spot, []WebsocketBook{
+		{ID: 3, Price: 100, Amount: 0.5},
+		{ID: 1, Price: 100, Amount: 2},
+		{ID: 2, Price: 101, Amount: -1},
+		{ID: 4, Price: 99, Amount: 1e-7},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ob := b.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+	serialised := wsChecksumSerialise(ob, wsChecksumDepth)
+	if serialised != "1:2:2:-1:3:0.5:4:1e-7" {
+		t.Errorf("unexpected checksum serialisation %s", serialised)
+	}
+
+	checksum := int32(crc32.ChecksumIEEE([]byte(serialised)))
+	if err = b.WsVerifyOrderbook(p, asset.Spot, checksum); err != nil {
+		t.Error(err)
+	}
+	if err = b.WsVerifyOrderbook(p, asset.Spot, checksum+1); err == nil {
+		t.Error("expected checksum mismatch")
+	}
+	if !b.Websocket.Orderbook.IsInvalid(p, asset.Spot) {
+		t.Error("expected orderbook to be invalidated")
+	}
+}
+
+func TestWsOrderStatus(t *testing.T) {
+	testCases := []struct {
+		status   string
+		expected order.Status
+	}{
+		{"ACTIVE", order.Active},
+		{"EXECUTED @ 107.6(-0.2)", order.Filled},
+		{"PARTIALLY FILLED @ 107.6(-0.1)", order.PartiallyFilled},
+		{"CANCELED was: PARTIALLY FILLED @ 107.6(-0.1)", order.PartiallyCancelled},
+		{"CANCELED", order.Cancelled},
+		{"INSUFFICIENT MARGIN was: PARTIALLY FILLED @ 107.6(-0.1)", order.PartiallyCancelled},
+		{"INSUFFICIENT BALANCE", order.Cancelled},
+		{"RSN_POS_REDUCE_FLIP", order.Cancelled},
+		{"bad", order.UnknownStatus},
+	}
+	for x := range testCases {
+		if s := wsOrderStatus(testCases[x].status); s != testCases[x].expected {
+			t.Errorf("%s expected %v received %v",
+				testCases[x].status, testCases[x].expected, s)
+		}
+	}
+}
+
+func TestWsOrderType(t *testing.T) {
+	testCases := []struct {
+		orderType string
+		expected  order.Type
+	}{
+		{"LIMIT", order.Limit},
+		{"EXCHANGE LIMIT", order.Limit},
+		{"EXCHANGE MARKET", order.Market},
+		{"EXCHANGE STOP", order.Stop},
+		{"STOP LIMIT", order.Stop},
+		{"EXCHANGE TRAILING STOP", order.TrailingStop},
+		{"EXCHANGE IOC", order.ImmediateOrCancel},
+		{"EXCHANGE FOK", order.Unknown},
+	}
+	for x := range testCases {
+		if o := wsOrderType(testCases[x].orderType); o != testCases[x].expected {
+			t.Errorf("%s expected %v received %v",
+				testCases[x].orderType, testCases[x].expected, o)
+		}
+	}
+}
+
+func TestWsHandleOrder(t *testing.T) {
+	b.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	pressXToJSON := []byte(`[0,"ou",[1151718504,null,1337,"tBTCUSD",1584451200000,1584451260000,-0.1,-0.2,"EXCHANGE LIMIT",null,null,null,0,"PARTIALLY FILLED @ 107.6(-0.1)",null,null,107.6,107.6,0,0,null,null,null,0,0,null,null,null,"API>BFX",null,null,null]]`)
+	var response []interface{}
+	err := json.Unmarshal(pressXToJSON, &response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.wsHandleOrder(response[2].([]interface{}))
+	u, ok := (<-b.Websocket.DataHandler).(wshandler.OrderUpdate)
+	if !ok {
+		t.Fatal("expected an order update")
+	}
+	if u.OrderID != "1151718504" ||
+		u.ClientOrderID != "1337" ||
+		u.Status != order.PartiallyFilled ||
+		u.Type != order.Limit ||
+		u.Side != order.Sell ||
+		u.Price != 107.6 ||
+		u.Amount != 0.2 ||
+		u.ExecutedAmount != 0.1 ||
+		u.RemainingAmount != 0.1 ||
+		u.CurrencyPair.String() != "BTCUSD" ||
+		u.Timestamp.Unix() != 1584451260 {
+		t.Errorf("unexpected order update %+v", u)
+	}
+
+	b.wsHandleOrder([]interface{}{1151718504.0, nil, 1337.0})
+	if _, ok = (<-b.Websocket.DataHandler).(error); !ok {
+		t.Error("expected an error for a short order entry")
+	}
+}
+
+func TestWsHandleTradeExecution(t *testing.T) {
+	b.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	pressXToJSON := []byte(`[0,"tu",[402088407,"tBTCUSD",1574963975602,1151718504,-0.1,107.6,"EXCHANGE LIMIT",107.6,1,-0.0215,"USD"]]`)
+	var response []interface{}
+	err := json.Unmarshal(pressXToJSON, &response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.wsHandleTradeExecution(response[2].([]interface{}))
+	f, ok := (<-b.Websocket.DataHandler).(wshandler.FillUpdate)
+	if !ok {
+		t.Fatal("expected a fill update")
+	}
+	if f.TradeID != "402088407" ||
+		f.OrderID != "1151718504" ||
+		f.Side != order.Sell ||
+		f.Price != 107.6 ||
+		f.Amount != 0.1 ||
+		f.Fee != 0.0215 ||
+		f.FeeCurrency != "USD" ||
+		f.CurrencyPair.String() != "BTCUSD" {
+		t.Errorf("unexpected fill update %+v", f)
+	}
+}
+
+func TestSetDeadMansSwitch(t *testing.T) {
+	t.Parallel()
+	if err := b.SetDeadMansSwitch(-time.Second); err == nil {
+		t.Error("expected an error for a negative timeout")
+	}
+}
@@ -507,6 +507,7 @@ const (
 	wsBalanceUpdate                        = "bu"
 	wsMarginInfoUpdate                     = "miu"
 	wsNotification                         = "n"
+	wsOrderSnapshot                        = "os"
 	wsOrderNew                             = "on"
 	wsOrderUpdate                          = "ou"
 	wsOrderCancel                          = "oc"
@@ -516,12 +517,25 @@ const (
 	wsFundingOrderCancel                   = "foc"
 	wsCancelMultipleOrders                 = "oc_multi"
 	wsBook                                 = "book"
+	wsChecksum                             = "cs"
 	wsCandles                              = "candles"
 	wsTicker                               = "ticker"
 	wsTrades                               = "trades"
 	wsError                                = "error"
 )
 
+// wsDeadMansSwitchCancelOnDisconnect is the auth dms flag which cancels all
+// orders when the authenticated websocket closes
+const wsDeadMansSwitchCancelOnDisconnect = 4
+
+// wsOrderbookChecksumFlag is the conf flag which enables orderbook checksum
+// messages
+const wsOrderbookChecksumFlag = 131072
+
+// wsChecksumDepth is the number of levels each side of the orderbook checksum
+// covers
+const wsChecksumDepth = 25
+
 // WsAuthRequest container for WS auth request
 type WsAuthRequest struct {
 	Event         string `json:"event"`
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -37,6 +38,13 @@ func (b *Bitfinex) WsConnect() error {
 		return fmt.Errorf("%v unable to connect to Websocket. Error: %s", b.Name, err)
 	}
 	go b.WsReadData(b.WebsocketConn)
+	err = b.WebsocketConn.SendJSONMessage(map[string]interface{}{
+		"event": "conf",
+		"flags": wsOrderbookChecksumFlag,
+	})
+	if err != nil {
+		return fmt.Errorf("%v unable to enable orderbook checksums. Error: %s", b.Name, err)
+	}
 
 	if b.Websocket.CanUseAuthenticatedEndpoints() {
 		err = b.AuthenticatedWebsocketConn.Dial(&dialer, http.Header{})
@@ -141,6 +149,19 @@ func (b *Bitfinex) WsDataHandler() {
 					case wsBook:
 						var newOrderbook []WebsocketBook
 						curr := currency.NewPairFromString(chanInfo.Pair)
+						if event, ok := chanData[1].(string); ok && event == wsChecksum {
+							checksum, ok := chanData[2].(float64)
+							if !ok {
+								b.Websocket.DataHandler <- errors.New("bitfinex_websocket.go unable to parse orderbook checksum")
+								continue
+							}
+							err := b.WsVerifyOrderbook(curr, asset.Spot, int32(checksum))
+							if err != nil {
+								b.Websocket.DataHandler <- fmt.Errorf("bitfinex_websocket.go orderbook checksum error: %s",
+									err)
+							}
+							continue
+						}
 						if obSnapBundle, ok := chanData[1].([]interface{}); ok {
 							switch id := obSnapBundle[0].(type) {
 							case []interface{}:
@@ -296,6 +317,7 @@ func (b *Bitfinex) WsDataHandler() {
 								Exchange:     b.Name,
 								AssetType:    asset.Spot,
 								Side:         side,
+								TID:          strconv.FormatInt(trades[i].ID, 10),
 							}
 						}
 						continue
@@ -368,22 +390,22 @@ func (b *Bitfinex) WsDataHandler() {
 								}
 								b.Websocket.DataHandler <- position
 							}
-						case wsTradeExecutionUpdate:
-							if tradeData, ok := chanData[2].([]interface{}); ok && len(tradeData) > 4 {
-								b.Websocket.DataHandler <- WebsocketTradeData{
-									TradeID:        int64(tradeData[0].(float64)),
-									Pair:           tradeData[1].(string),
-									Timestamp:      int64(tradeData[2].(float64)),
-									OrderID:        int64(tradeData[3].(float64)),
-									AmountExecuted: tradeData[4].(float64),
-									PriceExecuted:  tradeData[5].(float64),
-									OrderType:      tradeData[6].(string),
-									OrderPrice:     tradeData[7].(float64),
-									Maker:          tradeData[8].(float64) == 1,
-									Fee:            tradeData[9].(float64),
-									FeeCurrency:    tradeData[10].(string),
+						case wsOrderSnapshot:
+							if snapBundle, ok := chanData[2].([]interface{}); ok {
+								for i := range snapBundle {
+									if data, ok := snapBundle[i].([]interface{}); ok {
+										b.wsHandleOrder(data)
+									}
 								}
 							}
+						case wsOrderNew, wsOrderUpdate, wsOrderCancel:
+							if data, ok := chanData[2].([]interface{}); ok {
+								b.wsHandleOrder(data)
+							}
+						case wsTradeExecutionUpdate:
+							if tradeData, ok := chanData[2].([]interface{}); ok && len(tradeData) > 10 {
+								b.wsHandleTradeExecution(tradeData)
+							}
 						case wsFundingOrderSnapshot:
 							var snapshot []WsFundingOffer
 							if snapBundle, ok := chanData[2].([]interface{}); ok && len(snapBundle) > 0 {
@@ -634,6 +656,128 @@ func (b *Bitfinex) WsDataHandler() {
 	}
 }
 
+// wsHandleOrder sends an order stream entry to the data handler as a
+// normalised order update
+func (b *Bitfinex) wsHandleOrder(data []interface{}) {
+	if len(data) < 18 {
+		b.Websocket.DataHandler <- fmt.Errorf("%s - Unexpected order data returned %v", b.Name, data)
+		return
+	}
+
+	id, _ := data[0].(float64)
+	symbol, _ := data[3].(string)
+	updated, _ := data[5].(float64)
+	remaining, _ := data[6].(float64)
+	original, _ := data[7].(float64)
+	orderType, _ := data[8].(string)
+	status, _ := data[13].(string)
+	price, _ := data[16].(float64)
+
+	side := order.Buy
+	if original < 0 {
+		side = order.Sell
+	}
+	remaining = math.Abs(remaining)
+	original = math.Abs(original)
+
+	update := wshandler.OrderUpdate{
+		Timestamp:       time.Unix(0, int64(updated)*int64(time.Millisecond)),
+		CurrencyPair:    wsPairFromSymbol(symbol),
+		AssetType:       asset.Spot,
+		Exchange:        b.Name,
+		OrderID:         strconv.FormatFloat(id, 'f', -1, 64),
+		Side:            side,
+		Type:            wsOrderType(orderType),
+		Status:          wsOrderStatus(status),
+		Price:           price,
+		Amount:          original,
+		ExecutedAmount:  original - remaining,
+		RemainingAmount: remaining,
+	}
+	if cid, ok := data[2].(float64); ok && cid > 0 {
+		update.ClientOrderID = strconv.FormatFloat(cid, 'f', -1, 64)
+	}
+	b.Websocket.DataHandler <- update
+}
+
+// wsHandleTradeExecution sends a trade execution update to the data handler
+// as a normalised fill
+func (b *Bitfinex) wsHandleTradeExecution(data []interface{}) {
+	tradeID, _ := data[0].(float64)
+	symbol, _ := data[1].(string)
+	created, _ := data[2].(float64)
+	orderID, _ := data[3].(float64)
+	amount, _ := data[4].(float64)
+	price, _ := data[5].(float64)
+	fee, _ := data[9].(float64)
+	feeCurrency, _ := data[10].(string)
+
+	side := order.Buy
+	if amount < 0 {
+		side = order.Sell
+	}
+
+	b.Websocket.DataHandler <- wshandler.FillUpdate{
+		Timestamp:    time.Unix(0, int64(created)*int64(time.Millisecond)),
+		CurrencyPair: wsPairFromSymbol(symbol),
+		AssetType:    asset.Spot,
+		Exchange:     b.Name,
+		OrderID:      strconv.FormatFloat(orderID, 'f', -1, 64),
+		TradeID:      strconv.FormatFloat(tradeID, 'f', -1, 64),
+		Side:         side,
+		Price:        price,
+		Amount:       math.Abs(amount),
+		// Fees are returned as a negative amount when paid
+		Fee:         math.Abs(fee),
+		FeeCurrency: feeCurrency,
+	}
+}
+
+// wsPairFromSymbol converts a trading symbol e.g. tBTCUSD to a currency pair
+func wsPairFromSymbol(symbol string) currency.Pair {
+	return currency.NewPairFromString(strings.TrimPrefix(symbol, "t"))
+}
+
+// wsOrderType converts an order stream order type e.g. EXCHANGE LIMIT
+func wsOrderType(orderType string) order.Type {
+	switch strings.TrimPrefix(orderType, "EXCHANGE ") {
+	case "LIMIT":
+		return order.Limit
+	case "MARKET":
+		return order.Market
+	case "STOP", "STOP LIMIT":
+		return order.Stop
+	case "TRAILING STOP":
+		return order.TrailingStop
+	case "IOC":
+		return order.ImmediateOrCancel
+	default:
+		return order.Unknown
+	}
+}
+
+// wsOrderStatus converts an order stream status, closed orders are suffixed
+// with their fill details e.g. EXECUTED @ 107.6(-0.2) or prior status e.g.
+// CANCELED was: PARTIALLY FILLED @ 107.6(-0.1)
+func wsOrderStatus(status string) order.Status {
+	switch {
+	case strings.HasPrefix(status, "ACTIVE"):
+		return order.Active
+	case strings.HasPrefix(status, "EXECUTED"):
+		return order.Filled
+	case strings.HasPrefix(status, "PARTIALLY FILLED"):
+		return order.PartiallyFilled
+	case strings.Contains(status, "PARTIALLY FILLED"):
+		return order.PartiallyCancelled
+	case strings.Contains(status, "CANCELED"),
+		strings.HasPrefix(status, "INSUFFICIENT"),
+		strings.HasPrefix(status, "RSN_"):
+		return order.Cancelled
+	default:
+		return order.UnknownStatus
+	}
+}
+
 // WsInsertSnapshot add the initial orderbook snapshot when subscribed to a
 // channel
 func (b *Bitfinex) WsInsertSnapshot(p currency.Pair, assetType asset.Item, books []WebsocketBook) error {
@@ -726,6 +870,83 @@ func (b *Bitfinex) WsUpdateOrderbook(p currency.Pair, assetType asset.Item, book
 	return nil
 }
 
+// WsVerifyOrderbook verifies the local orderbook against the checksum the
+// exchange sends after each orderbook update
+func (b *Bitfinex) WsVerifyOrderbook(p currency.Pair, assetType asset.Item, checksum int32) error {
+	return b.Websocket.Orderbook.Update(&wsorderbook.WebsocketOrderbookUpdate{
+		Asset:    assetType,
+		Pair:     p,
+		Checksum: uint32(checksum),
+	})
+}
+
+// wsChecksumSerialise serialises the top bids and asks of a raw orderbook
+// alternating as order ID then amount delimited by colons, ask amounts are
+// negative as sent by the exchange
+func wsChecksumSerialise(b *orderbook.Base, depth int) string {
+	var s []string
+	for i := 0; i < depth; i++ {
+		if i < len(b.Bids) {
+			s = append(s,
+				strconv.FormatInt(b.Bids[i].ID, 10),
+				wsChecksumNumber(b.Bids[i].Amount))
+		}
+		if i < len(b.Asks) {
+			s = append(s,
+				strconv.FormatInt(b.Asks[i].ID, 10),
+				wsChecksumNumber(-b.Asks[i].Amount))
+		}
+	}
+	return strings.Join(s, ":")
+}
+
+// wsChecksumNumber formats an amount as the exchange does when calculating the
+// checksum, amounts smaller than 1e-6 are in exponent notation without a
+// padded exponent
+func wsChecksumNumber(f float64) string {
+	if f != 0 && math.Abs(f) < 1e-6 {
+		s := strconv.FormatFloat(f, 'e', -1, 64)
+		i := strings.IndexByte(s, 'e')
+		exponent, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return s
+		}
+		return s[:i+1] + strconv.Itoa(exponent)
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// wsOrderbookResync fetches a raw orderbook snapshot to resynchronise an
+// orderbook that failed checksum verification
+func (b *Bitfinex) wsOrderbookResync(p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+	b.appendOptionalDelimiter(&p)
+	orderbookNew, err := b.GetOrderbook("t"+p.String(), "R0", 100)
+	if err != nil {
+		return nil, err
+	}
+
+	ob := &orderbook.Base{
+		Pair:         p,
+		AssetType:    a,
+		ExchangeName: b.Name,
+	}
+	for x := range orderbookNew.Bids {
+		ob.Bids = append(ob.Bids, orderbook.Item{
+			ID:     orderbookNew.Bids[x].OrderID,
+			Price:  orderbookNew.Bids[x].Price,
+			Amount: orderbookNew.Bids[x].Amount,
+		})
+	}
+	for x := range orderbookNew.Asks {
+		ob.Asks = append(ob.Asks, orderbook.Item{
+			ID:     orderbookNew.Asks[x].OrderID,
+			Price:  orderbookNew.Asks[x].Price,
+			Amount: orderbookNew.Asks[x].Amount,
+		})
+	}
+	return ob, nil
+}
+
 // GenerateDefaultSubscriptions Adds default subscriptions to websocket to be handled by ManageSubscriptions()
 func (b *Bitfinex) GenerateDefaultSubscriptions() {
 	var channels = []string{
@@ -808,6 +1029,12 @@ func (b *Bitfinex) WsSendAuth() error {
 	}
 	nonce := strconv.FormatInt(time.Now().Unix(), 10)
 	payload := "AUTH" + nonce
+	b.dmsMtx.Lock()
+	defer b.dmsMtx.Unlock()
+	var dms int64
+	if b.deadMansSwitch {
+		dms = wsDeadMansSwitchCancelOnDisconnect
+	}
 	request := WsAuthRequest{
 		Event:       "auth",
 		APIKey:      b.API.Credentials.Key,
@@ -818,16 +1045,35 @@ func (b *Bitfinex) WsSendAuth() error {
 				[]byte(payload),
 				[]byte(b.API.Credentials.Secret))),
 		AuthNonce:     nonce,
-		DeadManSwitch: 0,
+		DeadManSwitch: dms,
 	}
 	err := b.AuthenticatedWebsocketConn.SendJSONMessage(request)
 	if err != nil {
 		b.Websocket.SetCanUseAuthenticatedEndpoints(false)
 		return err
 	}
+	b.dmsArmed = b.deadMansSwitch
 	return nil
 }
 
+// wsReconnect closes the websocket connections and reconnects them so the
+// authenticated connection authenticates again with the current dead man's
+// switch flag
+func (b *Bitfinex) wsReconnect() error {
+	for _, conn := range []*wshandler.WebsocketConnection{b.WebsocketConn, b.AuthenticatedWebsocketConn} {
+		if conn.Connection == nil {
+			continue
+		}
+		if err := conn.Connection.Close(); err != nil {
+			log.Warnf(log.ExchangeSys, "%v unable to close websocket connection. Error: %s\n", b.Name, err)
+		}
+	}
+	if err := b.Websocket.Shutdown(); err != nil {
+		return err
+	}
+	return b.Websocket.Connect()
+}
+
 // WsAddSubscriptionChannel adds a new subscription channel to the
 // WebsocketSubdChannels map in bitfinex.go (Bitfinex struct)
 func (b *Bitfinex) WsAddSubscriptionChannel(chanID int, channel, pair string) {
@@ -13,12 +13,14 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -113,9 +115,21 @@ func (b *Bitfinex) SetDefaults() {
 				AuthenticatedEndpoints: true,
 				MessageCorrelation:     true,
 				DeadMansSwitch:         true,
+				OrderUpdates:           true,
 			},
 			WithdrawPermissions: exchange.AutoWithdrawCryptoWithAPIPermission |
 				exchange.AutoWithdrawFiatWithAPIPermission,
+			Kline: kline.ExchangeCapabilities{
+				Intervals: map[kline.Interval]string{
+					kline.OneMin:     "1m",
+					kline.FiveMin:    "5m",
+					kline.FifteenMin: "15m",
+					kline.OneHour:    "1h",
+					kline.OneDay:     "1D",
+					kline.OneWeek:    "7D",
+				},
+				ResultLimit: 5000,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -189,6 +203,11 @@ func (b *Bitfinex) Setup(exch *config.ExchangeConfig) error {
 		false,
 		true,
 		exch.Name)
+	b.Websocket.Orderbook.SetVerifiers(&wsorderbook.CRC32Verifier{
+		Depth:     wsChecksumDepth,
+		Serialise: wsChecksumSerialise,
+	})
+	b.Websocket.Orderbook.SetResync(b.wsOrderbookResync)
 	return nil
 }
 
@@ -253,7 +272,7 @@ func (b *Bitfinex) FetchTradablePairs(a asset.Item) ([]string, error) {
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config along with their trading rules
 func (b *Bitfinex) UpdateTradablePairs(forceUpdate bool) error {
 	for i := range b.CurrencyPairs.AssetTypes {
 		pairs, err := b.FetchTradablePairs(b.CurrencyPairs.AssetTypes[i])
@@ -268,7 +287,42 @@ func (b *Bitfinex) UpdateTradablePairs(forceUpdate bool) error {
 			return err
 		}
 	}
-	return nil
+	return b.UpdateTradingRules()
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (b *Bitfinex) UpdateTradingRules() error {
+	rules, err := b.FetchTradingRules(asset.Spot)
+	if err != nil {
+		return err
+	}
+	return b.LoadTradingRules(asset.Spot, rules)
+}
+
+// FetchTradingRules returns the minimum and maximum order size of each pair.
+// Prices are limited to significant digits rather than a tick size so no price
+// rules are returned.
+func (b *Bitfinex) FetchTradingRules(a asset.Item) ([]order.TradingRules, error) {
+	if a != asset.Spot {
+		return nil, errors.New("asset type not supported by this endpoint")
+	}
+
+	details, err := b.GetSymbolsDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]order.TradingRules, len(details))
+	for x := range details {
+		rules[x] = order.TradingRules{
+			Pair:      currency.NewPairFromString(strings.ToUpper(details[x].Pair)),
+			Asset:     a,
+			MinAmount: details[x].MinimumOrderSize,
+			MaxAmount: details[x].MaximumOrderSize,
+		}
+	}
+	return rules, nil
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -515,6 +569,42 @@ func (b *Bitfinex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, er
 	return order.CancelAllResponse{}, err
 }
 
+// SubmitOrders submits a batch of orders
+func (b *Bitfinex) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	return exchange.SubmitOrdersConcurrently(s, b.SubmitOrder)
+}
+
+// BatchCancelOrders cancels a batch of orders
+func (b *Bitfinex) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	return exchange.CancelOrdersConcurrently(orders, b.CancelOrder)
+}
+
+// SetDeadMansSwitch requests the exchange cancel all open orders when the
+// authenticated websocket closes, a zero timeout disarms it. The timeout is
+// otherwise unused as the connection itself is the heartbeat. The switch is set
+// when the websocket authenticates and Bitfinex rejects a second auth request
+// on an authenticated connection, so a connected websocket is reconnected to
+// authenticate again with the changed switch.
+func (b *Bitfinex) SetDeadMansSwitch(timeout time.Duration) error {
+	if timeout < 0 {
+		return errors.New("dead man's switch timeout cannot be negative")
+	}
+	if !b.Websocket.IsEnabled() ||
+		!b.GetAuthenticatedAPISupport(exchange.WebsocketAuthentication) {
+		return errors.New("dead man's switch requires an authenticated websocket connection")
+	}
+	b.dmsMtx.Lock()
+	b.deadMansSwitch = timeout > 0
+	reauth := b.Websocket.IsConnected() &&
+		b.Websocket.CanUseAuthenticatedEndpoints() &&
+		b.dmsArmed != b.deadMansSwitch
+	b.dmsMtx.Unlock()
+	if !reauth {
+		return nil
+	}
+	return b.wsReconnect()
+}
+
 // GetOrderInfo returns information on a current open order
 func (b *Bitfinex) GetOrderInfo(orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
@@ -756,3 +846,58 @@ func (b *Bitfinex) ValidateCredentials() error {
 	_, err := b.UpdateAccountInfo()
 	return b.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time interval
+func (b *Bitfinex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := b.ValidateKline(pair, a, start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+
+	intervalString, err := b.FormatExchangeKlineInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	ret := kline.Item{
+		Exchange: b.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+
+	p := pair
+	b.appendOptionalDelimiter(&p)
+	var prefix = "t"
+	if a == asset.Margin {
+		prefix = "f"
+	}
+
+	limit := b.Features.Supports.Kline.ResultLimit
+	dates := kline.CalculateCandleDateRanges(start, end, interval, limit)
+	for x := range dates {
+		candles, err := b.GetCandles(prefix+p.String(),
+			intervalString,
+			dates[x].Start.Unix()*1000,
+			dates[x].End.Unix()*1000,
+			int64(limit),
+			true,
+			true)
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   time.Unix(0, candles[i].Timestamp*int64(time.Millisecond)),
+				Open:   candles[i].Open,
+				High:   candles[i].High,
+				Low:    candles[i].Low,
+				Close:  candles[i].Close,
+				Volume: candles[i].Volume,
+			})
+		}
+	}
+
+	ret.Tidy(start, end)
+	return ret, nil
+}
@@ -88,6 +88,7 @@ const (
 	orderV1ReqRate           = 10 // This is not specified just inputed above
 	orderMultiReqRate        = 10 // This is not specified just inputed above
 	statsV1ReqRate           = 10
+	symbolsDetailsV1ReqRate  = 10 // This is not specified just inputed above
 	fundingbookReqRate       = 15
 	lendsReqRate             = 30
 
@@ -169,6 +170,7 @@ const (
 	orderV1
 	orderMulti
 	statsV1
+	symbolsDetailsV1
 	fundingbook
 	lends
 )
@@ -249,6 +251,7 @@ type RateLimit struct {
 	OrderV1           *rate.Limiter
 	OrderMulti        *rate.Limiter
 	StatsV1           *rate.Limiter
+	SymbolsDetailsV1  *rate.Limiter
 	Fundingbook       *rate.Limiter
 	Lends             *rate.Limiter
 }
@@ -396,6 +399,8 @@ func (r *RateLimit) Limit(f request.EndpointLimit) error {
 		time.Sleep(r.OrderMulti.Reserve().Delay())
 	case statsV1:
 		time.Sleep(r.Stats.Reserve().Delay())
+	case symbolsDetailsV1:
+		time.Sleep(r.SymbolsDetailsV1.Reserve().Delay())
 	case fundingbook:
 		time.Sleep(r.Fundingbook.Reserve().Delay())
 	case lends:
@@ -483,6 +488,7 @@ func SetRateLimit() *RateLimit {
 		OrderV1:           request.NewRateLimit(requestLimitInterval, orderV1ReqRate),
 		OrderMulti:        request.NewRateLimit(requestLimitInterval, orderMultiReqRate),
 		StatsV1:           request.NewRateLimit(requestLimitInterval, statsV1ReqRate),
+		SymbolsDetailsV1:  request.NewRateLimit(requestLimitInterval, symbolsDetailsV1ReqRate),
 		Fundingbook:       request.NewRateLimit(requestLimitInterval, fundingbookReqRate),
 		Lends:             request.NewRateLimit(requestLimitInterval, lendsReqRate),
 	}
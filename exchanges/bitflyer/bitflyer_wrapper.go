@@ -3,6 +3,7 @@ package bitflyer
 import (
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
@@ -306,6 +307,28 @@ func (b *Bitflyer) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, er
 	return order.CancelAllResponse{}, common.ErrNotYetImplemented
 }
 
+// SubmitOrders submits a batch of orders
+func (b *Bitflyer) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	return exchange.SubmitOrdersConcurrently(s, b.SubmitOrder)
+}
+
+// BatchCancelOrders cancels a batch of orders
+func (b *Bitflyer) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	return exchange.CancelOrdersConcurrently(orders, b.CancelOrder)
+}
+
+// SetDeadMansSwitch arms a timer on the exchange which cancels all open orders
+// unless it is refreshed within the timeout
+func (b *Bitflyer) SetDeadMansSwitch(timeout time.Duration) error {
+	return common.ErrFunctionNotSupported
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (b *Bitflyer) UpdateTradingRules() error {
+	return common.ErrFunctionNotSupported
+}
+
 // GetOrderInfo returns information on a current open order
 func (b *Bitflyer) GetOrderInfo(orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
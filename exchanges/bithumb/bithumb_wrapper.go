@@ -405,6 +405,28 @@ func (b *Bithumb) CancelAllOrders(orderCancellation *order.Cancel) (order.Cancel
 	return cancelAllOrdersResponse, nil
 }
 
+// SubmitOrders submits a batch of orders
+func (b *Bithumb) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	return exchange.SubmitOrdersConcurrently(s, b.SubmitOrder)
+}
+
+// BatchCancelOrders cancels a batch of orders
+func (b *Bithumb) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	return exchange.CancelOrdersConcurrently(orders, b.CancelOrder)
+}
+
+// SetDeadMansSwitch arms a timer on the exchange which cancels all open orders
+// unless it is refreshed within the timeout
+func (b *Bithumb) SetDeadMansSwitch(timeout time.Duration) error {
+	return common.ErrFunctionNotSupported
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (b *Bithumb) UpdateTradingRules() error {
+	return common.ErrFunctionNotSupported
+}
+
 // GetOrderInfo returns information on a current open order
 func (b *Bithumb) GetOrderInfo(orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
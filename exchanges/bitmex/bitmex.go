@@ -9,7 +9,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -350,9 +349,26 @@ func (b *Bitmex) CreateOrder(params *OrderNewParams) (Order, error) {
 		&orderInfo)
 }
 
+// CancelExistingOrders cancels one or a batch of orders on the exchange and
+// returns a cancelled order list
+func (b *Bitmex) CancelExistingOrders(params *OrderCancelParams) ([]Order, error) {
+	var cancelledOrders []Order
+
+	return cancelledOrders, b.SendAuthenticatedHTTPRequest(http.MethodDelete,
+		bitmexEndpointOrder,
+		params,
+		&cancelledOrders)
+}
+
 // CancelOrders cancels one or a batch of orders on the exchange and returns
-// a cancelled order list
+// a cancelled order list, it calls CancelExistingOrders
 func (b *Bitmex) CancelOrders(params *OrderCancelParams) ([]Order, error) {
+	return b.CancelExistingOrders(params)
+}
+
+// CancelBulkOrders cancels a batch of orders on the exchange and returns a
+// cancelled order list
+func (b *Bitmex) CancelBulkOrders(params OrderCancelBulkParams) ([]Order, error) {
 	var cancelledOrders []Order
 
 	return cancelledOrders, b.SendAuthenticatedHTTPRequest(http.MethodDelete,
@@ -391,14 +407,16 @@ func (b *Bitmex) CreateBulkOrders(params OrderNewBulkParams) ([]Order, error) {
 		&orders)
 }
 
-// CancelAllOrdersAfterTime closes all positions after a certain time period
-func (b *Bitmex) CancelAllOrdersAfterTime(params OrderCancelAllAfterParams) ([]Order, error) {
-	var cancelledOrder []Order
+// CancelAllOrdersAfterTime arms a dead man's switch which cancels all orders
+// once the timeout elapses unless it is called again, a zero timeout cancels
+// the timer
+func (b *Bitmex) CancelAllOrdersAfterTime(params OrderCancelAllAfterParams) (CancelAllAfterResponse, error) {
+	var resp CancelAllAfterResponse
 
-	return cancelledOrder, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return resp, b.SendAuthenticatedHTTPRequest(http.MethodPost,
 		bitmexEndpointCancelOrderAfter,
 		params,
-		&cancelledOrder)
+		&resp)
 }
 
 // ClosePosition closes a position WARNING deprecated use /order endpoint
@@ -534,6 +552,16 @@ func (b *Bitmex) GetPreviousTrades(params *TradeGetBucketedParams) ([]Trade, err
 		&trade)
 }
 
+// GetTradeBuckets returns open, high, low and close prices and volume of trades
+// in time buckets, bucket timestamps are the close of the bucket
+func (b *Bitmex) GetTradeBuckets(params *TradeGetBucketedParams) ([]TradeBucket, error) {
+	var buckets []TradeBucket
+
+	return buckets, b.SendHTTPRequest(bitmexEndpointTradeBucketed,
+		params,
+		&buckets)
+}
+
 // GetUserInfo returns your user information
 func (b *Bitmex) GetUserInfo() (User, error) {
 	var userInfo User
@@ -903,8 +931,3 @@ func calculateTradingFee(purchasePrice, amount float64, isMaker bool) float64 {
 
 	return fee * purchasePrice * amount
 }
-
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Bitmex) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
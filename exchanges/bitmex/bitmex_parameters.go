@@ -441,6 +441,32 @@ func (p OrderCancelParams) IsNil() bool {
 	return p == (OrderCancelParams{})
 }
 
+// OrderCancelBulkParams contains all the parameters to send to the API
+// endpoint for cancelling a batch of orders
+type OrderCancelBulkParams struct {
+	// OrderIDs - Order IDs.
+	OrderIDs []string `json:"orderID,omitempty"`
+
+	// Text - [Optional] cancellation annotation. e.g. 'Spread Exceeded'.
+	Text string `json:"text,omitempty"`
+}
+
+// VerifyData verifies outgoing data sets
+func (p OrderCancelBulkParams) VerifyData() error {
+	return nil
+}
+
+// ToURLVals converts struct values to url.values and encodes it on the supplied
+// path
+func (p OrderCancelBulkParams) ToURLVals(path string) (string, error) {
+	return "", nil
+}
+
+// IsNil checks to see if any values has been set for the paramater
+func (p OrderCancelBulkParams) IsNil() bool {
+	return len(p.OrderIDs) == 0
+}
+
 // OrderCancelAllParams contains all the parameters to send to the API endpoint
 // for cancelling all your orders
 type OrderCancelAllParams struct {
@@ -520,7 +546,7 @@ func (p OrderNewBulkParams) IsNil() bool {
 // endpoint
 type OrderCancelAllAfterParams struct {
 	// Timeout in ms. Set to 0 to cancel this timer.
-	Timeout float64 `json:"timeout,omitempty"`
+	Timeout float64 `json:"timeout"`
 }
 
 // VerifyData verifies outgoing data sets
@@ -842,7 +868,11 @@ func (p *TradeGetBucketedParams) VerifyData() error {
 // ToURLVals converts struct values to url.values and encodes it on the supplied
 // path
 func (p *TradeGetBucketedParams) ToURLVals(path string) (string, error) {
-	return "", nil
+	values, err := StructValsToURLVals(p)
+	if err != nil {
+		return "", err
+	}
+	return common.EncodeURLValues(path, values), nil
 }
 
 // IsNil checks to see if any values has been set for the paramater
@@ -14,6 +14,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -239,10 +241,17 @@ func TestCreateOrder(t *testing.T) {
 	}
 }
 
-func TestCancelOrders(t *testing.T) {
-	_, err := b.CancelOrders(&OrderCancelParams{})
+func TestCancelExistingOrders(t *testing.T) {
+	_, err := b.CancelExistingOrders(&OrderCancelParams{})
 	if err == nil {
-		t.Error("CancelOrders() Expected error")
+		t.Error("CancelExistingOrders() Expected error")
+	}
+}
+
+func TestCancelBulkOrders(t *testing.T) {
+	_, err := b.CancelBulkOrders(OrderCancelBulkParams{})
+	if err == nil {
+		t.Error("CancelBulkOrders() Expected error")
 	}
 }
 
@@ -375,6 +384,26 @@ func TestGetPreviousTrades(t *testing.T) {
 	}
 }
 
+func TestGetTradeBuckets(t *testing.T) {
+	_, err := b.GetTradeBuckets(&TradeGetBucketedParams{
+		BinSize: "1h",
+		Count:   10,
+		Symbol:  "XBTUSD",
+		Reverse: true})
+	if err != nil {
+		t.Error("GetTradeBuckets() error", err)
+	}
+}
+
+func TestGetHistoricCandles(t *testing.T) {
+	end := time.Now().Truncate(time.Hour)
+	_, err := b.GetHistoricCandles(currency.NewPairFromString("XBTUSD"),
+		asset.PerpetualContract, end.Add(-time.Hour*24), end, kline.OneHour)
+	if err != nil {
+		t.Error("GetHistoricCandles() error", err)
+	}
+}
+
 func setFeeBuilder() *exchange.FeeBuilder {
 	return &exchange.FeeBuilder{
 		Amount:        1,
@@ -583,6 +612,29 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 	}
 }
 
+func TestSetDeadMansSwitch(t *testing.T) {
+	if areTestAPIKeysSet() && !canManipulateRealOrders {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+
+	if err := b.SetDeadMansSwitch(-time.Second); err == nil {
+		t.Error("Expecting an error with a negative timeout")
+	}
+
+	err := b.SetDeadMansSwitch(time.Minute)
+	if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+	if areTestAPIKeysSet() && err != nil {
+		t.Errorf("Could not arm dead man's switch: %v", err)
+	}
+
+	err = b.SetDeadMansSwitch(0)
+	if areTestAPIKeysSet() && err != nil {
+		t.Errorf("Could not disarm dead man's switch: %v", err)
+	}
+}
+
 func TestGetAccountInfo(t *testing.T) {
 	if areTestAPIKeysSet() {
 		_, err := b.UpdateAccountInfo()
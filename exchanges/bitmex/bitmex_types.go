@@ -321,6 +321,13 @@ type Order struct {
 	WorkingIndicator      bool    `json:"workingIndicator"`
 }
 
+// CancelAllAfterResponse is the state of the dead man's switch timer,
+// CancelTime is zero once the timer has been cancelled
+type CancelAllAfterResponse struct {
+	Now        string      `json:"now"`
+	CancelTime interface{} `json:"cancelTime"`
+}
+
 // OrderBookL2 contains order book l2
 type OrderBookL2 struct {
 	ID     int64   `json:"id"`
@@ -491,6 +498,24 @@ type Trade struct {
 	TrdMatchID      string  `json:"trdMatchID"`
 }
 
+// TradeBucket holds the trades of an instrument over a bin size, Timestamp is
+// the close of the bucket and HomeNotional is the volume in the base currency
+type TradeBucket struct {
+	Timestamp       time.Time `json:"timestamp"`
+	Symbol          string    `json:"symbol"`
+	Open            float64   `json:"open"`
+	High            float64   `json:"high"`
+	Low             float64   `json:"low"`
+	Close           float64   `json:"close"`
+	Trades          int64     `json:"trades"`
+	Volume          int64     `json:"volume"`
+	VWAP            float64   `json:"vwap"`
+	LastSize        int64     `json:"lastSize"`
+	Turnover        int64     `json:"turnover"`
+	HomeNotional    float64   `json:"homeNotional"`
+	ForeignNotional float64   `json:"foreignNotional"`
+}
+
 // User Account Operations
 type User struct {
 	TFAEnabled   string          `json:"TFAEnabled"`
@@ -262,7 +262,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 						b.Websocket.DataHandler <- err
 						continue
 					}
-					b.Websocket.DataHandler <- response
+					b.processExecutions(response.Data)
 				case bitmexWSOrder:
 					var response WsOrderResponse
 					err = json.Unmarshal(resp.Raw, &response)
@@ -270,7 +270,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 						b.Websocket.DataHandler <- err
 						continue
 					}
-					b.Websocket.DataHandler <- response
+					b.processOrders(response.Data)
 				case bitmexWSMargin:
 					var response WsMarginResponse
 					err = json.Unmarshal(resp.Raw, &response)
@@ -394,6 +394,111 @@ func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPai
 	return nil
 }
 
+// processOrders sends private order data to the data handler as normalised
+// order updates
+func (b *Bitmex) processOrders(data []WsOrderData) {
+	for i := range data {
+		update := wshandler.OrderUpdate{
+			Timestamp:       data[i].Timestamp,
+			Exchange:        b.Name,
+			OrderID:         data[i].OrderID,
+			ClientOrderID:   data[i].ClOrdID,
+			Status:          wsOrderStatus(data[i].OrdStatus),
+			Price:           data[i].Price,
+			Amount:          data[i].OrderQty,
+			ExecutedAmount:  data[i].CumQty,
+			RemainingAmount: data[i].LeavesQty,
+		}
+		if data[i].Symbol != "" {
+			update.CurrencyPair, update.AssetType = b.wsPairAndAsset(data[i].Symbol)
+		}
+		if data[i].Side != "" {
+			update.Side = order.Side(strings.ToUpper(data[i].Side))
+		}
+		if data[i].OrdType != "" {
+			update.Type = wsOrderType(data[i].OrdType)
+		}
+		b.Websocket.DataHandler <- update
+	}
+}
+
+// processExecutions sends private trade executions to the data handler as
+// normalised fills, other execution types are reflected by the order table
+func (b *Bitmex) processExecutions(data []WsExecutionData) {
+	for i := range data {
+		if data[i].ExecType != "Trade" {
+			continue
+		}
+		fill := wshandler.FillUpdate{
+			Timestamp:     data[i].Timestamp,
+			Exchange:      b.Name,
+			OrderID:       data[i].OrderID,
+			ClientOrderID: data[i].ClOrdID,
+			TradeID:       data[i].ExecID,
+			Side:          order.Side(strings.ToUpper(data[i].Side)),
+			Price:         data[i].LastPx,
+			Amount:        data[i].LastQty,
+			Fee:           data[i].ExecComm,
+			FeeCurrency:   data[i].SettlCurrency,
+		}
+		// Commission settled in XBt is denominated in satoshis
+		if data[i].SettlCurrency == "XBt" {
+			fill.Fee = data[i].ExecComm / 1e8
+			fill.FeeCurrency = currency.XBT.String()
+		}
+		fill.CurrencyPair, fill.AssetType = b.wsPairAndAsset(data[i].Symbol)
+		b.Websocket.DataHandler <- fill
+	}
+}
+
+// wsPairAndAsset returns the currency pair and asset type of a symbol
+func (b *Bitmex) wsPairAndAsset(symbol string) (currency.Pair, asset.Item) {
+	p := currency.NewPairFromString(symbol)
+	a, err := b.GetPairAssetType(p)
+	if err != nil {
+		b.Websocket.DataHandler <- err
+	}
+	return p, a
+}
+
+// wsOrderStatus converts an order status e.g. PartiallyFilled
+func wsOrderStatus(status string) order.Status {
+	switch status {
+	case "":
+		return ""
+	case "New":
+		return order.New
+	case "PartiallyFilled":
+		return order.PartiallyFilled
+	case "Filled":
+		return order.Filled
+	case "Canceled":
+		return order.Cancelled
+	case "PendingCancel":
+		return order.PendingCancel
+	case "Rejected":
+		return order.Rejected
+	case "Expired":
+		return order.Expired
+	default:
+		return order.UnknownStatus
+	}
+}
+
+// wsOrderType converts an order type e.g. StopLimit
+func wsOrderType(ordType string) order.Type {
+	switch ordType {
+	case "Limit":
+		return order.Limit
+	case "Market":
+		return order.Market
+	case "Stop", "StopLimit":
+		return order.Stop
+	default:
+		return order.Unknown
+	}
+}
+
 // GenerateDefaultSubscriptions Adds default subscriptions to websocket to be handled by ManageSubscriptions()
 func (b *Bitmex) GenerateDefaultSubscriptions() {
 	assets := b.GetAssetTypes()
@@ -1,5 +1,7 @@
 package bitmex
 
+import "time"
+
 // WebsocketRequest is the main request type
 type WebsocketRequest struct {
 	Command   string        `json:"op"`
@@ -97,7 +99,23 @@ type WsOrderResponse struct {
 	ForeignKeys WsOrderResponseForeignKeys `json:"foreignKeys"`
 	Attributes  WsOrderResponseAttributes  `json:"attributes"`
 	Filter      WsOrderResponseFilter      `json:"filter"`
-	Data        []interface{}              `json:"data"`
+	Data        []WsOrderData              `json:"data"`
+}
+
+// WsOrderData private order data, update actions only contain the order ID,
+// symbol and the fields which have changed
+type WsOrderData struct {
+	OrderID   string    `json:"orderID"`
+	ClOrdID   string    `json:"clOrdID"`
+	Symbol    string    `json:"symbol"`
+	Side      string    `json:"side"`
+	OrdType   string    `json:"ordType"`
+	OrdStatus string    `json:"ordStatus"`
+	OrderQty  float64   `json:"orderQty"`
+	Price     float64   `json:"price"`
+	CumQty    float64   `json:"cumQty"`
+	LeavesQty float64   `json:"leavesQty"`
+	Timestamp time.Time `json:"timestamp"`
 }
 
 // WsOrderResponseAttributes private api data
@@ -195,7 +213,23 @@ type WsExecutionResponse struct {
 	ForeignKeys WsExecutionResponseForeignKeys `json:"foreignKeys"`
 	Attributes  WsExecutionResponseAttributes  `json:"attributes"`
 	Filter      WsExecutionResponseFilter      `json:"filter"`
-	Data        []interface{}                  `json:"data"`
+	Data        []WsExecutionData              `json:"data"`
+}
+
+// WsExecutionData private execution data
+type WsExecutionData struct {
+	ExecID        string    `json:"execID"`
+	OrderID       string    `json:"orderID"`
+	ClOrdID       string    `json:"clOrdID"`
+	Symbol        string    `json:"symbol"`
+	Side          string    `json:"side"`
+	ExecType      string    `json:"execType"`
+	OrdStatus     string    `json:"ordStatus"`
+	LastQty       float64   `json:"lastQty"`
+	LastPx        float64   `json:"lastPx"`
+	ExecComm      float64   `json:"execComm"`
+	SettlCurrency string    `json:"settlCurrency"`
+	Timestamp     time.Time `json:"timestamp"`
 }
 
 // WsExecutionResponseAttributes private api data
@@ -5,6 +5,7 @@ import (
 	"math"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
@@ -12,6 +13,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -97,6 +99,7 @@ func (b *Bitmex) SetDefaults() {
 				TickerFetching:      true,
 				TradeFetching:       true,
 				OrderbookFetching:   true,
+				KlineFetching:       true,
 				AutoPairUpdates:     true,
 				AccountInfo:         true,
 				GetOrder:            true,
@@ -122,11 +125,21 @@ func (b *Bitmex) SetDefaults() {
 				AuthenticatedEndpoints: true,
 				AccountInfo:            true,
 				DeadMansSwitch:         true,
+				OrderUpdates:           true,
 			},
 			WithdrawPermissions: exchange.AutoWithdrawCryptoWithAPIPermission |
 				exchange.WithdrawCryptoWithEmail |
 				exchange.WithdrawCryptoWith2FA |
 				exchange.NoFiatWithdrawals,
+			Kline: kline.ExchangeCapabilities{
+				Intervals: map[kline.Interval]string{
+					kline.OneMin:  "1m",
+					kline.FiveMin: "5m",
+					kline.OneHour: "1h",
+					kline.OneDay:  "1d",
+				},
+				ResultLimit: 1000,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -425,12 +438,35 @@ func (b *Bitmex) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]ex
 // SubmitOrder submits a new order
 func (b *Bitmex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
-	if err := s.Validate(); err != nil {
+	orderNewParams, err := newOrderParams(s)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
+	response, err := b.CreateOrder(&orderNewParams)
+	if err != nil {
 		return submitOrderResponse, err
 	}
+	if response.OrderID != "" {
+		submitOrderResponse.OrderID = response.OrderID
+	}
+	if s.OrderType == order.Market {
+		submitOrderResponse.FullyMatched = true
+	}
+	submitOrderResponse.IsOrderPlaced = true
+
+	return submitOrderResponse, nil
+}
+
+// newOrderParams validates an order submission and converts it to new order
+// parameters
+func newOrderParams(s *order.Submit) (OrderNewParams, error) {
+	if err := s.Validate(); err != nil {
+		return OrderNewParams{}, err
+	}
 
 	if math.Mod(s.Amount, 1) != 0 {
-		return submitOrderResponse,
+		return OrderNewParams{},
 			errors.New("order contract amount can not have decimals")
 	}
 
@@ -444,20 +480,7 @@ func (b *Bitmex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 	if s.OrderType == order.Limit {
 		orderNewParams.Price = s.Price
 	}
-
-	response, err := b.CreateOrder(&orderNewParams)
-	if err != nil {
-		return submitOrderResponse, err
-	}
-	if response.OrderID != "" {
-		submitOrderResponse.OrderID = response.OrderID
-	}
-	if s.OrderType == order.Market {
-		submitOrderResponse.FullyMatched = true
-	}
-	submitOrderResponse.IsOrderPlaced = true
-
-	return submitOrderResponse, nil
+	return orderNewParams, nil
 }
 
 // ModifyOrder will allow of changing orderbook placement and limit to
@@ -486,7 +509,7 @@ func (b *Bitmex) CancelOrder(order *order.Cancel) error {
 	var params = OrderCancelParams{
 		OrderID: order.OrderID,
 	}
-	_, err := b.CancelOrders(&params)
+	_, err := b.CancelExistingOrders(&params)
 	return err
 }
 
@@ -510,6 +533,103 @@ func (b *Bitmex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 	return cancelAllOrdersResponse, nil
 }
 
+// SubmitOrders submits a batch of orders with a bulk order request for each
+// symbol
+func (b *Bitmex) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	if len(s) == 0 {
+		return nil, order.ErrBatchIsEmpty
+	}
+
+	resp := make([]order.BatchSubmitResponse, len(s))
+	var symbols []string
+	bulk := make(map[string]OrderNewBulkParams)
+	indexes := make(map[string][]int)
+	for x := range s {
+		params, err := newOrderParams(&s[x])
+		if err != nil {
+			resp[x].Error = err
+			continue
+		}
+		if _, ok := bulk[params.Symbol]; !ok {
+			symbols = append(symbols, params.Symbol)
+		}
+		p := bulk[params.Symbol]
+		p.Orders = append(p.Orders, params)
+		bulk[params.Symbol] = p
+		indexes[params.Symbol] = append(indexes[params.Symbol], x)
+	}
+
+	for _, symbol := range symbols {
+		orders, err := b.CreateBulkOrders(bulk[symbol])
+		for y, x := range indexes[symbol] {
+			switch {
+			case err != nil:
+				resp[x].Error = err
+			case y >= len(orders):
+				resp[x].Error = errors.New("order missing from bulk order response")
+			case orders[y].OrdRejReason != "":
+				resp[x].Error = errors.New(orders[y].OrdRejReason)
+			default:
+				resp[x].IsOrderPlaced = true
+				resp[x].OrderID = orders[y].OrderID
+				resp[x].FullyMatched = s[x].OrderType == order.Market
+			}
+		}
+	}
+	return resp, nil
+}
+
+// BatchCancelOrders cancels a batch of orders with a single request
+func (b *Bitmex) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	resp := order.CancelBatchResponse{
+		Status: make(map[string]string),
+	}
+	if len(orders) == 0 {
+		return resp, order.ErrBatchIsEmpty
+	}
+
+	var params OrderCancelBulkParams
+	for x := range orders {
+		params.OrderIDs = append(params.OrderIDs, orders[x].OrderID)
+	}
+	cancelled, err := b.CancelBulkOrders(params)
+	if err != nil {
+		return resp, err
+	}
+
+	found := make(map[string]bool)
+	for x := range cancelled {
+		found[cancelled[x].OrderID] = true
+		if cancelled[x].OrdRejReason != "" {
+			resp.Status[cancelled[x].OrderID] = cancelled[x].OrdRejReason
+		}
+	}
+	for x := range params.OrderIDs {
+		if !found[params.OrderIDs[x]] {
+			resp.Status[params.OrderIDs[x]] = "order missing from bulk cancellation response"
+		}
+	}
+	return resp, nil
+}
+
+// SetDeadMansSwitch arms a timer on the exchange which cancels all open orders
+// unless it is refreshed within the timeout, a zero timeout disarms it
+func (b *Bitmex) SetDeadMansSwitch(timeout time.Duration) error {
+	if timeout < 0 {
+		return errors.New("dead man's switch timeout cannot be negative")
+	}
+	_, err := b.CancelAllOrdersAfterTime(OrderCancelAllAfterParams{
+		Timeout: float64(timeout / time.Millisecond),
+	})
+	return err
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (b *Bitmex) UpdateTradingRules() error {
+	return common.ErrFunctionNotSupported
+}
+
 // GetOrderInfo returns information on a current open order
 func (b *Bitmex) GetOrderInfo(orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
@@ -681,3 +801,53 @@ func (b *Bitmex) ValidateCredentials() error {
 	_, err := b.UpdateAccountInfo()
 	return b.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time interval
+func (b *Bitmex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := b.ValidateKline(pair, a, start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+
+	intervalString, err := b.FormatExchangeKlineInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	ret := kline.Item{
+		Exchange: b.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+
+	// Buckets are timestamped at their close so each date range is shifted
+	// forward by an interval to return the candles opened within it
+	limit := b.Features.Supports.Kline.ResultLimit
+	dates := kline.CalculateCandleDateRanges(start, end, interval, limit)
+	for x := range dates {
+		buckets, err := b.GetTradeBuckets(&TradeGetBucketedParams{
+			BinSize:   intervalString,
+			Count:     int32(limit),
+			StartTime: dates[x].Start.Add(time.Duration(interval)).UTC().Format(time.RFC3339),
+			EndTime:   dates[x].End.UTC().Format(time.RFC3339),
+			Symbol:    b.FormatExchangeCurrency(pair, a).String(),
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range buckets {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   buckets[i].Timestamp.Add(-time.Duration(interval)),
+				Open:   buckets[i].Open,
+				High:   buckets[i].High,
+				Low:    buckets[i].Low,
+				Close:  buckets[i].Close,
+				Volume: buckets[i].HomeNotional,
+			})
+		}
+	}
+
+	ret.Tidy(start, end)
+	return ret, nil
+}
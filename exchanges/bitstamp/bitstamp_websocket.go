@@ -114,6 +114,7 @@ func (b *Bitstamp) WsHandleData() {
 					CurrencyPair: p,
 					Exchange:     b.Name,
 					AssetType:    asset.Spot,
+					TID:          strconv.Itoa(wsTradeTemp.Data.ID),
 				}
 			}
 		}
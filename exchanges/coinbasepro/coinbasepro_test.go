@@ -13,6 +13,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -117,6 +119,21 @@ func expectedCandles(expectedCandles int, timeRange time.Duration, candleGranula
 	return nil
 }
 
+func TestGetHistoricCandles(t *testing.T) {
+	end := time.Now().Truncate(time.Hour)
+	_, err := c.GetHistoricCandles(currency.NewPairFromString(testPair),
+		asset.Spot, end.Add(-time.Hour*24), end, kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.GetHistoricCandles(currency.NewPairFromString(testPair),
+		asset.Spot, end.Add(-time.Hour*24), end, kline.FourHour)
+	if err == nil {
+		t.Error("expected error on unsupported interval")
+	}
+}
+
 func TestGetHistoricRatesGranularityCheck(t *testing.T) {
 	end := time.Now().UTC()
 	start := time.Now().UTC().Add(-time.Second * 300)
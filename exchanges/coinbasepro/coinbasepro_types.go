@@ -417,6 +417,12 @@ type WebsocketMatch struct {
 	ProductID    string  `json:"product_id"`
 	Sequence     int64   `json:"sequence"`
 	Time         string  `json:"time"`
+	// Authenticated user channel matches contain the user and fee rate of our
+	// side of the match
+	TakerUserID  string  `json:"taker_user_id"`
+	TakerFeeRate float64 `json:"taker_fee_rate,string"`
+	MakerUserID  string  `json:"maker_user_id"`
+	MakerFeeRate float64 `json:"maker_fee_rate,string"`
 }
 
 // WebsocketChange holds change information
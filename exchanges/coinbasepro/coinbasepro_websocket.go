@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -131,41 +132,78 @@ func (c *CoinbasePro) WsHandleData() {
 					continue
 				}
 			case "received":
-				// We currently use l2update to calculate orderbook changes
 				received := WebsocketReceived{}
 				err := json.Unmarshal(resp.Raw, &received)
 				if err != nil {
 					c.Websocket.DataHandler <- err
 					continue
 				}
-				c.Websocket.DataHandler <- received
+				c.wsProcessReceived(&received)
 			case "open":
-				// We currently use l2update to calculate orderbook changes
 				open := WebsocketOpen{}
 				err := json.Unmarshal(resp.Raw, &open)
 				if err != nil {
 					c.Websocket.DataHandler <- err
 					continue
 				}
-				c.Websocket.DataHandler <- open
+				c.Websocket.DataHandler <- wshandler.OrderUpdate{
+					Timestamp:       parseWsTime(open.Time),
+					CurrencyPair:    currency.NewPairFromString(open.ProductID),
+					AssetType:       asset.Spot,
+					Exchange:        c.Name,
+					OrderID:         open.OrderID,
+					Side:            order.Side(strings.ToUpper(open.Side)),
+					Status:          order.Active,
+					Price:           open.Price,
+					RemainingAmount: open.RemainingSize,
+				}
 			case "done":
-				// We currently use l2update to calculate orderbook changes
 				done := WebsocketDone{}
 				err := json.Unmarshal(resp.Raw, &done)
 				if err != nil {
 					c.Websocket.DataHandler <- err
 					continue
 				}
-				c.Websocket.DataHandler <- done
+				status := order.Filled
+				if done.Reason == "canceled" {
+					status = order.Cancelled
+				}
+				c.Websocket.DataHandler <- wshandler.OrderUpdate{
+					Timestamp:       parseWsTime(done.Time),
+					CurrencyPair:    currency.NewPairFromString(done.ProductID),
+					AssetType:       asset.Spot,
+					Exchange:        c.Name,
+					OrderID:         done.OrderID,
+					Side:            order.Side(strings.ToUpper(done.Side)),
+					Status:          status,
+					Price:           done.Price,
+					RemainingAmount: done.RemainingSize,
+				}
 			case "change":
-				// We currently use l2update to calculate orderbook changes
 				change := WebsocketChange{}
 				err := json.Unmarshal(resp.Raw, &change)
 				if err != nil {
 					c.Websocket.DataHandler <- err
 					continue
 				}
-				c.Websocket.DataHandler <- change
+				c.Websocket.DataHandler <- wshandler.OrderUpdate{
+					Timestamp:       parseWsTime(change.Time),
+					CurrencyPair:    currency.NewPairFromString(msgType.ProductID),
+					AssetType:       asset.Spot,
+					Exchange:        c.Name,
+					OrderID:         change.OrderID,
+					Side:            order.Side(strings.ToUpper(change.Side)),
+					Price:           change.Price,
+					RemainingAmount: change.NewSize,
+				}
+			case "match":
+				match := WebsocketMatch{}
+				err := json.Unmarshal(resp.Raw, &match)
+				if err != nil {
+					c.Websocket.DataHandler <- err
+					continue
+				}
+				c.wsProcessMatch(&match)
 			case "activate":
 				// We currently use l2update to calculate orderbook changes
 				activate := WebsocketActivate{}
@@ -180,6 +218,68 @@ func (c *CoinbasePro) WsHandleData() {
 	}
 }
 
+// wsProcessReceived sends an order accepted by the matching engine to the
+// data handler as a normalised order update
+func (c *CoinbasePro) wsProcessReceived(received *WebsocketReceived) {
+	oType, err := order.StringToOrderType(received.OrderType)
+	if err != nil {
+		c.Websocket.DataHandler <- err
+	}
+	c.Websocket.DataHandler <- wshandler.OrderUpdate{
+		Timestamp:       parseWsTime(received.Time),
+		CurrencyPair:    currency.NewPairFromString(received.ProductID),
+		AssetType:       asset.Spot,
+		Exchange:        c.Name,
+		OrderID:         received.OrderID,
+		ClientOrderID:   received.ClientOID,
+		Side:            order.Side(strings.ToUpper(received.Side)),
+		Type:            oType,
+		Status:          order.New,
+		Price:           received.Price,
+		Amount:          received.Size,
+		RemainingAmount: received.Size,
+	}
+}
+
+// wsProcessMatch sends a match against one of our orders to the data handler
+// as a normalised fill. The side of a match is the maker order side so it is
+// reversed when our order was the taker.
+func (c *CoinbasePro) wsProcessMatch(match *WebsocketMatch) {
+	fill := wshandler.FillUpdate{
+		Timestamp:    parseWsTime(match.Time),
+		CurrencyPair: currency.NewPairFromString(match.ProductID),
+		AssetType:    asset.Spot,
+		Exchange:     c.Name,
+		OrderID:      match.MakerOrderID,
+		TradeID:      strconv.Itoa(match.TradeID),
+		Side:         order.Side(strings.ToUpper(match.Side)),
+		Price:        match.Price,
+		Amount:       match.Size,
+		Fee:          match.Price * match.Size * match.MakerFeeRate,
+		FeeCurrency:  currency.NewPairFromString(match.ProductID).Quote.String(),
+	}
+	if match.TakerUserID != "" {
+		fill.OrderID = match.TakerOrderID
+		fill.Fee = match.Price * match.Size * match.TakerFeeRate
+		if fill.Side == order.Buy {
+			fill.Side = order.Sell
+		} else {
+			fill.Side = order.Buy
+		}
+	}
+	c.Websocket.DataHandler <- fill
+}
+
+// parseWsTime parses a websocket message time, a zero time is returned if it
+// is unable to be parsed
+func parseWsTime(t string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, t)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
+
 // ProcessSnapshot processes the initial orderbook snap shot
 func (c *CoinbasePro) ProcessSnapshot(snapshot *WebsocketOrderbookSnapshot) error {
 	var base orderbook.Base
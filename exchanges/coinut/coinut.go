@@ -8,12 +8,14 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -54,9 +56,9 @@ type COINUT struct {
 	instrumentMap instrumentMap
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (c *COINUT) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
+// GetHistoricCandles returns candles between a time period for a set time interval
+func (c *COINUT) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrNotYetImplemented
 }
 
 // SeedInstruments seeds the instrument map
@@ -174,8 +176,13 @@ func (c *COINUT) CancelExistingOrder(instrumentID, orderID int64) (bool, error)
 	return true, nil
 }
 
-// CancelOrders cancels multiple orders
+// CancelOrders cancels multiple orders, it calls CancelExistingOrders
 func (c *COINUT) CancelOrders(orders []CancelOrders) (CancelOrdersResponse, error) {
+	return c.CancelExistingOrders(orders)
+}
+
+// CancelExistingOrders cancels multiple orders
+func (c *COINUT) CancelExistingOrders(orders []CancelOrders) (CancelOrdersResponse, error) {
 	var result CancelOrdersResponse
 	params := make(map[string]interface{})
 	type Request struct {
@@ -7,12 +7,15 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -30,6 +33,9 @@ const (
 	DefaultWebsocketResponseMaxLimit = time.Second * 7
 	// DefaultWebsocketOrderbookBufferLimit is the maximum number of orderbook updates that get stored before being applied
 	DefaultWebsocketOrderbookBufferLimit = 5
+	// DefaultBatchOrderWorkers is the number of orders submitted or cancelled
+	// at once for exchanges without batch order endpoints
+	DefaultBatchOrderWorkers = 10
 )
 
 func (e *Base) checkAndInitRequester() {
@@ -755,6 +761,50 @@ func (e *Base) PrintEnabledPairs() {
 	}
 }
 
+// LoadTradingRules replaces the stored trading rules of an asset type with the
+// rules fetched from the exchange
+func (e *Base) LoadTradingRules(a asset.Item, rules []order.TradingRules) error {
+	if len(rules) == 0 {
+		return fmt.Errorf("%s LoadTradingRules error - rules is empty", e.Name)
+	}
+
+	m := make(map[string]order.TradingRules, len(rules))
+	for x := range rules {
+		if rules[x].Pair.IsEmpty() {
+			continue
+		}
+		rules[x].Asset = a
+		m[tradingRulesKey(rules[x].Pair)] = rules[x]
+	}
+
+	e.tradingRulesMtx.Lock()
+	defer e.tradingRulesMtx.Unlock()
+	if e.tradingRules == nil {
+		e.tradingRules = make(map[asset.Item]map[string]order.TradingRules)
+	}
+	e.tradingRules[a] = m
+	return nil
+}
+
+// GetTradingRules returns the trading rules of a pair, an error is returned if
+// the exchange does not publish rules or they have not been fetched yet
+func (e *Base) GetTradingRules(p currency.Pair, a asset.Item) (order.TradingRules, error) {
+	e.tradingRulesMtx.RLock()
+	defer e.tradingRulesMtx.RUnlock()
+	rules, ok := e.tradingRules[a][tradingRulesKey(p)]
+	if !ok {
+		return order.TradingRules{}, fmt.Errorf("%s trading rules not found for %v %v",
+			e.Name, a, p)
+	}
+	return rules, nil
+}
+
+// tradingRulesKey returns the key trading rules are stored under so the pair
+// format used by the exchange does not matter
+func tradingRulesKey(p currency.Pair) string {
+	return p.Base.Upper().String() + "-" + p.Quote.Upper().String()
+}
+
 // GetBase returns the exchange base
 func (e *Base) GetBase() *Base { return e }
 
@@ -780,3 +830,87 @@ func (e *Base) DisableRateLimiter() error {
 func (e *Base) EnableRateLimiter() error {
 	return e.Requester.EnableRateLimiter()
 }
+
+// FormatExchangeKlineInterval returns the exchange's request format for the
+// supplied kline interval
+func (e *Base) FormatExchangeKlineInterval(in kline.Interval) (string, error) {
+	v, ok := e.Features.Supports.Kline.Intervals[in]
+	if !ok {
+		return "", fmt.Errorf("%s %v %s", e.Name, kline.ErrUnsupportedInterval, in)
+	}
+	return v, nil
+}
+
+// ValidateKline checks that the pair is enabled, the asset type is supported
+// and the interval and date range can be serviced by the exchange
+func (e *Base) ValidateKline(p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) error {
+	if !e.SupportsAsset(a) {
+		return fmt.Errorf("%s asset type %s is not supported", e.Name, a)
+	}
+	if !e.GetEnabledPairs(a).Contains(p, true) {
+		return fmt.Errorf("%s pair %s is not enabled for asset type %s", e.Name, p, a)
+	}
+	if _, err := e.FormatExchangeKlineInterval(interval); err != nil {
+		return err
+	}
+	return kline.ValidateDateRange(start, end)
+}
+
+// SubmitOrdersConcurrently submits a batch of orders with an exchange's single
+// order submission for exchanges without a batch order endpoint, responses are
+// returned in the order of the submissions
+func SubmitOrdersConcurrently(s []order.Submit, submit func(*order.Submit) (order.SubmitResponse, error)) ([]order.BatchSubmitResponse, error) {
+	if len(s) == 0 {
+		return nil, order.ErrBatchIsEmpty
+	}
+
+	resp := make([]order.BatchSubmitResponse, len(s))
+	workers := make(chan struct{}, DefaultBatchOrderWorkers)
+	var wg sync.WaitGroup
+	for x := range s {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(x int) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			r, err := submit(&s[x])
+			resp[x] = order.BatchSubmitResponse{SubmitResponse: r, Error: err}
+		}(x)
+	}
+	wg.Wait()
+	return resp, nil
+}
+
+// CancelOrdersConcurrently cancels a batch of orders with an exchange's single
+// order cancellation for exchanges without a batch cancellation endpoint
+func CancelOrdersConcurrently(c []order.Cancel, cancel func(*order.Cancel) error) (order.CancelBatchResponse, error) {
+	resp := order.CancelBatchResponse{
+		Status: make(map[string]string),
+	}
+	if len(c) == 0 {
+		return resp, order.ErrBatchIsEmpty
+	}
+
+	var m sync.Mutex
+	workers := make(chan struct{}, DefaultBatchOrderWorkers)
+	var wg sync.WaitGroup
+	for x := range c {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(x int) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			if err := cancel(&c[x]); err != nil {
+				m.Lock()
+				resp.Status[c[x].OrderID] = err.Error()
+				m.Unlock()
+			}
+		}(x)
+	}
+	wg.Wait()
+	return resp, nil
+}
@@ -1,7 +1,9 @@
 package exchange
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -9,6 +11,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -1443,6 +1447,54 @@ func TestGetBase(t *testing.T) {
 	}
 }
 
+func TestTradingRules(t *testing.T) {
+	t.Parallel()
+	b := Base{
+		Name: "MEOW",
+	}
+	p := currency.NewPairWithDelimiter("btc", "usdt", "_")
+	if _, err := b.GetTradingRules(p, asset.Spot); err == nil {
+		t.Error("expected an error without trading rules loaded")
+	}
+
+	if err := b.LoadTradingRules(asset.Spot, nil); err == nil {
+		t.Error("expected an error loading empty trading rules")
+	}
+
+	err := b.LoadTradingRules(asset.Spot, []order.TradingRules{
+		{
+			Pair:          currency.NewPair(currency.BTC, currency.USDT),
+			PriceTickSize: 0.01,
+		},
+		{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := b.GetTradingRules(p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.PriceTickSize != 0.01 || r.Asset != asset.Spot {
+		t.Errorf("unexpected trading rules %+v", r)
+	}
+
+	if _, err = b.GetTradingRules(p, asset.Margin); err == nil {
+		t.Error("expected an error for an asset type without trading rules")
+	}
+
+	err = b.LoadTradingRules(asset.Spot, []order.TradingRules{
+		{Pair: currency.NewPair(currency.LTC, currency.USDT)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = b.GetTradingRules(p, asset.Spot); err == nil {
+		t.Error("expected reloaded trading rules to replace the stored rules")
+	}
+}
+
 func TestGetAssetType(t *testing.T) {
 	var b Base
 	p := currency.NewPair(currency.BTC, currency.USD)
@@ -1468,3 +1520,130 @@ func TestGetAssetType(t *testing.T) {
 		t.Error("should be spot but is", a)
 	}
 }
+
+func TestFormatExchangeKlineInterval(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME"}
+	b.Features.Supports.Kline.Intervals = map[kline.Interval]string{
+		kline.OneHour: "60",
+	}
+
+	v, err := b.FormatExchangeKlineInterval(kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "60" {
+		t.Errorf("expected 60 received %s", v)
+	}
+
+	_, err = b.FormatExchangeKlineInterval(kline.OneWeek)
+	if err == nil {
+		t.Error("expected error for unsupported interval")
+	}
+}
+
+func TestValidateKline(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME"}
+	format := currency.PairFormat{Delimiter: "-", Uppercase: true}
+	b.CurrencyPairs.UseGlobalFormat = true
+	b.CurrencyPairs.RequestFormat = &format
+	b.CurrencyPairs.ConfigFormat = &format
+	b.CurrencyPairs.AssetTypes = asset.Items{asset.Spot}
+	b.CurrencyPairs.StorePairs(asset.Spot,
+		currency.NewPairsFromStrings([]string{defaultTestCurrencyPair}), true)
+	b.Features.Supports.Kline.Intervals = map[kline.Interval]string{
+		kline.OneHour: "1h",
+	}
+
+	p := currency.NewPairDelimiter(defaultTestCurrencyPair, "-")
+	end := time.Now()
+	start := end.Add(-time.Hour * 24)
+	err := b.ValidateKline(p, asset.Spot, start, end, kline.OneHour)
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = b.ValidateKline(p, asset.Futures, start, end, kline.OneHour)
+	if err == nil {
+		t.Error("expected error for unsupported asset type")
+	}
+
+	err = b.ValidateKline(currency.NewPair(currency.LTC, currency.BTC),
+		asset.Spot, start, end, kline.OneHour)
+	if err == nil {
+		t.Error("expected error for disabled pair")
+	}
+
+	err = b.ValidateKline(p, asset.Spot, start, end, kline.OneMin)
+	if err == nil {
+		t.Error("expected error for unsupported interval")
+	}
+
+	err = b.ValidateKline(p, asset.Spot, end, start, kline.OneHour)
+	if err != kline.ErrInvalidDateRange {
+		t.Errorf("expected %v received %v", kline.ErrInvalidDateRange, err)
+	}
+}
+
+func TestSubmitOrdersConcurrently(t *testing.T) {
+	t.Parallel()
+	_, err := SubmitOrdersConcurrently(nil, nil)
+	if err != order.ErrBatchIsEmpty {
+		t.Errorf("expected %v received %v", order.ErrBatchIsEmpty, err)
+	}
+
+	s := make([]order.Submit, DefaultBatchOrderWorkers*2+1)
+	for x := range s {
+		s[x].Amount = float64(x)
+	}
+	errOddAmount := errors.New("odd amount")
+	resp, err := SubmitOrdersConcurrently(s, func(o *order.Submit) (order.SubmitResponse, error) {
+		if int(o.Amount)%2 == 1 {
+			return order.SubmitResponse{}, errOddAmount
+		}
+		return order.SubmitResponse{
+			IsOrderPlaced: true,
+			OrderID:       strconv.Itoa(int(o.Amount)),
+		}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != len(s) {
+		t.Fatalf("expected %v responses received %v", len(s), len(resp))
+	}
+	for x := range resp {
+		if x%2 == 1 {
+			if resp[x].Error != errOddAmount || resp[x].IsOrderPlaced {
+				t.Errorf("expected order %v to fail received %+v", x, resp[x])
+			}
+			continue
+		}
+		if resp[x].Error != nil || resp[x].OrderID != strconv.Itoa(x) {
+			t.Errorf("expected order %v to be placed received %+v", x, resp[x])
+		}
+	}
+}
+
+func TestCancelOrdersConcurrently(t *testing.T) {
+	t.Parallel()
+	_, err := CancelOrdersConcurrently(nil, nil)
+	if err != order.ErrBatchIsEmpty {
+		t.Errorf("expected %v received %v", order.ErrBatchIsEmpty, err)
+	}
+
+	c := []order.Cancel{{OrderID: "1"}, {OrderID: "2"}, {OrderID: "3"}}
+	resp, err := CancelOrdersConcurrently(c, func(o *order.Cancel) error {
+		if o.OrderID == "2" {
+			return errors.New("order not found")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Status) != 1 || resp.Status["2"] != "order not found" {
+		t.Errorf("unexpected cancellation status %v", resp.Status)
+	}
+}
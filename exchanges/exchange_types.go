@@ -1,10 +1,14 @@
 package exchange
 
 import (
+	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -119,16 +123,6 @@ type TradeHistory struct {
 	Description string
 }
 
-// Candle holds historic rate information. Modelled after the Coinbase historic rate structure
-type Candle struct {
-	Time   int64
-	Low    float64
-	High   float64
-	Open   float64
-	Close  float64
-	Volume float64
-}
-
 // FundHistory holds exchange funding history data
 type FundHistory struct {
 	ExchangeName      string
@@ -166,6 +160,7 @@ type FeaturesSupported struct {
 	Websocket             bool
 	WebsocketCapabilities protocol.Features
 	WithdrawPermissions   uint32
+	Kline                 kline.ExchangeCapabilities
 }
 
 // API stores the exchange API settings
@@ -220,5 +215,7 @@ type Base struct {
 	WebsocketOrderbookBufferLimit int64
 	Websocket                     *wshandler.Websocket
 	*request.Requester
-	Config *config.ExchangeConfig
+	Config          *config.ExchangeConfig
+	tradingRules    map[asset.Item]map[string]order.TradingRules
+	tradingRulesMtx sync.RWMutex
 }
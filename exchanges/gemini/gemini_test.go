@@ -1,6 +1,7 @@
 package gemini
 
 import (
+	"encoding/json"
 	"net/url"
 	"testing"
 	"time"
@@ -569,3 +570,69 @@ func TestWsAuth(t *testing.T) {
 	}
 	timer.Stop()
 }
+
+func TestWsProcessOrderEvent(t *testing.T) {
+	g.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	pressXToJSON := []byte(`[{"type":"accepted","order_id":"556309","event_id":"556310","api_session":"UI","client_order_id":"20170208_example","symbol":"ethbtc","side":"sell","order_type":"exchange limit","timestamp":"1478729284","timestampms":1478729284169,"is_live":true,"is_cancelled":false,"is_hidden":false,"executed_amount":"0","remaining_amount":"0.481","original_amount":"0.481","price":"0.01514","socket_sequence":12},{"type":"fill","order_id":"556309","api_session":"UI","client_order_id":"20170208_example","symbol":"ethbtc","side":"sell","order_type":"exchange limit","timestamp":"1478729285","timestampms":1478729285169,"is_live":true,"is_cancelled":false,"is_hidden":false,"avg_execution_price":"0.01514","executed_amount":"0.2","remaining_amount":"0.281","original_amount":"0.481","price":"0.01514","fill":{"trade_id":"557315","liquidity":"Maker","price":"0.01514","amount":"0.2","fee":"0.0000182","fee_currency":"BTC"},"socket_sequence":13},{"type":"cancelled","order_id":"556309","event_id":"556311","api_session":"UI","client_order_id":"20170208_example","symbol":"ethbtc","side":"sell","order_type":"exchange limit","timestamp":"1478729286","timestampms":1478729286169,"is_live":false,"is_cancelled":true,"is_hidden":false,"avg_execution_price":"0.01514","executed_amount":"0.2","remaining_amount":"0.281","original_amount":"0.481","price":"0.01514","reason":"Requested","socket_sequence":14}]`)
+	var events []WsOrderEvent
+	err := json.Unmarshal(pressXToJSON, &events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range events {
+		g.wsProcessOrderEvent(&events[i])
+	}
+
+	u, ok := (<-g.Websocket.DataHandler).(wshandler.OrderUpdate)
+	if !ok {
+		t.Fatal("expected an order update")
+	}
+	if u.OrderID != "556309" ||
+		u.ClientOrderID != "20170208_example" ||
+		u.Status != order.New ||
+		u.Type != order.Limit ||
+		u.Side != order.Sell ||
+		u.Price != 0.01514 ||
+		u.Amount != 0.481 ||
+		!u.CurrencyPair.Equal(currency.NewPair(currency.ETH, currency.BTC)) {
+		t.Errorf("unexpected accepted order update %+v", u)
+	}
+
+	f, ok := (<-g.Websocket.DataHandler).(wshandler.FillUpdate)
+	if !ok {
+		t.Fatal("expected a fill update before the order update")
+	}
+	if f.TradeID != "557315" ||
+		f.OrderID != "556309" ||
+		f.Amount != 0.2 ||
+		f.Fee != 0.0000182 ||
+		f.FeeCurrency != "BTC" {
+		t.Errorf("unexpected fill update %+v", f)
+	}
+	u, ok = (<-g.Websocket.DataHandler).(wshandler.OrderUpdate)
+	if !ok {
+		t.Fatal("expected an order update")
+	}
+	if u.Status != order.PartiallyFilled ||
+		u.ExecutedAmount != 0.2 ||
+		u.RemainingAmount != 0.281 {
+		t.Errorf("unexpected fill order update %+v", u)
+	}
+
+	u, ok = (<-g.Websocket.DataHandler).(wshandler.OrderUpdate)
+	if !ok {
+		t.Fatal("expected an order update")
+	}
+	if u.Status != order.PartiallyCancelled {
+		t.Errorf("expected partially cancelled received %v", u.Status)
+	}
+
+	g.wsProcessOrderEvent(&WsOrderEvent{Type: "rejected", OrderID: "556312", RemainingAmount: 1})
+	u, ok = (<-g.Websocket.DataHandler).(wshandler.OrderUpdate)
+	if !ok {
+		t.Fatal("expected an order update")
+	}
+	if u.Status != order.Rejected {
+		t.Errorf("expected rejected received %v", u.Status)
+	}
+}
@@ -275,27 +275,6 @@ type WsHeartbeatResponse struct {
 	SocketSequence int64  `json:"socket_sequence"`
 }
 
-// WsActiveOrdersResponse contains active orders
-type WsActiveOrdersResponse struct {
-	Type              string        `json:"type"`
-	OrderID           string        `json:"order_id"`
-	APISession        string        `json:"api_session"`
-	Symbol            currency.Pair `json:"symbol"`
-	Side              string        `json:"side"`
-	OrderType         string        `json:"order_type"`
-	Timestamp         string        `json:"timestamp"`
-	Timestampms       int64         `json:"timestampms"`
-	IsLive            bool          `json:"is_live"`
-	IsCancelled       bool          `json:"is_cancelled"`
-	IsHidden          bool          `json:"is_hidden"`
-	AvgExecutionPrice float64       `json:"avg_execution_price,string"`
-	ExecutedAmount    float64       `json:"executed_amount,string"`
-	RemainingAmount   float64       `json:"remaining_amount,string"`
-	OriginalAmount    float64       `json:"original_amount,string"`
-	Price             float64       `json:"price,string"`
-	SocketSequence    int64         `json:"socket_sequence"`
-}
-
 // WsOrderRejectedResponse ws response
 type WsOrderRejectedResponse struct {
 	Type           string        `json:"type"`
@@ -314,36 +293,18 @@ type WsOrderRejectedResponse struct {
 	SocketSequence int64         `json:"socket_sequence"`
 }
 
-// WsOrderBookedResponse ws response
-type WsOrderBookedResponse struct {
-	Type              string        `json:"type"`
-	OrderID           string        `json:"order_id"`
-	EventID           string        `json:"event_id"`
-	APISession        string        `json:"api_session"`
-	Symbol            currency.Pair `json:"symbol"`
-	Side              string        `json:"side"`
-	OrderType         string        `json:"order_type"`
-	Timestamp         string        `json:"timestamp"`
-	Timestampms       int64         `json:"timestampms"`
-	IsLive            bool          `json:"is_live"`
-	IsCancelled       bool          `json:"is_cancelled"`
-	IsHidden          bool          `json:"is_hidden"`
-	AvgExecutionPrice float64       `json:"avg_execution_price,string"`
-	ExecutedAmount    float64       `json:"executed_amount,string"`
-	RemainingAmount   float64       `json:"remaining_amount,string"`
-	OriginalAmount    float64       `json:"original_amount,string"`
-	Price             float64       `json:"price,string"`
-	SocketSequence    int64         `json:"socket_sequence"`
-}
-
-// WsOrderFilledResponse ws response
-type WsOrderFilledResponse struct {
+// WsOrderEvent defines a private order event, events are sent as an array
+// which is initially populated with our live orders
+type WsOrderEvent struct {
 	Type              string            `json:"type"`
 	OrderID           string            `json:"order_id"`
+	EventID           string            `json:"event_id"`
+	ClientOrderID     string            `json:"client_order_id"`
 	APISession        string            `json:"api_session"`
 	Symbol            currency.Pair     `json:"symbol"`
 	Side              string            `json:"side"`
 	OrderType         string            `json:"order_type"`
+	Reason            string            `json:"reason"`
 	Timestamp         string            `json:"timestamp"`
 	Timestampms       int64             `json:"timestampms"`
 	IsLive            bool              `json:"is_live"`
@@ -368,30 +329,6 @@ type WsOrderFilledData struct {
 	FeeCurrency string  `json:"fee_currency"`
 }
 
-// WsOrderCancelledResponse ws response
-type WsOrderCancelledResponse struct {
-	Type              string        `json:"type"`
-	OrderID           string        `json:"order_id"`
-	EventID           string        `json:"event_id"`
-	CancelCommandID   string        `json:"cancel_command_id,omitempty"`
-	Reason            string        `json:"reason"`
-	APISession        string        `json:"api_session"`
-	Symbol            currency.Pair `json:"symbol"`
-	Side              string        `json:"side"`
-	OrderType         string        `json:"order_type"`
-	Timestamp         string        `json:"timestamp"`
-	Timestampms       int64         `json:"timestampms"`
-	IsLive            bool          `json:"is_live"`
-	IsCancelled       bool          `json:"is_cancelled"`
-	IsHidden          bool          `json:"is_hidden"`
-	AvgExecutionPrice float64       `json:"avg_execution_price,string"`
-	ExecutedAmount    float64       `json:"executed_amount,string"`
-	RemainingAmount   float64       `json:"remaining_amount,string"`
-	OriginalAmount    float64       `json:"original_amount,string"`
-	Price             float64       `json:"price,string"`
-	SocketSequence    int64         `json:"socket_sequence"`
-}
-
 // WsOrderCancellationRejectedResponse ws response
 type WsOrderCancellationRejectedResponse struct {
 	Type              string        `json:"type"`
@@ -415,25 +352,3 @@ type WsOrderCancellationRejectedResponse struct {
 	Price             float64       `json:"price,string"`
 	SocketSequence    int64         `json:"socket_sequence"`
 }
-
-// WsOrderClosedResponse ws response
-type WsOrderClosedResponse struct {
-	Type              string        `json:"type"`
-	OrderID           string        `json:"order_id"`
-	EventID           string        `json:"event_id"`
-	APISession        string        `json:"api_session"`
-	Symbol            currency.Pair `json:"symbol"`
-	Side              string        `json:"side"`
-	OrderType         string        `json:"order_type"`
-	Timestamp         string        `json:"timestamp"`
-	Timestampms       int64         `json:"timestampms"`
-	IsLive            bool          `json:"is_live"`
-	IsCancelled       bool          `json:"is_cancelled"`
-	IsHidden          bool          `json:"is_hidden"`
-	AvgExecutionPrice float64       `json:"avg_execution_price,string"`
-	ExecutedAmount    float64       `json:"executed_amount,string"`
-	RemainingAmount   float64       `json:"remaining_amount,string"`
-	OriginalAmount    float64       `json:"original_amount,string"`
-	Price             float64       `json:"price,string"`
-	SocketSequence    int64         `json:"socket_sequence"`
-}
@@ -165,6 +165,19 @@ func (g *Gemini) WsHandleData() {
 			if string(resp.Raw) == "[]" {
 				continue
 			}
+			// Order events are batched into an array
+			if resp.Raw[0] == '[' {
+				var events []WsOrderEvent
+				err := json.Unmarshal(resp.Raw, &events)
+				if err != nil {
+					g.Websocket.DataHandler <- err
+					continue
+				}
+				for i := range events {
+					g.wsProcessOrderEvent(&events[i])
+				}
+				continue
+			}
 			var result map[string]interface{}
 			err := json.Unmarshal(resp.Raw, &result)
 			if err != nil {
@@ -180,54 +193,14 @@ func (g *Gemini) WsHandleData() {
 					continue
 				}
 				g.Websocket.DataHandler <- result
-			case "initial":
-				var result WsSubscriptionAcknowledgementResponse
-				err := json.Unmarshal(resp.Raw, &result)
-				if err != nil {
-					g.Websocket.DataHandler <- err
-					continue
-				}
-				g.Websocket.DataHandler <- result
-			case "accepted":
-				var result WsActiveOrdersResponse
-				err := json.Unmarshal(resp.Raw, &result)
+			case "initial", "accepted", "rejected", "booked", "fill", "cancelled", "closed":
+				var event WsOrderEvent
+				err := json.Unmarshal(resp.Raw, &event)
 				if err != nil {
 					g.Websocket.DataHandler <- err
 					continue
 				}
-				g.Websocket.DataHandler <- result
-			case "booked":
-				var result WsOrderBookedResponse
-				err := json.Unmarshal(resp.Raw, &result)
-				if err != nil {
-					g.Websocket.DataHandler <- err
-					continue
-				}
-				g.Websocket.DataHandler <- result
-			case "fill":
-				var result WsOrderFilledResponse
-				err := json.Unmarshal(resp.Raw, &result)
-				if err != nil {
-					g.Websocket.DataHandler <- err
-					continue
-				}
-				g.Websocket.DataHandler <- result
-			case "cancelled":
-				var result WsOrderCancelledResponse
-				err := json.Unmarshal(resp.Raw, &result)
-				if err != nil {
-					g.Websocket.DataHandler <- err
-					continue
-				}
-				g.Websocket.DataHandler <- result
-			case "closed":
-				var result WsOrderClosedResponse
-				err := json.Unmarshal(resp.Raw, &result)
-				if err != nil {
-					g.Websocket.DataHandler <- err
-					continue
-				}
-				g.Websocket.DataHandler <- result
+				g.wsProcessOrderEvent(&event)
 			case "heartbeat":
 				var result WsHeartbeatResponse
 				err := json.Unmarshal(resp.Raw, &result)
@@ -257,6 +230,75 @@ func (g *Gemini) WsHandleData() {
 	}
 }
 
+// wsProcessOrderEvent sends an order event to the data handler as a
+// normalised order update, fill events are preceded by a normalised fill
+func (g *Gemini) wsProcessOrderEvent(event *WsOrderEvent) {
+	switch event.Type {
+	case "subscription_ack", "heartbeat", "cancel_rejected":
+		return
+	}
+	ts := time.Unix(0, event.Timestampms*int64(time.Millisecond))
+	side := order.Side(strings.ToUpper(event.Side))
+	if event.Type == "fill" {
+		g.Websocket.DataHandler <- wshandler.FillUpdate{
+			Timestamp:     ts,
+			CurrencyPair:  event.Symbol,
+			AssetType:     asset.Spot,
+			Exchange:      g.Name,
+			OrderID:       event.OrderID,
+			ClientOrderID: event.ClientOrderID,
+			TradeID:       event.Fill.TradeID,
+			Side:          side,
+			Price:         event.Fill.Price,
+			Amount:        event.Fill.Amount,
+			Fee:           event.Fill.Fee,
+			FeeCurrency:   event.Fill.FeeCurrency,
+		}
+	}
+
+	var status order.Status
+	switch {
+	case event.Type == "accepted":
+		status = order.New
+	case event.Type == "rejected":
+		status = order.Rejected
+	case event.IsCancelled && event.ExecutedAmount > 0:
+		status = order.PartiallyCancelled
+	case event.IsCancelled:
+		status = order.Cancelled
+	case event.RemainingAmount == 0:
+		status = order.Filled
+	case event.ExecutedAmount > 0:
+		status = order.PartiallyFilled
+	default:
+		status = order.Active
+	}
+
+	oType := order.Unknown
+	switch {
+	case strings.Contains(event.OrderType, "limit"):
+		oType = order.Limit
+	case strings.HasPrefix(event.OrderType, "market"):
+		oType = order.Market
+	}
+
+	g.Websocket.DataHandler <- wshandler.OrderUpdate{
+		Timestamp:       ts,
+		CurrencyPair:    event.Symbol,
+		AssetType:       asset.Spot,
+		Exchange:        g.Name,
+		OrderID:         event.OrderID,
+		ClientOrderID:   event.ClientOrderID,
+		Side:            side,
+		Type:            oType,
+		Status:          status,
+		Price:           event.Price,
+		Amount:          event.OriginalAmount,
+		ExecutedAmount:  event.ExecutedAmount,
+		RemainingAmount: event.RemainingAmount,
+	}
+}
+
 // wsProcessUpdate handles order book data
 func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pair) {
 	if result.Timestamp == 0 && result.TimestampMS == 0 {
@@ -15,6 +15,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -166,15 +167,117 @@ func (h *HitBTC) handleSubscriptionUpdates(resp wshandler.WebsocketResponse, ini
 		err := json.Unmarshal(resp.Raw, &activeOrders)
 		if err != nil {
 			h.Websocket.DataHandler <- err
+			return
+		}
+		for i := range activeOrders.Params {
+			h.wsProcessOrder(&activeOrders.Params[i])
 		}
-		h.Websocket.DataHandler <- activeOrders
 	case "report":
 		var reportData WsReportResponse
 		err := json.Unmarshal(resp.Raw, &reportData)
 		if err != nil {
 			h.Websocket.DataHandler <- err
+			return
+		}
+		h.wsProcessReport(&reportData.Params)
+	}
+}
+
+// wsProcessOrder sends an active order to the data handler as a normalised
+// order update
+func (h *HitBTC) wsProcessOrder(data *WsActiveOrdersResponseData) {
+	h.Websocket.DataHandler <- wshandler.OrderUpdate{
+		Timestamp:       data.UpdatedAt,
+		CurrencyPair:    h.wsPairFromSymbol(data.Symbol),
+		AssetType:       asset.Spot,
+		Exchange:        h.Name,
+		OrderID:         data.ID,
+		ClientOrderID:   data.ClientOrderID,
+		Side:            order.Side(strings.ToUpper(data.Side)),
+		Type:            wsOrderType(data.Type),
+		Status:          wsOrderStatus(data.Status),
+		Price:           data.Price,
+		Amount:          data.Quantity,
+		ExecutedAmount:  data.CumQuantity,
+		RemainingAmount: data.Quantity - data.CumQuantity,
+	}
+}
+
+// wsProcessReport sends an execution report to the data handler as a
+// normalised order update, trade reports are preceded by a normalised fill
+func (h *HitBTC) wsProcessReport(data *WsReportResponseData) {
+	p := h.wsPairFromSymbol(data.Symbol)
+	side := order.Side(strings.ToUpper(data.Side))
+	if data.ReportType == "trade" {
+		h.Websocket.DataHandler <- wshandler.FillUpdate{
+			Timestamp:     data.UpdatedAt,
+			CurrencyPair:  p,
+			AssetType:     asset.Spot,
+			Exchange:      h.Name,
+			OrderID:       data.ID,
+			ClientOrderID: data.ClientOrderID,
+			TradeID:       strconv.FormatInt(data.TradeID, 10),
+			Side:          side,
+			Price:         data.TradePrice,
+			Amount:        data.TradeQuantity,
+			Fee:           data.TradeFee,
+			FeeCurrency:   p.Quote.String(),
 		}
-		h.Websocket.DataHandler <- reportData
+	}
+	h.Websocket.DataHandler <- wshandler.OrderUpdate{
+		Timestamp:       data.UpdatedAt,
+		CurrencyPair:    p,
+		AssetType:       asset.Spot,
+		Exchange:        h.Name,
+		OrderID:         data.ID,
+		ClientOrderID:   data.ClientOrderID,
+		Side:            side,
+		Type:            wsOrderType(data.Type),
+		Status:          wsOrderStatus(data.Status),
+		Price:           data.Price,
+		Amount:          data.Quantity,
+		ExecutedAmount:  data.CumQuantity,
+		RemainingAmount: data.Quantity - data.CumQuantity,
+	}
+}
+
+// wsPairFromSymbol returns the enabled currency pair of a symbol e.g. ETHBTC
+func (h *HitBTC) wsPairFromSymbol(symbol string) currency.Pair {
+	return currency.NewPairFromFormattedPairs(symbol,
+		h.GetEnabledPairs(asset.Spot), h.GetPairFormat(asset.Spot, true))
+}
+
+// wsOrderType converts an order type e.g. stopLimit
+func wsOrderType(oType string) order.Type {
+	switch oType {
+	case "limit":
+		return order.Limit
+	case "market":
+		return order.Market
+	case "stopLimit", "stopMarket":
+		return order.Stop
+	default:
+		return order.Unknown
+	}
+}
+
+// wsOrderStatus converts an order status e.g. partiallyFilled
+func wsOrderStatus(status string) order.Status {
+	switch status {
+	case "new":
+		return order.New
+	case "suspended":
+		return order.Active
+	case "partiallyFilled":
+		return order.PartiallyFilled
+	case "filled":
+		return order.Filled
+	case "canceled":
+		return order.Cancelled
+	case "expired":
+		return order.Expired
+	default:
+		return order.UnknownStatus
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -12,6 +13,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -103,6 +106,16 @@ func TestGetSpotKline(t *testing.T) {
 	}
 }
 
+func TestGetHistoricCandles(t *testing.T) {
+	t.Parallel()
+	end := time.Now().Truncate(time.Hour)
+	_, err := h.GetHistoricCandles(currency.NewPairFromString(testSymbol),
+		asset.Spot, end.Add(-time.Hour*24), end, kline.OneHour)
+	if err != nil {
+		t.Errorf("Huobi TestGetHistoricCandles: %s", err)
+	}
+}
+
 func TestGetMarketDetailMerged(t *testing.T) {
 	t.Parallel()
 	_, err := h.GetMarketDetailMerged(testSymbol)
@@ -163,6 +176,14 @@ func TestGetSymbols(t *testing.T) {
 	}
 }
 
+func TestFetchTradingRules(t *testing.T) {
+	t.Parallel()
+	_, err := h.FetchTradingRules(asset.Spot)
+	if err != nil {
+		t.Errorf("Huobi TestFetchTradingRules: %s", err)
+	}
+}
+
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
 	_, err := h.GetCurrencies()
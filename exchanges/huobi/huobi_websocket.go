@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -185,15 +187,118 @@ func (h *HUOBI) wsHandleAuthenticatedData(resp WsMessage) {
 		err := json.Unmarshal(resp.Raw, &response)
 		if err != nil {
 			h.Websocket.DataHandler <- err
+			return
 		}
-		h.Websocket.DataHandler <- response
+		h.wsProcessOrderUpdate(&response.Data)
 	case strings.Contains(init.Topic, "orders"):
 		var response WsAuthenticatedOrdersResponse
 		err := json.Unmarshal(resp.Raw, &response)
 		if err != nil {
 			h.Websocket.DataHandler <- err
+			return
 		}
-		h.Websocket.DataHandler <- response
+		for i := range response.Data {
+			h.wsProcessOrder(&response.Data[i])
+		}
+	}
+}
+
+// wsProcessOrder sends an order change to the data handler as a normalised
+// order update
+func (h *HUOBI) wsProcessOrder(data *WsAuthenticatedOrdersResponseData) {
+	side, oType := wsOrderSideAndType(data.OrderType)
+	h.Websocket.DataHandler <- wshandler.OrderUpdate{
+		Timestamp:       time.Unix(0, data.CreatedAt*int64(time.Millisecond)),
+		CurrencyPair:    h.wsPairFromSymbol(data.Symbol),
+		AssetType:       asset.Spot,
+		Exchange:        h.Name,
+		OrderID:         strconv.FormatInt(data.OrderID, 10),
+		Side:            side,
+		Type:            oType,
+		Status:          wsOrderStatus(data.OrderState),
+		Price:           data.OrderPrice,
+		Amount:          data.OrderAmount,
+		ExecutedAmount:  data.FilledAmount,
+		RemainingAmount: data.UnfilledAmount,
+		Fee:             data.FilledFees,
+	}
+}
+
+// wsProcessOrderUpdate sends a match or cancellation of an order to the data
+// handler, a match is sent as a normalised fill followed by the resulting
+// order state
+func (h *HUOBI) wsProcessOrderUpdate(data *WsAuthenticatedOrdersUpdateResponseData) {
+	p := h.wsPairFromSymbol(data.Symbol)
+	orderID := strconv.FormatInt(data.OrderID, 10)
+	if data.MatchID > 0 && data.FilledAmount > 0 {
+		h.Websocket.DataHandler <- wshandler.FillUpdate{
+			Timestamp:    time.Now(),
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+			Exchange:     h.Name,
+			OrderID:      orderID,
+			TradeID:      strconv.FormatInt(data.MatchID, 10),
+			Price:        data.Price,
+			Amount:       data.FilledAmount,
+		}
+	}
+	h.Websocket.DataHandler <- wshandler.OrderUpdate{
+		Timestamp:       time.Now(),
+		CurrencyPair:    p,
+		AssetType:       asset.Spot,
+		Exchange:        h.Name,
+		OrderID:         orderID,
+		Status:          wsOrderStatus(data.OrderState),
+		RemainingAmount: data.UnfilledAmount,
+	}
+}
+
+// wsPairFromSymbol returns the enabled currency pair of a symbol e.g. btcusdt
+func (h *HUOBI) wsPairFromSymbol(symbol string) currency.Pair {
+	return currency.NewPairFromFormattedPairs(symbol,
+		h.GetEnabledPairs(asset.Spot), h.GetPairFormat(asset.Spot, true))
+}
+
+// wsOrderSideAndType converts an order type e.g. buy-limit
+func wsOrderSideAndType(orderType string) (order.Side, order.Type) {
+	split := strings.SplitN(orderType, "-", 2)
+	side := order.Side(strings.ToUpper(split[0]))
+	if len(split) == 1 {
+		return side, order.Unknown
+	}
+	switch split[1] {
+	case "limit", "limit-maker":
+		return side, order.Limit
+	case "market":
+		return side, order.Market
+	case "ioc":
+		return side, order.ImmediateOrCancel
+	case "stop-limit":
+		return side, order.Stop
+	default:
+		return side, order.Unknown
+	}
+}
+
+// wsOrderStatus converts an order state e.g. partial-filled
+func wsOrderStatus(state string) order.Status {
+	switch state {
+	case "created", "submitting":
+		return order.New
+	case "submitted":
+		return order.Active
+	case "partial-filled":
+		return order.PartiallyFilled
+	case "filled":
+		return order.Filled
+	case "partial-canceled":
+		return order.PartiallyCancelled
+	case "canceled":
+		return order.Cancelled
+	case "rejected":
+		return order.Rejected
+	default:
+		return order.UnknownStatus
 	}
 }
 
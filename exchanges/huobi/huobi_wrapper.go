@@ -3,6 +3,7 @@ package huobi
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +15,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -102,9 +104,22 @@ func (h *HUOBI) SetDefaults() {
 				MessageCorrelation:     true,
 				GetOrder:               true,
 				GetOrders:              true,
+				OrderUpdates:           true,
 			},
 			WithdrawPermissions: exchange.AutoWithdrawCryptoWithSetup |
 				exchange.NoFiatWithdrawals,
+			Kline: kline.ExchangeCapabilities{
+				Intervals: map[kline.Interval]string{
+					kline.OneMin:     string(TimeIntervalMinute),
+					kline.FiveMin:    string(TimeIntervalFiveMinutes),
+					kline.FifteenMin: string(TimeIntervalFifteenMinutes),
+					kline.OneHour:    string(TimeIntervalHour),
+					kline.FourHour:   string(TimeIntervalFourHours),
+					kline.OneDay:     string(TimeIntervalDay),
+					kline.OneWeek:    string(TimeIntervalWeek),
+				},
+				ResultLimit: 2000,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -173,6 +188,8 @@ func (h *HUOBI) Setup(exch *config.ExchangeConfig) error {
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
 	}
 
+	// Depth messages are full snapshots which replace the orderbook, they hold
+	// no checksum or sequence for an orderbook verifier to check
 	h.Websocket.Orderbook.Setup(
 		exch.WebsocketOrderbookBufferLimit,
 		false,
@@ -260,32 +277,83 @@ func (h *HUOBI) FetchTradablePairs(asset asset.Item) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	return h.tradablePairs(symbols, asset), nil
+}
 
+// tradablePairs returns the pairs of the symbols which are online
+func (h *HUOBI) tradablePairs(symbols []Symbol, a asset.Item) []string {
 	var pairs []string
 	for x := range symbols {
 		if symbols[x].State != "online" {
 			continue
 		}
 		pairs = append(pairs, symbols[x].BaseCurrency+
-			h.GetPairFormat(asset, false).Delimiter+
+			h.GetPairFormat(a, false).Delimiter+
 			symbols[x].QuoteCurrency)
 	}
-
-	return pairs, nil
+	return pairs
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are updated
+// from the same response
 func (h *HUOBI) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := h.FetchTradablePairs(asset.Spot)
+	symbols, err := h.GetSymbols()
 	if err != nil {
 		return err
 	}
 
-	return h.UpdatePairs(currency.NewPairsFromStrings(pairs),
+	err = h.UpdatePairs(currency.NewPairsFromStrings(h.tradablePairs(symbols, asset.Spot)),
 		asset.Spot,
 		false,
 		forceUpdate)
+	if err != nil {
+		return err
+	}
+	return h.LoadTradingRules(asset.Spot, h.tradingRules(symbols, asset.Spot))
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (h *HUOBI) UpdateTradingRules() error {
+	rules, err := h.FetchTradingRules(asset.Spot)
+	if err != nil {
+		return err
+	}
+	return h.LoadTradingRules(asset.Spot, rules)
+}
+
+// FetchTradingRules returns the price and amount precision and order size
+// limits of each online pair
+func (h *HUOBI) FetchTradingRules(a asset.Item) ([]order.TradingRules, error) {
+	symbols, err := h.GetSymbols()
+	if err != nil {
+		return nil, err
+	}
+	return h.tradingRules(symbols, a), nil
+}
+
+// tradingRules returns the price and amount precision and order size limits
+// of the symbols which are online
+func (h *HUOBI) tradingRules(symbols []Symbol, a asset.Item) []order.TradingRules {
+	var rules []order.TradingRules
+	for x := range symbols {
+		if symbols[x].State != "online" {
+			continue
+		}
+		rules = append(rules, order.TradingRules{
+			Pair: currency.NewPairWithDelimiter(symbols[x].BaseCurrency,
+				symbols[x].QuoteCurrency,
+				h.GetPairFormat(a, false).Delimiter),
+			Asset:          a,
+			PriceTickSize:  math.Pow10(-symbols[x].PricePrecision),
+			AmountStepSize: math.Pow10(-symbols[x].AmountPrecision),
+			MinAmount:      symbols[x].MinimumOrderAmount,
+			MaxAmount:      symbols[x].MaximumOrderAmount,
+			MinNotional:    symbols[x].MinimumOrderValue,
+		})
+	}
+	return rules
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -592,6 +660,22 @@ func (h *HUOBI) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAl
 	return cancelAllOrdersResponse, nil
 }
 
+// SubmitOrders submits a batch of orders
+func (h *HUOBI) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	return exchange.SubmitOrdersConcurrently(s, h.SubmitOrder)
+}
+
+// BatchCancelOrders cancels a batch of orders
+func (h *HUOBI) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	return exchange.CancelOrdersConcurrently(orders, h.CancelOrder)
+}
+
+// SetDeadMansSwitch arms a timer on the exchange which cancels all open orders
+// unless it is refreshed within the timeout
+func (h *HUOBI) SetDeadMansSwitch(timeout time.Duration) error {
+	return common.ErrFunctionNotSupported
+}
+
 // GetOrderInfo returns information on a current open order
 func (h *HUOBI) GetOrderInfo(orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
@@ -867,3 +951,53 @@ func (h *HUOBI) ValidateCredentials() error {
 	_, err := h.UpdateAccountInfo()
 	return h.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, the exchange only serves the latest 2000 candles of an interval
+func (h *HUOBI) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := h.ValidateKline(pair, a, start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+
+	intervalString, err := h.FormatExchangeKlineInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	ret := kline.Item{
+		Exchange: h.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+
+	// Klines are only available counting back from now so request enough
+	// to reach the start of the period
+	size := kline.TotalCandlesPerInterval(start, time.Now(), interval) + 1
+	if limit := h.Features.Supports.Kline.ResultLimit; size > limit {
+		size = limit
+	}
+
+	candles, err := h.GetSpotKline(KlinesRequestParams{
+		Symbol: h.FormatExchangeCurrency(pair, a).String(),
+		Period: TimeInterval(intervalString),
+		Size:   int(size),
+	})
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	for x := range candles {
+		ret.Candles = append(ret.Candles, kline.Candle{
+			Time:   time.Unix(candles[x].ID, 0),
+			Open:   candles[x].Open,
+			High:   candles[x].High,
+			Low:    candles[x].Low,
+			Close:  candles[x].Close,
+			Volume: candles[x].Amount,
+		})
+	}
+
+	ret.Tidy(start, end)
+	return ret, nil
+}
@@ -2,11 +2,13 @@ package exchange
 
 import (
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -50,6 +52,14 @@ type IBotExchange interface {
 	ModifyOrder(action *order.Modify) (string, error)
 	CancelOrder(order *order.Cancel) error
 	CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse, error)
+	SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error)
+	// BatchCancelOrders cancels a batch of orders. It is not named
+	// CancelOrders as the Bitmex and COINUT API wrappers already have
+	// CancelOrders methods with exchange specific signatures.
+	BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error)
+	SetDeadMansSwitch(timeout time.Duration) error
+	UpdateTradingRules() error
+	GetTradingRules(p currency.Pair, a asset.Item) (order.TradingRules, error)
 	GetOrderInfo(orderID string) (order.Detail, error)
 	GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error)
 	GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
@@ -71,7 +81,7 @@ type IBotExchange interface {
 	GetDefaultConfig() (*config.ExchangeConfig, error)
 	GetBase() *Base
 	SupportsAsset(assetType asset.Item) bool
-	GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]Candle, error)
+	GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error)
 	DisableRateLimiter() error
 	EnableRateLimiter() error
 }
@@ -0,0 +1,374 @@
+package kline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func init() {
+	service = new(Service)
+	service.Candles = make(map[candleKey]*candleRoute)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SupportedIntervals returns a list of all the kline intervals supported by
+// GoCryptoTrader
+func SupportedIntervals() []Interval {
+	return append([]Interval(nil), supportedIntervals...)
+}
+
+// ParseInterval converts a short interval string such as 1m, 4h or 1w to an
+// Interval
+func ParseInterval(s string) (Interval, error) {
+	for x := range supportedIntervals {
+		if strings.EqualFold(supportedIntervals[x].Short(), s) {
+			return supportedIntervals[x], nil
+		}
+	}
+	return 0, fmt.Errorf("%v %s", ErrUnsupportedInterval, s)
+}
+
+// Duration returns the interval as a time.Duration
+func (i Interval) Duration() time.Duration {
+	return time.Duration(i)
+}
+
+// Short returns the short string representation of an interval e.g 15m
+func (i Interval) Short() string {
+	switch {
+	case i >= OneWeek && i%OneWeek == 0:
+		return fmt.Sprintf("%dw", i/OneWeek)
+	case i >= OneDay && i%OneDay == 0:
+		return fmt.Sprintf("%dd", i/OneDay)
+	case i >= OneHour && i%OneHour == 0:
+		return fmt.Sprintf("%dh", i/OneHour)
+	case i >= OneMin && i%OneMin == 0:
+		return fmt.Sprintf("%dm", i/OneMin)
+	}
+	return time.Duration(i).String()
+}
+
+// String implements the stringer interface
+func (i Interval) String() string {
+	return i.Short()
+}
+
+// Supported returns whether or not the interval is supported by
+// GoCryptoTrader
+func (i Interval) Supported() bool {
+	for x := range supportedIntervals {
+		if supportedIntervals[x] == i {
+			return true
+		}
+	}
+	return false
+}
+
+// Truncate rounds t down to the start of the interval period it falls within
+func (i Interval) Truncate(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Duration(i))
+}
+
+// TotalCandlesPerInterval returns the amount of candles required to cover the
+// time period between start and end
+func TotalCandlesPerInterval(start, end time.Time, interval Interval) uint32 {
+	if interval <= 0 || !start.Before(end) {
+		return 0
+	}
+	return uint32(end.Sub(start) / time.Duration(interval))
+}
+
+// CalculateCandleDateRanges splits the period between start and end into
+// date ranges that each hold at most limit candles
+func CalculateCandleDateRanges(start, end time.Time, interval Interval, limit uint32) []DateRange {
+	if interval <= 0 || !start.Before(end) {
+		return nil
+	}
+	if limit == 0 {
+		return []DateRange{{Start: start, End: end}}
+	}
+	step := time.Duration(interval) * time.Duration(limit)
+	var ranges []DateRange
+	for s := start; s.Before(end); s = s.Add(step) {
+		e := s.Add(step)
+		if e.After(end) {
+			e = end
+		}
+		ranges = append(ranges, DateRange{Start: s, End: e})
+	}
+	return ranges
+}
+
+// ValidateDateRange checks that a start and end time are set and ordered
+// correctly
+func ValidateDateRange(start, end time.Time) error {
+	if start.IsZero() || end.IsZero() || !start.Before(end) {
+		return ErrInvalidDateRange
+	}
+	return nil
+}
+
+// SortCandlesByTimestamp sorts the candles by time, ascending unless desc is
+// set
+func (k *Item) SortCandlesByTimestamp(desc bool) {
+	sort.Slice(k.Candles, func(i, j int) bool {
+		if desc {
+			return k.Candles[i].Time.After(k.Candles[j].Time)
+		}
+		return k.Candles[i].Time.Before(k.Candles[j].Time)
+	})
+}
+
+// RemoveDuplicates removes any candles sharing the same open time, keeping
+// the first occurrence. Candles are expected to be sorted.
+func (k *Item) RemoveDuplicates() {
+	if len(k.Candles) < 2 {
+		return
+	}
+	filtered := k.Candles[:1]
+	for x := 1; x < len(k.Candles); x++ {
+		if k.Candles[x].Time.Equal(filtered[len(filtered)-1].Time) {
+			continue
+		}
+		filtered = append(filtered, k.Candles[x])
+	}
+	k.Candles = filtered
+}
+
+// RemoveOutsideRange removes any candles which open before start or at or
+// after end
+func (k *Item) RemoveOutsideRange(start, end time.Time) {
+	filtered := k.Candles[:0]
+	for x := range k.Candles {
+		if k.Candles[x].Time.Before(start) || !k.Candles[x].Time.Before(end) {
+			continue
+		}
+		filtered = append(filtered, k.Candles[x])
+	}
+	k.Candles = filtered
+}
+
+// MissingRanges returns the date ranges between start and end which are not
+// covered by a candle. Candles are expected to be sorted in ascending order
+// and aligned to the item interval.
+func (k *Item) MissingRanges(start, end time.Time) []DateRange {
+	if k.Interval <= 0 || !start.Before(end) {
+		return nil
+	}
+	var ranges []DateRange
+	cursor := start
+	for x := range k.Candles {
+		if k.Candles[x].Time.Before(cursor) {
+			continue
+		}
+		if !k.Candles[x].Time.Before(end) {
+			break
+		}
+		if k.Candles[x].Time.After(cursor) {
+			ranges = append(ranges, DateRange{Start: cursor, End: k.Candles[x].Time})
+		}
+		cursor = k.Candles[x].Time.Add(time.Duration(k.Interval))
+	}
+	if cursor.Before(end) {
+		ranges = append(ranges, DateRange{Start: cursor, End: end})
+	}
+	return ranges
+}
+
+// Tidy sorts candles in ascending order, removes duplicates and trims any
+// candles outside of the requested range
+func (k *Item) Tidy(start, end time.Time) {
+	k.SortCandlesByTimestamp(false)
+	k.RemoveDuplicates()
+	k.RemoveOutsideRange(start, end)
+}
+
+// NewBuilder returns a candle builder for an exchange, currency pair, asset
+// type and interval
+func NewBuilder(exchange string, p currency.Pair, a asset.Item, interval Interval) (*Builder, error) {
+	if exchange == "" || p.IsEmpty() || a == "" {
+		return nil, errItemNotSet
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("%v %s", ErrUnsupportedInterval, interval)
+	}
+	return &Builder{
+		Exchange: exchange,
+		Pair:     p,
+		Asset:    a,
+		Interval: interval,
+	}, nil
+}
+
+// AddTrade applies a trade to the open candle. When the trade falls within a
+// later period the open candle is closed and returned and a new candle is
+// opened. Trades that occur before the open candle period or within an
+// already closed period are rejected so a closed candle is never re-opened.
+func (b *Builder) AddTrade(price, amount float64, t time.Time) (*Candle, error) {
+	start := b.Interval.Truncate(t)
+	if b.candle != nil {
+		if start.Before(b.candle.Time) {
+			return nil, ErrTradeBeforeCandle
+		}
+		if start.Equal(b.candle.Time) {
+			if price > b.candle.High {
+				b.candle.High = price
+			}
+			if price < b.candle.Low {
+				b.candle.Low = price
+			}
+			b.candle.Close = price
+			b.candle.Volume += amount
+			return nil, nil
+		}
+	} else if b.closed && !start.After(b.lastClosed) {
+		return nil, ErrTradeInClosedCandle
+	}
+
+	closed := b.candle
+	if closed != nil {
+		b.setClosed(closed.Time)
+	}
+	b.candle = &Candle{
+		Time:   start,
+		Open:   price,
+		High:   price,
+		Low:    price,
+		Close:  price,
+		Volume: amount,
+	}
+	return closed, nil
+}
+
+// Close closes and returns the open candle if its period has ended by t,
+// otherwise nil is returned
+func (b *Builder) Close(t time.Time) *Candle {
+	if b.candle == nil || t.Before(b.candle.Time.Add(time.Duration(b.Interval))) {
+		return nil
+	}
+	closed := b.candle
+	b.candle = nil
+	b.setClosed(closed.Time)
+	return closed
+}
+
+// setClosed records the open time of the most recently closed candle
+func (b *Builder) setClosed(t time.Time) {
+	b.lastClosed = t
+	b.closed = true
+}
+
+// Current returns a copy of the open candle and whether one exists
+func (b *Builder) Current() (Candle, bool) {
+	if b.candle == nil {
+		return Candle{}, false
+	}
+	return *b.candle, true
+}
+
+// SubscribeCandles subscribes to candles built for an exchange, currency
+// pair, asset type and interval and returns a communication channel to stream
+// closed candles. Closed candles are buffered so a busy subscriber does not
+// miss them.
+func SubscribeCandles(exchange string, p currency.Pair, a asset.Item, interval Interval) (Pipe, error) {
+	service.RLock()
+	defer service.RUnlock()
+	route, ok := service.Candles[newCandleKey(exchange, p, a, interval)]
+	if !ok {
+		return Pipe{}, fmt.Errorf("candles not found for %s %s %s %s",
+			exchange,
+			p,
+			a,
+			interval)
+	}
+	return subscribe(route.Main, dispatch.SubscribeOptions{
+		Name:   fmt.Sprintf("candles %s %s %s %s", strings.ToLower(exchange), p, a, interval),
+		Policy: dispatch.PolicyDropOldest,
+	})
+}
+
+// SubscribeToExchangeCandles subscribes to all candles built for an exchange
+func SubscribeToExchangeCandles(exchange string) (Pipe, error) {
+	service.RLock()
+	defer service.RUnlock()
+	id, ok := service.Exchange[strings.ToLower(exchange)]
+	if !ok {
+		return Pipe{}, fmt.Errorf("%s exchange candles not found",
+			exchange)
+	}
+	return subscribe(id, dispatch.SubscribeOptions{
+		Name:   "candles " + strings.ToLower(exchange),
+		Policy: dispatch.PolicyDropOldest,
+	})
+}
+
+// subscribe returns a pipe relaying the candles published to the ID
+func subscribe(id uuid.UUID, opts dispatch.SubscribeOptions) (Pipe, error) {
+	c := make(chan *Item)
+	r, err := service.mux.SubscribeRelay(id, opts, c)
+	if err != nil {
+		return Pipe{}, err
+	}
+	return Pipe{C: c, Relay: r}, nil
+}
+
+// ProcessCandles publishes closed candles held by a kline.Item to any
+// subscribers
+func ProcessCandles(k *Item) error {
+	if k == nil || k.Exchange == "" || k.Pair.IsEmpty() || k.Asset == "" || k.Interval <= 0 {
+		return errItemNotSet
+	}
+	if len(k.Candles) == 0 {
+		return ErrNoCandles
+	}
+	return service.publish(k)
+}
+
+func (s *Service) publish(k *Item) error {
+	key := newCandleKey(k.Exchange, k.Pair, k.Asset, k.Interval)
+
+	s.Lock()
+	route, ok := s.Candles[key]
+	if !ok {
+		exchangeID, ok := s.Exchange[key.Exchange]
+		if !ok {
+			var err error
+			exchangeID, err = s.mux.GetID()
+			if err != nil {
+				s.Unlock()
+				return err
+			}
+			s.Exchange[key.Exchange] = exchangeID
+		}
+
+		mainID, err := s.mux.GetID()
+		if err != nil {
+			s.Unlock()
+			return err
+		}
+		route = &candleRoute{Main: mainID, Assoc: []uuid.UUID{exchangeID}}
+		s.Candles[key] = route
+	}
+	ids := append([]uuid.UUID{route.Main}, route.Assoc...)
+	s.Unlock()
+
+	return s.mux.Publish(ids, k)
+}
+
+func newCandleKey(exchange string, p currency.Pair, a asset.Item, interval Interval) candleKey {
+	return candleKey{
+		Exchange: strings.ToLower(exchange),
+		Base:     p.Base.Item,
+		Quote:    p.Quote.Item,
+		Asset:    a,
+		Interval: interval,
+	}
+}
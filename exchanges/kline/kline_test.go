@@ -0,0 +1,319 @@
+package kline
+
+import (
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestParseInterval(t *testing.T) {
+	for _, i := range SupportedIntervals() {
+		p, err := ParseInterval(i.Short())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p != i {
+			t.Errorf("expected %v received %v", i, p)
+		}
+	}
+
+	_, err := ParseInterval("7m")
+	if err == nil {
+		t.Error("expected error on unsupported interval")
+	}
+}
+
+func TestIntervalShort(t *testing.T) {
+	tests := map[Interval]string{
+		OneMin:     "1m",
+		FiveMin:    "5m",
+		FifteenMin: "15m",
+		OneHour:    "1h",
+		FourHour:   "4h",
+		OneDay:     "1d",
+		OneWeek:    "1w",
+	}
+	for i, expected := range tests {
+		if i.Short() != expected {
+			t.Errorf("expected %s received %s", expected, i.Short())
+		}
+	}
+
+	if Interval(90*time.Second).Short() != "1m30s" {
+		t.Error("unexpected short format for non standard interval")
+	}
+}
+
+func TestIntervalSupported(t *testing.T) {
+	if !OneHour.Supported() {
+		t.Error("expected one hour to be supported")
+	}
+	if Interval(time.Second).Supported() {
+		t.Error("expected one second to be unsupported")
+	}
+}
+
+func TestTotalCandlesPerInterval(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	if c := TotalCandlesPerInterval(start, end, OneHour); c != 24 {
+		t.Errorf("expected 24 candles received %d", c)
+	}
+	if c := TotalCandlesPerInterval(end, start, OneHour); c != 0 {
+		t.Errorf("expected 0 candles received %d", c)
+	}
+}
+
+func TestCalculateCandleDateRanges(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 25)
+	ranges := CalculateCandleDateRanges(start, end, OneHour, 10)
+	if len(ranges) != 3 {
+		t.Fatalf("expected 3 ranges received %d", len(ranges))
+	}
+	if !ranges[0].Start.Equal(start) || !ranges[2].End.Equal(end) {
+		t.Error("ranges do not cover the requested period")
+	}
+	if !ranges[1].Start.Equal(ranges[0].End) {
+		t.Error("ranges should be contiguous")
+	}
+
+	if len(CalculateCandleDateRanges(start, end, OneHour, 0)) != 1 {
+		t.Error("expected a single range when no limit is set")
+	}
+	if CalculateCandleDateRanges(end, start, OneHour, 10) != nil {
+		t.Error("expected no ranges for an invalid date range")
+	}
+}
+
+func TestValidateDateRange(t *testing.T) {
+	now := time.Now()
+	if err := ValidateDateRange(now, now.Add(time.Minute)); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateDateRange(now, now); err != ErrInvalidDateRange {
+		t.Errorf("expected %v received %v", ErrInvalidDateRange, err)
+	}
+	if err := ValidateDateRange(time.Time{}, now); err != ErrInvalidDateRange {
+		t.Errorf("expected %v received %v", ErrInvalidDateRange, err)
+	}
+}
+
+func TestTidy(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := Item{
+		Candles: []Candle{
+			{Time: start.Add(time.Hour * 2)},
+			{Time: start},
+			{Time: start.Add(time.Hour)},
+			{Time: start.Add(time.Hour)},
+			{Time: start.Add(-time.Hour)},
+			{Time: start.Add(time.Hour * 3)},
+		},
+	}
+	k.Tidy(start, start.Add(time.Hour*3))
+	if len(k.Candles) != 3 {
+		t.Fatalf("expected 3 candles received %d", len(k.Candles))
+	}
+	for x := range k.Candles {
+		if !k.Candles[x].Time.Equal(start.Add(time.Hour * time.Duration(x))) {
+			t.Errorf("unexpected candle time %v", k.Candles[x].Time)
+		}
+	}
+
+	k.SortCandlesByTimestamp(true)
+	if !k.Candles[0].Time.After(k.Candles[1].Time) {
+		t.Error("candles should be sorted in descending order")
+	}
+}
+
+func TestMissingRanges(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 10)
+	k := Item{Interval: OneHour}
+	ranges := k.MissingRanges(start, end)
+	if len(ranges) != 1 || !ranges[0].Start.Equal(start) || !ranges[0].End.Equal(end) {
+		t.Fatalf("expected the full range to be missing received %v", ranges)
+	}
+
+	for _, h := range []int{0, 1, 4, 5, 9} {
+		k.Candles = append(k.Candles, Candle{Time: start.Add(time.Hour * time.Duration(h))})
+	}
+	ranges = k.MissingRanges(start, end)
+	if len(ranges) != 2 {
+		t.Fatalf("expected 2 missing ranges received %d", len(ranges))
+	}
+	if !ranges[0].Start.Equal(start.Add(time.Hour*2)) || !ranges[0].End.Equal(start.Add(time.Hour*4)) {
+		t.Errorf("unexpected first range %v", ranges[0])
+	}
+	if !ranges[1].Start.Equal(start.Add(time.Hour*6)) || !ranges[1].End.Equal(start.Add(time.Hour*9)) {
+		t.Errorf("unexpected second range %v", ranges[1])
+	}
+
+	if k.MissingRanges(end, start) != nil {
+		t.Error("expected no ranges for an invalid date range")
+	}
+}
+
+func TestNewBuilder(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	if _, err := NewBuilder("", p, asset.Spot, OneMin); err == nil {
+		t.Error("expected error when exchange is not set")
+	}
+	if _, err := NewBuilder("test", p, asset.Spot, 0); err == nil {
+		t.Error("expected error for invalid interval")
+	}
+	if _, err := NewBuilder("test", p, asset.Spot, OneMin); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBuilderAddTrade(t *testing.T) {
+	b, err := NewBuilder("test", currency.NewPair(currency.BTC, currency.USD), asset.Spot, OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	trades := []struct {
+		price, amount float64
+		offset        time.Duration
+	}{
+		{10, 1, time.Second},
+		{12, 2, time.Second * 10},
+		{8, 1, time.Second * 30},
+		{11, 1, time.Second * 59},
+	}
+	for x := range trades {
+		c, err := b.AddTrade(trades[x].price, trades[x].amount, start.Add(trades[x].offset))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c != nil {
+			t.Fatal("candle should not close within the same period")
+		}
+	}
+
+	closed, err := b.AddTrade(20, 1, start.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if closed == nil {
+		t.Fatal("expected candle to close when a trade occurs in the next period")
+	}
+	expected := Candle{Time: start, Open: 10, High: 12, Low: 8, Close: 11, Volume: 5}
+	if *closed != expected {
+		t.Errorf("expected %+v received %+v", expected, *closed)
+	}
+
+	_, err = b.AddTrade(1, 1, start.Add(time.Second*30))
+	if err != ErrTradeBeforeCandle {
+		t.Errorf("expected %v received %v", ErrTradeBeforeCandle, err)
+	}
+
+	if c := b.Close(start.Add(time.Minute + time.Second*59)); c != nil {
+		t.Error("candle should not close before its period has ended")
+	}
+	if _, ok := b.Current(); !ok {
+		t.Error("expected an open candle")
+	}
+	c := b.Close(start.Add(time.Minute * 2))
+	if c == nil || c.Open != 20 || !c.Time.Equal(start.Add(time.Minute)) {
+		t.Errorf("unexpected closed candle %+v", c)
+	}
+	if _, ok := b.Current(); ok {
+		t.Error("expected no open candle after close")
+	}
+
+	_, err = b.AddTrade(5, 1, start.Add(time.Minute+time.Second*59))
+	if err != ErrTradeInClosedCandle {
+		t.Errorf("expected %v received %v", ErrTradeInClosedCandle, err)
+	}
+	_, err = b.AddTrade(5, 1, start.Add(time.Second*30))
+	if err != ErrTradeInClosedCandle {
+		t.Errorf("expected %v received %v", ErrTradeInClosedCandle, err)
+	}
+	if _, ok := b.Current(); ok {
+		t.Error("a late trade should not re-open a closed period")
+	}
+
+	closed, err = b.AddTrade(6, 1, start.Add(time.Minute*2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if closed != nil {
+		t.Error("no candle should close when opening after an expired period")
+	}
+	if c, ok := b.Current(); !ok || c.Open != 6 {
+		t.Errorf("expected open candle at 6 received %+v", c)
+	}
+}
+
+func TestProcessCandles(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	if err := ProcessCandles(nil); err == nil {
+		t.Error("expected error for nil item")
+	}
+	if err := ProcessCandles(&Item{Exchange: "candletest", Pair: p, Asset: asset.Spot, Interval: OneMin}); err != ErrNoCandles {
+		t.Errorf("expected %v received %v", ErrNoCandles, err)
+	}
+
+	_, err := SubscribeCandles("candletest", p, asset.Spot, OneMin)
+	if err == nil {
+		t.Error("expected error subscribing before candles are processed")
+	}
+
+	k := &Item{
+		Exchange: "candletest",
+		Pair:     p,
+		Asset:    asset.Spot,
+		Interval: OneMin,
+		Candles:  []Candle{{Time: time.Now(), Open: 1, High: 1, Low: 1, Close: 1}},
+	}
+	if err = ProcessCandles(k); err != nil {
+		t.Fatal(err)
+	}
+
+	pipe, err := SubscribeCandles("CandleTest", p, asset.Spot, OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	if _, err = SubscribeToExchangeCandles("candletest"); err != nil {
+		t.Error(err)
+	}
+	if _, err = SubscribeToExchangeCandles("nonexistent"); err == nil {
+		t.Error("expected error for unknown exchange")
+	}
+
+	if err = ProcessCandles(k); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case received := <-pipe.C:
+		if received == nil || len(received.Candles) != 1 {
+			t.Errorf("unexpected candle payload %+v", received)
+		}
+	case <-time.After(time.Second * 5):
+		t.Error("timed out waiting for published candle")
+	}
+}
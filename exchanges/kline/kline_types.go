@@ -0,0 +1,125 @@
+package kline
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Consts here define the supported kline time intervals
+const (
+	OneMin     = Interval(time.Minute)
+	FiveMin    = 5 * OneMin
+	FifteenMin = 15 * OneMin
+	OneHour    = 60 * OneMin
+	FourHour   = 4 * OneHour
+	OneDay     = 24 * OneHour
+	OneWeek    = 7 * OneDay
+)
+
+// Vars for the kline package
+var (
+	ErrUnsupportedInterval = errors.New("unsupported kline interval")
+	ErrInvalidDateRange    = errors.New("kline start date must be before end date")
+	ErrNoCandles           = errors.New("no candle data returned")
+	ErrTradeBeforeCandle   = errors.New("trade occurred before the open candle period")
+	ErrTradeInClosedCandle = errors.New("trade occurred within a closed candle period")
+
+	errItemNotSet = errors.New("kline item exchange, pair, asset and interval must be set")
+
+	service *Service
+
+	supportedIntervals = []Interval{
+		OneMin,
+		FiveMin,
+		FifteenMin,
+		OneHour,
+		FourHour,
+		OneDay,
+		OneWeek,
+	}
+)
+
+// Interval defines the time period covered by a single candle
+type Interval time.Duration
+
+// Item holds all the candle data for an exchange, currency pair, asset type
+// and interval
+type Item struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval Interval
+	Candles  []Candle
+}
+
+// Candle holds historic rate information
+type Candle struct {
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// DateRange holds a start and end time used to split a large candle request
+// into smaller requests that fit within an exchange's result limit
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ExchangeCapabilities holds the kline intervals an exchange supports mapped
+// to the exchange's request format, and the maximum amount of candles the
+// exchange returns for a single request
+type ExchangeCapabilities struct {
+	Intervals   map[Interval]string
+	ResultLimit uint32
+}
+
+// Builder aggregates trades into candles for a single exchange, currency pair,
+// asset type and interval
+type Builder struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval Interval
+	candle   *Candle
+
+	closed     bool
+	lastClosed time.Time
+}
+
+// Pipe is a candle subscription, C receives each candle update
+type Pipe struct {
+	C <-chan *Item
+	*dispatch.Relay
+}
+
+// Service holds the dispatch routing information for candles built from
+// trades
+type Service struct {
+	Candles  map[candleKey]*candleRoute
+	Exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.RWMutex
+}
+
+type candleKey struct {
+	Exchange string
+	Base     *currency.Item
+	Quote    *currency.Item
+	Asset    asset.Item
+	Interval Interval
+}
+
+type candleRoute struct {
+	Main  uuid.UUID
+	Assoc []uuid.UUID
+}
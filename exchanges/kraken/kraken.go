@@ -10,7 +10,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -67,11 +66,6 @@ type Kraken struct {
 	wsRequestMtx               sync.Mutex
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (k *Kraken) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
-
 // GetServerTime returns current server time
 func (k *Kraken) GetServerTime() (TimeResponse, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.API.Endpoints.URL, krakenAPIVersion, krakenServerTime)
@@ -207,8 +201,21 @@ func (k *Kraken) GetTickers(pairList string) (map[string]Ticker, error) {
 
 // GetOHLC returns an array of open high low close values of a currency pair
 func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
+	return k.GetOHLCData(symbol, "", time.Time{})
+}
+
+// GetOHLCData returns an array of open high low close values of a currency
+// pair for an interval in minutes, the exchange returns at most the latest 720
+// values committed after since
+func (k *Kraken) GetOHLCData(symbol, interval string, since time.Time) ([]OpenHighLowClose, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
+	if interval != "" {
+		values.Set("interval", interval)
+	}
+	if !since.IsZero() {
+		values.Set("since", strconv.FormatInt(since.Unix(), 10))
+	}
 
 	type Response struct {
 		Error []interface{}          `json:"error"`
@@ -229,29 +236,44 @@ func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
 		return OHLC, fmt.Errorf("getOHLC error: %s", result.Error)
 	}
 
-	for _, y := range result.Data[symbol].([]interface{}) {
-		o := OpenHighLowClose{}
-		for i, x := range y.([]interface{}) {
-			switch i {
-			case 0:
-				o.Time = x.(float64)
-			case 1:
-				o.Open, _ = strconv.ParseFloat(x.(string), 64)
-			case 2:
-				o.High, _ = strconv.ParseFloat(x.(string), 64)
-			case 3:
-				o.Low, _ = strconv.ParseFloat(x.(string), 64)
-			case 4:
-				o.Close, _ = strconv.ParseFloat(x.(string), 64)
-			case 5:
-				o.VolumeWeightedAveragePrice, _ = strconv.ParseFloat(x.(string), 64)
-			case 6:
-				o.Volume, _ = strconv.ParseFloat(x.(string), 64)
-			case 7:
-				o.Count = x.(float64)
+	// The result is keyed by the exchange pair name which can differ from
+	// the requested symbol, alongside the "last" id for polling
+	for key, data := range result.Data {
+		if key == "last" {
+			continue
+		}
+		rows, ok := data.([]interface{})
+		if !ok {
+			return OHLC, fmt.Errorf("getOHLC error: unable to parse %s data", key)
+		}
+		for _, y := range rows {
+			row, ok := y.([]interface{})
+			if !ok || len(row) < 8 {
+				return OHLC, fmt.Errorf("getOHLC error: unable to parse %s data", key)
+			}
+			o := OpenHighLowClose{}
+			for i, x := range row {
+				switch i {
+				case 0:
+					o.Time, _ = x.(float64)
+				case 1:
+					o.Open, _ = strconv.ParseFloat(fmt.Sprint(x), 64)
+				case 2:
+					o.High, _ = strconv.ParseFloat(fmt.Sprint(x), 64)
+				case 3:
+					o.Low, _ = strconv.ParseFloat(fmt.Sprint(x), 64)
+				case 4:
+					o.Close, _ = strconv.ParseFloat(fmt.Sprint(x), 64)
+				case 5:
+					o.VolumeWeightedAveragePrice, _ = strconv.ParseFloat(fmt.Sprint(x), 64)
+				case 6:
+					o.Volume, _ = strconv.ParseFloat(fmt.Sprint(x), 64)
+				case 7:
+					o.Count, _ = x.(float64)
+				}
 			}
+			OHLC = append(OHLC, o)
 		}
-		OHLC = append(OHLC, o)
 	}
 	return OHLC, nil
 }
@@ -1,20 +1,26 @@
 package kraken
 
 import (
+	"encoding/json"
 	"log"
 	"net/http"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 )
 
@@ -81,6 +87,22 @@ func TestGetAssetPairs(t *testing.T) {
 	}
 }
 
+func TestFetchTradingRules(t *testing.T) {
+	t.Parallel()
+	_, err := k.FetchTradingRules(asset.Spot)
+	if err != nil {
+		t.Error("FetchTradingRules() error", err)
+	}
+}
+
+func TestUpdateTradingRules(t *testing.T) {
+	t.Parallel()
+	err := k.UpdateTradingRules()
+	if err != nil {
+		t.Error("UpdateTradingRules() error", err)
+	}
+}
+
 // TestGetTicker API endpoint test
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
@@ -108,6 +130,26 @@ func TestGetOHLC(t *testing.T) {
 	}
 }
 
+// TestGetOHLCData API endpoint test
+func TestGetOHLCData(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetOHLCData("XBTUSD", "60", time.Now().Add(-time.Hour*24))
+	if err != nil {
+		t.Error("GetOHLCData() error", err)
+	}
+}
+
+// TestGetHistoricCandles API endpoint test
+func TestGetHistoricCandles(t *testing.T) {
+	t.Parallel()
+	end := time.Now().Truncate(time.Hour)
+	_, err := k.GetHistoricCandles(currency.NewPairFromString("XBTUSD"),
+		asset.Spot, end.Add(-time.Hour*24), end, kline.OneHour)
+	if err != nil {
+		t.Error("GetHistoricCandles() error", err)
+	}
+}
+
 // TestGetDepth API endpoint test
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
@@ -737,3 +779,191 @@ func TestWsCancelOrder(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// checksumBook is the orderbook from the Kraken websocket checksum
+// documentation, its checksum is 974947235
+func checksumBook(p currency.Pair) *orderbook.Base {
+	b := &orderbook.Base{Pair: p, AssetType: asset.Spot}
+	for _, price := range []float64{0.05005, 0.0501, 0.05015, 0.0502, 0.05025, 0.0503, 0.05035, 0.0504, 0.05045, 0.0505} {
+		b.Asks = append(b.Asks, orderbook.Item{Price: price, Amount: 0.000005})
+	}
+	for _, price := range []float64{0.05, 0.04995, 0.0499, 0.0498, 0.04975, 0.0497, 0.04965, 0.0496, 0.04955, 0.0495} {
+		b.Bids = append(b.Bids, orderbook.Item{Price: price, Amount: 0.000005})
+	}
+	return b
+}
+
+func TestWsChecksumVerifier(t *testing.T) {
+	p := currency.NewPairWithDelimiter("LTC", "XBT", "/")
+	v := wsChecksumVerifier{k: &k}
+	b := checksumBook(p)
+	if err := v.Verify(b, &wsorderbook.WebsocketOrderbookUpdate{Checksum: 1}); err != nil {
+		t.Errorf("pairs without trading rules should not be verified received %v", err)
+	}
+
+	err := k.LoadTradingRules(asset.Spot, []order.TradingRules{
+		{Pair: p, Asset: asset.Spot, PriceTickSize: 0.00001, AmountStepSize: 0.00000001},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = v.Verify(b, &wsorderbook.WebsocketOrderbookUpdate{Checksum: 974947235}); err != nil {
+		t.Error(err)
+	}
+	b.Bids[0].Amount = 0.000006
+	if err = v.Verify(b, &wsorderbook.WebsocketOrderbookUpdate{Checksum: 974947235}); err == nil {
+		t.Error("expected checksum mismatch")
+	}
+}
+
+func TestWsOrderbookData(t *testing.T) {
+	pressXToJSON := []byte(`[1234,{"a":[["5541.30000","2.50700000","1534614248.123678"]]},{"b":[["5541.20000","1.52900000","1534614248.765567"]],"c":"974942666"},"book-10","XBT/USD"]`)
+	var response WebsocketDataResponse
+	err := json.Unmarshal(pressXToJSON, &response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := wsOrderbookData(response)
+	if _, ok := data["a"].([]interface{}); !ok {
+		t.Error("expected ask updates")
+	}
+	if _, ok := data["b"].([]interface{}); !ok {
+		t.Error("expected bid updates")
+	}
+	if data["c"] != "974942666" {
+		t.Errorf("expected checksum received %v", data["c"])
+	}
+}
+
+func TestWsOrderbookSnapshot(t *testing.T) {
+	k.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	pressXToJSON := []byte(`[1234,{"as":[["5541.30000","2.50700000","1534614248.123678"],["5541.80000","0.33000000","1534614098.345543"]],"bs":[["5541.20000","1.52900000","1534614248.765567"],["5539.90000","0.30000000","1534614241.769870"]]},"book-10","XBT/USD"]`)
+	var response WebsocketDataResponse
+	err := json.Unmarshal(pressXToJSON, &response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := currency.NewPairWithDelimiter("XBT", "USD", "/")
+	k.wsProcessOrderBook(&WebsocketChannelData{Subscription: krakenWsOrderbook, Pair: p},
+		wsOrderbookData(response))
+	ob := k.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+	if ob == nil {
+		t.Fatal("expected orderbook snapshot to be loaded")
+	}
+	if len(ob.Asks) != 2 || len(ob.Bids) != 2 || ob.Bids[0].Price != 5541.2 {
+		t.Errorf("unexpected orderbook snapshot %+v", ob)
+	}
+}
+
+func TestWsOrderStatus(t *testing.T) {
+	testCases := []struct {
+		status   string
+		executed float64
+		expected order.Status
+	}{
+		{"pending", 0, order.New},
+		{"open", 0, order.Active},
+		{"open", 0.5, order.PartiallyFilled},
+		{"closed", 1, order.Filled},
+		{"canceled", 0, order.Cancelled},
+		{"expired", 0, order.Expired},
+		{"bad", 0, order.UnknownStatus},
+	}
+	for x := range testCases {
+		s := wsOrderStatus(testCases[x].status,
+			&wshandler.OrderUpdate{ExecutedAmount: testCases[x].executed})
+		if s != testCases[x].expected {
+			t.Errorf("%s expected %v received %v",
+				testCases[x].status, testCases[x].expected, s)
+		}
+	}
+}
+
+func TestWsOrderType(t *testing.T) {
+	testCases := []struct {
+		oType    string
+		expected order.Type
+	}{
+		{"limit", order.Limit},
+		{"market", order.Market},
+		{"stop-loss", order.Stop},
+		{"stop-loss-limit", order.Stop},
+		{"trailing-stop", order.TrailingStop},
+		{"trailing-stop-limit", order.TrailingStop},
+		{"settle-position", order.Unknown},
+	}
+	for x := range testCases {
+		if o := wsOrderType(testCases[x].oType); o != testCases[x].expected {
+			t.Errorf("%s expected %v received %v",
+				testCases[x].oType, testCases[x].expected, o)
+		}
+	}
+}
+
+func TestWsProcessOpenOrders(t *testing.T) {
+	k.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	pressXToJSON := []byte(`[[{"OGTT3Y-C6I3P-XRI6HX":{"cost":"0.00000","descr":{"close":"","leverage":"0:1","order":"sell 10.00345345 XBT/EUR @ limit 34.50000 with 0:1 leverage","ordertype":"limit","pair":"XBT/EUR","price":"34.50000","price2":"0.00000","type":"sell"},"expiretm":"0.000000","fee":"0.00000","limitprice":"34.50000","misc":"","oflags":"fcib","opentm":"1560516023.070651","refid":"OKIVMP-5GVZN-Z2D2UA","starttm":"0.000000","status":"open","stopprice":"0.000000","userref":1337,"vol":"10.00345345","vol_exec":"4.00000000"}}],"openOrders"]`)
+	var response []interface{}
+	err := json.Unmarshal(pressXToJSON, &response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.wsProcessOpenOrders(response[0])
+	u, ok := (<-k.Websocket.DataHandler).(wshandler.OrderUpdate)
+	if !ok {
+		t.Fatal("expected an order update")
+	}
+	if u.OrderID != "OGTT3Y-C6I3P-XRI6HX" ||
+		u.ClientOrderID != "1337" ||
+		u.Status != order.PartiallyFilled ||
+		u.Type != order.Limit ||
+		u.Side != order.Sell ||
+		u.Price != 34.5 ||
+		u.Amount != 10.00345345 ||
+		u.ExecutedAmount != 4 ||
+		u.CurrencyPair.String() != "XBT/EUR" {
+		t.Errorf("unexpected order update %+v", u)
+	}
+
+	pressXToJSON = []byte(`[[{"OGTT3Y-C6I3P-XRI6HX":{"status":"closed","vol_exec":"10.00345345","cost":"345.11914","fee":"0.55219"}}],"openOrders"]`)
+	err = json.Unmarshal(pressXToJSON, &response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.wsProcessOpenOrders(response[0])
+	u, ok = (<-k.Websocket.DataHandler).(wshandler.OrderUpdate)
+	if !ok {
+		t.Fatal("expected an order update")
+	}
+	if u.Status != order.Filled ||
+		u.ExecutedAmount != 10.00345345 ||
+		u.Fee != 0.55219 ||
+		u.Amount != 0 ||
+		u.Type != "" {
+		t.Errorf("expected a change with only the order status and fill received %+v", u)
+	}
+}
+
+func TestWsProcessOwnTrades(t *testing.T) {
+	k.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	pressXToJSON := []byte(`[[{"TDLH43-DVQXD-2KHVYY":{"cost":"1000000.00000","fee":"1600.00000","margin":"0.00000","ordertxid":"OGTT3Y-C6I3P-XRI6HX","ordertype":"limit","pair":"XBT/EUR","postxid":"OGTT3Y-C6I3P-XRI6HX","price":"100000.00000","time":"1560516023.070651","type":"sell","vol":"10.00000000"}},{"TDLH43-DVQXD-2KHVYZ":{"cost":"34.50000","fee":"0.05520","margin":"0.00000","ordertxid":"OGTT3Y-C6I3P-XRI6HX","ordertype":"limit","pair":"XBT/EUR","postxid":"OGTT3Y-C6I3P-XRI6HX","price":"34.50000","time":"1560516024.070651","type":"sell","vol":"1.00000000"}}],"ownTrades"]`)
+	var response []interface{}
+	err := json.Unmarshal(pressXToJSON, &response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.wsProcessOwnTrades(response[0])
+	for _, tradeID := range []string{"TDLH43-DVQXD-2KHVYY", "TDLH43-DVQXD-2KHVYZ"} {
+		f, ok := (<-k.Websocket.DataHandler).(wshandler.FillUpdate)
+		if !ok {
+			t.Fatal("expected a fill update")
+		}
+		if f.TradeID != tradeID ||
+			f.OrderID != "OGTT3Y-C6I3P-XRI6HX" ||
+			f.Side != order.Sell ||
+			f.FeeCurrency != "EUR" ||
+			f.Timestamp.Unix() == 0 {
+			t.Errorf("unexpected fill update %+v", f)
+		}
+	}
+}
@@ -1,10 +1,6 @@
 package kraken
 
-import (
-	"time"
-
-	"github.com/thrasher-corp/gocryptotrader/currency"
-)
+import "github.com/thrasher-corp/gocryptotrader/currency"
 
 // TimeResponse type
 type TimeResponse struct {
@@ -38,6 +34,7 @@ type AssetPairs struct {
 	FeeVolumeCurrency string      `json:"fee_volume_currency"`
 	MarginCall        int         `json:"margin_call"`
 	MarginStop        int         `json:"margin_stop"`
+	OrderMinimum      float64     `json:"ordermin,string"`
 }
 
 // Ticker is a standard ticker type
@@ -475,53 +472,6 @@ type WsTokenResponse struct {
 	} `json:"result"`
 }
 
-// WsOwnTrade ws auth owntrade data
-type WsOwnTrade struct {
-	Cost               float64   `json:"cost,string"`
-	Fee                float64   `json:"fee,string"`
-	Margin             float64   `json:"margin,string"`
-	OrderTransactionID string    `json:"ordertxid"`
-	OrderType          string    `json:"ordertype"`
-	Pair               string    `json:"pair"`
-	PostTransactionID  string    `json:"postxid"`
-	Price              float64   `json:"price,string"`
-	Time               time.Time `json:"time"`
-	Type               string    `json:"type"`
-	Vol                float64   `json:"vol,string"`
-}
-
-// WsOpenOrders ws auth open order data
-type WsOpenOrders struct {
-	Cost           float64                `json:"cost,string"`
-	Description    WsOpenOrderDescription `json:"descr"`
-	ExpireTime     time.Time              `json:"expiretm"`
-	Fee            float64                `json:"fee,string"`
-	LimitPrice     float64                `json:"limitprice,string"`
-	Misc           string                 `json:"misc"`
-	OFlags         string                 `json:"oflags"`
-	OpenTime       time.Time              `json:"opentm"`
-	Price          float64                `json:"price,string"`
-	RefID          string                 `json:"refid"`
-	StartTime      time.Time              `json:"starttm"`
-	Status         string                 `json:"status"`
-	StopPrice      float64                `json:"stopprice,string"`
-	UserReference  float64                `json:"userref"`
-	Volume         float64                `json:"vol,string"`
-	ExecutedVolume float64                `json:"vol_exec,string"`
-}
-
-// WsOpenOrderDescription additional data for WsOpenOrders
-type WsOpenOrderDescription struct {
-	Close     string  `json:"close"`
-	Leverage  string  `json:"leverage"`
-	Order     string  `json:"order"`
-	OrderType string  `json:"ordertype"`
-	Pair      string  `json:"pair"`
-	Price     float64 `json:"price,string"`
-	Price2    float64 `json:"price2,string"`
-	Type      string  `json:"type"`
-}
-
 // WsAddOrderRequest request type for ws adding order
 type WsAddOrderRequest struct {
 	Event           string  `json:"event"`
@@ -7,6 +7,7 @@ import (
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -14,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -44,6 +46,7 @@ const (
 	krakenWsCancelOrder        = "cancelOrder"
 	krakenWsRateLimit          = 50
 	krakenWsPingDelay          = time.Second * 27
+	krakenWsChecksumDepth      = 10
 )
 
 // orderbookMutex Ensures if two entries arrive at once, only one can be processed at a time
@@ -187,7 +190,7 @@ func (k *Kraken) WsHandleDataResponse(response WebsocketDataResponse) {
 				log.Debugf(log.ExchangeSys, "%v Websocket Orderbook data received",
 					k.Name)
 			}
-			k.wsProcessOrderBook(&channelData, response[1].(map[string]interface{}))
+			k.wsProcessOrderBook(&channelData, wsOrderbookData(response))
 		case krakenWsSpread:
 			if k.Verbose {
 				log.Debugf(log.ExchangeSys, "%v Websocket Spread data received",
@@ -252,181 +255,171 @@ func (k *Kraken) wsHandleAuthDataResponse(response WebsocketDataResponse) {
 				log.Debugf(log.ExchangeSys, "%v Websocket auth own trade data received",
 					k.Name)
 			}
-			k.wsProcessOwnTrades(&response[0])
+			k.wsProcessOwnTrades(response[0])
 		case krakenWsOpenOrders:
 			if k.Verbose {
 				log.Debugf(log.ExchangeSys, "%v Websocket auth open order data received",
 					k.Name)
 			}
-			k.wsProcessOpenOrders(&response[0])
+			k.wsProcessOpenOrders(response[0])
 		}
 	}
 }
 
+// wsProcessOwnTrades sends our executed trades to the data handler as
+// normalised fills
 func (k *Kraken) wsProcessOwnTrades(ownOrders interface{}) {
-	if data, ok := ownOrders.([]interface{}); ok {
-		for i := range data {
-			ownTrade := data[i].(map[string]interface{})
-			for _, val := range ownTrade {
-				tradeData := val.(map[string]interface{})
-				cost, err := strconv.ParseFloat(tradeData["cost"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				fee, err := strconv.ParseFloat(tradeData["fee"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				margin, err := strconv.ParseFloat(tradeData["margin"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				vol, err := strconv.ParseFloat(tradeData["vol"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				price, err := strconv.ParseFloat(tradeData["price"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				timeTogether, err := strconv.ParseFloat(tradeData["time"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				first, second, err := convert.SplitFloatDecimals(timeTogether)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				k.Websocket.DataHandler <- WsOwnTrade{
-					Cost:               cost,
-					Fee:                fee,
-					Margin:             margin,
-					OrderTransactionID: tradeData["ordertxid"].(string),
-					OrderType:          tradeData["ordertype"].(string),
-					Pair:               tradeData["pair"].(string),
-					PostTransactionID:  tradeData["postxid"].(string),
-					Price:              price,
-					Time:               time.Unix(first, second),
-					Type:               tradeData["type"].(string),
-					Vol:                vol,
-				}
+	data, ok := ownOrders.([]interface{})
+	if !ok {
+		k.Websocket.DataHandler <- errors.New(k.Name + " - Invalid own trades data")
+		return
+	}
+	for i := range data {
+		ownTrade, ok := data[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key, val := range ownTrade {
+			tradeData, ok := val.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			orderID, _ := tradeData["ordertxid"].(string)
+			pair, _ := tradeData["pair"].(string)
+			side, _ := tradeData["type"].(string)
+			p := currency.NewPairDelimiter(pair, "/")
+			k.Websocket.DataHandler <- wshandler.FillUpdate{
+				Timestamp:    k.wsParseTime(tradeData, "time"),
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+				Exchange:     k.Name,
+				OrderID:      orderID,
+				TradeID:      key,
+				Side:         order.Side(strings.ToUpper(side)),
+				Price:        k.wsParseFloat(tradeData, "price"),
+				Amount:       k.wsParseFloat(tradeData, "vol"),
+				Fee:          k.wsParseFloat(tradeData, "fee"),
+				// Fees are charged in the quote currency unless the order
+				// was placed with the fcib flag
+				FeeCurrency: p.Quote.String(),
 			}
 		}
-	} else {
-		k.Websocket.DataHandler <- errors.New(k.Name + " - Invalid own trades data")
 	}
 }
 
+// wsProcessOpenOrders sends our order snapshots and changes to the data
+// handler as normalised order updates. Changes only contain the order status
+// and the fields which have changed.
 func (k *Kraken) wsProcessOpenOrders(ownOrders interface{}) {
-	if data, ok := ownOrders.([]interface{}); ok {
-		for i := range data {
-			ownTrade := data[i].(map[string]interface{})
-			for key, val := range ownTrade {
-				tradeData := val.(map[string]interface{})
-				if len(tradeData) == 1 {
-					// just a status update
-					if status, ok := tradeData["status"].(string); ok {
-						k.Websocket.DataHandler <- k.Name + " - Order " + key + " " + status
-					}
-				}
-				startTimeConv, err := strconv.ParseFloat(tradeData["starttm"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				startTime, startTimeNano, err := convert.SplitFloatDecimals(startTimeConv)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				openTimeConv, err := strconv.ParseFloat(tradeData["opentm"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				openTime, openTimeNano, err := convert.SplitFloatDecimals(openTimeConv)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				expireTimeConv, err := strconv.ParseFloat(tradeData["expiretm"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				expireTime, expireTimeNano, err := convert.SplitFloatDecimals(expireTimeConv)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				cost, err := strconv.ParseFloat(tradeData["cost"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				executedVolume, err := strconv.ParseFloat(tradeData["vol_exec"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				volume, err := strconv.ParseFloat(tradeData["vol"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				userReference, err := strconv.ParseFloat(tradeData["userref"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				stopPrice, err := strconv.ParseFloat(tradeData["stopprice"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				price, err := strconv.ParseFloat(tradeData["price"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				limitPrice, err := strconv.ParseFloat(tradeData["limitprice"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				fee, err := strconv.ParseFloat(tradeData["fee"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				descriptionSubData := tradeData["description"].(map[string]interface{})
-				descriptionPrice, err := strconv.ParseFloat(descriptionSubData["price"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				descriptionPrice2, err := strconv.ParseFloat(descriptionSubData["price2"].(string), 64)
-				if err != nil {
-					k.Websocket.DataHandler <- err
-				}
-				description := WsOpenOrderDescription{
-					Close:     descriptionSubData["close"].(string),
-					Leverage:  descriptionSubData["leverage"].(string),
-					Order:     descriptionSubData["order"].(string),
-					OrderType: descriptionSubData["ordertype"].(string),
-					Pair:      descriptionSubData["pair"].(string),
-					Price:     descriptionPrice,
-					Price2:    descriptionPrice2,
-					Type:      descriptionSubData["type"].(string),
-				}
-
-				k.Websocket.DataHandler <- WsOpenOrders{
-					Cost:           cost,
-					ExpireTime:     time.Unix(expireTime, expireTimeNano),
-					Description:    description,
-					Fee:            fee,
-					LimitPrice:     limitPrice,
-					Misc:           tradeData["misc"].(string),
-					OFlags:         tradeData["oflags"].(string),
-					OpenTime:       time.Unix(openTime, openTimeNano),
-					Price:          price,
-					RefID:          tradeData["refid"].(string),
-					StartTime:      time.Unix(startTime, startTimeNano),
-					Status:         tradeData["status"].(string),
-					StopPrice:      stopPrice,
-					UserReference:  userReference,
-					Volume:         volume,
-					ExecutedVolume: executedVolume,
-				}
+	data, ok := ownOrders.([]interface{})
+	if !ok {
+		k.Websocket.DataHandler <- errors.New(k.Name + " - Invalid open orders data")
+		return
+	}
+	for i := range data {
+		openOrder, ok := data[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key, val := range openOrder {
+			orderData, ok := val.(map[string]interface{})
+			if !ok {
+				continue
 			}
+			update := wshandler.OrderUpdate{
+				Timestamp:      k.wsParseTime(orderData, "opentm"),
+				AssetType:      asset.Spot,
+				Exchange:       k.Name,
+				OrderID:        key,
+				Amount:         k.wsParseFloat(orderData, "vol"),
+				ExecutedAmount: k.wsParseFloat(orderData, "vol_exec"),
+				Fee:            k.wsParseFloat(orderData, "fee"),
+			}
+			if update.Amount > 0 {
+				update.RemainingAmount = update.Amount - update.ExecutedAmount
+			}
+			if ref, ok := orderData["userref"].(float64); ok && ref != 0 {
+				update.ClientOrderID = strconv.FormatFloat(ref, 'f', -1, 64)
+			}
+			if status, ok := orderData["status"].(string); ok {
+				update.Status = wsOrderStatus(status, &update)
+			}
+			if description, ok := orderData["descr"].(map[string]interface{}); ok {
+				pair, _ := description["pair"].(string)
+				side, _ := description["type"].(string)
+				oType, _ := description["ordertype"].(string)
+				update.CurrencyPair = currency.NewPairDelimiter(pair, "/")
+				update.Side = order.Side(strings.ToUpper(side))
+				update.Type = wsOrderType(oType)
+				update.Price = k.wsParseFloat(description, "price")
+			}
+			k.Websocket.DataHandler <- update
 		}
-	} else {
-		k.Websocket.DataHandler <- errors.New(k.Name + " - Invalid own trades data")
+	}
+}
+
+// wsParseFloat parses an optional string float field of authenticated data
+func (k *Kraken) wsParseFloat(data map[string]interface{}, field string) float64 {
+	str, ok := data[field].(string)
+	if !ok {
+		return 0
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		k.Websocket.DataHandler <- err
+	}
+	return f
+}
+
+// wsParseTime parses an optional unix timestamp field of authenticated data
+func (k *Kraken) wsParseTime(data map[string]interface{}, field string) time.Time {
+	f := k.wsParseFloat(data, field)
+	if f == 0 {
+		return time.Time{}
+	}
+	sec, nsec, err := convert.SplitFloatDecimals(f)
+	if err != nil {
+		k.Websocket.DataHandler <- err
+		return time.Time{}
+	}
+	return time.Unix(sec, nsec)
+}
+
+// wsOrderStatus converts an order status, open orders which have been
+// partially executed are returned as partially filled
+func wsOrderStatus(status string, update *wshandler.OrderUpdate) order.Status {
+	switch status {
+	case "pending":
+		return order.New
+	case "open":
+		if update.ExecutedAmount > 0 {
+			return order.PartiallyFilled
+		}
+		return order.Active
+	case "closed":
+		return order.Filled
+	case "canceled":
+		return order.Cancelled
+	case "expired":
+		return order.Expired
+	default:
+		return order.UnknownStatus
+	}
+}
+
+// wsOrderType converts an order type e.g. stop-loss
+func wsOrderType(oType string) order.Type {
+	switch oType {
+	case "limit":
+		return order.Limit
+	case "market":
+		return order.Market
+	case "stop-loss", "stop-loss-limit":
+		return order.Stop
+	case "trailing-stop", "trailing-stop-limit":
+		return order.TrailingStop
+	default:
+		return order.Unknown
 	}
 }
 
@@ -574,19 +567,43 @@ func (k *Kraken) wsProcessTrades(channelData *WebsocketChannelData, data []inter
 	}
 }
 
+// wsOrderbookData merges the ask and bid objects of an orderbook message,
+// updates to both sides are sent as separate objects with the checksum in the
+// last
+func wsOrderbookData(response WebsocketDataResponse) map[string]interface{} {
+	data := make(map[string]interface{})
+	for i := 1; i < len(response); i++ {
+		if m, ok := response[i].(map[string]interface{}); ok {
+			for key, value := range m {
+				data[key] = value
+			}
+		}
+	}
+	return data
+}
+
 // wsProcessOrderBook determines if the orderbook data is partial or update
 // Then sends to appropriate fun
 func (k *Kraken) wsProcessOrderBook(channelData *WebsocketChannelData, data map[string]interface{}) {
 	if fullAsk, ok := data["as"].([]interface{}); ok {
-		fullBids := data["as"].([]interface{})
+		fullBids, _ := data["bs"].([]interface{})
 		k.wsProcessOrderBookPartial(channelData, fullAsk, fullBids)
 	} else {
 		askData, asksExist := data["a"].([]interface{})
 		bidData, bidsExist := data["b"].([]interface{})
 		if asksExist || bidsExist {
+			var checksum uint32
+			if c, ok := data["c"].(string); ok {
+				parsed, err := strconv.ParseUint(c, 10, 32)
+				if err != nil {
+					k.Websocket.DataHandler <- err
+					return
+				}
+				checksum = uint32(parsed)
+			}
 			k.wsRequestMtx.Lock()
 			defer k.wsRequestMtx.Unlock()
-			err := k.wsProcessOrderBookUpdate(channelData, askData, bidData)
+			err := k.wsProcessOrderBookUpdate(channelData, askData, bidData, checksum)
 			if err != nil {
 				subscriptionToRemove := wshandler.WebsocketChannelSubscription{
 					Channel:  krakenWsOrderbook,
@@ -678,10 +695,11 @@ func (k *Kraken) wsProcessOrderBookPartial(channelData *WebsocketChannelData, as
 }
 
 // wsProcessOrderBookUpdate updates an orderbook entry for a given currency pair
-func (k *Kraken) wsProcessOrderBookUpdate(channelData *WebsocketChannelData, askData, bidData []interface{}) error {
+func (k *Kraken) wsProcessOrderBookUpdate(channelData *WebsocketChannelData, askData, bidData []interface{}, checksum uint32) error {
 	update := wsorderbook.WebsocketOrderbookUpdate{
-		Asset: asset.Spot,
-		Pair:  channelData.Pair,
+		Asset:    asset.Spot,
+		Pair:     channelData.Pair,
+		Checksum: checksum,
 	}
 
 	var highestLastUpdate time.Time
@@ -756,6 +774,58 @@ func (k *Kraken) wsProcessOrderBookUpdate(channelData *WebsocketChannelData, ask
 	return nil
 }
 
+// wsChecksumVerifier verifies the checksum sent with each orderbook update.
+// Prices and amounts are checksummed to the price and lot decimals of the pair
+// which are taken from its trading rules, orderbooks of pairs without trading
+// rules are not verified.
+type wsChecksumVerifier struct {
+	k *Kraken
+}
+
+// Verify implements the wsorderbook.Verifier interface
+func (v *wsChecksumVerifier) Verify(b *orderbook.Base, u *wsorderbook.WebsocketOrderbookUpdate) error {
+	rules, err := v.k.GetTradingRules(b.Pair, b.AssetType)
+	if err != nil || rules.PriceTickSize <= 0 || rules.AmountStepSize <= 0 {
+		return nil
+	}
+	priceDecimals := incrementDecimals(rules.PriceTickSize)
+	amountDecimals := incrementDecimals(rules.AmountStepSize)
+	crc := wsorderbook.CRC32Verifier{
+		Depth: krakenWsChecksumDepth,
+		Serialise: func(b *orderbook.Base, depth int) string {
+			return wsChecksumSerialise(b, depth, priceDecimals, amountDecimals)
+		},
+	}
+	return crc.Verify(b, u)
+}
+
+// wsChecksumSerialise serialises the top asks followed by the top bids of an
+// orderbook as each price then amount formatted to their decimals with the
+// decimal point and leading zeros removed
+func wsChecksumSerialise(b *orderbook.Base, depth, priceDecimals, amountDecimals int) string {
+	var s strings.Builder
+	for i := 0; i < depth && i < len(b.Asks); i++ {
+		s.WriteString(wsChecksumValue(b.Asks[i].Price, priceDecimals))
+		s.WriteString(wsChecksumValue(b.Asks[i].Amount, amountDecimals))
+	}
+	for i := 0; i < depth && i < len(b.Bids); i++ {
+		s.WriteString(wsChecksumValue(b.Bids[i].Price, priceDecimals))
+		s.WriteString(wsChecksumValue(b.Bids[i].Amount, amountDecimals))
+	}
+	return s.String()
+}
+
+func wsChecksumValue(v float64, decimals int) string {
+	s := strings.Replace(strconv.FormatFloat(v, 'f', decimals, 64), ".", "", 1)
+	return strings.TrimLeft(s, "0")
+}
+
+// incrementDecimals returns the number of decimals of a price or amount
+// increment such as 0.001
+func incrementDecimals(increment float64) int {
+	return int(math.Round(-math.Log10(increment)))
+}
+
 // wsProcessCandles converts candle data and sends it to the data handler
 func (k *Kraken) wsProcessCandles(channelData *WebsocketChannelData, data []interface{}) {
 	startTime, err := strconv.ParseFloat(data[0].(string), 64)
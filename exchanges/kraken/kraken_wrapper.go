@@ -2,6 +2,7 @@ package kraken
 
 import (
 	"errors"
+	"math"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +15,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -111,11 +113,24 @@ func (k *Kraken) SetDefaults() {
 				SubmitOrder:        true,
 				CancelOrder:        true,
 				CancelOrders:       true,
+				OrderUpdates:       true,
 			},
 			WithdrawPermissions: exchange.AutoWithdrawCryptoWithSetup |
 				exchange.WithdrawCryptoWith2FA |
 				exchange.AutoWithdrawFiatWithSetup |
 				exchange.WithdrawFiatWith2FA,
+			Kline: kline.ExchangeCapabilities{
+				Intervals: map[kline.Interval]string{
+					kline.OneMin:     "1",
+					kline.FiveMin:    "5",
+					kline.FifteenMin: "15",
+					kline.OneHour:    "60",
+					kline.FourHour:   "240",
+					kline.OneDay:     "1440",
+					kline.OneWeek:    "10080",
+				},
+				ResultLimit: 720,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -185,13 +200,16 @@ func (k *Kraken) Setup(exch *config.ExchangeConfig) error {
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
 	}
 
+	// Updates are applied in the order received so each update checksum is
+	// verified against the orderbook it was calculated from
 	k.Websocket.Orderbook.Setup(
 		exch.WebsocketOrderbookBufferLimit,
 		true,
-		true,
+		false,
 		false,
 		false,
 		exch.Name)
+	k.Websocket.Orderbook.SetVerifiers(&wsChecksumVerifier{k: k})
 	return nil
 }
 
@@ -248,37 +266,95 @@ func (k *Kraken) FetchTradablePairs(asset asset.Item) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	return k.tradablePairs(pairs, asset), nil
+}
 
+// tradablePairs returns the asset pairs which are not dark pool pairs
+func (k *Kraken) tradablePairs(pairs map[string]AssetPairs, a asset.Item) []string {
 	var products []string
 	for i := range pairs {
-		v := pairs[i]
-		if strings.Contains(v.Altname, ".d") {
+		if strings.Contains(pairs[i].Altname, ".d") {
 			continue
 		}
-		if v.Base[0] == 'X' {
-			if len(v.Base) > 3 {
-				v.Base = v.Base[1:]
-			}
+		base, quote := assetPairCurrencies(pairs[i].Base, pairs[i].Quote)
+		products = append(products, base+
+			k.GetPairFormat(a, false).Delimiter+
+			quote)
+	}
+	return products
+}
+
+// FetchTradingRules returns the price and volume decimals and minimum order
+// volume of each pair
+func (k *Kraken) FetchTradingRules(a asset.Item) ([]order.TradingRules, error) {
+	pairs, err := k.GetAssetPairs()
+	if err != nil {
+		return nil, err
+	}
+	return k.tradingRules(pairs, a), nil
+}
+
+// tradingRules returns the price and volume decimals and minimum order volume
+// of the asset pairs which are not dark pool pairs
+func (k *Kraken) tradingRules(pairs map[string]AssetPairs, a asset.Item) []order.TradingRules {
+	var rules []order.TradingRules
+	for i := range pairs {
+		if strings.Contains(pairs[i].Altname, ".d") {
+			continue
 		}
-		if v.Quote[0] == 'Z' || v.Quote[0] == 'X' {
-			v.Quote = v.Quote[1:]
+		base, quote := assetPairCurrencies(pairs[i].Base, pairs[i].Quote)
+		rules = append(rules, order.TradingRules{
+			Pair: currency.NewPairWithDelimiter(base,
+				quote,
+				k.GetPairFormat(a, false).Delimiter),
+			Asset:          a,
+			PriceTickSize:  math.Pow10(-pairs[i].PairDecimals),
+			AmountStepSize: math.Pow10(-pairs[i].LotDecimals),
+			MinAmount:      pairs[i].OrderMinimum,
+		})
+	}
+	return rules
+}
+
+// assetPairCurrencies returns the base and quote currencies of an asset pair
+// without the X and Z asset class prefixes
+func assetPairCurrencies(base, quote string) (string, string) {
+	if base[0] == 'X' {
+		if len(base) > 3 {
+			base = base[1:]
 		}
-		products = append(products, v.Base+
-			k.GetPairFormat(asset, false).Delimiter+
-			v.Quote)
 	}
-	return products, nil
+	if quote[0] == 'Z' || quote[0] == 'X' {
+		quote = quote[1:]
+	}
+	return base, quote
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are updated
+// from the same response
 func (k *Kraken) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := k.FetchTradablePairs(asset.Spot)
+	pairs, err := k.GetAssetPairs()
 	if err != nil {
 		return err
 	}
 
-	return k.UpdatePairs(currency.NewPairsFromStrings(pairs), asset.Spot, false, forceUpdate)
+	err = k.UpdatePairs(currency.NewPairsFromStrings(k.tradablePairs(pairs, asset.Spot)),
+		asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+	return k.LoadTradingRules(asset.Spot, k.tradingRules(pairs, asset.Spot))
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (k *Kraken) UpdateTradingRules() error {
+	rules, err := k.FetchTradingRules(asset.Spot)
+	if err != nil {
+		return err
+	}
+	return k.LoadTradingRules(asset.Spot, rules)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -512,6 +588,22 @@ func (k *Kraken) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 	return cancelAllOrdersResponse, nil
 }
 
+// SubmitOrders submits a batch of orders
+func (k *Kraken) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	return exchange.SubmitOrdersConcurrently(s, k.SubmitOrder)
+}
+
+// BatchCancelOrders cancels a batch of orders
+func (k *Kraken) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	return exchange.CancelOrdersConcurrently(orders, k.CancelOrder)
+}
+
+// SetDeadMansSwitch arms a timer on the exchange which cancels all open orders
+// unless it is refreshed within the timeout
+func (k *Kraken) SetDeadMansSwitch(timeout time.Duration) error {
+	return common.ErrFunctionNotSupported
+}
+
 // GetOrderInfo returns information on a current open order
 func (k *Kraken) GetOrderInfo(orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
@@ -727,3 +819,44 @@ func (k *Kraken) ValidateCredentials() error {
 	_, err := k.UpdateAccountInfo()
 	return k.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, the exchange only serves the latest 720 candles of an interval
+func (k *Kraken) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := k.ValidateKline(pair, a, start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+
+	intervalString, err := k.FormatExchangeKlineInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	ret := kline.Item{
+		Exchange: k.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+
+	candles, err := k.GetOHLCData(k.FormatExchangeCurrency(pair, a).String(),
+		intervalString,
+		start.Add(-time.Duration(interval)))
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	for x := range candles {
+		ret.Candles = append(ret.Candles, kline.Candle{
+			Time:   time.Unix(int64(candles[x].Time), 0),
+			Open:   candles[x].Open,
+			High:   candles[x].High,
+			Low:    candles[x].Low,
+			Close:  candles[x].Close,
+			Volume: candles[x].Volume,
+		})
+	}
+
+	ret.Tidy(start, end)
+	return ret, nil
+}
@@ -397,6 +397,28 @@ func (l *LakeBTC) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, err
 	return cancelAllOrdersResponse, l.CancelExistingOrders(ordersToCancel)
 }
 
+// SubmitOrders submits a batch of orders
+func (l *LakeBTC) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	return exchange.SubmitOrdersConcurrently(s, l.SubmitOrder)
+}
+
+// BatchCancelOrders cancels a batch of orders
+func (l *LakeBTC) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	return exchange.CancelOrdersConcurrently(orders, l.CancelOrder)
+}
+
+// SetDeadMansSwitch arms a timer on the exchange which cancels all open orders
+// unless it is refreshed within the timeout
+func (l *LakeBTC) SetDeadMansSwitch(timeout time.Duration) error {
+	return common.ErrFunctionNotSupported
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (l *LakeBTC) UpdateTradingRules() error {
+	return common.ErrFunctionNotSupported
+}
+
 // GetOrderInfo returns information on a current open order
 func (l *LakeBTC) GetOrderInfo(orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
@@ -17,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
@@ -454,6 +455,13 @@ func TestGetSpotTokenPairDetails(t *testing.T) {
 	}
 }
 
+func TestFetchTradingRules(t *testing.T) {
+	_, err := o.FetchTradingRules(asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 // TestGetSpotAllTokenPairsInformation API endpoint test
 func TestGetSpotAllTokenPairsInformation(t *testing.T) {
 	_, err := o.GetSpotAllTokenPairsInformation()
@@ -493,6 +501,16 @@ func TestGetSpotMarketData(t *testing.T) {
 	}
 }
 
+// TestGetHistoricCandles API endpoint test
+func TestGetHistoricCandles(t *testing.T) {
+	end := time.Now().Truncate(time.Hour)
+	_, err := o.GetHistoricCandles(currency.NewPairDelimiter("BTC-USD", "-"),
+		asset.Spot, end.Add(-time.Hour*24), end, kline.OneHour)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 // TestGetMarginTradingAccounts API endpoint test
 func TestGetMarginTradingAccounts(t *testing.T) {
 	_, err := o.GetMarginTradingAccounts()
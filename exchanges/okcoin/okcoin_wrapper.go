@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -103,9 +104,22 @@ func (o *OKCoin) SetDefaults() {
 				Unsubscribe:            true,
 				AuthenticatedEndpoints: true,
 				MessageCorrelation:     true,
+				OrderUpdates:           true,
 			},
 			WithdrawPermissions: exchange.AutoWithdrawCrypto |
 				exchange.NoFiatWithdrawals,
+			Kline: kline.ExchangeCapabilities{
+				Intervals: map[kline.Interval]string{
+					kline.OneMin:     "60",
+					kline.FiveMin:    "300",
+					kline.FifteenMin: "900",
+					kline.OneHour:    "3600",
+					kline.FourHour:   "14400",
+					kline.OneDay:     "86400",
+					kline.OneWeek:    "604800",
+				},
+				ResultLimit: 200,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -189,27 +203,14 @@ func (o *OKCoin) FetchTradablePairs(asset asset.Item) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
-
-	var pairs []string
-	for x := range prods {
-		pairs = append(pairs, prods[x].BaseCurrency+
-			o.GetPairFormat(asset, false).Delimiter+
-			prods[x].QuoteCurrency)
-	}
-
-	return pairs, nil
+	return o.SpotPairs(prods), nil
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are updated
+// from the same response
 func (o *OKCoin) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := o.FetchTradablePairs(asset.Spot)
-	if err != nil {
-		return err
-	}
-
-	return o.UpdatePairs(currency.NewPairsFromStrings(pairs),
-		asset.Spot, false, forceUpdate)
+	return o.UpdateSpotPairs(forceUpdate)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
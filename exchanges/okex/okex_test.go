@@ -19,6 +19,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
@@ -486,6 +487,14 @@ func TestGetSpotTokenPairDetails(t *testing.T) {
 	}
 }
 
+func TestFetchTradingRules(t *testing.T) {
+	t.Parallel()
+	_, err := o.FetchTradingRules(asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 // TestGetSpotAllTokenPairsInformation API endpoint test
 func TestGetSpotAllTokenPairsInformation(t *testing.T) {
 	t.Parallel()
@@ -529,6 +538,17 @@ func TestGetSpotMarketData(t *testing.T) {
 	}
 }
 
+// TestGetHistoricCandles API endpoint test
+func TestGetHistoricCandles(t *testing.T) {
+	t.Parallel()
+	end := time.Now().Truncate(time.Hour)
+	_, err := o.GetHistoricCandles(currency.NewPairDelimiter("BTC-USDT", "-"),
+		asset.Spot, end.Add(-time.Hour*24), end, kline.OneHour)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 // TestGetMarginTradingAccounts API endpoint test
 func TestGetMarginTradingAccounts(t *testing.T) {
 	t.Parallel()
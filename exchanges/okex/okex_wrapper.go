@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -137,9 +138,22 @@ func (o *OKEX) SetDefaults() {
 				Unsubscribe:            true,
 				AuthenticatedEndpoints: true,
 				MessageCorrelation:     true,
+				OrderUpdates:           true,
 			},
 			WithdrawPermissions: exchange.AutoWithdrawCrypto |
 				exchange.NoFiatWithdrawals,
+			Kline: kline.ExchangeCapabilities{
+				Intervals: map[kline.Interval]string{
+					kline.OneMin:     "60",
+					kline.FiveMin:    "300",
+					kline.FifteenMin: "900",
+					kline.OneHour:    "3600",
+					kline.FourHour:   "14400",
+					kline.OneDay:     "86400",
+					kline.OneWeek:    "604800",
+				},
+				ResultLimit: 200,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -224,14 +238,7 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 		if err != nil {
 			return nil, err
 		}
-
-		for x := range prods {
-			pairs = append(pairs,
-				currency.NewPairWithDelimiter(prods[x].BaseCurrency,
-					prods[x].QuoteCurrency,
-					o.GetPairFormat(i, false).Delimiter).String())
-		}
-		return pairs, nil
+		return o.SpotPairs(prods), nil
 	case asset.Futures:
 		prods, err := o.GetFuturesContractInformation()
 		if err != nil {
@@ -269,12 +276,21 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the spot trading rules are updated with the
+// spot pairs
 func (o *OKEX) UpdateTradablePairs(forceUpdate bool) error {
 	for x := range o.CurrencyPairs.AssetTypes {
-		if o.CurrencyPairs.AssetTypes[x] == asset.Index {
+		switch o.CurrencyPairs.AssetTypes[x] {
+		case asset.Index:
 			// Update from futures
 			continue
+		case asset.Spot:
+			// Trading rules are updated with the spot pairs
+			err := o.UpdateSpotPairs(forceUpdate)
+			if err != nil {
+				return err
+			}
+			continue
 		}
 
 		pairs, err := o.FetchTradablePairs(o.CurrencyPairs.AssetTypes[x])
@@ -79,6 +79,10 @@ const (
 	okGroupGetLoanHistory        = "borrowed"
 	okGroupGetLoan               = "borrow"
 	okGroupGetRepayment          = "repayment"
+	// Batch order request limits for the number of trading pairs and orders
+	// per trading pair
+	okGroupBatchOrderPairs    = 4
+	okGroupBatchOrdersPerPair = 4
 )
 
 var errMissValue = errors.New("warning - resp value is missing from exchange")
@@ -1515,6 +1515,39 @@ type WebsocketSpotOrderResponse struct {
 	// OrderID      A member, but part already exists as part of WebsocketDataResponse
 }
 
+// WebsocketOrderResponse holds private order table data, it is decoded
+// separately to WebsocketDataWrapper as its fields overlap with public data
+type WebsocketOrderResponse struct {
+	Table string               `json:"table"`
+	Data  []WebsocketOrderData `json:"data"`
+}
+
+// WebsocketOrderData contains spot, futures and swap order data. Numeric
+// fields are left as strings as they are returned empty when not applicable.
+type WebsocketOrderData struct {
+	InstrumentID string `json:"instrument_id"`
+	OrderID      string `json:"order_id"`
+	ClientOID    string `json:"client_oid"`
+	// Side is only returned for spot orders
+	Side string `json:"side"`
+	// Type is limit or market for spot orders and 1 to 4 for futures and
+	// swap orders e.g. 1 open long
+	Type             string    `json:"type"`
+	OrderType        string    `json:"order_type"`
+	State            string    `json:"state"`
+	Price            string    `json:"price"`
+	Size             string    `json:"size"`
+	FilledSize       string    `json:"filled_size"`
+	FilledQuantity   string    `json:"filled_qty"`
+	Fee              string    `json:"fee"`
+	FeeCurrency      string    `json:"fee_currency"`
+	LastFillID       string    `json:"last_fill_id"`
+	LastFillPrice    string    `json:"last_fill_px"`
+	LastFillQuantity string    `json:"last_fill_qty"`
+	LastFillTime     string    `json:"last_fill_time"`
+	Timestamp        time.Time `json:"timestamp"`
+}
+
 // WebsocketErrorResponse yo
 type WebsocketErrorResponse struct {
 	Event     string `json:"event"`
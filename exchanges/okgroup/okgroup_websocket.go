@@ -1,10 +1,12 @@
 package okgroup
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"hash/crc32"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -16,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -226,6 +229,15 @@ func (o *OKGroup) WsHandleData(wg *sync.WaitGroup) {
 				return
 			}
 			o.Websocket.TrafficAlert <- struct{}{}
+			if bytes.Contains(resp.Raw, []byte("/"+okGroupWsOrder)) {
+				var orderResponse WebsocketOrderResponse
+				err = json.Unmarshal(resp.Raw, &orderResponse)
+				if err == nil &&
+					o.GetWsChannelWithoutOrderType(orderResponse.Table) == okGroupWsOrder {
+					o.wsProcessOrders(&orderResponse)
+					continue
+				}
+			}
 			var dataResponse WebsocketDataResponse
 			err = json.Unmarshal(resp.Raw, &dataResponse)
 			if err == nil && dataResponse.Table != "" {
@@ -455,6 +467,121 @@ func (o *OKGroup) wsProcessTrades(response *WebsocketDataResponse) {
 	}
 }
 
+// wsProcessOrders converts private order data and sends it to the data
+// handler as normalised order updates, orders which have been matched since
+// their last update are preceded by a normalised fill
+func (o *OKGroup) wsProcessOrders(response *WebsocketOrderResponse) {
+	a := o.GetAssetTypeFromTableName(response.Table)
+	for i := range response.Data {
+		data := &response.Data[i]
+		f := strings.Split(data.InstrumentID, delimiterDash)
+		var c currency.Pair
+		switch {
+		case len(f) < 2:
+			c = currency.NewPairFromString(data.InstrumentID)
+		case len(f) > 2:
+			c = currency.NewPairWithDelimiter(f[0]+delimiterDash+f[1], f[2], delimiterUnderscore)
+		default:
+			c = currency.NewPairWithDelimiter(f[0], f[1], delimiterDash)
+		}
+
+		side := order.Side(strings.ToUpper(data.Side))
+		switch data.Type {
+		case "1", "4":
+			side = order.Buy
+		case "2", "3":
+			side = order.Sell
+		}
+		oType := order.Limit
+		switch {
+		case data.Type == "market":
+			oType = order.Market
+		case data.OrderType == "3":
+			oType = order.ImmediateOrCancel
+		}
+
+		executed := parseWsFloat(data.FilledSize)
+		if a != asset.Spot {
+			executed = parseWsFloat(data.FilledQuantity)
+		}
+		amount := parseWsFloat(data.Size)
+
+		if fillAmount := parseWsFloat(data.LastFillQuantity); fillAmount > 0 {
+			fillTime, err := time.Parse(time.RFC3339, data.LastFillTime)
+			if err != nil {
+				fillTime = data.Timestamp
+			}
+			tradeID := data.LastFillID
+			if tradeID == "" || tradeID == "0" {
+				tradeID = data.OrderID + "-" +
+					strconv.FormatInt(fillTime.UnixNano(), 10)
+			}
+			o.Websocket.DataHandler <- wshandler.FillUpdate{
+				Timestamp:     fillTime,
+				CurrencyPair:  c,
+				AssetType:     a,
+				Exchange:      o.Name,
+				OrderID:       data.OrderID,
+				ClientOrderID: data.ClientOID,
+				TradeID:       tradeID,
+				Side:          side,
+				Price:         parseWsFloat(data.LastFillPrice),
+				Amount:        fillAmount,
+			}
+		}
+
+		o.Websocket.DataHandler <- wshandler.OrderUpdate{
+			Timestamp:       data.Timestamp,
+			CurrencyPair:    c,
+			AssetType:       a,
+			Exchange:        o.Name,
+			OrderID:         data.OrderID,
+			ClientOrderID:   data.ClientOID,
+			Side:            side,
+			Type:            oType,
+			Status:          wsOrderStatus(data.State),
+			Price:           parseWsFloat(data.Price),
+			Amount:          amount,
+			ExecutedAmount:  executed,
+			RemainingAmount: amount - executed,
+			// Fees are returned as a negative amount when paid
+			Fee: math.Abs(parseWsFloat(data.Fee)),
+		}
+	}
+}
+
+// wsOrderStatus converts an order state e.g. -2 failed
+func wsOrderStatus(state string) order.Status {
+	switch state {
+	case "-2":
+		return order.Rejected
+	case "-1":
+		return order.Cancelled
+	case "0":
+		return order.Active
+	case "1":
+		return order.PartiallyFilled
+	case "2":
+		return order.Filled
+	case "3":
+		return order.New
+	case "4":
+		return order.PendingCancel
+	default:
+		return order.UnknownStatus
+	}
+}
+
+// parseWsFloat parses an optional numeric string, empty or invalid values
+// return zero
+func parseWsFloat(s string) float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
 // wsProcessCandles converts candle data and sends it to the data handler
 func (o *OKGroup) wsProcessCandles(response *WebsocketDataResponse) {
 	for i := range response.Data {
@@ -627,6 +754,7 @@ func (o *OKGroup) WsProcessUpdateOrderbook(wsEventData *WebsocketDataWrapper, in
 		Asset:      a,
 		Pair:       instrument,
 		UpdateTime: wsEventData.Timestamp,
+		Checksum:   uint32(wsEventData.Checksum),
 	}
 
 	var err error
@@ -639,22 +767,13 @@ func (o *OKGroup) WsProcessUpdateOrderbook(wsEventData *WebsocketDataWrapper, in
 		return err
 	}
 
+	// The checksum is verified against the merged orderbook by the
+	// wsorderbook verifier, a mismatch resynchronises the orderbook
 	err = o.Websocket.Orderbook.Update(&update)
 	if err != nil {
 		return err
 	}
 
-	updatedOb := o.Websocket.Orderbook.GetOrderbook(instrument, a)
-	checksum := o.CalculateUpdateOrderbookChecksum(updatedOb)
-
-	if checksum != wsEventData.Checksum {
-		// re-sub
-		log.Warnf(log.ExchangeSys, "%s checksum failure for item %s",
-			o.Name,
-			wsEventData.InstrumentID)
-		return errors.New("checksum failed")
-	}
-
 	o.Websocket.DataHandler <- wshandler.WebsocketOrderbookUpdate{
 		Exchange: o.Name,
 		Asset:    a,
@@ -689,29 +808,6 @@ func (o *OKGroup) CalculatePartialOrderbookChecksum(orderbookData *WebsocketData
 	return int32(crc32.ChecksumIEEE([]byte(checksumStr)))
 }
 
-// CalculateUpdateOrderbookChecksum alternates over the first 25 bid and ask
-// entries of a merged orderbook. The checksum is made up of the price and the
-// quantity with a semicolon (:) deliminating them. This will also work when
-// there are less than 25 entries (for whatever reason)
-// eg Bid:Ask:Bid:Ask:Ask:Ask
-func (o *OKGroup) CalculateUpdateOrderbookChecksum(orderbookData *orderbook.Base) int32 {
-	var checksum strings.Builder
-	for i := 0; i < allowableIterations; i++ {
-		if len(orderbookData.Bids)-1 >= i {
-			price := strconv.FormatFloat(orderbookData.Bids[i].Price, 'f', -1, 64)
-			amount := strconv.FormatFloat(orderbookData.Bids[i].Amount, 'f', -1, 64)
-			checksum.WriteString(price + delimiterColon + amount + delimiterColon)
-		}
-		if len(orderbookData.Asks)-1 >= i {
-			price := strconv.FormatFloat(orderbookData.Asks[i].Price, 'f', -1, 64)
-			amount := strconv.FormatFloat(orderbookData.Asks[i].Amount, 'f', -1, 64)
-			checksum.WriteString(price + delimiterColon + amount + delimiterColon)
-		}
-	}
-	checksumStr := strings.TrimSuffix(checksum.String(), delimiterColon)
-	return int32(crc32.ChecksumIEEE([]byte(checksumStr)))
-}
-
 // GenerateDefaultSubscriptions Adds default subscriptions to websocket to be
 // handled by ManageSubscriptions()
 func (o *OKGroup) GenerateDefaultSubscriptions() {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
@@ -12,9 +13,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 )
 
@@ -68,6 +71,9 @@ func (o *OKGroup) Setup(exch *config.ExchangeConfig) error {
 		false,
 		false,
 		exch.Name)
+	o.Websocket.Orderbook.SetVerifiers(&wsorderbook.CRC32Verifier{
+		Depth: allowableIterations,
+	})
 	return nil
 }
 
@@ -266,6 +272,90 @@ func (o *OKGroup) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]e
 	return nil, common.ErrNotYetImplemented
 }
 
+// FetchTradingRules returns the tick size, size increment and minimum size of
+// each spot instrument
+func (o *OKGroup) FetchTradingRules(a asset.Item) ([]order.TradingRules, error) {
+	if a != asset.Spot {
+		return nil, fmt.Errorf("%s trading rules not supported for asset type %v",
+			o.Name, a)
+	}
+
+	prods, err := o.GetSpotTokenPairDetails()
+	if err != nil {
+		return nil, err
+	}
+	return o.spotTradingRules(prods)
+}
+
+// spotTradingRules returns the tick size, size increment and minimum size of
+// the spot instruments
+func (o *OKGroup) spotTradingRules(prods []GetSpotTokenPairDetailsResponse) ([]order.TradingRules, error) {
+	rules := make([]order.TradingRules, len(prods))
+	for x := range prods {
+		rules[x] = order.TradingRules{
+			Pair: currency.NewPairWithDelimiter(prods[x].BaseCurrency,
+				prods[x].QuoteCurrency,
+				o.GetPairFormat(asset.Spot, false).Delimiter),
+			Asset: asset.Spot,
+		}
+		var err error
+		rules[x].PriceTickSize, err = strconv.ParseFloat(prods[x].TickSize, 64)
+		if err != nil {
+			return nil, err
+		}
+		rules[x].AmountStepSize, err = strconv.ParseFloat(prods[x].SizeIncrement, 64)
+		if err != nil {
+			return nil, err
+		}
+		rules[x].MinAmount, err = strconv.ParseFloat(prods[x].MinSize, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rules, nil
+}
+
+// SpotPairs returns the pairs of the spot instruments
+func (o *OKGroup) SpotPairs(prods []GetSpotTokenPairDetailsResponse) []string {
+	pairs := make([]string, len(prods))
+	for x := range prods {
+		pairs[x] = prods[x].BaseCurrency +
+			o.GetPairFormat(asset.Spot, false).Delimiter +
+			prods[x].QuoteCurrency
+	}
+	return pairs
+}
+
+// UpdateSpotPairs updates the available spot pairs and their trading rules
+// from the spot instruments
+func (o *OKGroup) UpdateSpotPairs(forceUpdate bool) error {
+	prods, err := o.GetSpotTokenPairDetails()
+	if err != nil {
+		return err
+	}
+
+	err = o.UpdatePairs(currency.NewPairsFromStrings(o.SpotPairs(prods)),
+		asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+	rules, err := o.spotTradingRules(prods)
+	if err != nil {
+		return err
+	}
+	return o.LoadTradingRules(asset.Spot, rules)
+}
+
+// UpdateTradingRules fetches and stores the price and amount increments and
+// order size limits of the exchange pairs
+func (o *OKGroup) UpdateTradingRules() error {
+	rules, err := o.FetchTradingRules(asset.Spot)
+	if err != nil {
+		return err
+	}
+	return o.LoadTradingRules(asset.Spot, rules)
+}
+
 // SubmitOrder submits a new order
 func (o *OKGroup) SubmitOrder(s *order.Submit) (resp order.SubmitResponse, err error) {
 	err = s.Validate()
@@ -273,17 +363,7 @@ func (o *OKGroup) SubmitOrder(s *order.Submit) (resp order.SubmitResponse, err e
 		return resp, err
 	}
 
-	request := PlaceOrderRequest{
-		ClientOID:    s.ClientID,
-		InstrumentID: o.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
-		Side:         s.OrderSide.Lower(),
-		Type:         s.OrderType.Lower(),
-		Size:         strconv.FormatFloat(s.Amount, 'f', -1, 64),
-	}
-	if s.OrderType == order.Limit {
-		request.Price = strconv.FormatFloat(s.Price, 'f', -1, 64)
-	}
-
+	request := o.placeOrderRequest(s)
 	orderResponse, err := o.PlaceSpotOrder(&request)
 	if err != nil {
 		return
@@ -297,6 +377,21 @@ func (o *OKGroup) SubmitOrder(s *order.Submit) (resp order.SubmitResponse, err e
 	return
 }
 
+// placeOrderRequest converts an order submission to a spot order request
+func (o *OKGroup) placeOrderRequest(s *order.Submit) PlaceOrderRequest {
+	request := PlaceOrderRequest{
+		ClientOID:    s.ClientID,
+		InstrumentID: o.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
+		Side:         s.OrderSide.Lower(),
+		Type:         s.OrderType.Lower(),
+		Size:         strconv.FormatFloat(s.Amount, 'f', -1, 64),
+	}
+	if s.OrderType == order.Limit {
+		request.Price = strconv.FormatFloat(s.Price, 'f', -1, 64)
+	}
+	return request
+}
+
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
 func (o *OKGroup) ModifyOrder(action *order.Modify) (string, error) {
@@ -354,6 +449,127 @@ func (o *OKGroup) CancelAllOrders(orderCancellation *order.Cancel) (resp order.C
 	return
 }
 
+// SubmitOrders submits a batch of orders, orders are split into batch order
+// requests of up to four trading pairs with up to four orders each
+func (o *OKGroup) SubmitOrders(s []order.Submit) ([]order.BatchSubmitResponse, error) {
+	if len(s) == 0 {
+		return nil, order.ErrBatchIsEmpty
+	}
+
+	resp := make([]order.BatchSubmitResponse, len(s))
+	var requests []PlaceOrderRequest
+	// indexes holds the submission index of each request by instrument in
+	// request order
+	indexes := make(map[string][]int)
+	send := func() {
+		if len(requests) == 0 {
+			return
+		}
+		placed, errs := o.PlaceMultipleSpotOrders(requests)
+		for instrument, idx := range indexes {
+			for x := range idx {
+				switch {
+				case x < len(placed[instrument]) && placed[instrument][x].Result:
+					resp[idx[x]].IsOrderPlaced = true
+					resp[idx[x]].OrderID = placed[instrument][x].OrderID
+					resp[idx[x]].FullyMatched = s[idx[x]].OrderType == order.Market
+				case x < len(placed[instrument]):
+					resp[idx[x]].Error = fmt.Errorf("order for currency %v failed to be placed", instrument)
+				case len(errs) > 0:
+					resp[idx[x]].Error = errs[0]
+				default:
+					resp[idx[x]].Error = fmt.Errorf("order for currency %v missing from batch response", instrument)
+				}
+			}
+		}
+		requests = nil
+		indexes = make(map[string][]int)
+	}
+
+	for x := range s {
+		if err := s[x].Validate(); err != nil {
+			resp[x].Error = err
+			continue
+		}
+		request := o.placeOrderRequest(&s[x])
+		idx, ok := indexes[request.InstrumentID]
+		if (!ok && len(indexes) == okGroupBatchOrderPairs) ||
+			len(idx) == okGroupBatchOrdersPerPair {
+			send()
+		}
+		requests = append(requests, request)
+		indexes[request.InstrumentID] = append(indexes[request.InstrumentID], x)
+	}
+	send()
+	return resp, nil
+}
+
+// BatchCancelOrders cancels a batch of orders, orders are split into batch
+// cancellation requests of up to four orders for each trading pair
+func (o *OKGroup) BatchCancelOrders(orders []order.Cancel) (order.CancelBatchResponse, error) {
+	resp := order.CancelBatchResponse{
+		Status: make(map[string]string),
+	}
+	if len(orders) == 0 {
+		return resp, order.ErrBatchIsEmpty
+	}
+
+	var instruments []string
+	orderIDs := make(map[string][]int64)
+	for x := range orders {
+		orderID, err := strconv.ParseInt(orders[x].OrderID, 10, 64)
+		if err != nil {
+			resp.Status[orders[x].OrderID] = err.Error()
+			continue
+		}
+		instrument := o.FormatExchangeCurrency(orders[x].CurrencyPair, asset.Spot).String()
+		if _, ok := orderIDs[instrument]; !ok {
+			instruments = append(instruments, instrument)
+		}
+		orderIDs[instrument] = append(orderIDs[instrument], orderID)
+	}
+
+	for _, instrument := range instruments {
+		ids := orderIDs[instrument]
+		for len(ids) > 0 {
+			batch := ids
+			if len(batch) > okGroupBatchOrdersPerPair {
+				batch = ids[:okGroupBatchOrdersPerPair]
+			}
+			ids = ids[len(batch):]
+
+			cancelled, err := o.CancelMultipleSpotOrders(CancelMultipleSpotOrdersRequest{
+				InstrumentID: instrument,
+				OrderIDs:     batch,
+			})
+			results := make(map[int64]bool)
+			for _, r := range cancelled {
+				for y := range r {
+					results[r[y].OrderID] = r[y].Result
+				}
+			}
+			for y := range batch {
+				id := strconv.FormatInt(batch[y], 10)
+				switch result, ok := results[batch[y]]; {
+				case err != nil:
+					resp.Status[id] = err.Error()
+				case !ok:
+					resp.Status[id] = "order missing from batch cancellation response"
+				case !result:
+					resp.Status[id] = fmt.Sprintf("order %v failed to be cancelled", id)
+				}
+			}
+		}
+	}
+	return resp, nil
+}
+
+// SetDeadMansSwitch arms a timer on the exchange which cancels all open orders
+// unless it is refreshed within the timeout
+func (o *OKGroup) SetDeadMansSwitch(timeout time.Duration) error {
+	return common.ErrFunctionNotSupported
+}
+
 // GetOrderInfo returns information on a current open order
 func (o *OKGroup) GetOrderInfo(orderID string) (resp order.Detail, err error) {
 	mOrder, err := o.GetSpotOrder(GetSpotOrderRequest{OrderID: orderID})
@@ -527,3 +743,84 @@ func (o *OKGroup) ValidateCredentials() error {
 	_, err := o.UpdateAccountInfo()
 	return o.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, only spot candles are supported
+func (o *OKGroup) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if a != asset.Spot {
+		return kline.Item{}, common.ErrFunctionNotSupported
+	}
+
+	if err := o.ValidateKline(pair, a, start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+
+	intervalString, err := o.FormatExchangeKlineInterval(interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	granularity, err := strconv.ParseInt(intervalString, 10, 64)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	ret := kline.Item{
+		Exchange: o.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+
+	dates := kline.CalculateCandleDateRanges(start, end, interval, o.Features.Supports.Kline.ResultLimit)
+	for x := range dates {
+		candles, err := o.GetSpotMarketData(GetSpotMarketDataRequest{
+			Start:        dates[x].Start.UTC().Format(time.RFC3339),
+			End:          dates[x].End.UTC().Format(time.RFC3339),
+			Granularity:  granularity,
+			InstrumentID: o.FormatExchangeCurrency(pair, a).String(),
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range candles {
+			candle, err := parseSpotCandle(candles[i])
+			if err != nil {
+				return kline.Item{}, err
+			}
+			ret.Candles = append(ret.Candles, candle)
+		}
+	}
+
+	ret.Tidy(start, end)
+	return ret, nil
+}
+
+// parseSpotCandle converts a spot market data row of start time, open, high,
+// low, close and volume strings to a candle
+func parseSpotCandle(data interface{}) (kline.Candle, error) {
+	row, ok := data.([]interface{})
+	if !ok || len(row) < 6 {
+		return kline.Candle{}, errors.New("unable to parse spot candle")
+	}
+
+	var fields [6]string
+	for i := range fields {
+		if fields[i], ok = row[i].(string); !ok {
+			return kline.Candle{}, errors.New("unable to parse spot candle")
+		}
+	}
+
+	var candle kline.Candle
+	var err error
+	if candle.Time, err = time.Parse(time.RFC3339, fields[0]); err != nil {
+		return kline.Candle{}, err
+	}
+	values := []*float64{&candle.Open, &candle.High, &candle.Low, &candle.Close, &candle.Volume}
+	for i := range values {
+		if *values[i], err = strconv.ParseFloat(fields[i+1], 64); err != nil {
+			return kline.Candle{}, err
+		}
+	}
+	return candle, nil
+}
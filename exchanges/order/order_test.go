@@ -83,6 +83,186 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestModifyValidate(t *testing.T) {
+	var m *Modify
+	if err := m.Validate(); err != ErrModifyIsNil {
+		t.Errorf("Unexpected result. Got: %s, want: %s", err, ErrModifyIsNil)
+	}
+
+	tester := []struct {
+		OrderID string
+		Side
+		Amount      float64
+		Price       float64
+		ExpectedErr error
+	}{
+		{
+			ExpectedErr: ErrOrderIDIsEmpty,
+		}, // empty order ID
+		{
+			OrderID:     "1",
+			Side:        AnySide,
+			ExpectedErr: ErrSideIsInvalid,
+		}, // valid order ID but invalid order side
+		{
+			OrderID:     "1",
+			Price:       -1,
+			ExpectedErr: ErrPriceIsInvalid,
+		}, // valid order ID but negative price
+		{
+			OrderID:     "1",
+			Amount:      -1,
+			ExpectedErr: ErrAmountIsInvalid,
+		}, // valid order ID but negative amount
+		{
+			OrderID:     "1",
+			Side:        Buy,
+			ExpectedErr: ErrModifyIsEmpty,
+		}, // valid order ID and side but nothing to modify
+		{
+			OrderID:     "1",
+			Price:       1000,
+			ExpectedErr: nil,
+		}, // valid price modification
+		{
+			OrderID:     "1",
+			Side:        Sell,
+			Amount:      1,
+			ExpectedErr: nil,
+		}, // valid amount modification
+	}
+
+	for x := range tester {
+		m = &Modify{
+			OrderID: tester[x].OrderID,
+			Side:    tester[x].Side,
+			Amount:  tester[x].Amount,
+			Price:   tester[x].Price,
+		}
+		if err := m.Validate(); err != tester[x].ExpectedErr {
+			t.Errorf("Unexpected result. Got: %s, want: %s", err, tester[x].ExpectedErr)
+		}
+	}
+}
+
+func TestTradingRulesConform(t *testing.T) {
+	var r TradingRules
+	if err := r.Conform(nil); err != ErrSubmissionIsNil {
+		t.Errorf("Unexpected result. Got: %s, want: %s", err, ErrSubmissionIsNil)
+	}
+
+	r = TradingRules{
+		PriceTickSize:  0.01,
+		MinPrice:       1,
+		MaxPrice:       100000,
+		AmountStepSize: 0.001,
+		MinAmount:      0.01,
+		MaxAmount:      100,
+		MinNotional:    10,
+	}
+
+	tester := []struct {
+		Side
+		Type
+		Price          float64
+		Amount         float64
+		ExpectedPrice  float64
+		ExpectedAmount float64
+		ExpectedErr    error
+	}{
+		{Buy, Limit, 100.019, 1.0009, 100.01, 1, nil},                    // buy price rounds down
+		{Sell, Limit, 100.011, 1, 100.02, 1, nil},                        // sell price rounds up
+		{Ask, Limit, 3.3, 3, 3.3, 3, ErrNotionalBelowMin},                // price on the tick is unchanged
+		{Buy, Limit, 100, 0.0099, 100, 0.009, ErrAmountBelowMin},         // amount below minimum after rounding
+		{Buy, Limit, 100, 0.0009, 100, 0, ErrAmountBelowMin},             // amount rounds to zero
+		{Buy, Limit, 100, 101, 100, 101, ErrAmountAboveMax},              // amount above maximum
+		{Buy, Limit, 0.5, 50, 0.5, 50, ErrPriceBelowMin},                 // price below minimum
+		{Sell, Limit, 100001, 1, 100001, 1, ErrPriceAboveMax},            // price above maximum
+		{Buy, Market, 0, 0.0011, 0, 0.001, ErrAmountBelowMin},            // market amount below minimum
+		{Buy, Market, 0, 0.01, 0, 0.01, nil},                             // market notional unknown without a price
+		{Bid, Market, 100.019, 0.5, 100.019, 0.5, nil},                   // market price is not rounded
+		{Buy, Limit, 9.99, 1, 9.99, 1, ErrNotionalBelowMin},              // value below minimum notional
+		{Sell, Limit, 1234.5678, 2.34567, 1234.57, 2.345, nil},           // rounded both ways
+		{Buy, Limit, 1234.5678, 2.34567, 1234.56, 2.345, nil},            // rounded both ways
+		{Buy, Limit, 4.35, 10, 4.35, 10, nil},                            // float error below the tick
+		{Sell, Limit, 3.3000000000000003, 10, 3.3, 10, nil},              // float error above the tick
+		{Buy, Limit, 30.7, 12.000000001, 30.7, 12, nil},                  // amount just above the step rounds down
+		{Buy, Limit, 1e5, 10, 1e5, 10, nil},                              // price at maximum
+		{Sell, Limit, 0.999, 100, 1, 100, nil},                           // sell price rounded up to minimum
+		{Buy, Limit, 1.009, 9.9, 1, 9.9, ErrNotionalBelowMin},            // rounding can breach the notional
+		{Buy, Limit, 1.009, 10.0009, 1, 10, nil},                         // notional met after rounding
+		{Buy, Limit, 50000, 0.00123456, 50000, 0.001, ErrAmountBelowMin}, // small amount below minimum
+	}
+
+	for x := range tester {
+		s := &Submit{
+			OrderSide: tester[x].Side,
+			OrderType: tester[x].Type,
+			Price:     tester[x].Price,
+			Amount:    tester[x].Amount,
+		}
+		if err := r.Conform(s); err != tester[x].ExpectedErr {
+			t.Errorf("Test %d unexpected result. Got: %v, want: %v", x, err, tester[x].ExpectedErr)
+		}
+		if s.Price != tester[x].ExpectedPrice {
+			t.Errorf("Test %d unexpected price. Got: %v, want: %v", x, s.Price, tester[x].ExpectedPrice)
+		}
+		if s.Amount != tester[x].ExpectedAmount {
+			t.Errorf("Test %d unexpected amount. Got: %v, want: %v", x, s.Amount, tester[x].ExpectedAmount)
+		}
+	}
+
+	// Zero rules leave the submission unchanged
+	r = TradingRules{}
+	s := &Submit{OrderSide: Buy, OrderType: Limit, Price: 0.123456789, Amount: 0.987654321}
+	if err := r.Conform(s); err != nil {
+		t.Error(err)
+	}
+	if s.Price != 0.123456789 || s.Amount != 0.987654321 {
+		t.Errorf("Unexpected change in submission. Got: %v %v", s.Price, s.Amount)
+	}
+}
+
+func TestTradingRulesConformPrice(t *testing.T) {
+	var r TradingRules
+	if err := r.ConformPrice(nil); err != ErrSubmissionIsNil {
+		t.Errorf("Unexpected result. Got: %s, want: %s", err, ErrSubmissionIsNil)
+	}
+
+	r = TradingRules{
+		PriceTickSize: 0.01,
+		MinPrice:      1,
+		MinAmount:     0.01,
+		MinNotional:   10,
+	}
+	s := &Submit{OrderSide: Sell, OrderType: Limit, Price: 100.011}
+	if err := r.ConformPrice(s); err != nil {
+		t.Error(err)
+	}
+	if s.Price != 100.02 || s.Amount != 0 {
+		t.Errorf("Unexpected price and amount. Got: %v %v", s.Price, s.Amount)
+	}
+
+	s.Price = 0.5
+	if err := r.ConformPrice(s); err != ErrPriceBelowMin {
+		t.Errorf("Unexpected result. Got: %v, want: %v", err, ErrPriceBelowMin)
+	}
+}
+
+func TestTradingRulesRoundAmount(t *testing.T) {
+	var r TradingRules
+	if a := r.RoundAmount(0.987654321); a != 0.987654321 {
+		t.Errorf("Unexpected amount without a step size. Got: %v", a)
+	}
+	r.AmountStepSize = 0.001
+	if a := r.RoundAmount(0.987654321); a != 0.987 {
+		t.Errorf("Unexpected amount. Got: %v, want: 0.987", a)
+	}
+	if a := r.RoundAmount(12.000000001); a != 12 {
+		t.Errorf("Unexpected amount. Got: %v, want: 12", a)
+	}
+}
+
 func TestOrderSides(t *testing.T) {
 	t.Parallel()
 
@@ -33,11 +33,38 @@ var (
 	ErrTypeIsInvalid              = errors.New("order type is invalid")
 	ErrAmountIsInvalid            = errors.New("order amount is invalid")
 	ErrPriceMustBeSetIfLimitOrder = errors.New("order price must be set if limit order type is desired")
+	ErrBatchIsEmpty               = errors.New("order batch is empty")
+	ErrModifyIsNil                = errors.New("order modify is nil")
+	ErrOrderIDIsEmpty             = errors.New("order id is empty")
+	ErrPriceIsInvalid             = errors.New("order price is invalid")
+	ErrModifyIsEmpty              = errors.New("order modify must change the price or amount")
+	ErrPriceBelowMin              = errors.New("order price is below the exchange minimum")
+	ErrPriceAboveMax              = errors.New("order price is above the exchange maximum")
+	ErrAmountBelowMin             = errors.New("order amount is below the exchange minimum")
+	ErrAmountAboveMax             = errors.New("order amount is above the exchange maximum")
+	ErrNotionalBelowMin           = errors.New("order value is below the exchange minimum notional")
 )
 
-// Submit contains the order submission data
+// TradingRules are the price and amount increments and the order size limits
+// an exchange enforces on a pair, zero values are not enforced. MinNotional is
+// the minimum order value in the quote currency.
+type TradingRules struct {
+	Pair           currency.Pair
+	Asset          asset.Item
+	PriceTickSize  float64
+	MinPrice       float64
+	MaxPrice       float64
+	AmountStepSize float64
+	MinAmount      float64
+	MaxAmount      float64
+	MinNotional    float64
+}
+
+// Submit contains the order submission data, the order manager treats an unset
+// asset type as spot
 type Submit struct {
 	Pair         currency.Pair
+	AssetType    asset.Item
 	OrderType    Type
 	OrderSide    Side
 	TriggerPrice float64
@@ -54,6 +81,13 @@ type SubmitResponse struct {
 	OrderID       string
 }
 
+// BatchSubmitResponse is the result of an order in a batch submission, Error
+// is set when the order was not placed
+type BatchSubmitResponse struct {
+	SubmitResponse
+	Error error
+}
+
 // Modify is an order modifyer
 type Modify struct {
 	OrderID string
@@ -81,6 +115,13 @@ type CancelAllResponse struct {
 	Status map[string]string
 }
 
+// CancelBatchResponse returns the status from attempting to cancel a batch of
+// orders, orders which could not be cancelled are keyed by order ID with the
+// reason
+type CancelBatchResponse struct {
+	Status map[string]string
+}
+
 // Type enforces a standard for order types across the code base
 type Type string
 
@@ -113,7 +154,9 @@ type Detail struct {
 	Exchange        string
 	AccountID       string
 	ID              string
+	InternalOrderID string
 	CurrencyPair    currency.Pair
+	AssetType       asset.Item
 	OrderSide       Side
 	OrderType       Type
 	OrderDate       time.Time
@@ -2,7 +2,9 @@ package order
 
 import (
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -92,6 +94,138 @@ func (s *Submit) Validate() error {
 	return nil
 }
 
+// Validate checks the supplied modification and returns whether or not it's
+// valid, a zero price or amount leaves that field of the order unchanged
+func (m *Modify) Validate() error {
+	if m == nil {
+		return ErrModifyIsNil
+	}
+
+	if m.OrderID == "" {
+		return ErrOrderIDIsEmpty
+	}
+
+	if m.Side != "" &&
+		m.Side != Buy &&
+		m.Side != Sell &&
+		m.Side != Bid &&
+		m.Side != Ask {
+		return ErrSideIsInvalid
+	}
+
+	if m.Price < 0 {
+		return ErrPriceIsInvalid
+	}
+
+	if m.Amount < 0 {
+		return ErrAmountIsInvalid
+	}
+
+	if m.Price == 0 && m.Amount == 0 {
+		return ErrModifyIsEmpty
+	}
+
+	return nil
+}
+
+// incrementTolerance is the fraction of an increment a value may be off a
+// multiple of it due to float error and still be treated as on the increment
+const incrementTolerance = 1e-9
+
+// Conform rounds the price of a limit order submission to the price tick size
+// and the amount down to the amount step size, buy prices round down and sell
+// prices round up so an order is never priced more aggressively than
+// submitted. The rounded submission is then checked against the price, amount
+// and notional limits. The notional limit is only checked when the submission
+// has a price.
+func (t *TradingRules) Conform(s *Submit) error {
+	if s == nil {
+		return ErrSubmissionIsNil
+	}
+
+	s.Amount = t.RoundAmount(s.Amount)
+
+	if s.Amount <= 0 || (t.MinAmount > 0 && s.Amount < t.MinAmount) {
+		return ErrAmountBelowMin
+	}
+
+	if t.MaxAmount > 0 && s.Amount > t.MaxAmount {
+		return ErrAmountAboveMax
+	}
+
+	if err := t.ConformPrice(s); err != nil || s.Price <= 0 {
+		return err
+	}
+
+	if t.MinNotional > 0 && s.Price*s.Amount < t.MinNotional {
+		return ErrNotionalBelowMin
+	}
+	return nil
+}
+
+// ConformPrice rounds the price of a limit order submission to the price tick
+// size and checks it against the price limits, the amount is not checked. It
+// is used for order modifications which only change the price.
+func (t *TradingRules) ConformPrice(s *Submit) error {
+	if s == nil {
+		return ErrSubmissionIsNil
+	}
+
+	if s.OrderType == Limit && t.PriceTickSize > 0 {
+		s.Price = roundToIncrement(s.Price,
+			t.PriceTickSize,
+			s.OrderSide == Sell || s.OrderSide == Ask)
+	}
+
+	if s.Price <= 0 {
+		return nil
+	}
+
+	if t.MinPrice > 0 && s.Price < t.MinPrice {
+		return ErrPriceBelowMin
+	}
+
+	if t.MaxPrice > 0 && s.Price > t.MaxPrice {
+		return ErrPriceAboveMax
+	}
+	return nil
+}
+
+// RoundAmount rounds an amount down to the amount step size, the amount is
+// returned unchanged when there is no step size
+func (t *TradingRules) RoundAmount(amount float64) float64 {
+	if t.AmountStepSize <= 0 {
+		return amount
+	}
+	return roundToIncrement(amount, t.AmountStepSize, false)
+}
+
+// roundToIncrement rounds a value down, or up if roundUp is set, to a multiple
+// of increment. The result is trimmed to the decimal places of the increment
+// so float error does not leave digits the exchange would reject.
+func roundToIncrement(value, increment float64, roundUp bool) float64 {
+	steps := value / increment
+	rounded := math.Round(steps)
+	if math.Abs(steps-rounded) > incrementTolerance {
+		if roundUp {
+			rounded = math.Ceil(steps)
+		} else {
+			rounded = math.Floor(steps)
+		}
+	}
+
+	decimals := 0
+	inc := strconv.FormatFloat(increment, 'f', -1, 64)
+	if i := strings.IndexByte(inc, '.'); i != -1 {
+		decimals = len(inc) - i - 1
+	}
+	result, err := strconv.ParseFloat(strconv.FormatFloat(rounded*increment, 'f', decimals, 64), 64)
+	if err != nil {
+		return rounded * increment
+	}
+	return result
+}
+
 // String implements the stringer interface
 func (t Type) String() string {
 	return string(t)
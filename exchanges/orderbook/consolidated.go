@@ -0,0 +1,129 @@
+package orderbook
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// convertCurrency is used to normalise the quote currency of orderbooks added
+// to a consolidated orderbook
+var convertCurrency = currency.ConvertCurrency
+
+// ConsolidatedItem is a price level of a consolidated orderbook tagged with the
+// exchange it was sourced from
+type ConsolidatedItem struct {
+	Exchange string
+	Amount   float64
+	// Price is in the quote currency of the consolidated orderbook and
+	// OriginalPrice in the quote currency of the source orderbook
+	Price         float64
+	OriginalPrice float64
+}
+
+// ConsolidatedSource describes an orderbook merged into a consolidated
+// orderbook
+type ConsolidatedSource struct {
+	Exchange string
+	Pair     currency.Pair
+	// Rate is the conversion rate from the source quote currency to the
+	// consolidated quote currency
+	Rate        float64
+	LastUpdated time.Time
+}
+
+// Consolidated is a single price level view of the orderbooks of multiple
+// exchanges for the same currency pair
+type Consolidated struct {
+	Pair        currency.Pair
+	AssetType   asset.Item
+	Bids        []ConsolidatedItem
+	Asks        []ConsolidatedItem
+	Sources     []ConsolidatedSource
+	LastUpdated time.Time
+}
+
+// NewConsolidated returns an empty consolidated orderbook priced in the quote
+// currency of the supplied pair
+func NewConsolidated(p currency.Pair, a asset.Item) *Consolidated {
+	return &Consolidated{Pair: p, AssetType: a}
+}
+
+// Add merges an orderbook into the consolidated orderbook. The orderbook must
+// share the base currency of the consolidated pair, prices quoted in another
+// currency are converted with currency.ConvertCurrency. Levels remain sorted
+// best price first, equal prices keep the order their orderbooks were added.
+func (c *Consolidated) Add(b *Base) error {
+	if b.ExchangeName == "" {
+		return fmt.Errorf("%s %s", b.Pair, errExchangeNameUnset)
+	}
+	if b.Pair.Base.Item != c.Pair.Base.Item {
+		return fmt.Errorf("%s %s base currency does not match consolidated pair %s",
+			b.ExchangeName, b.Pair, c.Pair)
+	}
+
+	rate := 1.0
+	if b.Pair.Quote.Item != c.Pair.Quote.Item {
+		var err error
+		rate, err = convertCurrency(1, b.Pair.Quote, c.Pair.Quote)
+		if err != nil {
+			return fmt.Errorf("%s %s unable to convert quote currency to %s: %v",
+				b.ExchangeName, b.Pair, c.Pair.Quote, err)
+		}
+		if rate <= 0 {
+			return fmt.Errorf("%s %s invalid conversion rate %v to %s",
+				b.ExchangeName, b.Pair, rate, c.Pair.Quote)
+		}
+	}
+
+	exch := strings.ToLower(b.ExchangeName)
+	for x := range b.Bids {
+		c.Bids = append(c.Bids, ConsolidatedItem{
+			Exchange:      exch,
+			Amount:        b.Bids[x].Amount,
+			Price:         b.Bids[x].Price * rate,
+			OriginalPrice: b.Bids[x].Price,
+		})
+	}
+	for x := range b.Asks {
+		c.Asks = append(c.Asks, ConsolidatedItem{
+			Exchange:      exch,
+			Amount:        b.Asks[x].Amount,
+			Price:         b.Asks[x].Price * rate,
+			OriginalPrice: b.Asks[x].Price,
+		})
+	}
+	sort.SliceStable(c.Bids, func(i, j int) bool { return c.Bids[i].Price > c.Bids[j].Price })
+	sort.SliceStable(c.Asks, func(i, j int) bool { return c.Asks[i].Price < c.Asks[j].Price })
+
+	c.Sources = append(c.Sources, ConsolidatedSource{
+		Exchange:    exch,
+		Pair:        b.Pair,
+		Rate:        rate,
+		LastUpdated: b.LastUpdated,
+	})
+	if b.LastUpdated.After(c.LastUpdated) {
+		c.LastUpdated = b.LastUpdated
+	}
+	return nil
+}
+
+// BestBid returns the highest bid across all exchanges
+func (c *Consolidated) BestBid() (ConsolidatedItem, error) {
+	if len(c.Bids) == 0 {
+		return ConsolidatedItem{}, ErrNoBids
+	}
+	return c.Bids[0], nil
+}
+
+// BestAsk returns the lowest ask across all exchanges
+func (c *Consolidated) BestAsk() (ConsolidatedItem, error) {
+	if len(c.Asks) == 0 {
+		return ConsolidatedItem{}, ErrNoAsks
+	}
+	return c.Asks[0], nil
+}
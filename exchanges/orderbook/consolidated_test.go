@@ -0,0 +1,101 @@
+package orderbook
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestConsolidatedAdd(t *testing.T) {
+	convertCurrency = func(amount float64, from, to currency.Code) (float64, error) {
+		if from == currency.EUR && to == currency.USD {
+			return amount * 2, nil
+		}
+		return 0, errors.New("no rate")
+	}
+	defer func() { convertCurrency = currency.ConvertCurrency }()
+
+	now := time.Now()
+	c := NewConsolidated(currency.NewPair(currency.BTC, currency.USD), asset.Spot)
+	err := c.Add(&Base{
+		ExchangeName: "Bitstamp",
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		Bids:         []Item{{Price: 100, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks:         []Item{{Price: 101, Amount: 1}, {Price: 103, Amount: 2}},
+		LastUpdated:  now.Add(-time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Add(&Base{
+		ExchangeName: "Kraken",
+		Pair:         currency.NewPair(currency.BTC, currency.EUR),
+		Bids:         []Item{{Price: 49.5, Amount: 3}, {Price: 49, Amount: 4}},
+		Asks:         []Item{{Price: 51, Amount: 5}},
+		LastUpdated:  now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.Bids) != 4 || len(c.Asks) != 3 || len(c.Sources) != 2 {
+		t.Fatalf("unexpected consolidated orderbook %+v", c)
+	}
+	expectedBids := []ConsolidatedItem{
+		{Exchange: "bitstamp", Amount: 1, Price: 100, OriginalPrice: 100},
+		{Exchange: "kraken", Amount: 3, Price: 99, OriginalPrice: 49.5},
+		{Exchange: "bitstamp", Amount: 2, Price: 98, OriginalPrice: 98},
+		{Exchange: "kraken", Amount: 4, Price: 98, OriginalPrice: 49},
+	}
+	for x := range expectedBids {
+		if c.Bids[x] != expectedBids[x] {
+			t.Errorf("bid %d expected %+v, received %+v", x, expectedBids[x], c.Bids[x])
+		}
+	}
+	if c.Asks[0].Price != 101 || c.Asks[1].Price != 102 || c.Asks[1].Exchange != "kraken" {
+		t.Errorf("unexpected asks %+v", c.Asks)
+	}
+	if c.Sources[1].Rate != 2 || !c.LastUpdated.Equal(now) {
+		t.Errorf("unexpected sources %+v", c.Sources)
+	}
+
+	bid, err := c.BestBid()
+	if err != nil || bid.Exchange != "bitstamp" {
+		t.Errorf("unexpected best bid %+v %v", bid, err)
+	}
+	ask, err := c.BestAsk()
+	if err != nil || ask.Price != 101 {
+		t.Errorf("unexpected best ask %+v %v", ask, err)
+	}
+
+	err = c.Add(&Base{
+		ExchangeName: "Binance",
+		Pair:         currency.NewPair(currency.BTC, currency.JPY),
+		Bids:         []Item{{Price: 1, Amount: 1}},
+	})
+	if err == nil {
+		t.Error("expected error converting an unknown rate")
+	}
+	err = c.Add(&Base{
+		ExchangeName: "Binance",
+		Pair:         currency.NewPair(currency.ETH, currency.USD),
+		Bids:         []Item{{Price: 1, Amount: 1}},
+	})
+	if err == nil {
+		t.Error("expected error adding a mismatched base currency")
+	}
+	if len(c.Bids) != 4 || len(c.Sources) != 2 {
+		t.Error("failed additions should not modify the consolidated orderbook")
+	}
+
+	empty := NewConsolidated(c.Pair, asset.Spot)
+	if _, err = empty.BestBid(); err != ErrNoBids {
+		t.Errorf("expected %v, received %v", ErrNoBids, err)
+	}
+	if _, err = empty.BestAsk(); err != ErrNoAsks {
+		t.Errorf("expected %v, received %v", ErrNoAsks, err)
+	}
+}
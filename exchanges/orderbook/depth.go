@@ -0,0 +1,211 @@
+package orderbook
+
+import "errors"
+
+const basisPoints = 10000
+
+// Depth analytics errors
+var (
+	ErrNoBids           = errors.New("orderbook has no bids")
+	ErrNoAsks           = errors.New("orderbook has no asks")
+	ErrInvalidAmount    = errors.New("amount must be greater than zero")
+	ErrInvalidPercent   = errors.New("depth percentage must be greater than zero")
+	ErrInsufficientBook = errors.New("insufficient orderbook depth to fill amount")
+)
+
+// VWAPResult holds the result of walking the orderbook to fill an amount
+type VWAPResult struct {
+	// VWAP is the volume weighted average price of the fill
+	VWAP float64
+	// BaseAmount and QuoteAmount are the amounts filled
+	BaseAmount  float64
+	QuoteAmount float64
+	// BestPrice is the top of book price and WorstPrice the last level
+	// consumed
+	BestPrice  float64
+	WorstPrice float64
+	// PriceImpact is the difference between the VWAP and the best price in
+	// basis points
+	PriceImpact float64
+	// MidSlippage is the difference between the VWAP and the mid price in
+	// basis points, including half the spread
+	MidSlippage float64
+	Levels      int
+	FullyFilled bool
+}
+
+// Depth holds the cumulative orderbook depth within a percentage of the mid
+// price
+type Depth struct {
+	Percent   float64
+	BidAmount float64
+	BidValue  float64
+	AskAmount float64
+	AskValue  float64
+	// Imbalance is the bid and ask amount imbalance ratio from -1 (all asks)
+	// to 1 (all bids)
+	Imbalance float64
+}
+
+// BestBid returns the highest bid price
+func (b *Base) BestBid() (float64, error) {
+	if len(b.Bids) == 0 {
+		return 0, ErrNoBids
+	}
+	return b.Bids[0].Price, nil
+}
+
+// BestAsk returns the lowest ask price
+func (b *Base) BestAsk() (float64, error) {
+	if len(b.Asks) == 0 {
+		return 0, ErrNoAsks
+	}
+	return b.Asks[0].Price, nil
+}
+
+// MidPrice returns the price between the best bid and best ask
+func (b *Base) MidPrice() (float64, error) {
+	bid, err := b.BestBid()
+	if err != nil {
+		return 0, err
+	}
+	ask, err := b.BestAsk()
+	if err != nil {
+		return 0, err
+	}
+	return (bid + ask) / 2, nil
+}
+
+// Spread returns the difference between the best ask and best bid and the
+// spread in basis points of the mid price
+func (b *Base) Spread() (spread, spreadBasisPoints float64, err error) {
+	mid, err := b.MidPrice()
+	if err != nil {
+		return 0, 0, err
+	}
+	spread = b.Asks[0].Price - b.Bids[0].Price
+	return spread, spread / mid * basisPoints, nil
+}
+
+// VWAP walks the asks when buying or the bids when selling and returns the
+// volume weighted average price to fill the amount. When quote is set the
+// amount is in the quote currency otherwise it is in the base currency. If
+// the orderbook cannot fill the amount the partial result is returned along
+// with ErrInsufficientBook.
+func (b *Base) VWAP(amount float64, buy, quote bool) (*VWAPResult, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	levels := b.Bids
+	if buy {
+		levels = b.Asks
+	}
+	if len(levels) == 0 {
+		if buy {
+			return nil, ErrNoAsks
+		}
+		return nil, ErrNoBids
+	}
+
+	r := &VWAPResult{BestPrice: levels[0].Price}
+	remaining := amount
+	for x := range levels {
+		if levels[x].Price <= 0 || levels[x].Amount <= 0 {
+			continue
+		}
+		baseAmt := levels[x].Amount
+		if quote {
+			if levels[x].Price*baseAmt >= remaining {
+				baseAmt = remaining / levels[x].Price
+			}
+		} else if baseAmt >= remaining {
+			baseAmt = remaining
+		}
+
+		r.BaseAmount += baseAmt
+		r.QuoteAmount += baseAmt * levels[x].Price
+		r.WorstPrice = levels[x].Price
+		r.Levels++
+
+		if quote {
+			remaining = amount - r.QuoteAmount
+		} else {
+			remaining = amount - r.BaseAmount
+		}
+		if remaining <= amount*1e-12 {
+			r.FullyFilled = true
+			break
+		}
+	}
+
+	if r.BaseAmount > 0 {
+		r.VWAP = r.QuoteAmount / r.BaseAmount
+		r.PriceImpact = (r.VWAP - r.BestPrice) / r.BestPrice * basisPoints
+		if !buy {
+			r.PriceImpact = -r.PriceImpact
+		}
+		if mid, err := b.MidPrice(); err == nil {
+			r.MidSlippage = (r.VWAP - mid) / mid * basisPoints
+			if !buy {
+				r.MidSlippage = -r.MidSlippage
+			}
+		}
+	}
+
+	if !r.FullyFilled {
+		return r, ErrInsufficientBook
+	}
+	return r, nil
+}
+
+// SlippageCurve returns the VWAP results for a series of amounts so the cost
+// of increasing order sizes can be compared. Amounts the orderbook cannot
+// fill are returned with FullyFilled unset.
+func (b *Base) SlippageCurve(amounts []float64, buy, quote bool) ([]VWAPResult, error) {
+	results := make([]VWAPResult, 0, len(amounts))
+	for x := range amounts {
+		r, err := b.VWAP(amounts[x], buy, quote)
+		if err != nil && err != ErrInsufficientBook {
+			return nil, err
+		}
+		results = append(results, *r)
+	}
+	return results, nil
+}
+
+// DepthWithin returns the cumulative bid and ask depth within a percentage of
+// the mid price and the imbalance between them
+func (b *Base) DepthWithin(percent float64) (*Depth, error) {
+	if percent <= 0 {
+		return nil, ErrInvalidPercent
+	}
+	mid, err := b.MidPrice()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Depth{Percent: percent}
+	lower := mid * (1 - percent/100)
+	for x := range b.Bids {
+		if b.Bids[x].Price < lower {
+			break
+		}
+		d.BidAmount += b.Bids[x].Amount
+		d.BidValue += b.Bids[x].Amount * b.Bids[x].Price
+	}
+
+	upper := mid * (1 + percent/100)
+	for x := range b.Asks {
+		if b.Asks[x].Price > upper {
+			break
+		}
+		d.AskAmount += b.Asks[x].Amount
+		d.AskValue += b.Asks[x].Amount * b.Asks[x].Price
+	}
+
+	if total := d.BidAmount + d.AskAmount; total > 0 {
+		d.Imbalance = (d.BidAmount - d.AskAmount) / total
+	}
+	return d, nil
+}
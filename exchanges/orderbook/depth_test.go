@@ -0,0 +1,139 @@
+package orderbook
+
+import (
+	"math"
+	"testing"
+)
+
+func depthSetup() Base {
+	return Base{
+		Asks: []Item{
+			{Price: 101, Amount: 1},
+			{Price: 102, Amount: 2},
+			{Price: 110, Amount: 5},
+		},
+		Bids: []Item{
+			{Price: 99, Amount: 2},
+			{Price: 98, Amount: 3},
+			{Price: 90, Amount: 10},
+		},
+	}
+}
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSpread(t *testing.T) {
+	t.Parallel()
+	b := depthSetup()
+	mid, err := b.MidPrice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mid != 100 {
+		t.Errorf("expected mid price 100, received %v", mid)
+	}
+	spread, bps, err := b.Spread()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spread != 2 || !approxEqual(bps, 200) {
+		t.Errorf("expected spread 2 and 200 bps, received %v %v", spread, bps)
+	}
+
+	var empty Base
+	if _, _, err = empty.Spread(); err != ErrNoBids {
+		t.Errorf("expected %v, received %v", ErrNoBids, err)
+	}
+	empty.Bids = b.Bids
+	if _, err = empty.MidPrice(); err != ErrNoAsks {
+		t.Errorf("expected %v, received %v", ErrNoAsks, err)
+	}
+}
+
+func TestVWAP(t *testing.T) {
+	t.Parallel()
+	b := depthSetup()
+
+	if _, err := b.VWAP(0, true, false); err != ErrInvalidAmount {
+		t.Errorf("expected %v, received %v", ErrInvalidAmount, err)
+	}
+
+	// Buy 2 base: 1 @ 101 + 1 @ 102
+	r, err := b.VWAP(2, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !approxEqual(r.VWAP, 101.5) || r.Levels != 2 || !r.FullyFilled {
+		t.Errorf("unexpected buy result %+v", r)
+	}
+	if !approxEqual(r.PriceImpact, 0.5/101*basisPoints) ||
+		!approxEqual(r.MidSlippage, 1.5/100*basisPoints) {
+		t.Errorf("unexpected buy impact %+v", r)
+	}
+
+	// Sell 297 quote: 2 @ 99 + ~1.0102 @ 98
+	r, err = b.VWAP(297, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !approxEqual(r.QuoteAmount, 297) || r.WorstPrice != 98 {
+		t.Errorf("unexpected sell result %+v", r)
+	}
+	if r.PriceImpact <= 0 || r.MidSlippage <= r.PriceImpact {
+		t.Errorf("expected positive sell impact %+v", r)
+	}
+
+	r, err = b.VWAP(100, true, false)
+	if err != ErrInsufficientBook {
+		t.Errorf("expected %v, received %v", ErrInsufficientBook, err)
+	}
+	if r.FullyFilled || r.BaseAmount != 8 {
+		t.Errorf("unexpected partial result %+v", r)
+	}
+}
+
+func TestSlippageCurve(t *testing.T) {
+	t.Parallel()
+	b := depthSetup()
+	curve, err := b.SlippageCurve([]float64{1, 3, 10}, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(curve) != 3 {
+		t.Fatalf("expected 3 results, received %d", len(curve))
+	}
+	if curve[0].PriceImpact != 0 || curve[1].PriceImpact <= curve[0].PriceImpact {
+		t.Errorf("expected increasing price impact %+v", curve)
+	}
+	if curve[2].FullyFilled {
+		t.Error("expected final amount to be unfilled")
+	}
+
+	if _, err = b.SlippageCurve([]float64{-1}, true, false); err != ErrInvalidAmount {
+		t.Errorf("expected %v, received %v", ErrInvalidAmount, err)
+	}
+}
+
+func TestDepthWithin(t *testing.T) {
+	t.Parallel()
+	b := depthSetup()
+	if _, err := b.DepthWithin(0); err != ErrInvalidPercent {
+		t.Errorf("expected %v, received %v", ErrInvalidPercent, err)
+	}
+
+	d, err := b.DepthWithin(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.BidAmount != 5 || d.AskAmount != 3 {
+		t.Errorf("unexpected depth %+v", d)
+	}
+	if d.BidValue != 99*2+98*3 || d.AskValue != 101+102*2 {
+		t.Errorf("unexpected depth value %+v", d)
+	}
+	if !approxEqual(d.Imbalance, 0.25) {
+		t.Errorf("expected imbalance 0.25, received %v", d.Imbalance)
+	}
+}
@@ -25,33 +25,55 @@ func Get(exchange string, p currency.Pair, a asset.Item) (*Base, error) {
 
 // SubscribeOrderbook subcribes to an orderbook and returns a communication
 // channel to stream orderbook data updates
-func SubscribeOrderbook(exchange string, p currency.Pair, a asset.Item) (dispatch.Pipe, error) {
+func SubscribeOrderbook(exchange string, p currency.Pair, a asset.Item) (Pipe, error) {
+	return SubscribeOrderbookWithOptions(exchange, p, a, dispatch.SubscribeOptions{})
+}
+
+// SubscribeOrderbookWithOptions subcribes to an orderbook and returns a
+// communication channel to stream orderbook data updates relayed according to
+// the options
+func SubscribeOrderbookWithOptions(exchange string, p currency.Pair, a asset.Item, opts dispatch.SubscribeOptions) (Pipe, error) {
 	exchange = strings.ToLower(exchange)
 	service.RLock()
 	defer service.RUnlock()
 	book, ok := service.Books[exchange][p.Base.Item][p.Quote.Item][a]
 	if !ok {
-		return dispatch.Pipe{}, fmt.Errorf("orderbook item not found for %s %s %s",
+		return Pipe{}, fmt.Errorf("orderbook item not found for %s %s %s",
 			exchange,
 			p,
 			a)
 	}
 
-	return service.mux.Subscribe(book.Main)
+	if opts.Name == "" {
+		opts.Name = fmt.Sprintf("orderbook %s %s %s", exchange, p, a)
+	}
+	return subscribe(book.Main, opts)
 }
 
 // SubscribeToExchangeOrderbooks subcribes to all orderbooks on an exchange
-func SubscribeToExchangeOrderbooks(exchange string) (dispatch.Pipe, error) {
+func SubscribeToExchangeOrderbooks(exchange string) (Pipe, error) {
 	exchange = strings.ToLower(exchange)
 	service.RLock()
 	defer service.RUnlock()
 	id, ok := service.Exchange[exchange]
 	if !ok {
-		return dispatch.Pipe{}, fmt.Errorf("%s exchange orderbooks not found",
+		return Pipe{}, fmt.Errorf("%s exchange orderbooks not found",
 			exchange)
 	}
 
-	return service.mux.Subscribe(id)
+	return subscribe(id, dispatch.SubscribeOptions{
+		Name: "orderbooks " + exchange,
+	})
+}
+
+// subscribe returns a pipe relaying the orderbooks published to the ID
+func subscribe(id uuid.UUID, opts dispatch.SubscribeOptions) (Pipe, error) {
+	c := make(chan *Base)
+	r, err := service.mux.SubscribeRelay(id, opts, c)
+	if err != nil {
+		return Pipe{}, err
+	}
+	return Pipe{C: c, Relay: r}, nil
 }
 
 // Update stores orderbook data
@@ -37,6 +37,12 @@ type Book struct {
 	Assoc []uuid.UUID
 }
 
+// Pipe is an orderbook subscription, C receives each orderbook update
+type Pipe struct {
+	C <-chan *Base
+	*dispatch.Relay
+}
+
 // Service holds orderbook information for each individual exchange
 type Service struct {
 	Books    map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Book
@@ -64,6 +70,9 @@ type Base struct {
 	LastUpdated  time.Time     `json:"lastUpdated"`
 	AssetType    asset.Item    `json:"assetType"`
 	ExchangeName string        `json:"exchangeName"`
+	// LastUpdateID is the ID of the last websocket update applied, used to
+	// verify update sequences
+	LastUpdateID int64 `json:"lastUpdateID,omitempty"`
 }
 
 type byOBPrice []Item
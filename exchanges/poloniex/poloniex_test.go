@@ -11,6 +11,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -70,6 +72,16 @@ func TestGetChartData(t *testing.T) {
 	}
 }
 
+func TestGetHistoricCandles(t *testing.T) {
+	t.Parallel()
+	start := time.Unix(1405699200, 0)
+	_, err := p.GetHistoricCandles(currency.NewPairFromString("BTC_LTC"),
+		asset.Spot, start, start.Add(time.Minute*5), kline.FiveMin)
+	if err != nil {
+		t.Error("Test faild - Poloniex GetHistoricCandles() error", err)
+	}
+}
+
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
 	_, err := p.GetCurrencies()
@@ -38,4 +38,5 @@ type Features struct {
 	MessageCorrelation     bool `json:"messageCorrelation,omitempty"`
 	MessageSequenceNumbers bool `json:"messageSequenceNumbers,omitempty"`
 	CandleHistory          bool `json:"candlehistory,omitempty"`
+	OrderUpdates           bool `json:"orderUpdates,omitempty"`
 }
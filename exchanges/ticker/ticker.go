@@ -21,34 +21,55 @@ func init() {
 
 // SubscribeTicker subcribes to a ticker and returns a communication channel to
 // stream new ticker updates
-func SubscribeTicker(exchange string, p currency.Pair, a asset.Item) (dispatch.Pipe, error) {
+func SubscribeTicker(exchange string, p currency.Pair, a asset.Item) (Pipe, error) {
+	return SubscribeTickerWithOptions(exchange, p, a, dispatch.SubscribeOptions{})
+}
+
+// SubscribeTickerWithOptions subcribes to a ticker and returns a communication
+// channel to stream new ticker updates relayed according to the options
+func SubscribeTickerWithOptions(exchange string, p currency.Pair, a asset.Item, opts dispatch.SubscribeOptions) (Pipe, error) {
 	exchange = strings.ToLower(exchange)
 	service.RLock()
 	defer service.RUnlock()
 
 	tick, ok := service.Tickers[exchange][p.Base.Item][p.Quote.Item][a]
 	if !ok {
-		return dispatch.Pipe{}, fmt.Errorf("ticker item not found for %s %s %s",
+		return Pipe{}, fmt.Errorf("ticker item not found for %s %s %s",
 			exchange,
 			p,
 			a)
 	}
 
-	return service.mux.Subscribe(tick.Main)
+	if opts.Name == "" {
+		opts.Name = fmt.Sprintf("ticker %s %s %s", exchange, p, a)
+	}
+	return subscribe(tick.Main, opts)
 }
 
 // SubscribeToExchangeTickers subcribes to all tickers on an exchange
-func SubscribeToExchangeTickers(exchange string) (dispatch.Pipe, error) {
+func SubscribeToExchangeTickers(exchange string) (Pipe, error) {
 	exchange = strings.ToLower(exchange)
 	service.RLock()
 	defer service.RUnlock()
 	id, ok := service.Exchange[exchange]
 	if !ok {
-		return dispatch.Pipe{}, fmt.Errorf("%s exchange tickers not found",
+		return Pipe{}, fmt.Errorf("%s exchange tickers not found",
 			exchange)
 	}
 
-	return service.mux.Subscribe(id)
+	return subscribe(id, dispatch.SubscribeOptions{
+		Name: "tickers " + exchange,
+	})
+}
+
+// subscribe returns a pipe relaying the tickers published to the ID
+func subscribe(id uuid.UUID, opts dispatch.SubscribeOptions) (Pipe, error) {
+	c := make(chan *Price)
+	r, err := service.mux.SubscribeRelay(id, opts, c)
+	if err != nil {
+		return Pipe{}, err
+	}
+	return Pipe{C: c, Relay: r}, nil
 }
 
 // GetTicker checks and returns a requested ticker if it exists
@@ -71,6 +71,38 @@ func TestSubscribeTicker(t *testing.T) {
 	if err != nil {
 		t.Error("cannot subscribe to ticker", err)
 	}
+
+	pipe, err := SubscribeTickerWithOptions("subscribetest", p, asset.Spot,
+		dispatch.SubscribeOptions{Policy: dispatch.PolicyConflate})
+	if err != nil {
+		t.Fatal("cannot subscribe to ticker", err)
+	}
+
+	err = ProcessTicker("subscribetest", &Price{Pair: p, Last: 1337}, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case tick := <-pipe.C:
+		if tick.Last != 1337 {
+			t.Errorf("expected last 1337 received %v", tick.Last)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for published ticker")
+	}
+
+	if err = pipe.Release(); err != nil {
+		t.Error(err)
+	}
+	if err = pipe.Release(); err != nil {
+		t.Error("expected releasing twice to be a no-op", err)
+	}
+	if _, ok := <-pipe.C; ok {
+		t.Error("expected pipe channel to be closed once released")
+	}
+	if err = (Pipe{}).Release(); err == nil {
+		t.Error("expected error releasing an unsubscribed pipe")
+	}
 }
 
 func TestSubscribeToExchangeTickers(t *testing.T) {
@@ -23,6 +23,12 @@ var (
 	service *Service
 )
 
+// Pipe is a ticker subscription, C receives each ticker update
+type Pipe struct {
+	C <-chan *Price
+	*dispatch.Relay
+}
+
 // Service holds ticker information for each individual exchange
 type Service struct {
 	Tickers  map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Ticker
@@ -408,6 +408,13 @@ func (w *Websocket) SetExchangeName(exchName string) {
 	w.exchangeName = exchName
 }
 
+// CanUseOrderUpdates returns if the websocket connection is authenticated and
+// streams private order and fill updates
+func (w *Websocket) CanUseOrderUpdates() bool {
+	return w.features != nil && w.features.OrderUpdates &&
+		w.IsConnected() && w.CanUseAuthenticatedEndpoints()
+}
+
 // GetName returns exchange name
 func (w *Websocket) GetName() string {
 	return w.exchangeName
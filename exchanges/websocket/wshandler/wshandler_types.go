@@ -7,6 +7,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
 )
@@ -109,6 +110,46 @@ type TradeData struct {
 	Price        float64
 	Amount       float64
 	Side         string
+	// TID is the exchange assigned trade ID, if provided
+	TID string
+}
+
+// OrderUpdate defines a normalised private order state change received on an
+// authenticated websocket connection, zero values are fields the exchange did
+// not supply in the update
+type OrderUpdate struct {
+	Timestamp       time.Time
+	CurrencyPair    currency.Pair
+	AssetType       asset.Item
+	Exchange        string
+	OrderID         string
+	ClientOrderID   string
+	Side            order.Side
+	Type            order.Type
+	Status          order.Status
+	Price           float64
+	Amount          float64
+	ExecutedAmount  float64
+	RemainingAmount float64
+	Fee             float64
+}
+
+// FillUpdate defines a normalised private execution against one of our orders
+// received on an authenticated websocket connection
+type FillUpdate struct {
+	Timestamp     time.Time
+	CurrencyPair  currency.Pair
+	AssetType     asset.Item
+	Exchange      string
+	OrderID       string
+	ClientOrderID string
+	// TradeID is the exchange assigned ID of the execution
+	TradeID     string
+	Side        order.Side
+	Price       float64
+	Amount      float64
+	Fee         float64
+	FeeCurrency string
 }
 
 // FundingData defines funding data
@@ -0,0 +1,248 @@
+package wsorderbook
+
+import "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+
+// levels is one side of a local orderbook held as an AVL tree so entries are
+// found, inserted and deleted in O(log n) and always kept in price order.
+// Asks are ascending and bids descending by price. When entries are matched by
+// ID, entries at the same price are ordered by ID and an index of ID to price
+// locates an entry without its price.
+type levels struct {
+	root       *level
+	length     int
+	descending bool
+	byID       bool
+	ids        map[int64]float64
+}
+
+// level is a node in the levels tree
+type level struct {
+	item        orderbook.Item
+	left, right *level
+	height      int
+}
+
+func newLevels(descending, byID bool) *levels {
+	l := &levels{descending: descending, byID: byID}
+	if byID {
+		l.ids = make(map[int64]float64)
+	}
+	return l
+}
+
+// load replaces all entries, later duplicates of an entry overwrite earlier
+// ones
+func (l *levels) load(items []orderbook.Item) {
+	l.root = nil
+	l.length = 0
+	if l.byID {
+		l.ids = make(map[int64]float64, len(items))
+	}
+	for i := range items {
+		l.set(items[i])
+	}
+}
+
+// compare orders a price and ID against an entry in the tree
+func (l *levels) compare(price float64, id int64, item *orderbook.Item) int {
+	switch {
+	case price == item.Price:
+		if !l.byID || id == item.ID {
+			return 0
+		}
+		if id < item.ID {
+			return -1
+		}
+		return 1
+	case (price < item.Price) != l.descending:
+		return -1
+	}
+	return 1
+}
+
+// get returns the entry matching the price, or the ID when entries are
+// matched by ID, nil is returned when there is no matching entry
+func (l *levels) get(item *orderbook.Item) *orderbook.Item {
+	price := item.Price
+	if l.byID {
+		var ok bool
+		price, ok = l.ids[item.ID]
+		if !ok {
+			return nil
+		}
+	}
+	n := l.root
+	for n != nil {
+		switch c := l.compare(price, item.ID, &n.item); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return &n.item
+		}
+	}
+	return nil
+}
+
+// set inserts or replaces an entry, an entry matched by ID is moved when its
+// price has changed
+func (l *levels) set(item orderbook.Item) {
+	if l.byID {
+		if price, ok := l.ids[item.ID]; ok && price != item.Price {
+			l.delete(price, item.ID)
+		}
+		l.ids[item.ID] = item.Price
+	}
+	var inserted bool
+	l.root, inserted = l.insert(l.root, item)
+	if inserted {
+		l.length++
+	}
+}
+
+// remove deletes the entry matching the price, or the ID when entries are
+// matched by ID
+func (l *levels) remove(item *orderbook.Item) {
+	price := item.Price
+	if l.byID {
+		var ok bool
+		price, ok = l.ids[item.ID]
+		if !ok {
+			return
+		}
+		delete(l.ids, item.ID)
+	}
+	l.delete(price, item.ID)
+}
+
+func (l *levels) delete(price float64, id int64) {
+	var deleted bool
+	l.root, deleted = l.deleteNode(l.root, price, id)
+	if deleted {
+		l.length--
+	}
+}
+
+// items returns a copy of all entries in order
+func (l *levels) items() []orderbook.Item {
+	if l.length == 0 {
+		return nil
+	}
+	items := make([]orderbook.Item, 0, l.length)
+	stack := make([]*level, 0, l.root.height)
+	n := l.root
+	for n != nil || len(stack) > 0 {
+		for n != nil {
+			stack = append(stack, n)
+			n = n.left
+		}
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		items = append(items, n.item)
+		n = n.right
+	}
+	return items
+}
+
+func (l *levels) insert(n *level, item orderbook.Item) (*level, bool) {
+	if n == nil {
+		return &level{item: item, height: 1}, true
+	}
+	var inserted bool
+	switch c := l.compare(item.Price, item.ID, &n.item); {
+	case c < 0:
+		n.left, inserted = l.insert(n.left, item)
+	case c > 0:
+		n.right, inserted = l.insert(n.right, item)
+	default:
+		n.item = item
+		return n, false
+	}
+	if !inserted {
+		return n, false
+	}
+	return n.rebalance(), true
+}
+
+func (l *levels) deleteNode(n *level, price float64, id int64) (*level, bool) {
+	if n == nil {
+		return nil, false
+	}
+	var deleted bool
+	switch c := l.compare(price, id, &n.item); {
+	case c < 0:
+		n.left, deleted = l.deleteNode(n.left, price, id)
+	case c > 0:
+		n.right, deleted = l.deleteNode(n.right, price, id)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// Replace with the next entry in order and delete that instead
+		next := n.right
+		for next.left != nil {
+			next = next.left
+		}
+		n.item = next.item
+		n.right, deleted = l.deleteNode(n.right, next.item.Price, next.item.ID)
+	}
+	if !deleted {
+		return n, false
+	}
+	return n.rebalance(), true
+}
+
+func height(n *level) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *level) updateHeight() {
+	n.height = height(n.left)
+	if r := height(n.right); r > n.height {
+		n.height = r
+	}
+	n.height++
+}
+
+func (n *level) rotateLeft() *level {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.updateHeight()
+	r.updateHeight()
+	return r
+}
+
+func (n *level) rotateRight() *level {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.updateHeight()
+	l.updateHeight()
+	return l
+}
+
+// rebalance restores the AVL height invariant after an insert or delete
+func (n *level) rebalance() *level {
+	n.updateHeight()
+	switch balance := height(n.left) - height(n.right); {
+	case balance > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case balance < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
@@ -0,0 +1,142 @@
+package wsorderbook
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// checkLevels verifies the levels match the expected entries in order and the
+// tree is balanced
+func checkLevels(t *testing.T, l *levels, expected map[int64]orderbook.Item) {
+	t.Helper()
+	want := make([]orderbook.Item, 0, len(expected))
+	for _, item := range expected {
+		want = append(want, item)
+	}
+	sort.Slice(want, func(i, j int) bool {
+		if want[i].Price == want[j].Price {
+			return want[i].ID < want[j].ID
+		}
+		return (want[i].Price < want[j].Price) != l.descending
+	})
+
+	got := l.items()
+	if len(got) != len(want) || l.length != len(want) {
+		t.Fatalf("expected %d entries, received %d with length %d", len(want), len(got), l.length)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("entry %d expected %+v received %+v", i, want[i], got[i])
+		}
+	}
+
+	var balanced func(n *level) int
+	balanced = func(n *level) int {
+		if n == nil {
+			return 0
+		}
+		l, r := balanced(n.left), balanced(n.right)
+		if l-r > 1 || r-l > 1 {
+			t.Fatalf("unbalanced at %+v", n.item)
+		}
+		if l < r {
+			l = r
+		}
+		if n.height != l+1 {
+			t.Fatalf("incorrect height at %+v", n.item)
+		}
+		return l + 1
+	}
+	balanced(l.root)
+}
+
+func TestLevelsByPrice(t *testing.T) {
+	for _, descending := range []bool{false, true} {
+		l := newLevels(descending, false)
+		expected := make(map[int64]orderbook.Item)
+		for i := 0; i < 5000; i++ {
+			item := orderbook.Item{
+				Price:  float64(rand.Intn(500)),
+				Amount: float64(rand.Intn(5)),
+			}
+			// Entries matched by price are keyed by price alone
+			key := int64(item.Price)
+			if item.Amount == 0 {
+				l.remove(&item)
+				delete(expected, key)
+				continue
+			}
+			l.set(item)
+			expected[key] = item
+		}
+		checkLevels(t, l, expected)
+
+		for key, item := range expected {
+			got := l.get(&orderbook.Item{Price: item.Price})
+			if got == nil || *got != item {
+				t.Fatalf("expected %+v received %+v", item, got)
+			}
+			l.remove(&item)
+			delete(expected, key)
+		}
+		checkLevels(t, l, expected)
+		if l.root != nil {
+			t.Error("expected empty tree")
+		}
+	}
+}
+
+func TestLevelsByID(t *testing.T) {
+	l := newLevels(true, true)
+	expected := make(map[int64]orderbook.Item)
+	for i := 0; i < 5000; i++ {
+		item := orderbook.Item{
+			ID:     int64(rand.Intn(1000)),
+			Price:  float64(rand.Intn(100)),
+			Amount: float64(rand.Intn(5)),
+		}
+		if item.Amount == 0 {
+			l.remove(&orderbook.Item{ID: item.ID})
+			delete(expected, item.ID)
+			continue
+		}
+		// Setting an existing ID at a new price moves the entry
+		l.set(item)
+		expected[item.ID] = item
+	}
+	checkLevels(t, l, expected)
+	if len(l.ids) != len(expected) {
+		t.Errorf("expected %d indexed IDs received %d", len(expected), len(l.ids))
+	}
+	for id, item := range expected {
+		got := l.get(&orderbook.Item{ID: id})
+		if got == nil || *got != item {
+			t.Fatalf("expected %+v received %+v", item, got)
+		}
+	}
+
+	l.load([]orderbook.Item{{ID: 1, Price: 10, Amount: 1}, {ID: 2, Price: 10, Amount: 2}})
+	checkLevels(t, l, map[int64]orderbook.Item{
+		1: {ID: 1, Price: 10, Amount: 1},
+		2: {ID: 2, Price: 10, Amount: 2},
+	})
+}
+
+func TestUpdateByIDMovesPrice(t *testing.T) {
+	l := newLevels(false, true)
+	l.load([]orderbook.Item{{ID: 1, Price: 10, Amount: 1}, {ID: 2, Price: 11, Amount: 1}})
+	updateByIDAndAction(l, "update", []orderbook.Item{{ID: 1, Price: 12, Amount: 3}, {ID: 3, Amount: 1}})
+	items := l.items()
+	if len(items) != 2 || items[0].ID != 2 || items[1].Price != 12 || items[1].Amount != 3 {
+		t.Errorf("unexpected levels %+v", items)
+	}
+	updateByIDAndAction(l, "update/insert", []orderbook.Item{{ID: 3, Price: 9, Amount: 1}})
+	updateByIDAndAction(l, "update", []orderbook.Item{{ID: 2, Amount: 5}})
+	items = l.items()
+	if len(items) != 3 || items[0].ID != 3 || items[1].Amount != 5 {
+		t.Errorf("unexpected levels %+v", items)
+	}
+}
@@ -0,0 +1,203 @@
+package wsorderbook
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Record types
+const (
+	RecordTypeSettings = "settings"
+	RecordTypeSnapshot = "snapshot"
+	RecordTypeUpdate   = "update"
+)
+
+// RecordFileExtension is the file extension used for orderbook recordings
+const RecordFileExtension = ".json.gz"
+
+const recorderFlushInterval = time.Second
+
+// RecordingPath returns the file an exchange orderbook is recorded to
+func RecordingPath(dir, exchangeName string, p currency.Pair, a asset.Item) string {
+	return filepath.Join(dir,
+		strings.ToLower(exchangeName),
+		a.String(),
+		p.Format("-", true).String()+RecordFileExtension)
+}
+
+// StartRecording writes all subsequent snapshots and updates to compressed,
+// append only files under dir. Each recording session starts with the
+// processing settings of the local orderbook so a replay applies updates in
+// exactly the same way.
+func (w *WebsocketOrderbookLocal) StartRecording(dir string) error {
+	if dir == "" {
+		return errors.New("orderbook recording directory not set")
+	}
+
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.recorder != nil {
+		return fmt.Errorf("%s orderbook recording already started", w.exchangeName)
+	}
+
+	w.recorder = &recorder{
+		dir: dir,
+		settings: RecordSettings{
+			Exchange:              w.exchangeName,
+			BufferLimit:           w.obBufferLimit,
+			BufferEnabled:         w.bufferEnabled,
+			SortBuffer:            w.sortBuffer,
+			SortBufferByUpdateIDs: w.sortBufferByUpdateIDs,
+			UpdateEntriesByID:     w.updateEntriesByID,
+		},
+		files: make(map[string]*recordFile),
+	}
+	return nil
+}
+
+// StopRecording flushes and closes all open recordings
+func (w *WebsocketOrderbookLocal) StopRecording() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.recorder == nil {
+		return fmt.Errorf("%s orderbook recording not started", w.exchangeName)
+	}
+	err := w.recorder.close()
+	w.recorder = nil
+	return err
+}
+
+// IsRecording returns whether snapshots and updates are being recorded
+func (w *WebsocketOrderbookLocal) IsRecording() bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.recorder != nil
+}
+
+// recorder writes records for each pair and asset to a separate file. Updates
+// are only written once a snapshot has been written for the current session
+// so every recording can be replayed from its start.
+type recorder struct {
+	dir      string
+	settings RecordSettings
+	files    map[string]*recordFile
+}
+
+type recordFile struct {
+	f         *os.File
+	gz        *gzip.Writer
+	enc       *json.Encoder
+	lastFlush time.Time
+}
+
+func (r *recorder) recordSnapshot(b *orderbook.Base) {
+	r.write(b.Pair, b.AssetType, &Record{
+		Type:     RecordTypeSnapshot,
+		Time:     time.Now(),
+		Base:     b.Pair.Base.String(),
+		Quote:    b.Pair.Quote.String(),
+		Snapshot: b,
+	})
+}
+
+func (r *recorder) recordUpdate(u *WebsocketOrderbookUpdate) {
+	r.write(u.Pair, u.Asset, &Record{
+		Type:   RecordTypeUpdate,
+		Time:   time.Now(),
+		Base:   u.Pair.Base.String(),
+		Quote:  u.Pair.Quote.String(),
+		Update: u,
+	})
+}
+
+func (r *recorder) write(p currency.Pair, a asset.Item, rec *Record) {
+	path := RecordingPath(r.dir, r.settings.Exchange, p, a)
+	f, ok := r.files[path]
+	if !ok {
+		if rec.Type != RecordTypeSnapshot {
+			return
+		}
+		var err error
+		f, err = r.open(path)
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "%s unable to open orderbook recording %s: %v\n",
+				r.settings.Exchange, path, err)
+			return
+		}
+		r.files[path] = f
+	}
+
+	err := f.enc.Encode(rec)
+	if err != nil {
+		log.Errorf(log.WebsocketMgr, "%s unable to record orderbook %s: %v\n",
+			r.settings.Exchange, path, err)
+		return
+	}
+
+	if rec.Type == RecordTypeSnapshot || time.Since(f.lastFlush) >= recorderFlushInterval {
+		err = f.gz.Flush()
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "%s unable to flush orderbook recording %s: %v\n",
+				r.settings.Exchange, path, err)
+		}
+		f.lastFlush = time.Now()
+	}
+}
+
+// open appends a new gzip member to the recording, concatenated members are
+// read back as a single stream
+func (r *recorder) open(path string) (*recordFile, error) {
+	err := common.CreateDir(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(file)
+	f := &recordFile{
+		f:   file,
+		gz:  gz,
+		enc: json.NewEncoder(gz),
+	}
+	settings := r.settings
+	err = f.enc.Encode(&Record{
+		Type:     RecordTypeSettings,
+		Time:     time.Now(),
+		Settings: &settings,
+	})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func (r *recorder) close() error {
+	var lastErr error
+	for path, f := range r.files {
+		if err := f.gz.Close(); err != nil {
+			lastErr = err
+		}
+		if err := f.f.Close(); err != nil {
+			lastErr = err
+		}
+		delete(r.files, path)
+	}
+	return lastErr
+}
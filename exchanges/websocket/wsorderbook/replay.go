@@ -0,0 +1,205 @@
+package wsorderbook
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var errNoReplayOrderbook = errors.New("no orderbook snapshot replayed")
+
+// Replay reconstructs an orderbook from a recording by applying each record
+// through a local orderbook with the recorded settings, so the book is built
+// using the same code path as it was in production
+type Replay struct {
+	file *os.File
+	gz   *gzip.Reader
+	dec  *json.Decoder
+
+	local WebsocketOrderbookLocal
+	pair  currency.Pair
+	asset asset.Item
+	time  time.Time
+	next  *Record
+}
+
+// NewReplay opens a recording for replay. When publish is set each
+// reconstructed orderbook is processed through the orderbook service so
+// subscribers receive it exactly as they would from a live websocket.
+func NewReplay(path string, publish bool) (*Replay, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	r := &Replay{
+		file: file,
+		gz:   gz,
+		dec:  json.NewDecoder(gz),
+	}
+	if !publish {
+		r.local.process = r.processLocal
+	}
+	return r, nil
+}
+
+// Close closes the recording
+func (r *Replay) Close() error {
+	err := r.gz.Close()
+	if errF := r.file.Close(); errF != nil {
+		return errF
+	}
+	return err
+}
+
+// Time returns the time the last applied record was recorded
+func (r *Replay) Time() time.Time {
+	return r.time
+}
+
+// Next applies the next record and returns a copy of the reconstructed
+// orderbook, io.EOF is returned once the recording has been fully replayed.
+// An error applying a record is returned with the time of the record and the
+// replay can continue.
+func (r *Replay) Next() (*orderbook.Base, error) {
+	rec, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	err = r.apply(rec)
+	if err != nil {
+		return nil, fmt.Errorf("record at %s: %v", rec.Time, err)
+	}
+	return r.Orderbook()
+}
+
+// BookAt applies all records up to and including t and returns a copy of the
+// reconstructed orderbook
+func (r *Replay) BookAt(t time.Time) (*orderbook.Base, error) {
+	for {
+		rec, err := r.peek()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if rec.Time.After(t) {
+			break
+		}
+		r.next = nil
+		err = r.apply(rec)
+		if err != nil {
+			return nil, fmt.Errorf("record at %s: %v", rec.Time, err)
+		}
+	}
+	return r.Orderbook()
+}
+
+// Orderbook returns a copy of the current reconstructed orderbook
+func (r *Replay) Orderbook() (*orderbook.Base, error) {
+	b := r.local.GetOrderbook(r.pair, r.asset)
+	if b == nil {
+		return nil, errNoReplayOrderbook
+	}
+	return b, nil
+}
+
+func (r *Replay) peek() (*Record, error) {
+	if r.next != nil {
+		return r.next, nil
+	}
+	rec, err := r.decode()
+	if err != nil {
+		return nil, err
+	}
+	r.next = rec
+	return rec, nil
+}
+
+func (r *Replay) read() (*Record, error) {
+	if r.next != nil {
+		rec := r.next
+		r.next = nil
+		return rec, nil
+	}
+	return r.decode()
+}
+
+// decode reads the next record, a recording truncated by an unclean shutdown
+// is treated as complete
+func (r *Replay) decode() (*Record, error) {
+	var rec Record
+	err := r.dec.Decode(&rec)
+	if err == io.ErrUnexpectedEOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (r *Replay) apply(rec *Record) error {
+	r.time = rec.Time
+	switch rec.Type {
+	case RecordTypeSettings:
+		if rec.Settings == nil {
+			return errors.New("settings record missing settings")
+		}
+		// A new recording session starts from a fresh snapshot
+		r.local.FlushCache()
+		r.local.Setup(rec.Settings.BufferLimit,
+			rec.Settings.BufferEnabled,
+			rec.Settings.SortBuffer,
+			rec.Settings.SortBufferByUpdateIDs,
+			rec.Settings.UpdateEntriesByID,
+			rec.Settings.Exchange)
+		return nil
+	case RecordTypeSnapshot:
+		if rec.Snapshot == nil {
+			return errors.New("snapshot record missing orderbook")
+		}
+		rec.Snapshot.Pair = rec.pair(rec.Snapshot.Pair)
+		r.pair = rec.Snapshot.Pair
+		r.asset = rec.Snapshot.AssetType
+		return r.local.LoadSnapshot(rec.Snapshot)
+	case RecordTypeUpdate:
+		if rec.Update == nil {
+			return errors.New("update record missing update")
+		}
+		rec.Update.Pair = rec.pair(rec.Update.Pair)
+		return r.local.Update(rec.Update)
+	default:
+		return fmt.Errorf("unknown record type %s", rec.Type)
+	}
+}
+
+// pair restores the recorded pair from its components
+func (rec *Record) pair(decoded currency.Pair) currency.Pair {
+	if rec.Base == "" || rec.Quote == "" {
+		return decoded
+	}
+	return currency.NewPairWithDelimiter(rec.Base, rec.Quote, decoded.Delimiter)
+}
+
+// processLocal stamps the orderbook with the record time instead of
+// publishing it
+func (r *Replay) processLocal(b *orderbook.Base) error {
+	b.LastUpdated = r.time
+	return nil
+}
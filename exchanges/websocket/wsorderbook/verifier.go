@@ -0,0 +1,200 @@
+package wsorderbook
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Orderbook verification errors
+var (
+	ErrOrderbookInvalid = errors.New("orderbook invalid, awaiting resynchronisation")
+	ErrChecksumMismatch = errors.New("orderbook checksum mismatch")
+	ErrSequenceGap      = errors.New("orderbook update sequence gap")
+
+	errStaleUpdate = errors.New("orderbook update is stale")
+)
+
+// Verifier validates a local orderbook once an update has been applied and
+// before it is processed to the orderbook service. LastUpdateID still holds
+// the ID of the previously applied update. Returning an error invalidates the
+// orderbook until it is resynchronised.
+type Verifier interface {
+	Verify(b *orderbook.Base, u *WebsocketOrderbookUpdate) error
+}
+
+// StaleFilter may be implemented by a Verifier to discard updates the local
+// orderbook already reflects, such as updates sent before the snapshot it was
+// loaded from was taken. Stale updates are dropped before they are applied.
+type StaleFilter interface {
+	Stale(lastUpdateID int64, u *WebsocketOrderbookUpdate) bool
+}
+
+// ResyncFunc fetches a fresh orderbook snapshot, usually via the exchange REST
+// API, for an orderbook that failed verification
+type ResyncFunc func(p currency.Pair, a asset.Item) (*orderbook.Base, error)
+
+// CRC32Verifier verifies the CRC32 checksum supplied with each update against
+// the top Depth levels of the local orderbook. Levels are serialised by
+// Serialise, or when unset as price:amount alternating bid and ask delimited by
+// colons. Updates without a checksum are not verified.
+type CRC32Verifier struct {
+	Depth     int
+	Serialise func(b *orderbook.Base, depth int) string
+}
+
+// Verify implements the Verifier interface
+func (c *CRC32Verifier) Verify(b *orderbook.Base, u *WebsocketOrderbookUpdate) error {
+	if u.Checksum == 0 {
+		return nil
+	}
+	serialise := c.Serialise
+	if serialise == nil {
+		serialise = SerialiseAlternating
+	}
+	checksum := crc32.ChecksumIEEE([]byte(serialise(b, c.Depth)))
+	if checksum != u.Checksum {
+		return fmt.Errorf("%v calculated %d received %d", ErrChecksumMismatch, checksum, u.Checksum)
+	}
+	return nil
+}
+
+// SerialiseAlternating serialises the top levels of an orderbook as
+// price:amount alternating bid and ask delimited by colons, continuing with one
+// side once the other has no more levels
+func SerialiseAlternating(b *orderbook.Base, depth int) string {
+	var s strings.Builder
+	for i := 0; i < depth; i++ {
+		if i < len(b.Bids) {
+			s.WriteString(strconv.FormatFloat(b.Bids[i].Price, 'f', -1, 64) + ":" +
+				strconv.FormatFloat(b.Bids[i].Amount, 'f', -1, 64) + ":")
+		}
+		if i < len(b.Asks) {
+			s.WriteString(strconv.FormatFloat(b.Asks[i].Price, 'f', -1, 64) + ":" +
+				strconv.FormatFloat(b.Asks[i].Amount, 'f', -1, 64) + ":")
+		}
+	}
+	return strings.TrimSuffix(s.String(), ":")
+}
+
+// SequenceVerifier verifies update IDs are continuous. An update that sets
+// FirstUpdateID must span the ID following the last applied update, otherwise
+// its UpdateID must directly follow it. The first update after a snapshot
+// without a LastUpdateID starts the sequence. Updates with an UpdateID at or
+// before the last applied update are stale and dropped.
+type SequenceVerifier struct{}
+
+// Stale implements the StaleFilter interface
+func (SequenceVerifier) Stale(lastUpdateID int64, u *WebsocketOrderbookUpdate) bool {
+	return lastUpdateID != 0 && u.UpdateID != 0 && u.UpdateID <= lastUpdateID
+}
+
+// Verify implements the Verifier interface
+func (SequenceVerifier) Verify(b *orderbook.Base, u *WebsocketOrderbookUpdate) error {
+	if b.LastUpdateID == 0 {
+		return nil
+	}
+	next := b.LastUpdateID + 1
+	if u.FirstUpdateID != 0 {
+		if u.FirstUpdateID > next || u.UpdateID < next {
+			return fmt.Errorf("%v expected update spanning %d received %d to %d",
+				ErrSequenceGap, next, u.FirstUpdateID, u.UpdateID)
+		}
+		return nil
+	}
+	if u.UpdateID != next {
+		return fmt.Errorf("%v expected %d received %d", ErrSequenceGap, next, u.UpdateID)
+	}
+	return nil
+}
+
+// SetVerifiers sets the verifiers run against each update, replacing any
+// previously set
+func (w *WebsocketOrderbookLocal) SetVerifiers(v ...Verifier) {
+	w.m.Lock()
+	w.verifiers = v
+	w.m.Unlock()
+}
+
+// SetResync sets the function used to fetch a fresh snapshot when an orderbook
+// fails verification. Without it an invalid orderbook is only restored by the
+// next websocket snapshot.
+func (w *WebsocketOrderbookLocal) SetResync(fn ResyncFunc) {
+	w.m.Lock()
+	w.resync = fn
+	w.m.Unlock()
+}
+
+// IsInvalid returns whether an orderbook failed verification and is awaiting
+// resynchronisation
+func (w *WebsocketOrderbookLocal) IsInvalid(p currency.Pair, a asset.Item) bool {
+	book := w.lookup(p, a)
+	if book == nil {
+		return false
+	}
+	book.m.Lock()
+	defer book.m.Unlock()
+	return book.invalid
+}
+
+// invalidate clears an orderbook that failed verification so the corrupted
+// book is not processed and triggers a resync. Must be called with the
+// orderbook lock held.
+func (w *WebsocketOrderbookLocal) invalidate(book *localOrderbook, p currency.Pair, a asset.Item, cause error) error {
+	book.bids.load(nil)
+	book.asks.load(nil)
+	book.buffer = nil
+	book.invalid = true
+
+	log.Warnf(log.WebsocketMgr, "%s %s %s orderbook invalidated: %v\n",
+		w.exchangeName, p, a, cause)
+	w.m.Lock()
+	resync := w.resync
+	w.m.Unlock()
+	if resync != nil {
+		go w.resnapshot(resync, p, a)
+	}
+	return fmt.Errorf("%s %s %s %v: %v", w.exchangeName, p, a, ErrOrderbookInvalid, cause)
+}
+
+// resnapshot fetches and loads a fresh snapshot for an invalidated orderbook
+func (w *WebsocketOrderbookLocal) resnapshot(fn ResyncFunc, p currency.Pair, a asset.Item) {
+	b, err := fn(p, a)
+	if err != nil {
+		log.Errorf(log.WebsocketMgr, "%s %s %s orderbook resync failed: %v\n",
+			w.exchangeName, p, a, err)
+		return
+	}
+
+	cpy := *b
+	cpy.Pair = p
+	cpy.AssetType = a
+	cpy.ExchangeName = w.exchangeName
+
+	book := w.lookup(p, a)
+	if book == nil {
+		// The cache has been flushed
+		return
+	}
+	book.m.Lock()
+	defer book.m.Unlock()
+	if !book.invalid {
+		// A websocket snapshot has already restored the orderbook
+		return
+	}
+	err = w.loadSnapshot(book, &cpy)
+	if err != nil {
+		log.Errorf(log.WebsocketMgr, "%s %s %s orderbook resync failed: %v\n",
+			w.exchangeName, p, a, err)
+		return
+	}
+	log.Debugf(log.WebsocketMgr, "%s %s %s orderbook resynchronised\n",
+		w.exchangeName, p, a)
+}
@@ -0,0 +1,271 @@
+package wsorderbook
+
+import (
+	"hash/crc32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func verifierSnapshot() *orderbook.Base {
+	return &orderbook.Base{
+		ExchangeName: exchangeName,
+		Pair:         cp,
+		AssetType:    asset.Spot,
+		Bids:         []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks:         []orderbook.Item{{Price: 101, Amount: 1}},
+	}
+}
+
+func TestSerialiseAlternating(t *testing.T) {
+	s := SerialiseAlternating(verifierSnapshot(), 25)
+	if s != "99:1:101:1:98:2" {
+		t.Errorf("unexpected serialisation %s", s)
+	}
+	s = SerialiseAlternating(verifierSnapshot(), 1)
+	if s != "99:1:101:1" {
+		t.Errorf("unexpected serialisation %s", s)
+	}
+}
+
+func TestCRC32Verifier(t *testing.T) {
+	w := WebsocketOrderbookLocal{exchangeName: exchangeName}
+	w.SetVerifiers(&CRC32Verifier{Depth: 25})
+	err := w.LoadSnapshot(verifierSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Bids:       []orderbook.Item{{Price: 98, Amount: 3}},
+		Pair:       cp,
+		Asset:      asset.Spot,
+		UpdateTime: time.Now(),
+		Checksum:   crc32.ChecksumIEEE([]byte("99:1:101:1:98:3")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Updates without a checksum are not verified
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Bids:       []orderbook.Item{{Price: 98, Amount: 4}},
+		Pair:       cp,
+		Asset:      asset.Spot,
+		UpdateTime: time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Bids:       []orderbook.Item{{Price: 98, Amount: 5}},
+		Pair:       cp,
+		Asset:      asset.Spot,
+		UpdateTime: time.Now(),
+		Checksum:   1337,
+	})
+	if err == nil || !strings.Contains(err.Error(), ErrChecksumMismatch.Error()) {
+		t.Fatalf("expected checksum mismatch received %v", err)
+	}
+	if !w.IsInvalid(cp, asset.Spot) {
+		t.Error("expected orderbook to be invalid")
+	}
+	if w.GetOrderbook(cp, asset.Spot) != nil {
+		t.Error("expected invalid orderbook to be flushed")
+	}
+
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Bids:       []orderbook.Item{{Price: 98, Amount: 5}},
+		Pair:       cp,
+		Asset:      asset.Spot,
+		UpdateTime: time.Now(),
+	})
+	if err == nil || !strings.Contains(err.Error(), ErrOrderbookInvalid.Error()) {
+		t.Fatalf("expected invalid orderbook error received %v", err)
+	}
+
+	err = w.LoadSnapshot(verifierSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.IsInvalid(cp, asset.Spot) {
+		t.Error("expected snapshot to restore orderbook")
+	}
+}
+
+func TestSequenceVerifier(t *testing.T) {
+	var v SequenceVerifier
+	b := &orderbook.Base{}
+	if err := v.Verify(b, &WebsocketOrderbookUpdate{UpdateID: 10}); err != nil {
+		t.Error(err)
+	}
+	b.LastUpdateID = 10
+	if err := v.Verify(b, &WebsocketOrderbookUpdate{UpdateID: 11}); err != nil {
+		t.Error(err)
+	}
+	if err := v.Verify(b, &WebsocketOrderbookUpdate{UpdateID: 12}); err == nil {
+		t.Error("expected sequence gap")
+	}
+	if err := v.Verify(b, &WebsocketOrderbookUpdate{FirstUpdateID: 8, UpdateID: 15}); err != nil {
+		t.Error(err)
+	}
+	if err := v.Verify(b, &WebsocketOrderbookUpdate{FirstUpdateID: 12, UpdateID: 15}); err == nil {
+		t.Error("expected sequence gap")
+	}
+	if err := v.Verify(b, &WebsocketOrderbookUpdate{FirstUpdateID: 5, UpdateID: 10}); err == nil {
+		t.Error("expected stale update to fail")
+	}
+}
+
+func TestSequenceVerifierStale(t *testing.T) {
+	var v SequenceVerifier
+	if v.Stale(0, &WebsocketOrderbookUpdate{UpdateID: 5}) {
+		t.Error("updates should not be stale without a last update ID")
+	}
+	if !v.Stale(10, &WebsocketOrderbookUpdate{FirstUpdateID: 5, UpdateID: 10}) {
+		t.Error("expected update at the last update ID to be stale")
+	}
+	if v.Stale(10, &WebsocketOrderbookUpdate{FirstUpdateID: 5, UpdateID: 11}) {
+		t.Error("expected update spanning the next ID not to be stale")
+	}
+
+	w := WebsocketOrderbookLocal{exchangeName: exchangeName}
+	w.SetVerifiers(SequenceVerifier{})
+	snapshot := verifierSnapshot()
+	snapshot.LastUpdateID = 10
+	err := w.LoadSnapshot(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Asks:          []orderbook.Item{{Price: 101, Amount: 5}},
+		Pair:          cp,
+		Asset:         asset.Spot,
+		FirstUpdateID: 8,
+		UpdateID:      9,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ob := w.GetOrderbook(cp, asset.Spot)
+	if ob.LastUpdateID != 10 || ob.Asks[0].Amount != 1 {
+		t.Errorf("expected stale update to be dropped received %+v", ob)
+	}
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Asks:          []orderbook.Item{{Price: 101, Amount: 5}},
+		Pair:          cp,
+		Asset:         asset.Spot,
+		FirstUpdateID: 9,
+		UpdateID:      12,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ob = w.GetOrderbook(cp, asset.Spot)
+	if ob.LastUpdateID != 12 || ob.Asks[0].Amount != 5 {
+		t.Errorf("expected update to be applied received %+v", ob)
+	}
+}
+
+func TestChecksumOnlyUpdate(t *testing.T) {
+	w := WebsocketOrderbookLocal{exchangeName: exchangeName}
+	w.SetVerifiers(&CRC32Verifier{Depth: 25})
+	err := w.LoadSnapshot(verifierSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Checksum: crc32.ChecksumIEEE([]byte("99:1:101:1:98:2")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Update(&WebsocketOrderbookUpdate{Pair: cp, Asset: asset.Spot})
+	if err == nil {
+		t.Error("expected error for an update without targets or a checksum")
+	}
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Checksum: 1337,
+	})
+	if err == nil || !w.IsInvalid(cp, asset.Spot) {
+		t.Errorf("expected checksum mismatch to invalidate orderbook received %v", err)
+	}
+}
+
+func TestResync(t *testing.T) {
+	w := WebsocketOrderbookLocal{exchangeName: exchangeName}
+	w.SetVerifiers(SequenceVerifier{})
+	resynced := make(chan struct{})
+	w.SetResync(func(p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+		defer close(resynced)
+		b := verifierSnapshot()
+		b.LastUpdateID = 20
+		return b, nil
+	})
+	err := w.LoadSnapshot(verifierSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []int64{1, 2} {
+		err = w.Update(&WebsocketOrderbookUpdate{
+			Asks:       []orderbook.Item{{Price: 101, Amount: float64(id)}},
+			Pair:       cp,
+			Asset:      asset.Spot,
+			UpdateTime: time.Now(),
+			UpdateID:   id,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ob := w.GetOrderbook(cp, asset.Spot); ob.LastUpdateID != 2 {
+		t.Errorf("expected last update ID 2 received %d", ob.LastUpdateID)
+	}
+
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Asks:       []orderbook.Item{{Price: 101, Amount: 4}},
+		Pair:       cp,
+		Asset:      asset.Spot,
+		UpdateTime: time.Now(),
+		UpdateID:   4,
+	})
+	if err == nil {
+		t.Fatal("expected sequence gap to invalidate orderbook")
+	}
+
+	select {
+	case <-resynced:
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for resync")
+	}
+	for i := 0; w.IsInvalid(cp, asset.Spot); i++ {
+		if i == 50 {
+			t.Fatal("expected resync to restore orderbook")
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	ob := w.GetOrderbook(cp, asset.Spot)
+	if ob.LastUpdateID != 20 || ob.Asks[0].Amount != 1 {
+		t.Errorf("unexpected resynchronised orderbook %+v", ob)
+	}
+	err = w.Update(&WebsocketOrderbookUpdate{
+		Asks:       []orderbook.Item{{Price: 101, Amount: 4}},
+		Pair:       cp,
+		Asset:      asset.Spot,
+		UpdateTime: time.Now(),
+		UpdateID:   21,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -25,201 +25,192 @@ func (w *WebsocketOrderbookLocal) Setup(obBufferLimit int, bufferEnabled, sortBu
 // Volume == 0; deletion at price target
 // Price target not found; append of price target
 // Price target found; amend volume of price target
+// An update with only a checksum verifies the orderbook without amending it,
+// for exchanges that send checksums separately from updates
 func (w *WebsocketOrderbookLocal) Update(u *WebsocketOrderbookUpdate) error {
-	if (u.Bids == nil && u.Asks == nil) || (len(u.Bids) == 0 && len(u.Asks) == 0) {
+	if len(u.Bids) == 0 && len(u.Asks) == 0 && u.Checksum == 0 {
 		return fmt.Errorf("%v cannot have bids and ask targets both nil",
 			w.exchangeName)
 	}
-	w.m.Lock()
-	defer w.m.Unlock()
-	obLookup, ok := w.ob[u.Pair][u.Asset]
-	if !ok {
+	book := w.lookup(u.Pair, u.Asset)
+	if book == nil {
 		return fmt.Errorf("ob.Base could not be found for Exchange %s CurrencyPair: %s AssetType: %s",
 			w.exchangeName,
 			u.Pair,
 			u.Asset)
 	}
 
+	book.m.Lock()
+	defer book.m.Unlock()
+	if book.invalid {
+		return fmt.Errorf("%s %s %s %v",
+			w.exchangeName,
+			u.Pair,
+			u.Asset,
+			ErrOrderbookInvalid)
+	}
+
+	w.m.Lock()
+	if w.recorder != nil {
+		w.recorder.recordUpdate(u)
+	}
+	verifiers := w.verifiers
+	w.m.Unlock()
+
+	var ob *orderbook.Base
+	var err error
 	if w.bufferEnabled {
-		overBufferLimit := w.processBufferUpdate(obLookup, u)
+		var overBufferLimit bool
+		ob, overBufferLimit, err = w.processBufferUpdate(book, u, verifiers)
+		if err != nil {
+			return w.invalidate(book, u.Pair, u.Asset, err)
+		}
 		if !overBufferLimit {
 			return nil
 		}
 	} else {
-		w.processObUpdate(obLookup, u)
-	}
-	err := obLookup.Process()
-	if err != nil {
-		return err
+		ob, err = w.processObUpdate(book, u, verifiers)
+		if err == errStaleUpdate {
+			return nil
+		}
+		if err != nil {
+			return w.invalidate(book, u.Pair, u.Asset, err)
+		}
 	}
-	if w.bufferEnabled {
-		// Reset the buffer
-		w.buffer[u.Pair][u.Asset] = nil
+	if ob == nil {
+		ob = book.orderbook()
 	}
-	return nil
+	return w.processOrderbook(book, ob)
 }
 
-func (w *WebsocketOrderbookLocal) processBufferUpdate(o *orderbook.Base, u *WebsocketOrderbookUpdate) bool {
-	if w.buffer == nil {
-		w.buffer = make(map[currency.Pair]map[asset.Item][]*WebsocketOrderbookUpdate)
-	}
-	if w.buffer[u.Pair] == nil {
-		w.buffer[u.Pair] = make(map[asset.Item][]*WebsocketOrderbookUpdate)
-	}
-	bufferLookup := w.buffer[u.Pair][u.Asset]
-	if len(bufferLookup) <= w.obBufferLimit {
-		bufferLookup = append(bufferLookup, u)
-		if len(bufferLookup) < w.obBufferLimit {
-			w.buffer[u.Pair][u.Asset] = bufferLookup
-			return false
+// processBufferUpdate buffers an update and once the buffer limit is reached
+// applies every buffered update. The orderbook copied for verification of the
+// last update is returned so it is not copied again to be processed.
+func (w *WebsocketOrderbookLocal) processBufferUpdate(book *localOrderbook, u *WebsocketOrderbookUpdate, verifiers []Verifier) (*orderbook.Base, bool, error) {
+	if len(book.buffer) <= w.obBufferLimit {
+		book.buffer = append(book.buffer, u)
+		if len(book.buffer) < w.obBufferLimit {
+			return nil, false, nil
 		}
 	}
+	buffer := book.buffer
+	book.buffer = nil
 	if w.sortBuffer {
 		// sort by last updated to ensure each update is in order
 		if w.sortBufferByUpdateIDs {
-			sort.Slice(bufferLookup, func(i, j int) bool {
-				return bufferLookup[i].UpdateID < bufferLookup[j].UpdateID
+			sort.Slice(buffer, func(i, j int) bool {
+				return buffer[i].UpdateID < buffer[j].UpdateID
 			})
 		} else {
-			sort.Slice(bufferLookup, func(i, j int) bool {
-				return bufferLookup[i].UpdateTime.Before(bufferLookup[j].UpdateTime)
+			sort.Slice(buffer, func(i, j int) bool {
+				return buffer[i].UpdateTime.Before(buffer[j].UpdateTime)
 			})
 		}
 	}
-	for i := range bufferLookup {
-		w.processObUpdate(o, bufferLookup[i])
+	var ob *orderbook.Base
+	for i := range buffer {
+		var err error
+		ob, err = w.processObUpdate(book, buffer[i], verifiers)
+		if err == errStaleUpdate {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
 	}
-	w.buffer[u.Pair][u.Asset] = bufferLookup
-	return true
+	return ob, true, nil
 }
 
-func (w *WebsocketOrderbookLocal) processObUpdate(o *orderbook.Base, u *WebsocketOrderbookUpdate) {
+// processObUpdate applies an update to the local orderbook and verifies the
+// result. When there are verifiers the copy of the orderbook they verified is
+// returned. Updates a verifier reports as stale are not applied and
+// errStaleUpdate is returned.
+func (w *WebsocketOrderbookLocal) processObUpdate(book *localOrderbook, u *WebsocketOrderbookUpdate, verifiers []Verifier) (*orderbook.Base, error) {
+	for i := range verifiers {
+		if f, ok := verifiers[i].(StaleFilter); ok && f.Stale(book.base.LastUpdateID, u) {
+			return nil, errStaleUpdate
+		}
+	}
+
+	book.setMatchByID(w.updateEntriesByID)
 	if w.updateEntriesByID {
-		w.updateByIDAndAction(o, u)
+		updateByIDAndAction(book.bids, u.Action, u.Bids)
+		updateByIDAndAction(book.asks, u.Action, u.Asks)
 	} else {
-		w.updateAsksByPrice(o, u)
-		w.updateBidsByPrice(o, u)
+		updateByPrice(book.bids, u.Bids)
+		updateByPrice(book.asks, u.Asks)
 	}
-}
 
-func (w *WebsocketOrderbookLocal) updateAsksByPrice(o *orderbook.Base, u *WebsocketOrderbookUpdate) {
-updates:
-	for j := range u.Asks {
-		for k := range o.Asks {
-			if o.Asks[k].Price == u.Asks[j].Price {
-				if u.Asks[j].Amount <= 0 {
-					o.Asks = append(o.Asks[:k], o.Asks[k+1:]...)
-					continue updates
-				}
-				o.Asks[k].Amount = u.Asks[j].Amount
-				continue updates
+	var ob *orderbook.Base
+	if len(verifiers) > 0 {
+		ob = book.orderbook()
+		for i := range verifiers {
+			err := verifiers[i].Verify(ob, u)
+			if err != nil {
+				return nil, err
 			}
 		}
-		if u.Asks[j].Amount == 0 {
-			continue
+	}
+	if u.UpdateID != 0 {
+		book.base.LastUpdateID = u.UpdateID
+		if ob != nil {
+			ob.LastUpdateID = u.UpdateID
 		}
-		o.Asks = append(o.Asks, u.Asks[j])
 	}
-	sort.Slice(o.Asks, func(i, j int) bool {
-		return o.Asks[i].Price < o.Asks[j].Price
-	})
+	return ob, nil
 }
 
-func (w *WebsocketOrderbookLocal) updateBidsByPrice(o *orderbook.Base, u *WebsocketOrderbookUpdate) {
-updates:
-	for j := range u.Bids {
-		for k := range o.Bids {
-			if o.Bids[k].Price == u.Bids[j].Price {
-				if u.Bids[j].Amount <= 0 {
-					o.Bids = append(o.Bids[:k], o.Bids[k+1:]...)
-					continue updates
-				}
-				o.Bids[k].Amount = u.Bids[j].Amount
-				continue updates
-			}
+// updateByPrice amends the amount of the entry at each price target, appends
+// targets not found and deletes entries targeted with no amount
+func updateByPrice(side *levels, updates []orderbook.Item) {
+	for i := range updates {
+		if updates[i].Amount <= 0 {
+			side.remove(&updates[i])
+			continue
 		}
-		if u.Bids[j].Amount == 0 {
+		if existing := side.get(&updates[i]); existing != nil {
+			existing.Amount = updates[i].Amount
 			continue
 		}
-		o.Bids = append(o.Bids, u.Bids[j])
+		side.set(updates[i])
 	}
-	sort.Slice(o.Bids, func(i, j int) bool {
-		return o.Bids[i].Price > o.Bids[j].Price
-	})
 }
 
 // updateByIDAndAction will receive an action to execute against the orderbook
 // it will then match by IDs instead of price to perform the action
-func (w *WebsocketOrderbookLocal) updateByIDAndAction(o *orderbook.Base, u *WebsocketOrderbookUpdate) {
-	switch u.Action {
-	case "update":
-		for x := range u.Bids {
-			for y := range o.Bids {
-				if o.Bids[y].ID == u.Bids[x].ID {
-					o.Bids[y].Amount = u.Bids[x].Amount
-					break
-				}
-			}
-		}
-		for x := range u.Asks {
-			for y := range o.Asks {
-				if o.Asks[y].ID == u.Asks[x].ID {
-					o.Asks[y].Amount = u.Asks[x].Amount
-					break
-				}
-			}
-		}
-	case "delete":
-		for x := range u.Bids {
-			for y := 0; y < len(o.Bids); y++ {
-				if o.Bids[y].ID == u.Bids[x].ID {
-					o.Bids = append(o.Bids[:y], o.Bids[y+1:]...)
-					break
-				}
-			}
-		}
-		for x := range u.Asks {
-			for y := 0; y < len(o.Asks); y++ {
-				if o.Asks[y].ID == u.Asks[x].ID {
-					o.Asks = append(o.Asks[:y], o.Asks[y+1:]...)
-					break
-				}
+func updateByIDAndAction(side *levels, action string, updates []orderbook.Item) {
+	for i := range updates {
+		switch action {
+		case "update":
+			updateByID(side, &updates[i])
+		case "delete":
+			side.remove(&updates[i])
+		case "insert":
+			side.set(updates[i])
+		case "update/insert":
+			if !updateByID(side, &updates[i]) {
+				side.set(updates[i])
 			}
 		}
-	case "insert":
-		o.Bids = append(o.Bids, u.Bids...)
-		sort.Slice(o.Bids, func(i, j int) bool {
-			return o.Bids[i].Price > o.Bids[j].Price
-		})
-
-		o.Asks = append(o.Asks, u.Asks...)
-		sort.Slice(o.Asks, func(i, j int) bool {
-			return o.Asks[i].Price < o.Asks[j].Price
-		})
-
-	case "update/insert":
-	updateBids:
-		for x := range u.Bids {
-			for y := range o.Bids {
-				if o.Bids[y].ID == u.Bids[x].ID {
-					o.Bids[y].Amount = u.Bids[x].Amount
-					continue updateBids
-				}
-			}
-			o.Bids = append(o.Bids, u.Bids[x])
-		}
+	}
+}
 
-	updateAsks:
-		for x := range u.Asks {
-			for y := range o.Asks {
-				if o.Asks[y].ID == u.Asks[x].ID {
-					o.Asks[y].Amount = u.Asks[x].Amount
-					continue updateAsks
-				}
-			}
-			o.Asks = append(o.Asks, u.Asks[x])
-		}
+// updateByID amends the entry matching the ID of an update, moving it when
+// the update has a new price. Returns false when no entry matches.
+func updateByID(side *levels, update *orderbook.Item) bool {
+	existing := side.get(update)
+	if existing == nil {
+		return false
+	}
+	if update.Price == 0 || update.Price == existing.Price {
+		existing.Amount = update.Amount
+		return true
 	}
+	moved := *existing
+	moved.Price = update.Price
+	moved.Amount = update.Amount
+	side.set(moved)
+	return true
 }
 
 // LoadSnapshot loads initial snapshot of ob data, overwrite allows full
@@ -243,31 +234,109 @@ func (w *WebsocketOrderbookLocal) LoadSnapshot(newOrderbook *orderbook.Base) err
 	}
 
 	w.m.Lock()
-	defer w.m.Unlock()
 	if w.ob == nil {
-		w.ob = make(map[currency.Pair]map[asset.Item]*orderbook.Base)
+		w.ob = make(map[currency.Pair]map[asset.Item]*localOrderbook)
 	}
 	if w.ob[newOrderbook.Pair] == nil {
-		w.ob[newOrderbook.Pair] = make(map[asset.Item]*orderbook.Base)
+		w.ob[newOrderbook.Pair] = make(map[asset.Item]*localOrderbook)
 	}
+	book, ok := w.ob[newOrderbook.Pair][newOrderbook.AssetType]
+	if !ok {
+		book = &localOrderbook{}
+		w.ob[newOrderbook.Pair][newOrderbook.AssetType] = book
+	}
+	w.m.Unlock()
 
-	w.ob[newOrderbook.Pair][newOrderbook.AssetType] = newOrderbook
-	return newOrderbook.Process()
+	book.m.Lock()
+	defer book.m.Unlock()
+	return w.loadSnapshot(book, newOrderbook)
 }
 
-// GetOrderbook use sparingly. Modifying anything here will ruin hash
-// calculation and cause problems
-func (w *WebsocketOrderbookLocal) GetOrderbook(p currency.Pair, a asset.Item) *orderbook.Base {
+// loadSnapshot replaces the levels of an orderbook with a snapshot, restoring
+// an invalidated orderbook. Must be called with the orderbook lock held.
+func (w *WebsocketOrderbookLocal) loadSnapshot(book *localOrderbook, newOrderbook *orderbook.Base) error {
+	w.m.Lock()
+	if w.recorder != nil {
+		w.recorder.recordSnapshot(newOrderbook)
+	}
+	w.m.Unlock()
+
+	book.base = *newOrderbook
+	book.base.Bids = nil
+	book.base.Asks = nil
+	book.setMatchByID(w.updateEntriesByID)
+	book.bids.load(newOrderbook.Bids)
+	book.asks.load(newOrderbook.Asks)
+	book.buffer = nil
+	book.invalid = false
+	return w.processOrderbook(book, book.orderbook())
+}
+
+// processOrderbook updates the main orderbook store with a copy of the local
+// orderbook, keeping any fields set while processing
+func (w *WebsocketOrderbookLocal) processOrderbook(book *localOrderbook, ob *orderbook.Base) error {
+	var err error
+	if w.process != nil {
+		err = w.process(ob)
+	} else {
+		err = ob.Process()
+	}
+	book.base.ExchangeName = ob.ExchangeName
+	book.base.LastUpdated = ob.LastUpdated
+	return err
+}
+
+// lookup returns the local orderbook for a pair and asset, nil is returned
+// when no snapshot has been loaded
+func (w *WebsocketOrderbookLocal) lookup(p currency.Pair, a asset.Item) *localOrderbook {
 	w.m.Lock()
 	defer w.m.Unlock()
 	return w.ob[p][a]
 }
 
+// setMatchByID sets whether entries are matched by ID or price, rebuilding
+// the levels when changed. Must be called with the orderbook lock held.
+func (book *localOrderbook) setMatchByID(byID bool) {
+	if book.bids != nil && book.bids.byID == byID {
+		return
+	}
+	bids, asks := book.bids, book.asks
+	book.bids = newLevels(true, byID)
+	book.asks = newLevels(false, byID)
+	if bids != nil {
+		book.bids.load(bids.items())
+		book.asks.load(asks.items())
+	}
+}
+
+// orderbook returns a copy of the local orderbook. Must be called with the
+// orderbook lock held.
+func (book *localOrderbook) orderbook() *orderbook.Base {
+	ob := book.base
+	ob.Bids = book.bids.items()
+	ob.Asks = book.asks.items()
+	return &ob
+}
+
+// GetOrderbook returns a copy of the local orderbook, nil is returned when no
+// snapshot has been loaded or the orderbook is awaiting resynchronisation
+func (w *WebsocketOrderbookLocal) GetOrderbook(p currency.Pair, a asset.Item) *orderbook.Base {
+	book := w.lookup(p, a)
+	if book == nil {
+		return nil
+	}
+	book.m.Lock()
+	defer book.m.Unlock()
+	if book.invalid {
+		return nil
+	}
+	return book.orderbook()
+}
+
 // FlushCache flushes w.ob data to be garbage collected and refreshed when a
 // connection is lost and reconnected
 func (w *WebsocketOrderbookLocal) FlushCache() {
 	w.m.Lock()
 	w.ob = nil
-	w.buffer = nil
 	w.m.Unlock()
 }
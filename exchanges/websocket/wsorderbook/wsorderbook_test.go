@@ -2,7 +2,15 @@ package wsorderbook
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"math/rand"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -76,7 +84,7 @@ func BenchmarkUpdateBidsByPrice(b *testing.B) {
 			UpdateTime: time.Now(),
 			Asset:      asset.Spot,
 		}
-		ob.updateBidsByPrice(ob.ob[cp][asset.Spot], update)
+		updateByPrice(ob.ob[cp][asset.Spot].bids, update.Bids)
 	}
 }
 
@@ -95,7 +103,7 @@ func BenchmarkUpdateAsksByPrice(b *testing.B) {
 			UpdateTime: time.Now(),
 			Asset:      asset.Spot,
 		}
-		ob.updateAsksByPrice(ob.ob[cp][asset.Spot], update)
+		updateByPrice(ob.ob[cp][asset.Spot].asks, update.Asks)
 	}
 }
 
@@ -114,7 +122,7 @@ func BenchmarkBufferPerformance(b *testing.B) {
 		Price:  1337.1337,
 		ID:     1337,
 	}
-	obl.ob[cp][asset.Spot].Bids = append(obl.ob[cp][asset.Spot].Bids, dummyItem)
+	obl.ob[cp][asset.Spot].bids.set(dummyItem)
 	update := &WebsocketOrderbookUpdate{
 		Bids:       bids,
 		Asks:       asks,
@@ -149,7 +157,7 @@ func BenchmarkBufferSortingPerformance(b *testing.B) {
 		Price:  1337.1337,
 		ID:     1337,
 	}
-	obl.ob[cp][asset.Spot].Bids = append(obl.ob[cp][asset.Spot].Bids, dummyItem)
+	obl.ob[cp][asset.Spot].bids.set(dummyItem)
 	update := &WebsocketOrderbookUpdate{
 		Bids:       bids,
 		Asks:       asks,
@@ -185,7 +193,7 @@ func BenchmarkBufferSortingByIDPerformance(b *testing.B) {
 		Price:  1337.1337,
 		ID:     1337,
 	}
-	obl.ob[cp][asset.Spot].Bids = append(obl.ob[cp][asset.Spot].Bids, dummyItem)
+	obl.ob[cp][asset.Spot].bids.set(dummyItem)
 	update := &WebsocketOrderbookUpdate{
 		Bids:       bids,
 		Asks:       asks,
@@ -219,7 +227,7 @@ func BenchmarkNoBufferPerformance(b *testing.B) {
 		Price:  1337.1337,
 		ID:     1337,
 	}
-	obl.ob[cp][asset.Spot].Bids = append(obl.ob[cp][asset.Spot].Bids, dummyItem)
+	obl.ob[cp][asset.Spot].bids.set(dummyItem)
 	update := &WebsocketOrderbookUpdate{
 		Bids:       bids,
 		Asks:       asks,
@@ -239,35 +247,153 @@ func BenchmarkNoBufferPerformance(b *testing.B) {
 	}
 }
 
-func TestUpdates(t *testing.T) {
-	obl, _, _, err := createSnapshot()
-	if err != nil {
-		t.Error(err)
+// deepBook returns a snapshot with depth levels on each side
+func deepBook(p currency.Pair, depth int) *orderbook.Base {
+	b := &orderbook.Base{
+		ExchangeName: exchangeName,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Bids:         make([]orderbook.Item, depth),
+		Asks:         make([]orderbook.Item, depth),
+	}
+	for i := 0; i < depth; i++ {
+		b.Bids[i] = orderbook.Item{Price: float64(depth - i), Amount: 1}
+		b.Asks[i] = orderbook.Item{Price: float64(depth + 1 + i), Amount: 1}
+	}
+	return b
+}
+
+// deepBookUpdates returns updates amending, inserting and deleting random
+// levels throughout a book of depth levels
+func deepBookUpdates(depth, count int) []orderbook.Item {
+	updates := make([]orderbook.Item, count)
+	for i := range updates {
+		updates[i] = orderbook.Item{
+			Price:  float64(depth+1+rand.Intn(depth)) + float64(rand.Intn(2))/2,
+			Amount: float64(rand.Intn(3)),
+		}
 	}
+	return updates
+}
 
-	obl.updateAsksByPrice(obl.ob[cp][asset.Spot], &WebsocketOrderbookUpdate{
-		Bids:       itemArray[5],
-		Asks:       itemArray[5],
-		Pair:       cp,
-		UpdateTime: time.Now(),
-		Asset:      asset.Spot,
+// linearUpdateAsksByPrice is the previous slice based implementation, kept to
+// benchmark the levels against
+func linearUpdateAsksByPrice(o *orderbook.Base, updates []orderbook.Item) {
+updates:
+	for j := range updates {
+		for k := range o.Asks {
+			if o.Asks[k].Price == updates[j].Price {
+				if updates[j].Amount <= 0 {
+					o.Asks = append(o.Asks[:k], o.Asks[k+1:]...)
+					continue updates
+				}
+				o.Asks[k].Amount = updates[j].Amount
+				continue updates
+			}
+		}
+		if updates[j].Amount == 0 {
+			continue
+		}
+		o.Asks = append(o.Asks, updates[j])
+	}
+	sort.Slice(o.Asks, func(i, j int) bool {
+		return o.Asks[i].Price < o.Asks[j].Price
 	})
-	if err != nil {
-		t.Error(err)
+}
+
+// BenchmarkDeepBookLevels compares applying updates to deep books with the
+// levels against the previous slice implementation
+func BenchmarkDeepBookLevels(b *testing.B) {
+	for _, depth := range []int{100, 1000, 10000} {
+		book := deepBook(cp, depth)
+		updates := deepBookUpdates(depth, 100)
+		b.Run(fmt.Sprintf("levels/%d", depth), func(b *testing.B) {
+			asks := newLevels(false, false)
+			asks.load(book.Asks)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				updateByPrice(asks, updates)
+			}
+		})
+		b.Run(fmt.Sprintf("slice/%d", depth), func(b *testing.B) {
+			o := &orderbook.Base{Asks: append([]orderbook.Item(nil), book.Asks...)}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				linearUpdateAsksByPrice(o, updates)
+			}
+		})
 	}
+}
 
-	obl.updateAsksByPrice(obl.ob[cp][asset.Spot], &WebsocketOrderbookUpdate{
-		Bids:       itemArray[0],
-		Asks:       itemArray[0],
-		Pair:       cp,
-		UpdateTime: time.Now(),
-		Asset:      asset.Spot,
+// BenchmarkDeepBookUpdate benchmarks updating deep books including copying
+// the orderbook out to be processed
+func BenchmarkDeepBookUpdate(b *testing.B) {
+	for _, depth := range []int{100, 1000, 10000} {
+		b.Run(strconv.Itoa(depth), func(b *testing.B) {
+			obl := &WebsocketOrderbookLocal{exchangeName: exchangeName}
+			obl.process = func(*orderbook.Base) error { return nil }
+			err := obl.LoadSnapshot(deepBook(cp, depth))
+			if err != nil {
+				b.Fatal(err)
+			}
+			update := &WebsocketOrderbookUpdate{
+				Asks:  deepBookUpdates(depth, 100),
+				Pair:  cp,
+				Asset: asset.Spot,
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				err = obl.Update(update)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkConcurrentBookUpdates benchmarks updating different orderbooks in
+// parallel, which no longer contend on a single lock
+func BenchmarkConcurrentBookUpdates(b *testing.B) {
+	const books = 8
+	obl := &WebsocketOrderbookLocal{exchangeName: exchangeName}
+	obl.process = func(*orderbook.Base) error { return nil }
+	pairs := make([]currency.Pair, books)
+	for i := range pairs {
+		pairs[i] = currency.NewPairWithDelimiter("BTC"+strconv.Itoa(i), "USD", "-")
+		err := obl.LoadSnapshot(deepBook(pairs[i], 1000))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	var next int64
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		update := &WebsocketOrderbookUpdate{
+			Asks:  deepBookUpdates(1000, 100),
+			Pair:  pairs[atomic.AddInt64(&next, 1)%books],
+			Asset: asset.Spot,
+		}
+		for pb.Next() {
+			err := obl.Update(update)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
 	})
+}
+
+func TestUpdates(t *testing.T) {
+	obl, _, _, err := createSnapshot()
 	if err != nil {
 		t.Error(err)
 	}
 
-	if len(obl.ob[cp][asset.Spot].Asks) != 3 {
+	updateByPrice(obl.ob[cp][asset.Spot].asks, itemArray[5])
+
+	updateByPrice(obl.ob[cp][asset.Spot].asks, itemArray[0])
+
+	if len(obl.GetOrderbook(cp, asset.Spot).Asks) != 3 {
 		t.Error("Did not update")
 	}
 }
@@ -294,14 +420,14 @@ func TestHittingTheBuffer(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-	if len(obl.ob[cp][asset.Spot].Asks) != 3 {
-		t.Log(obl.ob[cp][asset.Spot])
+	if len(obl.GetOrderbook(cp, asset.Spot).Asks) != 3 {
+		t.Log(obl.GetOrderbook(cp, asset.Spot))
 		t.Errorf("expected 3 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Asks))
+			len(obl.GetOrderbook(cp, asset.Spot).Asks))
 	}
-	if len(obl.ob[cp][asset.Spot].Bids) != 3 {
+	if len(obl.GetOrderbook(cp, asset.Spot).Bids) != 3 {
 		t.Errorf("expected 3 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Bids))
+			len(obl.GetOrderbook(cp, asset.Spot).Bids))
 	}
 }
 
@@ -329,13 +455,14 @@ func TestInsertWithIDs(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-	if len(obl.ob[cp][asset.Spot].Asks) != 6 {
-		t.Errorf("expected 6 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Asks))
+	// The snapshot entry with ID 6 is replaced by the insert with the same ID
+	if len(obl.GetOrderbook(cp, asset.Spot).Asks) != 5 {
+		t.Errorf("expected 5 entries, received: %v",
+			len(obl.GetOrderbook(cp, asset.Spot).Asks))
 	}
-	if len(obl.ob[cp][asset.Spot].Bids) != 6 {
-		t.Errorf("expected 6 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Bids))
+	if len(obl.GetOrderbook(cp, asset.Spot).Bids) != 5 {
+		t.Errorf("expected 5 entries, received: %v",
+			len(obl.GetOrderbook(cp, asset.Spot).Bids))
 	}
 }
 
@@ -363,13 +490,13 @@ func TestSortIDs(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-	if len(obl.ob[cp][asset.Spot].Asks) != 3 {
+	if len(obl.GetOrderbook(cp, asset.Spot).Asks) != 3 {
 		t.Errorf("expected 3 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Asks))
+			len(obl.GetOrderbook(cp, asset.Spot).Asks))
 	}
-	if len(obl.ob[cp][asset.Spot].Bids) != 3 {
+	if len(obl.GetOrderbook(cp, asset.Spot).Bids) != 3 {
 		t.Errorf("expected 3 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Bids))
+			len(obl.GetOrderbook(cp, asset.Spot).Bids))
 	}
 }
 
@@ -387,11 +514,9 @@ func TestDeleteWithIDs(t *testing.T) {
 		Price:  1337.1337,
 		ID:     1337,
 	}
-	obl.ob[cp][asset.Spot].Bids = append(obl.ob[cp][asset.Spot].Bids, dummyItem)
-	obl.ob[cp][asset.Spot].Asks = append(obl.ob[cp][asset.Spot].Asks,
-		itemArray[2][0])
-	obl.ob[cp][asset.Spot].Asks = append(obl.ob[cp][asset.Spot].Asks,
-		itemArray[1][0])
+	obl.ob[cp][asset.Spot].bids.set(dummyItem)
+	obl.ob[cp][asset.Spot].asks.set(itemArray[2][0])
+	obl.ob[cp][asset.Spot].asks.set(itemArray[1][0])
 
 	obl.updateEntriesByID = true
 	for i := range itemArray {
@@ -410,13 +535,13 @@ func TestDeleteWithIDs(t *testing.T) {
 		}
 	}
 
-	if len(obl.ob[cp][asset.Spot].Asks) != 0 {
+	if len(obl.GetOrderbook(cp, asset.Spot).Asks) != 0 {
 		t.Errorf("expected 0 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Asks))
+			len(obl.GetOrderbook(cp, asset.Spot).Asks))
 	}
-	if len(obl.ob[cp][asset.Spot].Bids) != 1 {
+	if len(obl.GetOrderbook(cp, asset.Spot).Bids) != 1 {
 		t.Errorf("expected 1 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Bids))
+			len(obl.GetOrderbook(cp, asset.Spot).Bids))
 	}
 }
 
@@ -442,14 +567,14 @@ func TestUpdateWithIDs(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-	if len(obl.ob[cp][asset.Spot].Asks) != 1 {
-		t.Log(obl.ob[cp][asset.Spot])
+	if len(obl.GetOrderbook(cp, asset.Spot).Asks) != 1 {
+		t.Log(obl.GetOrderbook(cp, asset.Spot))
 		t.Errorf("expected 1 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Asks))
+			len(obl.GetOrderbook(cp, asset.Spot).Asks))
 	}
-	if len(obl.ob[cp][asset.Spot].Bids) != 1 {
+	if len(obl.GetOrderbook(cp, asset.Spot).Bids) != 1 {
 		t.Errorf("expected 1 entries, received: %v",
-			len(obl.ob[cp][asset.Spot].Bids))
+			len(obl.GetOrderbook(cp, asset.Spot).Bids))
 	}
 }
 
@@ -480,9 +605,9 @@ func TestOutOfOrderIDs(t *testing.T) {
 		}
 	}
 	// Index 1 since index 0 is price 7000
-	if obl.ob[cp][asset.Spot].Asks[1].Price != 2000 {
+	if obl.GetOrderbook(cp, asset.Spot).Asks[1].Price != 2000 {
 		t.Errorf("expected sorted price to be 3000, received: %v",
-			obl.ob[cp][asset.Spot].Asks[1].Price)
+			obl.GetOrderbook(cp, asset.Spot).Asks[1].Price)
 	}
 }
 
@@ -714,20 +839,22 @@ func TestInsertingSnapShots(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if obl.ob[snapShot1.Pair][snapShot1.AssetType].Asks[0] != snapShot1.Asks[0] {
+	if obl.GetOrderbook(snapShot1.Pair, snapShot1.AssetType).Asks[0] != snapShot1.Asks[0] {
 		t.Errorf("loaded data mismatch. Expected %v, received %v",
 			snapShot1.Asks[0],
-			obl.ob[snapShot1.Pair][snapShot1.AssetType].Asks[0])
+			obl.GetOrderbook(snapShot1.Pair, snapShot1.AssetType).Asks[0])
 	}
-	if obl.ob[snapShot2.Pair][snapShot2.AssetType].Asks[0] != snapShot2.Asks[0] {
+	// Snapshots are kept in price order
+	if obl.GetOrderbook(snapShot2.Pair, snapShot2.AssetType).Asks[0] != snapShot2.Asks[9] {
 		t.Errorf("loaded data mismatch. Expected %v, received %v",
-			snapShot2.Asks[0],
-			obl.ob[snapShot2.Pair][snapShot2.AssetType].Asks[0])
+			snapShot2.Asks[9],
+			obl.GetOrderbook(snapShot2.Pair, snapShot2.AssetType).Asks[0])
 	}
-	if obl.ob[snapShot3.Pair][snapShot3.AssetType].Asks[0] != snapShot3.Asks[0] {
+	// Snapshots are kept in price order
+	if obl.GetOrderbook(snapShot3.Pair, snapShot3.AssetType).Asks[0] != snapShot3.Asks[9] {
 		t.Errorf("loaded data mismatch. Expected %v, received %v",
-			snapShot3.Asks[0],
-			obl.ob[snapShot3.Pair][snapShot3.AssetType].Asks[0])
+			snapShot3.Asks[9],
+			obl.GetOrderbook(snapShot3.Pair, snapShot3.AssetType).Asks[0])
 	}
 }
 
@@ -737,8 +864,16 @@ func TestGetOrderbook(t *testing.T) {
 		t.Fatal(err)
 	}
 	ob := obl.GetOrderbook(cp, asset.Spot)
-	if obl.ob[cp][asset.Spot] != ob {
-		t.Error("Failed to get orderbook")
+	if ob == nil || len(ob.Asks) != 1 || ob.Asks[0].Price != 4000 {
+		t.Fatal("Failed to get orderbook")
+	}
+	// Modifying the copy must not alter the local orderbook
+	ob.Asks[0].Amount = 1337
+	if obl.GetOrderbook(cp, asset.Spot).Asks[0].Amount != 1 {
+		t.Error("expected a copy of the orderbook")
+	}
+	if obl.GetOrderbook(cp, asset.Futures) != nil {
+		t.Error("expected nil orderbook without a snapshot")
 	}
 }
 
@@ -762,18 +897,210 @@ func TestEnsureMultipleUpdatesViaPrice(t *testing.T) {
 	}
 
 	asks := bidAskGenerator()
-	obl.updateAsksByPrice(obl.ob[cp][asset.Spot], &WebsocketOrderbookUpdate{
-		Bids:       asks,
-		Asks:       asks,
-		Pair:       cp,
-		UpdateTime: time.Now(),
-		Asset:      asset.Spot,
+	updateByPrice(obl.ob[cp][asset.Spot].asks, asks)
+
+	if len(obl.GetOrderbook(cp, asset.Spot).Asks) <= 3 {
+		t.Errorf("Insufficient updates")
+	}
+}
+
+func TestConcurrentUpdates(t *testing.T) {
+	obl := &WebsocketOrderbookLocal{exchangeName: exchangeName}
+	pairs := []currency.Pair{
+		currency.NewPairWithDelimiter("BTC", "USD", "-"),
+		currency.NewPairWithDelimiter("LTC", "USD", "-"),
+		currency.NewPairWithDelimiter("ETH", "USD", "-"),
+	}
+	var wg sync.WaitGroup
+	for i := range pairs {
+		err := obl.LoadSnapshot(deepBook(pairs[i], 100))
+		if err != nil {
+			t.Fatal(err)
+		}
+		wg.Add(2)
+		go func(p currency.Pair) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				err := obl.Update(&WebsocketOrderbookUpdate{
+					Asks:  []orderbook.Item{{Price: 101, Amount: float64(j + 1)}},
+					Pair:  p,
+					Asset: asset.Spot,
+				})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(pairs[i])
+		go func(p currency.Pair) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if ob := obl.GetOrderbook(p, asset.Spot); ob == nil || len(ob.Asks) != 100 {
+					t.Error("expected orderbook with 100 asks")
+					return
+				}
+			}
+		}(pairs[i])
+	}
+	wg.Wait()
+	for i := range pairs {
+		ob := obl.GetOrderbook(pairs[i], asset.Spot)
+		if ob.Asks[0].Price != 101 || ob.Asks[0].Amount != 100 {
+			t.Errorf("unexpected best ask %+v", ob.Asks[0])
+		}
+	}
+}
+
+func recordSession(t *testing.T, dir string, p currency.Pair, updates int) *orderbook.Base {
+	obl := &WebsocketOrderbookLocal{}
+	obl.Setup(3, true, true, false, false, exchangeName)
+	err := obl.StartRecording(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = obl.LoadSnapshot(&orderbook.Base{
+		ExchangeName: exchangeName,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Bids:         []orderbook.Item{{Price: 999, Amount: 1}},
+		Asks:         []orderbook.Item{{Price: 1001, Amount: 1}},
 	})
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	for i := 0; i < updates; i++ {
+		err = obl.Update(&WebsocketOrderbookUpdate{
+			Bids:       []orderbook.Item{{Price: float64(990 - i), Amount: float64(i)}},
+			Asks:       []orderbook.Item{{Price: float64(1010 + i), Amount: float64(i)}},
+			Pair:       p,
+			UpdateTime: time.Now(),
+			Asset:      asset.Spot,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
 	}
 
-	if len(obl.ob[cp][asset.Spot].Asks) <= 3 {
-		t.Errorf("Insufficient updates")
+	err = obl.StopRecording()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obl.IsRecording() {
+		t.Error("expected recording to be stopped")
+	}
+	return obl.GetOrderbook(p, asset.Spot)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-orderbook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	p := currency.NewPairFromStrings("DOGE", "USDT")
+	first := recordSession(t, dir, p, 10)
+	// Recording again appends a new session to the same file
+	second := recordSession(t, dir, p, 4)
+
+	path := RecordingPath(dir, exchangeName, p, asset.Spot)
+	r, err := NewReplay(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	var books []*orderbook.Base
+	var times []time.Time
+	for {
+		b, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err == errNoReplayOrderbook {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		books = append(books, b)
+		times = append(times, r.Time())
+	}
+
+	// Two snapshots and 14 updates
+	if len(books) != 16 {
+		t.Fatalf("expected 16 replayed books, received %d", len(books))
+	}
+	if !books[0].Pair.Equal(p) {
+		t.Errorf("expected pair %s, received %s", p, books[0].Pair)
+	}
+	if !reflect.DeepEqual(books[10].Bids, first.Bids) ||
+		!reflect.DeepEqual(books[10].Asks, first.Asks) {
+		t.Errorf("first session replay mismatch %+v %+v", books[10], first)
+	}
+	if !reflect.DeepEqual(books[15].Bids, second.Bids) ||
+		!reflect.DeepEqual(books[15].Asks, second.Asks) {
+		t.Errorf("second session replay mismatch %+v %+v", books[15], second)
+	}
+	// The final update is held in the buffer so the book was last processed
+	// by the preceding update
+	if !books[15].LastUpdated.Equal(times[14]) {
+		t.Error("expected replayed orderbook to be stamped with the record time")
+	}
+
+	r2, err := NewReplay(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r2.Close()
+	b, err := r2.BookAt(times[5])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(b.Bids, books[5].Bids) {
+		t.Errorf("expected book at %s to match %+v, received %+v", times[5], books[5], b)
+	}
+}
+
+func TestRecordUpdateWithoutSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gct-orderbook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	obl, _, _, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = obl.StartRecording(""); err == nil {
+		t.Error("expected error for empty recording directory")
+	}
+	if err = obl.StartRecording(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err = obl.StartRecording(dir); err == nil {
+		t.Error("expected error when already recording")
+	}
+
+	err = obl.Update(&WebsocketOrderbookUpdate{
+		Bids:  itemArray[0],
+		Pair:  cp,
+		Asset: asset.Spot,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = obl.StopRecording(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Updates are not recorded until a snapshot has been recorded
+	if _, err = os.Stat(RecordingPath(dir, exchangeName, cp, asset.Spot)); !os.IsNotExist(err) {
+		t.Errorf("expected no recording, received %v", err)
+	}
+	if err = obl.StopRecording(); err == nil {
+		t.Error("expected error when not recording")
 	}
 }
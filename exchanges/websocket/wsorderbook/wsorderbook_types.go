@@ -10,26 +10,70 @@ import (
 )
 
 // WebsocketOrderbookLocal defines a local cache of orderbooks for amending,
-// appending and deleting changes and updates the main store in wsorderbook.go
+// appending and deleting changes and updates the main store in wsorderbook.go.
+// The mutex guards the cache and its settings while each orderbook has its own
+// lock so orderbooks are updated concurrently. An orderbook lock may be held
+// while acquiring the cache lock but never the reverse.
 type WebsocketOrderbookLocal struct {
-	ob                    map[currency.Pair]map[asset.Item]*orderbook.Base
-	buffer                map[currency.Pair]map[asset.Item][]*WebsocketOrderbookUpdate
+	ob                    map[currency.Pair]map[asset.Item]*localOrderbook
 	obBufferLimit         int
 	bufferEnabled         bool
 	sortBuffer            bool
 	sortBufferByUpdateIDs bool // When timestamps aren't provided, an id can help sort
 	updateEntriesByID     bool // Use the update IDs to match ob entries
 	exchangeName          string
+	recorder              *recorder
+	process               func(*orderbook.Base) error // Overrides orderbook processing when replaying
+	verifiers             []Verifier
+	resync                ResyncFunc
 	m                     sync.Mutex
 }
 
+// localOrderbook holds the price levels of a single orderbook. Base holds
+// everything but the bids and asks, which are copied out of the levels when
+// the orderbook is read or processed.
+type localOrderbook struct {
+	base    orderbook.Base
+	bids    *levels
+	asks    *levels
+	buffer  []*WebsocketOrderbookUpdate
+	invalid bool
+	m       sync.Mutex
+}
+
 // WebsocketOrderbookUpdate stores orderbook updates and dictates what features to use when processing
 type WebsocketOrderbookUpdate struct {
-	UpdateID   int64 // Used when no time is provided
-	UpdateTime time.Time
-	Asset      asset.Item
-	Action     string // Used in conjunction with UpdateEntriesByID
-	Bids       []orderbook.Item
-	Asks       []orderbook.Item
-	Pair       currency.Pair
+	UpdateID      int64 // Used when no time is provided
+	FirstUpdateID int64 // Set when an update spans a range of IDs
+	Checksum      uint32
+	UpdateTime    time.Time
+	Asset         asset.Item
+	Action        string // Used in conjunction with UpdateEntriesByID
+	Bids          []orderbook.Item
+	Asks          []orderbook.Item
+	Pair          currency.Pair
+}
+
+// Record is a single entry in an orderbook recording
+type Record struct {
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+	// Base and Quote are recorded separately as a pair without a delimiter
+	// cannot always be split correctly
+	Base     string                    `json:"base,omitempty"`
+	Quote    string                    `json:"quote,omitempty"`
+	Settings *RecordSettings           `json:"settings,omitempty"`
+	Snapshot *orderbook.Base           `json:"snapshot,omitempty"`
+	Update   *WebsocketOrderbookUpdate `json:"update,omitempty"`
+}
+
+// RecordSettings holds the processing settings of the recorded local
+// orderbook
+type RecordSettings struct {
+	Exchange              string `json:"exchange"`
+	BufferLimit           int    `json:"bufferLimit"`
+	BufferEnabled         bool   `json:"bufferEnabled"`
+	SortBuffer            bool   `json:"sortBuffer"`
+	SortBufferByUpdateIDs bool   `json:"sortBufferByUpdateIDs"`
+	UpdateEntriesByID     bool   `json:"updateEntriesByID"`
 }
@@ -3223,6 +3223,242 @@ func (m *SubmitOrderResponse) GetOrderId() string {
 	return ""
 }
 
+type BatchOrder struct {
+	Pair                 *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side                 string        `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string        `protobuf:"bytes,3,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount               float64       `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                float64       `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	ClientId             string        `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	AssetType            string        `protobuf:"bytes,7,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BatchOrder) Reset()         { *m = BatchOrder{} }
+func (m *BatchOrder) String() string { return proto.CompactTextString(m) }
+func (*BatchOrder) ProtoMessage()    {}
+func (*BatchOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{66}
+}
+
+func (m *BatchOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOrder.Unmarshal(m, b)
+}
+func (m *BatchOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOrder.Marshal(b, m, deterministic)
+}
+func (m *BatchOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOrder.Merge(m, src)
+}
+func (m *BatchOrder) XXX_Size() int {
+	return xxx_messageInfo_BatchOrder.Size(m)
+}
+func (m *BatchOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOrder proto.InternalMessageInfo
+
+func (m *BatchOrder) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *BatchOrder) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *BatchOrder) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *BatchOrder) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *BatchOrder) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *BatchOrder) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *BatchOrder) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type SubmitOrdersRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Orders               []*BatchOrder `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SubmitOrdersRequest) Reset()         { *m = SubmitOrdersRequest{} }
+func (m *SubmitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitOrdersRequest) ProtoMessage()    {}
+func (*SubmitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{67}
+}
+
+func (m *SubmitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOrdersRequest.Unmarshal(m, b)
+}
+func (m *SubmitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *SubmitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOrdersRequest.Merge(m, src)
+}
+func (m *SubmitOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitOrdersRequest.Size(m)
+}
+func (m *SubmitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOrdersRequest proto.InternalMessageInfo
+
+func (m *SubmitOrdersRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *SubmitOrdersRequest) GetOrders() []*BatchOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type BatchOrderResult struct {
+	OrderPlaced          bool     `protobuf:"varint,1,opt,name=order_placed,json=orderPlaced,proto3" json:"order_placed,omitempty"`
+	OrderId              string   `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	InternalOrderId      string   `protobuf:"bytes,3,opt,name=internal_order_id,json=internalOrderId,proto3" json:"internal_order_id,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchOrderResult) Reset()         { *m = BatchOrderResult{} }
+func (m *BatchOrderResult) String() string { return proto.CompactTextString(m) }
+func (*BatchOrderResult) ProtoMessage()    {}
+func (*BatchOrderResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+}
+
+func (m *BatchOrderResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOrderResult.Unmarshal(m, b)
+}
+func (m *BatchOrderResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOrderResult.Marshal(b, m, deterministic)
+}
+func (m *BatchOrderResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOrderResult.Merge(m, src)
+}
+func (m *BatchOrderResult) XXX_Size() int {
+	return xxx_messageInfo_BatchOrderResult.Size(m)
+}
+func (m *BatchOrderResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOrderResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOrderResult proto.InternalMessageInfo
+
+func (m *BatchOrderResult) GetOrderPlaced() bool {
+	if m != nil {
+		return m.OrderPlaced
+	}
+	return false
+}
+
+func (m *BatchOrderResult) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *BatchOrderResult) GetInternalOrderId() string {
+	if m != nil {
+		return m.InternalOrderId
+	}
+	return ""
+}
+
+func (m *BatchOrderResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SubmitOrdersResponse struct {
+	Orders               []*BatchOrderResult `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SubmitOrdersResponse) Reset()         { *m = SubmitOrdersResponse{} }
+func (m *SubmitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitOrdersResponse) ProtoMessage()    {}
+func (*SubmitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{69}
+}
+
+func (m *SubmitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOrdersResponse.Unmarshal(m, b)
+}
+func (m *SubmitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *SubmitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOrdersResponse.Merge(m, src)
+}
+func (m *SubmitOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_SubmitOrdersResponse.Size(m)
+}
+func (m *SubmitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOrdersResponse proto.InternalMessageInfo
+
+func (m *SubmitOrdersResponse) GetOrders() []*BatchOrderResult {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
 type SimulateOrderRequest struct {
 	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
@@ -3237,7 +3473,7 @@ func (m *SimulateOrderRequest) Reset()         { *m = SimulateOrderRequest{} }
 func (m *SimulateOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*SimulateOrderRequest) ProtoMessage()    {}
 func (*SimulateOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{66}
+	return fileDescriptor_77a6da22d6a3feb1, []int{70}
 }
 
 func (m *SimulateOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -3302,7 +3538,7 @@ func (m *SimulateOrderResponse) Reset()         { *m = SimulateOrderResponse{} }
 func (m *SimulateOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*SimulateOrderResponse) ProtoMessage()    {}
 func (*SimulateOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{67}
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
 }
 
 func (m *SimulateOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -3379,7 +3615,7 @@ func (m *WhaleBombRequest) Reset()         { *m = WhaleBombRequest{} }
 func (m *WhaleBombRequest) String() string { return proto.CompactTextString(m) }
 func (*WhaleBombRequest) ProtoMessage()    {}
 func (*WhaleBombRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
 }
 
 func (m *WhaleBombRequest) XXX_Unmarshal(b []byte) error {
@@ -3445,7 +3681,7 @@ func (m *CancelOrderRequest) Reset()         { *m = CancelOrderRequest{} }
 func (m *CancelOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelOrderRequest) ProtoMessage()    {}
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{69}
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
 }
 
 func (m *CancelOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -3525,7 +3761,7 @@ func (m *CancelOrderResponse) Reset()         { *m = CancelOrderResponse{} }
 func (m *CancelOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*CancelOrderResponse) ProtoMessage()    {}
 func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{70}
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
 }
 
 func (m *CancelOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -3546,4828 +3782,10495 @@ func (m *CancelOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelOrderResponse proto.InternalMessageInfo
 
-type CancelAllOrdersRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BatchCancelOrder struct {
+	OrderId              string        `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *CancelAllOrdersRequest) Reset()         { *m = CancelAllOrdersRequest{} }
-func (m *CancelAllOrdersRequest) String() string { return proto.CompactTextString(m) }
-func (*CancelAllOrdersRequest) ProtoMessage()    {}
-func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+func (m *BatchCancelOrder) Reset()         { *m = BatchCancelOrder{} }
+func (m *BatchCancelOrder) String() string { return proto.CompactTextString(m) }
+func (*BatchCancelOrder) ProtoMessage()    {}
+func (*BatchCancelOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
 }
 
-func (m *CancelAllOrdersRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CancelAllOrdersRequest.Unmarshal(m, b)
+func (m *BatchCancelOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCancelOrder.Unmarshal(m, b)
 }
-func (m *CancelAllOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CancelAllOrdersRequest.Marshal(b, m, deterministic)
+func (m *BatchCancelOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCancelOrder.Marshal(b, m, deterministic)
 }
-func (m *CancelAllOrdersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelAllOrdersRequest.Merge(m, src)
+func (m *BatchCancelOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCancelOrder.Merge(m, src)
 }
-func (m *CancelAllOrdersRequest) XXX_Size() int {
-	return xxx_messageInfo_CancelAllOrdersRequest.Size(m)
+func (m *BatchCancelOrder) XXX_Size() int {
+	return xxx_messageInfo_BatchCancelOrder.Size(m)
 }
-func (m *CancelAllOrdersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelAllOrdersRequest.DiscardUnknown(m)
+func (m *BatchCancelOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCancelOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelAllOrdersRequest proto.InternalMessageInfo
+var xxx_messageInfo_BatchCancelOrder proto.InternalMessageInfo
 
-func (m *CancelAllOrdersRequest) GetExchange() string {
+func (m *BatchCancelOrder) GetOrderId() string {
 	if m != nil {
-		return m.Exchange
+		return m.OrderId
 	}
 	return ""
 }
 
-type CancelAllOrdersResponse struct {
-	Orders               []*CancelAllOrdersResponse_Orders `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+func (m *BatchCancelOrder) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
 
-func (m *CancelAllOrdersResponse) Reset()         { *m = CancelAllOrdersResponse{} }
-func (m *CancelAllOrdersResponse) String() string { return proto.CompactTextString(m) }
-func (*CancelAllOrdersResponse) ProtoMessage()    {}
-func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+func (m *BatchCancelOrder) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-func (m *CancelAllOrdersResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CancelAllOrdersResponse.Unmarshal(m, b)
+func (m *BatchCancelOrder) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
 }
-func (m *CancelAllOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CancelAllOrdersResponse.Marshal(b, m, deterministic)
+
+type CancelOrdersRequest struct {
+	Exchange             string              `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Orders               []*BatchCancelOrder `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
-func (m *CancelAllOrdersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelAllOrdersResponse.Merge(m, src)
+
+func (m *CancelOrdersRequest) Reset()         { *m = CancelOrdersRequest{} }
+func (m *CancelOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelOrdersRequest) ProtoMessage()    {}
+func (*CancelOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
 }
-func (m *CancelAllOrdersResponse) XXX_Size() int {
-	return xxx_messageInfo_CancelAllOrdersResponse.Size(m)
+
+func (m *CancelOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOrdersRequest.Unmarshal(m, b)
 }
-func (m *CancelAllOrdersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelAllOrdersResponse.DiscardUnknown(m)
+func (m *CancelOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOrdersRequest.Merge(m, src)
+}
+func (m *CancelOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelOrdersRequest.Size(m)
+}
+func (m *CancelOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelAllOrdersResponse proto.InternalMessageInfo
+var xxx_messageInfo_CancelOrdersRequest proto.InternalMessageInfo
 
-func (m *CancelAllOrdersResponse) GetOrders() []*CancelAllOrdersResponse_Orders {
+func (m *CancelOrdersRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *CancelOrdersRequest) GetOrders() []*BatchCancelOrder {
 	if m != nil {
 		return m.Orders
 	}
 	return nil
 }
 
-type CancelAllOrdersResponse_Orders struct {
-	Exchange             string            `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	OrderStatus          map[string]string `protobuf:"bytes,2,rep,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type CancelOrdersResponse struct {
+	OrderStatus          map[string]string `protobuf:"bytes,1,rep,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *CancelAllOrdersResponse_Orders) Reset()         { *m = CancelAllOrdersResponse_Orders{} }
-func (m *CancelAllOrdersResponse_Orders) String() string { return proto.CompactTextString(m) }
-func (*CancelAllOrdersResponse_Orders) ProtoMessage()    {}
-func (*CancelAllOrdersResponse_Orders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72, 0}
+func (m *CancelOrdersResponse) Reset()         { *m = CancelOrdersResponse{} }
+func (m *CancelOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelOrdersResponse) ProtoMessage()    {}
+func (*CancelOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{77}
 }
 
-func (m *CancelAllOrdersResponse_Orders) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CancelAllOrdersResponse_Orders.Unmarshal(m, b)
+func (m *CancelOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOrdersResponse.Unmarshal(m, b)
 }
-func (m *CancelAllOrdersResponse_Orders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CancelAllOrdersResponse_Orders.Marshal(b, m, deterministic)
+func (m *CancelOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOrdersResponse.Marshal(b, m, deterministic)
 }
-func (m *CancelAllOrdersResponse_Orders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelAllOrdersResponse_Orders.Merge(m, src)
+func (m *CancelOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOrdersResponse.Merge(m, src)
 }
-func (m *CancelAllOrdersResponse_Orders) XXX_Size() int {
-	return xxx_messageInfo_CancelAllOrdersResponse_Orders.Size(m)
+func (m *CancelOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelOrdersResponse.Size(m)
 }
-func (m *CancelAllOrdersResponse_Orders) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelAllOrdersResponse_Orders.DiscardUnknown(m)
+func (m *CancelOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelAllOrdersResponse_Orders proto.InternalMessageInfo
-
-func (m *CancelAllOrdersResponse_Orders) GetExchange() string {
-	if m != nil {
-		return m.Exchange
-	}
-	return ""
-}
+var xxx_messageInfo_CancelOrdersResponse proto.InternalMessageInfo
 
-func (m *CancelAllOrdersResponse_Orders) GetOrderStatus() map[string]string {
+func (m *CancelOrdersResponse) GetOrderStatus() map[string]string {
 	if m != nil {
 		return m.OrderStatus
 	}
 	return nil
 }
 
-type GetEventsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ModifyOrderRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId              string        `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string        `protobuf:"bytes,6,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Price                float64       `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetEventsRequest) Reset()         { *m = GetEventsRequest{} }
-func (m *GetEventsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetEventsRequest) ProtoMessage()    {}
-func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{73}
+func (m *ModifyOrderRequest) Reset()         { *m = ModifyOrderRequest{} }
+func (m *ModifyOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyOrderRequest) ProtoMessage()    {}
+func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{78}
 }
 
-func (m *GetEventsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetEventsRequest.Unmarshal(m, b)
+func (m *ModifyOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyOrderRequest.Unmarshal(m, b)
 }
-func (m *GetEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetEventsRequest.Marshal(b, m, deterministic)
+func (m *ModifyOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyOrderRequest.Marshal(b, m, deterministic)
 }
-func (m *GetEventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEventsRequest.Merge(m, src)
+func (m *ModifyOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyOrderRequest.Merge(m, src)
 }
-func (m *GetEventsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetEventsRequest.Size(m)
+func (m *ModifyOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyOrderRequest.Size(m)
 }
-func (m *GetEventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEventsRequest.DiscardUnknown(m)
+func (m *ModifyOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetEventsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ModifyOrderRequest proto.InternalMessageInfo
 
-type ConditionParams struct {
-	Condition            string   `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
-	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	CheckBids            bool     `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
-	CheckBidsAndAsks     bool     `protobuf:"varint,4,opt,name=check_bids_and_asks,json=checkBidsAndAsks,proto3" json:"check_bids_and_asks,omitempty"`
-	OrderbookAmount      float64  `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ModifyOrderRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
 
-func (m *ConditionParams) Reset()         { *m = ConditionParams{} }
-func (m *ConditionParams) String() string { return proto.CompactTextString(m) }
-func (*ConditionParams) ProtoMessage()    {}
-func (*ConditionParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{74}
+func (m *ModifyOrderRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
 }
 
-func (m *ConditionParams) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConditionParams.Unmarshal(m, b)
-}
-func (m *ConditionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConditionParams.Marshal(b, m, deterministic)
-}
-func (m *ConditionParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConditionParams.Merge(m, src)
-}
-func (m *ConditionParams) XXX_Size() int {
-	return xxx_messageInfo_ConditionParams.Size(m)
-}
-func (m *ConditionParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConditionParams.DiscardUnknown(m)
+func (m *ModifyOrderRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
 
-var xxx_messageInfo_ConditionParams proto.InternalMessageInfo
-
-func (m *ConditionParams) GetCondition() string {
+func (m *ModifyOrderRequest) GetAssetType() string {
 	if m != nil {
-		return m.Condition
+		return m.AssetType
 	}
 	return ""
 }
 
-func (m *ConditionParams) GetPrice() float64 {
+func (m *ModifyOrderRequest) GetSide() string {
 	if m != nil {
-		return m.Price
+		return m.Side
 	}
-	return 0
+	return ""
 }
 
-func (m *ConditionParams) GetCheckBids() bool {
+func (m *ModifyOrderRequest) GetOrderType() string {
 	if m != nil {
-		return m.CheckBids
+		return m.OrderType
 	}
-	return false
+	return ""
 }
 
-func (m *ConditionParams) GetCheckBidsAndAsks() bool {
+func (m *ModifyOrderRequest) GetPrice() float64 {
 	if m != nil {
-		return m.CheckBidsAndAsks
+		return m.Price
 	}
-	return false
+	return 0
 }
 
-func (m *ConditionParams) GetOrderbookAmount() float64 {
+func (m *ModifyOrderRequest) GetAmount() float64 {
 	if m != nil {
-		return m.OrderbookAmount
+		return m.Amount
 	}
 	return 0
 }
 
-type GetEventsResponse struct {
-	Id                   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Exchange             string           `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item                 string           `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams      *ConditionParams `protobuf:"bytes,4,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair                 *CurrencyPair    `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
-	Action               string           `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
-	Executed             bool             `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ModifyOrderResponse struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetEventsResponse) Reset()         { *m = GetEventsResponse{} }
-func (m *GetEventsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetEventsResponse) ProtoMessage()    {}
-func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{75}
+func (m *ModifyOrderResponse) Reset()         { *m = ModifyOrderResponse{} }
+func (m *ModifyOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyOrderResponse) ProtoMessage()    {}
+func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{79}
 }
 
-func (m *GetEventsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetEventsResponse.Unmarshal(m, b)
+func (m *ModifyOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyOrderResponse.Unmarshal(m, b)
 }
-func (m *GetEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetEventsResponse.Marshal(b, m, deterministic)
+func (m *ModifyOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyOrderResponse.Marshal(b, m, deterministic)
 }
-func (m *GetEventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEventsResponse.Merge(m, src)
+func (m *ModifyOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyOrderResponse.Merge(m, src)
 }
-func (m *GetEventsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetEventsResponse.Size(m)
+func (m *ModifyOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyOrderResponse.Size(m)
 }
-func (m *GetEventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEventsResponse.DiscardUnknown(m)
+func (m *ModifyOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetEventsResponse proto.InternalMessageInfo
-
-func (m *GetEventsResponse) GetId() int64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
+var xxx_messageInfo_ModifyOrderResponse proto.InternalMessageInfo
 
-func (m *GetEventsResponse) GetExchange() string {
+func (m *ModifyOrderResponse) GetOrderId() string {
 	if m != nil {
-		return m.Exchange
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *GetEventsResponse) GetItem() string {
-	if m != nil {
-		return m.Item
-	}
-	return ""
+type CancelAllOrdersRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetEventsResponse) GetConditionParams() *ConditionParams {
-	if m != nil {
-		return m.ConditionParams
-	}
-	return nil
+func (m *CancelAllOrdersRequest) Reset()         { *m = CancelAllOrdersRequest{} }
+func (m *CancelAllOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelAllOrdersRequest) ProtoMessage()    {}
+func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{80}
 }
 
-func (m *GetEventsResponse) GetPair() *CurrencyPair {
-	if m != nil {
-		return m.Pair
-	}
-	return nil
+func (m *CancelAllOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelAllOrdersRequest.Unmarshal(m, b)
 }
-
-func (m *GetEventsResponse) GetAction() string {
-	if m != nil {
-		return m.Action
-	}
-	return ""
+func (m *CancelAllOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelAllOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelAllOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAllOrdersRequest.Merge(m, src)
+}
+func (m *CancelAllOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelAllOrdersRequest.Size(m)
+}
+func (m *CancelAllOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAllOrdersRequest.DiscardUnknown(m)
 }
 
-func (m *GetEventsResponse) GetExecuted() bool {
+var xxx_messageInfo_CancelAllOrdersRequest proto.InternalMessageInfo
+
+func (m *CancelAllOrdersRequest) GetExchange() string {
 	if m != nil {
-		return m.Executed
+		return m.Exchange
 	}
-	return false
+	return ""
 }
 
-type AddEventRequest struct {
-	Exchange             string           `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item                 string           `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams      *ConditionParams `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair                 *CurrencyPair    `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType            string           `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Action               string           `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type CancelAllOrdersResponse struct {
+	Orders               []*CancelAllOrdersResponse_Orders `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (m *AddEventRequest) Reset()         { *m = AddEventRequest{} }
-func (m *AddEventRequest) String() string { return proto.CompactTextString(m) }
-func (*AddEventRequest) ProtoMessage()    {}
-func (*AddEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{76}
+func (m *CancelAllOrdersResponse) Reset()         { *m = CancelAllOrdersResponse{} }
+func (m *CancelAllOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelAllOrdersResponse) ProtoMessage()    {}
+func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{81}
 }
 
-func (m *AddEventRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddEventRequest.Unmarshal(m, b)
+func (m *CancelAllOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelAllOrdersResponse.Unmarshal(m, b)
 }
-func (m *AddEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddEventRequest.Marshal(b, m, deterministic)
+func (m *CancelAllOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelAllOrdersResponse.Marshal(b, m, deterministic)
 }
-func (m *AddEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddEventRequest.Merge(m, src)
+func (m *CancelAllOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAllOrdersResponse.Merge(m, src)
 }
-func (m *AddEventRequest) XXX_Size() int {
-	return xxx_messageInfo_AddEventRequest.Size(m)
+func (m *CancelAllOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelAllOrdersResponse.Size(m)
 }
-func (m *AddEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddEventRequest.DiscardUnknown(m)
+func (m *CancelAllOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAllOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddEventRequest proto.InternalMessageInfo
+var xxx_messageInfo_CancelAllOrdersResponse proto.InternalMessageInfo
 
-func (m *AddEventRequest) GetExchange() string {
+func (m *CancelAllOrdersResponse) GetOrders() []*CancelAllOrdersResponse_Orders {
 	if m != nil {
-		return m.Exchange
+		return m.Orders
 	}
-	return ""
+	return nil
 }
 
-func (m *AddEventRequest) GetItem() string {
-	if m != nil {
-		return m.Item
-	}
-	return ""
+type CancelAllOrdersResponse_Orders struct {
+	Exchange             string            `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderStatus          map[string]string `protobuf:"bytes,2,rep,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Error                string            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *AddEventRequest) GetConditionParams() *ConditionParams {
+func (m *CancelAllOrdersResponse_Orders) Reset()         { *m = CancelAllOrdersResponse_Orders{} }
+func (m *CancelAllOrdersResponse_Orders) String() string { return proto.CompactTextString(m) }
+func (*CancelAllOrdersResponse_Orders) ProtoMessage()    {}
+func (*CancelAllOrdersResponse_Orders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{81, 0}
+}
+
+func (m *CancelAllOrdersResponse_Orders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelAllOrdersResponse_Orders.Unmarshal(m, b)
+}
+func (m *CancelAllOrdersResponse_Orders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelAllOrdersResponse_Orders.Marshal(b, m, deterministic)
+}
+func (m *CancelAllOrdersResponse_Orders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAllOrdersResponse_Orders.Merge(m, src)
+}
+func (m *CancelAllOrdersResponse_Orders) XXX_Size() int {
+	return xxx_messageInfo_CancelAllOrdersResponse_Orders.Size(m)
+}
+func (m *CancelAllOrdersResponse_Orders) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAllOrdersResponse_Orders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelAllOrdersResponse_Orders proto.InternalMessageInfo
+
+func (m *CancelAllOrdersResponse_Orders) GetExchange() string {
 	if m != nil {
-		return m.ConditionParams
+		return m.Exchange
 	}
-	return nil
+	return ""
 }
 
-func (m *AddEventRequest) GetPair() *CurrencyPair {
+func (m *CancelAllOrdersResponse_Orders) GetOrderStatus() map[string]string {
 	if m != nil {
-		return m.Pair
+		return m.OrderStatus
 	}
 	return nil
 }
 
-func (m *AddEventRequest) GetAssetType() string {
+func (m *CancelAllOrdersResponse_Orders) GetError() string {
 	if m != nil {
-		return m.AssetType
+		return m.Error
 	}
 	return ""
 }
 
-func (m *AddEventRequest) GetAction() string {
+type KillSwitchStatus struct {
+	Active               bool     `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActivatedAt          string   `protobuf:"bytes,3,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KillSwitchStatus) Reset()         { *m = KillSwitchStatus{} }
+func (m *KillSwitchStatus) String() string { return proto.CompactTextString(m) }
+func (*KillSwitchStatus) ProtoMessage()    {}
+func (*KillSwitchStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+}
+
+func (m *KillSwitchStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KillSwitchStatus.Unmarshal(m, b)
+}
+func (m *KillSwitchStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KillSwitchStatus.Marshal(b, m, deterministic)
+}
+func (m *KillSwitchStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KillSwitchStatus.Merge(m, src)
+}
+func (m *KillSwitchStatus) XXX_Size() int {
+	return xxx_messageInfo_KillSwitchStatus.Size(m)
+}
+func (m *KillSwitchStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_KillSwitchStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KillSwitchStatus proto.InternalMessageInfo
+
+func (m *KillSwitchStatus) GetActive() bool {
 	if m != nil {
-		return m.Action
+		return m.Active
+	}
+	return false
+}
+
+func (m *KillSwitchStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
 	return ""
 }
 
-type AddEventResponse struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (m *KillSwitchStatus) GetActivatedAt() string {
+	if m != nil {
+		return m.ActivatedAt
+	}
+	return ""
+}
+
+type ActivateKillSwitchRequest struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddEventResponse) Reset()         { *m = AddEventResponse{} }
-func (m *AddEventResponse) String() string { return proto.CompactTextString(m) }
-func (*AddEventResponse) ProtoMessage()    {}
-func (*AddEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{77}
+func (m *ActivateKillSwitchRequest) Reset()         { *m = ActivateKillSwitchRequest{} }
+func (m *ActivateKillSwitchRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivateKillSwitchRequest) ProtoMessage()    {}
+func (*ActivateKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{83}
 }
 
-func (m *AddEventResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddEventResponse.Unmarshal(m, b)
+func (m *ActivateKillSwitchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivateKillSwitchRequest.Unmarshal(m, b)
 }
-func (m *AddEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddEventResponse.Marshal(b, m, deterministic)
+func (m *ActivateKillSwitchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivateKillSwitchRequest.Marshal(b, m, deterministic)
 }
-func (m *AddEventResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddEventResponse.Merge(m, src)
+func (m *ActivateKillSwitchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateKillSwitchRequest.Merge(m, src)
 }
-func (m *AddEventResponse) XXX_Size() int {
-	return xxx_messageInfo_AddEventResponse.Size(m)
+func (m *ActivateKillSwitchRequest) XXX_Size() int {
+	return xxx_messageInfo_ActivateKillSwitchRequest.Size(m)
 }
-func (m *AddEventResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddEventResponse.DiscardUnknown(m)
+func (m *ActivateKillSwitchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateKillSwitchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddEventResponse proto.InternalMessageInfo
+var xxx_messageInfo_ActivateKillSwitchRequest proto.InternalMessageInfo
 
-func (m *AddEventResponse) GetId() int64 {
+func (m *ActivateKillSwitchRequest) GetReason() string {
 	if m != nil {
-		return m.Id
+		return m.Reason
 	}
-	return 0
+	return ""
 }
 
-type RemoveEventRequest struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ActivateKillSwitchResponse struct {
+	Status               *KillSwitchStatus                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Orders               []*CancelAllOrdersResponse_Orders `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (m *RemoveEventRequest) Reset()         { *m = RemoveEventRequest{} }
-func (m *RemoveEventRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveEventRequest) ProtoMessage()    {}
-func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{78}
+func (m *ActivateKillSwitchResponse) Reset()         { *m = ActivateKillSwitchResponse{} }
+func (m *ActivateKillSwitchResponse) String() string { return proto.CompactTextString(m) }
+func (*ActivateKillSwitchResponse) ProtoMessage()    {}
+func (*ActivateKillSwitchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{84}
 }
 
-func (m *RemoveEventRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveEventRequest.Unmarshal(m, b)
+func (m *ActivateKillSwitchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivateKillSwitchResponse.Unmarshal(m, b)
 }
-func (m *RemoveEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveEventRequest.Marshal(b, m, deterministic)
+func (m *ActivateKillSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivateKillSwitchResponse.Marshal(b, m, deterministic)
 }
-func (m *RemoveEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveEventRequest.Merge(m, src)
+func (m *ActivateKillSwitchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateKillSwitchResponse.Merge(m, src)
 }
-func (m *RemoveEventRequest) XXX_Size() int {
-	return xxx_messageInfo_RemoveEventRequest.Size(m)
+func (m *ActivateKillSwitchResponse) XXX_Size() int {
+	return xxx_messageInfo_ActivateKillSwitchResponse.Size(m)
 }
-func (m *RemoveEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveEventRequest.DiscardUnknown(m)
+func (m *ActivateKillSwitchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateKillSwitchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveEventRequest proto.InternalMessageInfo
+var xxx_messageInfo_ActivateKillSwitchResponse proto.InternalMessageInfo
 
-func (m *RemoveEventRequest) GetId() int64 {
+func (m *ActivateKillSwitchResponse) GetStatus() *KillSwitchStatus {
 	if m != nil {
-		return m.Id
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-type RemoveEventResponse struct {
+func (m *ActivateKillSwitchResponse) GetOrders() []*CancelAllOrdersResponse_Orders {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type ResetKillSwitchRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveEventResponse) Reset()         { *m = RemoveEventResponse{} }
-func (m *RemoveEventResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveEventResponse) ProtoMessage()    {}
-func (*RemoveEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{79}
+func (m *ResetKillSwitchRequest) Reset()         { *m = ResetKillSwitchRequest{} }
+func (m *ResetKillSwitchRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetKillSwitchRequest) ProtoMessage()    {}
+func (*ResetKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{85}
 }
 
-func (m *RemoveEventResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveEventResponse.Unmarshal(m, b)
+func (m *ResetKillSwitchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetKillSwitchRequest.Unmarshal(m, b)
 }
-func (m *RemoveEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveEventResponse.Marshal(b, m, deterministic)
+func (m *ResetKillSwitchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetKillSwitchRequest.Marshal(b, m, deterministic)
 }
-func (m *RemoveEventResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveEventResponse.Merge(m, src)
+func (m *ResetKillSwitchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetKillSwitchRequest.Merge(m, src)
 }
-func (m *RemoveEventResponse) XXX_Size() int {
-	return xxx_messageInfo_RemoveEventResponse.Size(m)
+func (m *ResetKillSwitchRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetKillSwitchRequest.Size(m)
 }
-func (m *RemoveEventResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveEventResponse.DiscardUnknown(m)
+func (m *ResetKillSwitchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetKillSwitchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveEventResponse proto.InternalMessageInfo
+var xxx_messageInfo_ResetKillSwitchRequest proto.InternalMessageInfo
 
-type GetCryptocurrencyDepositAddressesRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+type GetKillSwitchStatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCryptocurrencyDepositAddressesRequest) Reset() {
-	*m = GetCryptocurrencyDepositAddressesRequest{}
-}
-func (m *GetCryptocurrencyDepositAddressesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage()    {}
-func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+func (m *GetKillSwitchStatusRequest) Reset()         { *m = GetKillSwitchStatusRequest{} }
+func (m *GetKillSwitchStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKillSwitchStatusRequest) ProtoMessage()    {}
+func (*GetKillSwitchStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{86}
 }
 
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Unmarshal(m, b)
+func (m *GetKillSwitchStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKillSwitchStatusRequest.Unmarshal(m, b)
 }
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Marshal(b, m, deterministic)
+func (m *GetKillSwitchStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKillSwitchStatusRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Merge(m, src)
+func (m *GetKillSwitchStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKillSwitchStatusRequest.Merge(m, src)
 }
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Size(m)
+func (m *GetKillSwitchStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKillSwitchStatusRequest.Size(m)
 }
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.DiscardUnknown(m)
+func (m *GetKillSwitchStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKillSwitchStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest proto.InternalMessageInfo
-
-func (m *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
-	if m != nil {
-		return m.Exchange
-	}
-	return ""
-}
+var xxx_messageInfo_GetKillSwitchStatusRequest proto.InternalMessageInfo
 
-type GetCryptocurrencyDepositAddressesResponse struct {
-	Addresses            map[string]string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type GetTradingRulesRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetCryptocurrencyDepositAddressesResponse) Reset() {
-	*m = GetCryptocurrencyDepositAddressesResponse{}
-}
-func (m *GetCryptocurrencyDepositAddressesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage()    {}
-func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+func (m *GetTradingRulesRequest) Reset()         { *m = GetTradingRulesRequest{} }
+func (m *GetTradingRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTradingRulesRequest) ProtoMessage()    {}
+func (*GetTradingRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{87}
 }
 
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Unmarshal(m, b)
+func (m *GetTradingRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTradingRulesRequest.Unmarshal(m, b)
 }
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Marshal(b, m, deterministic)
+func (m *GetTradingRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTradingRulesRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Merge(m, src)
+func (m *GetTradingRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTradingRulesRequest.Merge(m, src)
 }
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Size(m)
+func (m *GetTradingRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTradingRulesRequest.Size(m)
 }
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.DiscardUnknown(m)
+func (m *GetTradingRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTradingRulesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetTradingRulesRequest proto.InternalMessageInfo
 
-func (m *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]string {
+func (m *GetTradingRulesRequest) GetExchange() string {
 	if m != nil {
-		return m.Addresses
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetTradingRulesRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
 	return nil
 }
 
-type GetCryptocurrencyDepositAddressRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Cryptocurrency       string   `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetTradingRulesRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-func (m *GetCryptocurrencyDepositAddressRequest) Reset() {
-	*m = GetCryptocurrencyDepositAddressRequest{}
+type TradingRules struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	PriceTickSize        float64       `protobuf:"fixed64,4,opt,name=price_tick_size,json=priceTickSize,proto3" json:"price_tick_size,omitempty"`
+	MinPrice             float64       `protobuf:"fixed64,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice             float64       `protobuf:"fixed64,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	AmountStepSize       float64       `protobuf:"fixed64,7,opt,name=amount_step_size,json=amountStepSize,proto3" json:"amount_step_size,omitempty"`
+	MinAmount            float64       `protobuf:"fixed64,8,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount            float64       `protobuf:"fixed64,9,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	MinNotional          float64       `protobuf:"fixed64,10,opt,name=min_notional,json=minNotional,proto3" json:"min_notional,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *GetCryptocurrencyDepositAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage()    {}
-func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+
+func (m *TradingRules) Reset()         { *m = TradingRules{} }
+func (m *TradingRules) String() string { return proto.CompactTextString(m) }
+func (*TradingRules) ProtoMessage()    {}
+func (*TradingRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{88}
 }
 
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Unmarshal(m, b)
+func (m *TradingRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradingRules.Unmarshal(m, b)
 }
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Marshal(b, m, deterministic)
+func (m *TradingRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradingRules.Marshal(b, m, deterministic)
 }
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Merge(m, src)
+func (m *TradingRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingRules.Merge(m, src)
 }
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Size(m)
+func (m *TradingRules) XXX_Size() int {
+	return xxx_messageInfo_TradingRules.Size(m)
 }
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.DiscardUnknown(m)
+func (m *TradingRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingRules.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCryptocurrencyDepositAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_TradingRules proto.InternalMessageInfo
 
-func (m *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
+func (m *TradingRules) GetExchange() string {
 	if m != nil {
 		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GetCryptocurrencyDepositAddressRequest) GetCryptocurrency() string {
+func (m *TradingRules) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.Cryptocurrency
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *TradingRules) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
 	}
 	return ""
 }
 
-type GetCryptocurrencyDepositAddressResponse struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TradingRules) GetPriceTickSize() float64 {
+	if m != nil {
+		return m.PriceTickSize
+	}
+	return 0
 }
 
-func (m *GetCryptocurrencyDepositAddressResponse) Reset() {
-	*m = GetCryptocurrencyDepositAddressResponse{}
-}
-func (m *GetCryptocurrencyDepositAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage()    {}
-func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{83}
+func (m *TradingRules) GetMinPrice() float64 {
+	if m != nil {
+		return m.MinPrice
+	}
+	return 0
 }
 
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Unmarshal(m, b)
-}
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Marshal(b, m, deterministic)
-}
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Merge(m, src)
+func (m *TradingRules) GetMaxPrice() float64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
 }
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Size(m)
+
+func (m *TradingRules) GetAmountStepSize() float64 {
+	if m != nil {
+		return m.AmountStepSize
+	}
+	return 0
 }
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.DiscardUnknown(m)
+
+func (m *TradingRules) GetMinAmount() float64 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetCryptocurrencyDepositAddressResponse proto.InternalMessageInfo
+func (m *TradingRules) GetMaxAmount() float64 {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return 0
+}
 
-func (m *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
+func (m *TradingRules) GetMinNotional() float64 {
 	if m != nil {
-		return m.Address
+		return m.MinNotional
 	}
-	return ""
+	return 0
 }
 
-type WithdrawCurrencyRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	OneTimePassword      string   `protobuf:"bytes,3,opt,name=one_time_password,json=oneTimePassword,proto3" json:"one_time_password,omitempty"`
-	AccountId            string   `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Pin                  int64    `protobuf:"varint,5,opt,name=pin,proto3" json:"pin,omitempty"`
-	TradePassword        string   `protobuf:"bytes,6,opt,name=trade_password,json=tradePassword,proto3" json:"trade_password,omitempty"`
-	Currency             string   `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
-	Address              string   `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
-	AddressTag           string   `protobuf:"bytes,9,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
-	Amount               float64  `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
-	FeeAmount            float64  `protobuf:"fixed64,11,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
-	BankName             string   `protobuf:"bytes,12,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
-	BankAddress          string   `protobuf:"bytes,13,opt,name=bank_address,json=bankAddress,proto3" json:"bank_address,omitempty"`
-	BankCity             string   `protobuf:"bytes,14,opt,name=bank_city,json=bankCity,proto3" json:"bank_city,omitempty"`
-	BankCountry          string   `protobuf:"bytes,15,opt,name=bank_country,json=bankCountry,proto3" json:"bank_country,omitempty"`
-	SwifeCode            string   `protobuf:"bytes,16,opt,name=swife_code,json=swifeCode,proto3" json:"swife_code,omitempty"`
-	WireCurrency         string   `protobuf:"bytes,17,opt,name=wire_currency,json=wireCurrency,proto3" json:"wire_currency,omitempty"`
+type GetEventsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WithdrawCurrencyRequest) Reset()         { *m = WithdrawCurrencyRequest{} }
-func (m *WithdrawCurrencyRequest) String() string { return proto.CompactTextString(m) }
-func (*WithdrawCurrencyRequest) ProtoMessage()    {}
-func (*WithdrawCurrencyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+func (m *GetEventsRequest) Reset()         { *m = GetEventsRequest{} }
+func (m *GetEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEventsRequest) ProtoMessage()    {}
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
 }
 
-func (m *WithdrawCurrencyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WithdrawCurrencyRequest.Unmarshal(m, b)
+func (m *GetEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsRequest.Unmarshal(m, b)
 }
-func (m *WithdrawCurrencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WithdrawCurrencyRequest.Marshal(b, m, deterministic)
+func (m *GetEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsRequest.Marshal(b, m, deterministic)
 }
-func (m *WithdrawCurrencyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WithdrawCurrencyRequest.Merge(m, src)
+func (m *GetEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsRequest.Merge(m, src)
 }
-func (m *WithdrawCurrencyRequest) XXX_Size() int {
-	return xxx_messageInfo_WithdrawCurrencyRequest.Size(m)
+func (m *GetEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEventsRequest.Size(m)
 }
-func (m *WithdrawCurrencyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WithdrawCurrencyRequest.DiscardUnknown(m)
+func (m *GetEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WithdrawCurrencyRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetEventsRequest proto.InternalMessageInfo
 
-func (m *WithdrawCurrencyRequest) GetExchange() string {
-	if m != nil {
-		return m.Exchange
-	}
-	return ""
+type ConditionParams struct {
+	Condition            string   `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	CheckBids            bool     `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
+	CheckBidsAndAsks     bool     `protobuf:"varint,4,opt,name=check_bids_and_asks,json=checkBidsAndAsks,proto3" json:"check_bids_and_asks,omitempty"`
+	OrderbookAmount      float64  `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WithdrawCurrencyRequest) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
+func (m *ConditionParams) Reset()         { *m = ConditionParams{} }
+func (m *ConditionParams) String() string { return proto.CompactTextString(m) }
+func (*ConditionParams) ProtoMessage()    {}
+func (*ConditionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
 }
 
-func (m *WithdrawCurrencyRequest) GetOneTimePassword() string {
-	if m != nil {
-		return m.OneTimePassword
-	}
-	return ""
+func (m *ConditionParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConditionParams.Unmarshal(m, b)
+}
+func (m *ConditionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConditionParams.Marshal(b, m, deterministic)
+}
+func (m *ConditionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionParams.Merge(m, src)
+}
+func (m *ConditionParams) XXX_Size() int {
+	return xxx_messageInfo_ConditionParams.Size(m)
+}
+func (m *ConditionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionParams.DiscardUnknown(m)
 }
 
-func (m *WithdrawCurrencyRequest) GetAccountId() string {
+var xxx_messageInfo_ConditionParams proto.InternalMessageInfo
+
+func (m *ConditionParams) GetCondition() string {
 	if m != nil {
-		return m.AccountId
+		return m.Condition
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetPin() int64 {
+func (m *ConditionParams) GetPrice() float64 {
 	if m != nil {
-		return m.Pin
+		return m.Price
 	}
 	return 0
 }
 
-func (m *WithdrawCurrencyRequest) GetTradePassword() string {
+func (m *ConditionParams) GetCheckBids() bool {
 	if m != nil {
-		return m.TradePassword
+		return m.CheckBids
 	}
-	return ""
+	return false
 }
 
-func (m *WithdrawCurrencyRequest) GetCurrency() string {
+func (m *ConditionParams) GetCheckBidsAndAsks() bool {
 	if m != nil {
-		return m.Currency
+		return m.CheckBidsAndAsks
 	}
-	return ""
+	return false
 }
 
-func (m *WithdrawCurrencyRequest) GetAddress() string {
+func (m *ConditionParams) GetOrderbookAmount() float64 {
 	if m != nil {
-		return m.Address
+		return m.OrderbookAmount
 	}
-	return ""
+	return 0
 }
 
-func (m *WithdrawCurrencyRequest) GetAddressTag() string {
-	if m != nil {
-		return m.AddressTag
-	}
-	return ""
+type GetEventsResponse struct {
+	Id                   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string           `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Item                 string           `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams      *ConditionParams `protobuf:"bytes,4,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Pair                 *CurrencyPair    `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	Action               string           `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Executed             bool             `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *WithdrawCurrencyRequest) GetAmount() float64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
+func (m *GetEventsResponse) Reset()         { *m = GetEventsResponse{} }
+func (m *GetEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEventsResponse) ProtoMessage()    {}
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
 }
 
-func (m *WithdrawCurrencyRequest) GetFeeAmount() float64 {
+func (m *GetEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsResponse.Unmarshal(m, b)
+}
+func (m *GetEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsResponse.Merge(m, src)
+}
+func (m *GetEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEventsResponse.Size(m)
+}
+func (m *GetEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEventsResponse proto.InternalMessageInfo
+
+func (m *GetEventsResponse) GetId() int64 {
 	if m != nil {
-		return m.FeeAmount
+		return m.Id
 	}
 	return 0
 }
 
-func (m *WithdrawCurrencyRequest) GetBankName() string {
+func (m *GetEventsResponse) GetExchange() string {
 	if m != nil {
-		return m.BankName
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetBankAddress() string {
+func (m *GetEventsResponse) GetItem() string {
 	if m != nil {
-		return m.BankAddress
+		return m.Item
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetBankCity() string {
+func (m *GetEventsResponse) GetConditionParams() *ConditionParams {
 	if m != nil {
-		return m.BankCity
+		return m.ConditionParams
 	}
-	return ""
+	return nil
 }
 
-func (m *WithdrawCurrencyRequest) GetBankCountry() string {
+func (m *GetEventsResponse) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.BankCountry
+		return m.Pair
 	}
-	return ""
+	return nil
 }
 
-func (m *WithdrawCurrencyRequest) GetSwifeCode() string {
+func (m *GetEventsResponse) GetAction() string {
 	if m != nil {
-		return m.SwifeCode
+		return m.Action
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetWireCurrency() string {
+func (m *GetEventsResponse) GetExecuted() bool {
 	if m != nil {
-		return m.WireCurrency
+		return m.Executed
 	}
-	return ""
-}
-
-type WithdrawResponse struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return false
 }
 
-func (m *WithdrawResponse) Reset()         { *m = WithdrawResponse{} }
-func (m *WithdrawResponse) String() string { return proto.CompactTextString(m) }
-func (*WithdrawResponse) ProtoMessage()    {}
-func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{85}
+type AddEventRequest struct {
+	Exchange             string           `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Item                 string           `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams      *ConditionParams `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Pair                 *CurrencyPair    `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string           `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Action               string           `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *WithdrawResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WithdrawResponse.Unmarshal(m, b)
+func (m *AddEventRequest) Reset()         { *m = AddEventRequest{} }
+func (m *AddEventRequest) String() string { return proto.CompactTextString(m) }
+func (*AddEventRequest) ProtoMessage()    {}
+func (*AddEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
 }
-func (m *WithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WithdrawResponse.Marshal(b, m, deterministic)
+
+func (m *AddEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddEventRequest.Unmarshal(m, b)
 }
-func (m *WithdrawResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WithdrawResponse.Merge(m, src)
+func (m *AddEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddEventRequest.Marshal(b, m, deterministic)
 }
-func (m *WithdrawResponse) XXX_Size() int {
-	return xxx_messageInfo_WithdrawResponse.Size(m)
+func (m *AddEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEventRequest.Merge(m, src)
 }
-func (m *WithdrawResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WithdrawResponse.DiscardUnknown(m)
+func (m *AddEventRequest) XXX_Size() int {
+	return xxx_messageInfo_AddEventRequest.Size(m)
+}
+func (m *AddEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEventRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WithdrawResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddEventRequest proto.InternalMessageInfo
 
-func (m *WithdrawResponse) GetResult() string {
+func (m *AddEventRequest) GetExchange() string {
 	if m != nil {
-		return m.Result
+		return m.Exchange
 	}
 	return ""
 }
 
-type GetLoggerDetailsRequest struct {
-	Logger               string   `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AddEventRequest) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
 }
 
-func (m *GetLoggerDetailsRequest) Reset()         { *m = GetLoggerDetailsRequest{} }
-func (m *GetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetLoggerDetailsRequest) ProtoMessage()    {}
-func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{86}
+func (m *AddEventRequest) GetConditionParams() *ConditionParams {
+	if m != nil {
+		return m.ConditionParams
+	}
+	return nil
 }
 
-func (m *GetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetLoggerDetailsRequest.Unmarshal(m, b)
-}
-func (m *GetLoggerDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetLoggerDetailsRequest.Marshal(b, m, deterministic)
-}
-func (m *GetLoggerDetailsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLoggerDetailsRequest.Merge(m, src)
-}
-func (m *GetLoggerDetailsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetLoggerDetailsRequest.Size(m)
-}
-func (m *GetLoggerDetailsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLoggerDetailsRequest.DiscardUnknown(m)
+func (m *AddEventRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetLoggerDetailsRequest proto.InternalMessageInfo
+func (m *AddEventRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
 
-func (m *GetLoggerDetailsRequest) GetLogger() string {
+func (m *AddEventRequest) GetAction() string {
 	if m != nil {
-		return m.Logger
+		return m.Action
 	}
 	return ""
 }
 
-type GetLoggerDetailsResponse struct {
-	Info                 bool     `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
-	Debug                bool     `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
-	Warn                 bool     `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
-	Error                bool     `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
+type AddEventResponse struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetLoggerDetailsResponse) Reset()         { *m = GetLoggerDetailsResponse{} }
-func (m *GetLoggerDetailsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetLoggerDetailsResponse) ProtoMessage()    {}
-func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{87}
+func (m *AddEventResponse) Reset()         { *m = AddEventResponse{} }
+func (m *AddEventResponse) String() string { return proto.CompactTextString(m) }
+func (*AddEventResponse) ProtoMessage()    {}
+func (*AddEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
 }
 
-func (m *GetLoggerDetailsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetLoggerDetailsResponse.Unmarshal(m, b)
-}
-func (m *GetLoggerDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetLoggerDetailsResponse.Marshal(b, m, deterministic)
-}
-func (m *GetLoggerDetailsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLoggerDetailsResponse.Merge(m, src)
+func (m *AddEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddEventResponse.Unmarshal(m, b)
 }
-func (m *GetLoggerDetailsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetLoggerDetailsResponse.Size(m)
+func (m *AddEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddEventResponse.Marshal(b, m, deterministic)
 }
-func (m *GetLoggerDetailsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLoggerDetailsResponse.DiscardUnknown(m)
+func (m *AddEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEventResponse.Merge(m, src)
 }
-
-var xxx_messageInfo_GetLoggerDetailsResponse proto.InternalMessageInfo
-
-func (m *GetLoggerDetailsResponse) GetInfo() bool {
-	if m != nil {
-		return m.Info
-	}
-	return false
+func (m *AddEventResponse) XXX_Size() int {
+	return xxx_messageInfo_AddEventResponse.Size(m)
 }
-
-func (m *GetLoggerDetailsResponse) GetDebug() bool {
-	if m != nil {
-		return m.Debug
-	}
-	return false
+func (m *AddEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEventResponse.DiscardUnknown(m)
 }
 
-func (m *GetLoggerDetailsResponse) GetWarn() bool {
-	if m != nil {
-		return m.Warn
-	}
-	return false
-}
+var xxx_messageInfo_AddEventResponse proto.InternalMessageInfo
 
-func (m *GetLoggerDetailsResponse) GetError() bool {
+func (m *AddEventResponse) GetId() int64 {
 	if m != nil {
-		return m.Error
+		return m.Id
 	}
-	return false
+	return 0
 }
 
-type SetLoggerDetailsRequest struct {
-	Logger               string   `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
-	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+type RemoveEventRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetLoggerDetailsRequest) Reset()         { *m = SetLoggerDetailsRequest{} }
-func (m *SetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
-func (*SetLoggerDetailsRequest) ProtoMessage()    {}
-func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{88}
+func (m *RemoveEventRequest) Reset()         { *m = RemoveEventRequest{} }
+func (m *RemoveEventRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveEventRequest) ProtoMessage()    {}
+func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
 }
 
-func (m *SetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetLoggerDetailsRequest.Unmarshal(m, b)
+func (m *RemoveEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveEventRequest.Unmarshal(m, b)
 }
-func (m *SetLoggerDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetLoggerDetailsRequest.Marshal(b, m, deterministic)
+func (m *RemoveEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveEventRequest.Marshal(b, m, deterministic)
 }
-func (m *SetLoggerDetailsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetLoggerDetailsRequest.Merge(m, src)
+func (m *RemoveEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveEventRequest.Merge(m, src)
 }
-func (m *SetLoggerDetailsRequest) XXX_Size() int {
-	return xxx_messageInfo_SetLoggerDetailsRequest.Size(m)
+func (m *RemoveEventRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveEventRequest.Size(m)
 }
-func (m *SetLoggerDetailsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetLoggerDetailsRequest.DiscardUnknown(m)
+func (m *RemoveEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveEventRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetLoggerDetailsRequest proto.InternalMessageInfo
-
-func (m *SetLoggerDetailsRequest) GetLogger() string {
-	if m != nil {
-		return m.Logger
-	}
-	return ""
-}
+var xxx_messageInfo_RemoveEventRequest proto.InternalMessageInfo
 
-func (m *SetLoggerDetailsRequest) GetLevel() string {
+func (m *RemoveEventRequest) GetId() int64 {
 	if m != nil {
-		return m.Level
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-type GetExchangePairsRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                string   `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+type RemoveEventResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetExchangePairsRequest) Reset()         { *m = GetExchangePairsRequest{} }
-func (m *GetExchangePairsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetExchangePairsRequest) ProtoMessage()    {}
-func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+func (m *RemoveEventResponse) Reset()         { *m = RemoveEventResponse{} }
+func (m *RemoveEventResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveEventResponse) ProtoMessage()    {}
+func (*RemoveEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
 }
 
-func (m *GetExchangePairsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetExchangePairsRequest.Unmarshal(m, b)
+func (m *RemoveEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveEventResponse.Unmarshal(m, b)
 }
-func (m *GetExchangePairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetExchangePairsRequest.Marshal(b, m, deterministic)
+func (m *RemoveEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveEventResponse.Marshal(b, m, deterministic)
 }
-func (m *GetExchangePairsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetExchangePairsRequest.Merge(m, src)
+func (m *RemoveEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveEventResponse.Merge(m, src)
 }
-func (m *GetExchangePairsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetExchangePairsRequest.Size(m)
+func (m *RemoveEventResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveEventResponse.Size(m)
 }
-func (m *GetExchangePairsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetExchangePairsRequest.DiscardUnknown(m)
+func (m *RemoveEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveEventResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetExchangePairsRequest proto.InternalMessageInfo
-
-func (m *GetExchangePairsRequest) GetExchange() string {
-	if m != nil {
-		return m.Exchange
-	}
-	return ""
-}
+var xxx_messageInfo_RemoveEventResponse proto.InternalMessageInfo
 
-func (m *GetExchangePairsRequest) GetAsset() string {
-	if m != nil {
-		return m.Asset
-	}
-	return ""
+type GetCryptocurrencyDepositAddressesRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type GetExchangePairsResponse struct {
-	SupportedAssets      map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *GetCryptocurrencyDepositAddressesRequest) Reset() {
+	*m = GetCryptocurrencyDepositAddressesRequest{}
 }
-
-func (m *GetExchangePairsResponse) Reset()         { *m = GetExchangePairsResponse{} }
-func (m *GetExchangePairsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetExchangePairsResponse) ProtoMessage()    {}
-func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+func (m *GetCryptocurrencyDepositAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage()    {}
+func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
 }
 
-func (m *GetExchangePairsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetExchangePairsResponse.Unmarshal(m, b)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Unmarshal(m, b)
 }
-func (m *GetExchangePairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetExchangePairsResponse.Marshal(b, m, deterministic)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Marshal(b, m, deterministic)
 }
-func (m *GetExchangePairsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetExchangePairsResponse.Merge(m, src)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Merge(m, src)
 }
-func (m *GetExchangePairsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetExchangePairsResponse.Size(m)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Size(m)
 }
-func (m *GetExchangePairsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetExchangePairsResponse.DiscardUnknown(m)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetExchangePairsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest proto.InternalMessageInfo
 
-func (m *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
+func (m *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
 	if m != nil {
-		return m.SupportedAssets
+		return m.Exchange
 	}
-	return nil
+	return ""
 }
 
-type ExchangePairRequest struct {
-	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AssetType            string        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type GetCryptocurrencyDepositAddressesResponse struct {
+	Addresses            map[string]string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ExchangePairRequest) Reset()         { *m = ExchangePairRequest{} }
-func (m *ExchangePairRequest) String() string { return proto.CompactTextString(m) }
-func (*ExchangePairRequest) ProtoMessage()    {}
-func (*ExchangePairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+func (m *GetCryptocurrencyDepositAddressesResponse) Reset() {
+	*m = GetCryptocurrencyDepositAddressesResponse{}
 }
-
-func (m *ExchangePairRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExchangePairRequest.Unmarshal(m, b)
+func (m *GetCryptocurrencyDepositAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage()    {}
+func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
 }
-func (m *ExchangePairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExchangePairRequest.Marshal(b, m, deterministic)
+
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Unmarshal(m, b)
 }
-func (m *ExchangePairRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExchangePairRequest.Merge(m, src)
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Marshal(b, m, deterministic)
 }
-func (m *ExchangePairRequest) XXX_Size() int {
-	return xxx_messageInfo_ExchangePairRequest.Size(m)
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Merge(m, src)
 }
-func (m *ExchangePairRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExchangePairRequest.DiscardUnknown(m)
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Size(m)
 }
-
-var xxx_messageInfo_ExchangePairRequest proto.InternalMessageInfo
-
-func (m *ExchangePairRequest) GetExchange() string {
-	if m != nil {
-		return m.Exchange
-	}
-	return ""
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.DiscardUnknown(m)
 }
 
-func (m *ExchangePairRequest) GetAssetType() string {
-	if m != nil {
-		return m.AssetType
-	}
-	return ""
-}
+var xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse proto.InternalMessageInfo
 
-func (m *ExchangePairRequest) GetPair() *CurrencyPair {
+func (m *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]string {
 	if m != nil {
-		return m.Pair
+		return m.Addresses
 	}
 	return nil
 }
 
-type GetOrderbookStreamRequest struct {
-	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type GetCryptocurrencyDepositAddressRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Cryptocurrency       string   `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetOrderbookStreamRequest) Reset()         { *m = GetOrderbookStreamRequest{} }
-func (m *GetOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetOrderbookStreamRequest) ProtoMessage()    {}
-func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+func (m *GetCryptocurrencyDepositAddressRequest) Reset() {
+	*m = GetCryptocurrencyDepositAddressRequest{}
+}
+func (m *GetCryptocurrencyDepositAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage()    {}
+func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
 }
 
-func (m *GetOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetOrderbookStreamRequest.Unmarshal(m, b)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Unmarshal(m, b)
 }
-func (m *GetOrderbookStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetOrderbookStreamRequest.Marshal(b, m, deterministic)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Marshal(b, m, deterministic)
 }
-func (m *GetOrderbookStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOrderbookStreamRequest.Merge(m, src)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Merge(m, src)
 }
-func (m *GetOrderbookStreamRequest) XXX_Size() int {
-	return xxx_messageInfo_GetOrderbookStreamRequest.Size(m)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Size(m)
 }
-func (m *GetOrderbookStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOrderbookStreamRequest.DiscardUnknown(m)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOrderbookStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetCryptocurrencyDepositAddressRequest proto.InternalMessageInfo
 
-func (m *GetOrderbookStreamRequest) GetExchange() string {
+func (m *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
 	if m != nil {
 		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
-	if m != nil {
-		return m.Pair
-	}
-	return nil
-}
-
-func (m *GetOrderbookStreamRequest) GetAssetType() string {
+func (m *GetCryptocurrencyDepositAddressRequest) GetCryptocurrency() string {
 	if m != nil {
-		return m.AssetType
+		return m.Cryptocurrency
 	}
 	return ""
 }
 
-type GetExchangeOrderbookStreamRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+type GetCryptocurrencyDepositAddressResponse struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetExchangeOrderbookStreamRequest) Reset()         { *m = GetExchangeOrderbookStreamRequest{} }
-func (m *GetExchangeOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetExchangeOrderbookStreamRequest) ProtoMessage()    {}
-func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+func (m *GetCryptocurrencyDepositAddressResponse) Reset() {
+	*m = GetCryptocurrencyDepositAddressResponse{}
+}
+func (m *GetCryptocurrencyDepositAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage()    {}
+func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
 }
 
-func (m *GetExchangeOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Unmarshal(m, b)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Unmarshal(m, b)
 }
-func (m *GetExchangeOrderbookStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Marshal(b, m, deterministic)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Marshal(b, m, deterministic)
 }
-func (m *GetExchangeOrderbookStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetExchangeOrderbookStreamRequest.Merge(m, src)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Merge(m, src)
 }
-func (m *GetExchangeOrderbookStreamRequest) XXX_Size() int {
-	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Size(m)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Size(m)
 }
-func (m *GetExchangeOrderbookStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetExchangeOrderbookStreamRequest.DiscardUnknown(m)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetExchangeOrderbookStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetCryptocurrencyDepositAddressResponse proto.InternalMessageInfo
 
-func (m *GetExchangeOrderbookStreamRequest) GetExchange() string {
+func (m *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
 	if m != nil {
-		return m.Exchange
+		return m.Address
 	}
 	return ""
 }
 
-type GetTickerStreamRequest struct {
-	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *GetTickerStreamRequest) Reset()         { *m = GetTickerStreamRequest{} }
-func (m *GetTickerStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTickerStreamRequest) ProtoMessage()    {}
-func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+type WithdrawCurrencyRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	OneTimePassword      string   `protobuf:"bytes,3,opt,name=one_time_password,json=oneTimePassword,proto3" json:"one_time_password,omitempty"`
+	AccountId            string   `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Pin                  int64    `protobuf:"varint,5,opt,name=pin,proto3" json:"pin,omitempty"`
+	TradePassword        string   `protobuf:"bytes,6,opt,name=trade_password,json=tradePassword,proto3" json:"trade_password,omitempty"`
+	Currency             string   `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Address              string   `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag           string   `protobuf:"bytes,9,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Amount               float64  `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeAmount            float64  `protobuf:"fixed64,11,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	BankName             string   `protobuf:"bytes,12,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	BankAddress          string   `protobuf:"bytes,13,opt,name=bank_address,json=bankAddress,proto3" json:"bank_address,omitempty"`
+	BankCity             string   `protobuf:"bytes,14,opt,name=bank_city,json=bankCity,proto3" json:"bank_city,omitempty"`
+	BankCountry          string   `protobuf:"bytes,15,opt,name=bank_country,json=bankCountry,proto3" json:"bank_country,omitempty"`
+	SwifeCode            string   `protobuf:"bytes,16,opt,name=swife_code,json=swifeCode,proto3" json:"swife_code,omitempty"`
+	WireCurrency         string   `protobuf:"bytes,17,opt,name=wire_currency,json=wireCurrency,proto3" json:"wire_currency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTickerStreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTickerStreamRequest.Unmarshal(m, b)
+func (m *WithdrawCurrencyRequest) Reset()         { *m = WithdrawCurrencyRequest{} }
+func (m *WithdrawCurrencyRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawCurrencyRequest) ProtoMessage()    {}
+func (*WithdrawCurrencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
 }
-func (m *GetTickerStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTickerStreamRequest.Marshal(b, m, deterministic)
+
+func (m *WithdrawCurrencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawCurrencyRequest.Unmarshal(m, b)
 }
-func (m *GetTickerStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTickerStreamRequest.Merge(m, src)
+func (m *WithdrawCurrencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawCurrencyRequest.Marshal(b, m, deterministic)
 }
-func (m *GetTickerStreamRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTickerStreamRequest.Size(m)
+func (m *WithdrawCurrencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawCurrencyRequest.Merge(m, src)
 }
-func (m *GetTickerStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTickerStreamRequest.DiscardUnknown(m)
+func (m *WithdrawCurrencyRequest) XXX_Size() int {
+	return xxx_messageInfo_WithdrawCurrencyRequest.Size(m)
+}
+func (m *WithdrawCurrencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawCurrencyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTickerStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_WithdrawCurrencyRequest proto.InternalMessageInfo
 
-func (m *GetTickerStreamRequest) GetExchange() string {
+func (m *WithdrawCurrencyRequest) GetExchange() string {
 	if m != nil {
 		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GetTickerStreamRequest) GetPair() *CurrencyPair {
+func (m *WithdrawCurrencyRequest) GetDescription() string {
 	if m != nil {
-		return m.Pair
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-func (m *GetTickerStreamRequest) GetAssetType() string {
+func (m *WithdrawCurrencyRequest) GetOneTimePassword() string {
 	if m != nil {
-		return m.AssetType
+		return m.OneTimePassword
 	}
 	return ""
 }
 
-type GetExchangeTickerStreamRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WithdrawCurrencyRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
 }
 
-func (m *GetExchangeTickerStreamRequest) Reset()         { *m = GetExchangeTickerStreamRequest{} }
-func (m *GetExchangeTickerStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetExchangeTickerStreamRequest) ProtoMessage()    {}
-func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95}
+func (m *WithdrawCurrencyRequest) GetPin() int64 {
+	if m != nil {
+		return m.Pin
+	}
+	return 0
 }
 
-func (m *GetExchangeTickerStreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetExchangeTickerStreamRequest.Unmarshal(m, b)
-}
-func (m *GetExchangeTickerStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetExchangeTickerStreamRequest.Marshal(b, m, deterministic)
-}
-func (m *GetExchangeTickerStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetExchangeTickerStreamRequest.Merge(m, src)
-}
-func (m *GetExchangeTickerStreamRequest) XXX_Size() int {
-	return xxx_messageInfo_GetExchangeTickerStreamRequest.Size(m)
-}
-func (m *GetExchangeTickerStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetExchangeTickerStreamRequest.DiscardUnknown(m)
+func (m *WithdrawCurrencyRequest) GetTradePassword() string {
+	if m != nil {
+		return m.TradePassword
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetExchangeTickerStreamRequest proto.InternalMessageInfo
-
-func (m *GetExchangeTickerStreamRequest) GetExchange() string {
+func (m *WithdrawCurrencyRequest) GetCurrency() string {
 	if m != nil {
-		return m.Exchange
+		return m.Currency
 	}
 	return ""
 }
 
-type GetAuditEventRequest struct {
-	StartDate            string   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate              string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	OrderBy              string   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WithdrawCurrencyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *GetAuditEventRequest) Reset()         { *m = GetAuditEventRequest{} }
-func (m *GetAuditEventRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAuditEventRequest) ProtoMessage()    {}
-func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+func (m *WithdrawCurrencyRequest) GetAddressTag() string {
+	if m != nil {
+		return m.AddressTag
+	}
+	return ""
 }
 
-func (m *GetAuditEventRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAuditEventRequest.Unmarshal(m, b)
-}
-func (m *GetAuditEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAuditEventRequest.Marshal(b, m, deterministic)
-}
-func (m *GetAuditEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAuditEventRequest.Merge(m, src)
-}
-func (m *GetAuditEventRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAuditEventRequest.Size(m)
+func (m *WithdrawCurrencyRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
-func (m *GetAuditEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAuditEventRequest.DiscardUnknown(m)
+
+func (m *WithdrawCurrencyRequest) GetFeeAmount() float64 {
+	if m != nil {
+		return m.FeeAmount
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetAuditEventRequest proto.InternalMessageInfo
+func (m *WithdrawCurrencyRequest) GetBankName() string {
+	if m != nil {
+		return m.BankName
+	}
+	return ""
+}
 
-func (m *GetAuditEventRequest) GetStartDate() string {
+func (m *WithdrawCurrencyRequest) GetBankAddress() string {
 	if m != nil {
-		return m.StartDate
+		return m.BankAddress
 	}
 	return ""
 }
 
-func (m *GetAuditEventRequest) GetEndDate() string {
+func (m *WithdrawCurrencyRequest) GetBankCity() string {
 	if m != nil {
-		return m.EndDate
+		return m.BankCity
 	}
 	return ""
 }
 
-func (m *GetAuditEventRequest) GetOrderBy() string {
+func (m *WithdrawCurrencyRequest) GetBankCountry() string {
 	if m != nil {
-		return m.OrderBy
+		return m.BankCountry
 	}
 	return ""
 }
 
-func (m *GetAuditEventRequest) GetLimit() int32 {
+func (m *WithdrawCurrencyRequest) GetSwifeCode() string {
 	if m != nil {
-		return m.Limit
+		return m.SwifeCode
 	}
-	return 0
+	return ""
 }
 
-func (m *GetAuditEventRequest) GetOffset() int32 {
+func (m *WithdrawCurrencyRequest) GetWireCurrency() string {
 	if m != nil {
-		return m.Offset
+		return m.WireCurrency
 	}
-	return 0
+	return ""
 }
 
-type GetAuditEventResponse struct {
-	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type WithdrawResponse struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAuditEventResponse) Reset()         { *m = GetAuditEventResponse{} }
-func (m *GetAuditEventResponse) String() string { return proto.CompactTextString(m) }
-func (*GetAuditEventResponse) ProtoMessage()    {}
-func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+func (m *WithdrawResponse) Reset()         { *m = WithdrawResponse{} }
+func (m *WithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*WithdrawResponse) ProtoMessage()    {}
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
 }
 
-func (m *GetAuditEventResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAuditEventResponse.Unmarshal(m, b)
+func (m *WithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawResponse.Unmarshal(m, b)
 }
-func (m *GetAuditEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAuditEventResponse.Marshal(b, m, deterministic)
+func (m *WithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawResponse.Marshal(b, m, deterministic)
 }
-func (m *GetAuditEventResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAuditEventResponse.Merge(m, src)
+func (m *WithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawResponse.Merge(m, src)
 }
-func (m *GetAuditEventResponse) XXX_Size() int {
-	return xxx_messageInfo_GetAuditEventResponse.Size(m)
+func (m *WithdrawResponse) XXX_Size() int {
+	return xxx_messageInfo_WithdrawResponse.Size(m)
 }
-func (m *GetAuditEventResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAuditEventResponse.DiscardUnknown(m)
+func (m *WithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAuditEventResponse proto.InternalMessageInfo
+var xxx_messageInfo_WithdrawResponse proto.InternalMessageInfo
 
-func (m *GetAuditEventResponse) GetEvents() []*AuditEvent {
+func (m *WithdrawResponse) GetResult() string {
 	if m != nil {
-		return m.Events
+		return m.Result
 	}
-	return nil
+	return ""
 }
 
-type GetHistoricCandlesRequest struct {
-	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Rangesize            int64         `protobuf:"varint,3,opt,name=rangesize,proto3" json:"rangesize,omitempty"`
-	Granularity          int64         `protobuf:"varint,4,opt,name=granularity,proto3" json:"granularity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type GetLoggerDetailsRequest struct {
+	Logger               string   `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetHistoricCandlesRequest) Reset()         { *m = GetHistoricCandlesRequest{} }
-func (m *GetHistoricCandlesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetHistoricCandlesRequest) ProtoMessage()    {}
-func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{98}
+func (m *GetLoggerDetailsRequest) Reset()         { *m = GetLoggerDetailsRequest{} }
+func (m *GetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoggerDetailsRequest) ProtoMessage()    {}
+func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
 }
 
-func (m *GetHistoricCandlesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetHistoricCandlesRequest.Unmarshal(m, b)
+func (m *GetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoggerDetailsRequest.Unmarshal(m, b)
 }
-func (m *GetHistoricCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetHistoricCandlesRequest.Marshal(b, m, deterministic)
+func (m *GetLoggerDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoggerDetailsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetHistoricCandlesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetHistoricCandlesRequest.Merge(m, src)
+func (m *GetLoggerDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoggerDetailsRequest.Merge(m, src)
 }
-func (m *GetHistoricCandlesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetHistoricCandlesRequest.Size(m)
+func (m *GetLoggerDetailsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoggerDetailsRequest.Size(m)
 }
-func (m *GetHistoricCandlesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetHistoricCandlesRequest.DiscardUnknown(m)
+func (m *GetLoggerDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoggerDetailsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetHistoricCandlesRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetLoggerDetailsRequest proto.InternalMessageInfo
 
-func (m *GetHistoricCandlesRequest) GetExchange() string {
+func (m *GetLoggerDetailsRequest) GetLogger() string {
 	if m != nil {
-		return m.Exchange
+		return m.Logger
 	}
 	return ""
 }
 
-func (m *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
-	if m != nil {
-		return m.Pair
-	}
-	return nil
-}
-
-func (m *GetHistoricCandlesRequest) GetRangesize() int64 {
-	if m != nil {
-		return m.Rangesize
-	}
-	return 0
-}
-
-func (m *GetHistoricCandlesRequest) GetGranularity() int64 {
-	if m != nil {
-		return m.Granularity
-	}
-	return 0
-}
-
-type GetHistoricCandlesResponse struct {
-	Candle               []*Candle `protobuf:"bytes,1,rep,name=candle,proto3" json:"candle,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *GetHistoricCandlesResponse) Reset()         { *m = GetHistoricCandlesResponse{} }
-func (m *GetHistoricCandlesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetHistoricCandlesResponse) ProtoMessage()    {}
-func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{99}
-}
-
-func (m *GetHistoricCandlesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetHistoricCandlesResponse.Unmarshal(m, b)
-}
-func (m *GetHistoricCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetHistoricCandlesResponse.Marshal(b, m, deterministic)
-}
-func (m *GetHistoricCandlesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetHistoricCandlesResponse.Merge(m, src)
-}
-func (m *GetHistoricCandlesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetHistoricCandlesResponse.Size(m)
-}
-func (m *GetHistoricCandlesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetHistoricCandlesResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetHistoricCandlesResponse proto.InternalMessageInfo
-
-func (m *GetHistoricCandlesResponse) GetCandle() []*Candle {
-	if m != nil {
-		return m.Candle
-	}
-	return nil
-}
-
-type Candle struct {
-	Time                 int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Low                  float64  `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
-	High                 float64  `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Open                 float64  `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
-	Close                float64  `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
-	Volume               float64  `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+type GetLoggerDetailsResponse struct {
+	Info                 bool     `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
+	Debug                bool     `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
+	Warn                 bool     `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
+	Error                bool     `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Candle) Reset()         { *m = Candle{} }
-func (m *Candle) String() string { return proto.CompactTextString(m) }
-func (*Candle) ProtoMessage()    {}
-func (*Candle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+func (m *GetLoggerDetailsResponse) Reset()         { *m = GetLoggerDetailsResponse{} }
+func (m *GetLoggerDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoggerDetailsResponse) ProtoMessage()    {}
+func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
 }
 
-func (m *Candle) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Candle.Unmarshal(m, b)
-}
-func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+func (m *GetLoggerDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoggerDetailsResponse.Unmarshal(m, b)
 }
-func (m *Candle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Candle.Merge(m, src)
+func (m *GetLoggerDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoggerDetailsResponse.Marshal(b, m, deterministic)
 }
-func (m *Candle) XXX_Size() int {
-	return xxx_messageInfo_Candle.Size(m)
+func (m *GetLoggerDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoggerDetailsResponse.Merge(m, src)
 }
-func (m *Candle) XXX_DiscardUnknown() {
-	xxx_messageInfo_Candle.DiscardUnknown(m)
+func (m *GetLoggerDetailsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoggerDetailsResponse.Size(m)
 }
-
-var xxx_messageInfo_Candle proto.InternalMessageInfo
-
-func (m *Candle) GetTime() int64 {
-	if m != nil {
-		return m.Time
-	}
-	return 0
+func (m *GetLoggerDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoggerDetailsResponse.DiscardUnknown(m)
 }
 
-func (m *Candle) GetLow() float64 {
-	if m != nil {
-		return m.Low
-	}
-	return 0
-}
+var xxx_messageInfo_GetLoggerDetailsResponse proto.InternalMessageInfo
 
-func (m *Candle) GetHigh() float64 {
+func (m *GetLoggerDetailsResponse) GetInfo() bool {
 	if m != nil {
-		return m.High
+		return m.Info
 	}
-	return 0
+	return false
 }
 
-func (m *Candle) GetOpen() float64 {
+func (m *GetLoggerDetailsResponse) GetDebug() bool {
 	if m != nil {
-		return m.Open
+		return m.Debug
 	}
-	return 0
+	return false
 }
 
-func (m *Candle) GetClose() float64 {
+func (m *GetLoggerDetailsResponse) GetWarn() bool {
 	if m != nil {
-		return m.Close
+		return m.Warn
 	}
-	return 0
+	return false
 }
 
-func (m *Candle) GetVolume() float64 {
+func (m *GetLoggerDetailsResponse) GetError() bool {
 	if m != nil {
-		return m.Volume
+		return m.Error
 	}
-	return 0
+	return false
 }
 
-type AuditEvent struct {
-	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Identifier           string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp            string   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+type SetLoggerDetailsRequest struct {
+	Logger               string   `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
-func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
-func (*AuditEvent) ProtoMessage()    {}
-func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+func (m *SetLoggerDetailsRequest) Reset()         { *m = SetLoggerDetailsRequest{} }
+func (m *SetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLoggerDetailsRequest) ProtoMessage()    {}
+func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
 }
 
-func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
-}
-func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+func (m *SetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLoggerDetailsRequest.Unmarshal(m, b)
 }
-func (m *AuditEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuditEvent.Merge(m, src)
+func (m *SetLoggerDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLoggerDetailsRequest.Marshal(b, m, deterministic)
 }
-func (m *AuditEvent) XXX_Size() int {
-	return xxx_messageInfo_AuditEvent.Size(m)
+func (m *SetLoggerDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLoggerDetailsRequest.Merge(m, src)
 }
-func (m *AuditEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+func (m *SetLoggerDetailsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLoggerDetailsRequest.Size(m)
 }
-
-var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
-
-func (m *AuditEvent) GetType() string {
-	if m != nil {
-		return m.Type
-	}
-	return ""
+func (m *SetLoggerDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLoggerDetailsRequest.DiscardUnknown(m)
 }
 
-func (m *AuditEvent) GetIdentifier() string {
-	if m != nil {
-		return m.Identifier
-	}
-	return ""
-}
+var xxx_messageInfo_SetLoggerDetailsRequest proto.InternalMessageInfo
 
-func (m *AuditEvent) GetMessage() string {
+func (m *SetLoggerDetailsRequest) GetLogger() string {
 	if m != nil {
-		return m.Message
+		return m.Logger
 	}
 	return ""
 }
 
-func (m *AuditEvent) GetTimestamp() string {
+func (m *SetLoggerDetailsRequest) GetLevel() string {
 	if m != nil {
-		return m.Timestamp
+		return m.Level
 	}
 	return ""
 }
 
-type GCTScript struct {
-	UUID                 string   `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	NextRun              string   `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+type GetExchangePairsRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                string   `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScript) Reset()         { *m = GCTScript{} }
-func (m *GCTScript) String() string { return proto.CompactTextString(m) }
-func (*GCTScript) ProtoMessage()    {}
-func (*GCTScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+func (m *GetExchangePairsRequest) Reset()         { *m = GetExchangePairsRequest{} }
+func (m *GetExchangePairsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangePairsRequest) ProtoMessage()    {}
+func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
 }
 
-func (m *GCTScript) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScript.Unmarshal(m, b)
+func (m *GetExchangePairsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangePairsRequest.Unmarshal(m, b)
 }
-func (m *GCTScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScript.Marshal(b, m, deterministic)
+func (m *GetExchangePairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangePairsRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScript) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScript.Merge(m, src)
+func (m *GetExchangePairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangePairsRequest.Merge(m, src)
 }
-func (m *GCTScript) XXX_Size() int {
-	return xxx_messageInfo_GCTScript.Size(m)
+func (m *GetExchangePairsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangePairsRequest.Size(m)
 }
-func (m *GCTScript) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScript.DiscardUnknown(m)
+func (m *GetExchangePairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangePairsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScript proto.InternalMessageInfo
+var xxx_messageInfo_GetExchangePairsRequest proto.InternalMessageInfo
 
-func (m *GCTScript) GetUUID() string {
+func (m *GetExchangePairsRequest) GetExchange() string {
 	if m != nil {
-		return m.UUID
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScript) GetName() string {
+func (m *GetExchangePairsRequest) GetAsset() string {
 	if m != nil {
-		return m.Name
+		return m.Asset
 	}
 	return ""
 }
 
-func (m *GCTScript) GetPath() string {
-	if m != nil {
-		return m.Path
-	}
-	return ""
+type GetExchangePairsResponse struct {
+	SupportedAssets      map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *GCTScript) GetNextRun() string {
-	if m != nil {
-		return m.NextRun
-	}
-	return ""
-}
-
-type GCTScriptExecuteRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
-}
-
-func (m *GCTScriptExecuteRequest) Reset()         { *m = GCTScriptExecuteRequest{} }
-func (m *GCTScriptExecuteRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptExecuteRequest) ProtoMessage()    {}
-func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+func (m *GetExchangePairsResponse) Reset()         { *m = GetExchangePairsResponse{} }
+func (m *GetExchangePairsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExchangePairsResponse) ProtoMessage()    {}
+func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
 }
 
-func (m *GCTScriptExecuteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptExecuteRequest.Unmarshal(m, b)
+func (m *GetExchangePairsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangePairsResponse.Unmarshal(m, b)
 }
-func (m *GCTScriptExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptExecuteRequest.Marshal(b, m, deterministic)
+func (m *GetExchangePairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangePairsResponse.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptExecuteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptExecuteRequest.Merge(m, src)
+func (m *GetExchangePairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangePairsResponse.Merge(m, src)
 }
-func (m *GCTScriptExecuteRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptExecuteRequest.Size(m)
+func (m *GetExchangePairsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExchangePairsResponse.Size(m)
 }
-func (m *GCTScriptExecuteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptExecuteRequest.DiscardUnknown(m)
+func (m *GetExchangePairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangePairsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptExecuteRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetExchangePairsResponse proto.InternalMessageInfo
 
-func (m *GCTScriptExecuteRequest) GetScript() *GCTScript {
+func (m *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
 	if m != nil {
-		return m.Script
+		return m.SupportedAssets
 	}
 	return nil
 }
 
-type GCTScriptStopRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type ExchangePairRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType            string        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GCTScriptStopRequest) Reset()         { *m = GCTScriptStopRequest{} }
-func (m *GCTScriptStopRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStopRequest) ProtoMessage()    {}
-func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+func (m *ExchangePairRequest) Reset()         { *m = ExchangePairRequest{} }
+func (m *ExchangePairRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangePairRequest) ProtoMessage()    {}
+func (*ExchangePairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{107}
 }
 
-func (m *GCTScriptStopRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStopRequest.Unmarshal(m, b)
+func (m *ExchangePairRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangePairRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptStopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStopRequest.Marshal(b, m, deterministic)
+func (m *ExchangePairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangePairRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptStopRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStopRequest.Merge(m, src)
+func (m *ExchangePairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangePairRequest.Merge(m, src)
 }
-func (m *GCTScriptStopRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStopRequest.Size(m)
+func (m *ExchangePairRequest) XXX_Size() int {
+	return xxx_messageInfo_ExchangePairRequest.Size(m)
 }
-func (m *GCTScriptStopRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStopRequest.DiscardUnknown(m)
+func (m *ExchangePairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangePairRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptStopRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExchangePairRequest proto.InternalMessageInfo
 
-func (m *GCTScriptStopRequest) GetScript() *GCTScript {
+func (m *ExchangePairRequest) GetExchange() string {
 	if m != nil {
-		return m.Script
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ExchangePairRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *ExchangePairRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
 	return nil
 }
 
-type GCTScriptStopAllRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetOrderbookStreamRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GCTScriptStopAllRequest) Reset()         { *m = GCTScriptStopAllRequest{} }
-func (m *GCTScriptStopAllRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStopAllRequest) ProtoMessage()    {}
-func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+func (m *GetOrderbookStreamRequest) Reset()         { *m = GetOrderbookStreamRequest{} }
+func (m *GetOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderbookStreamRequest) ProtoMessage()    {}
+func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{108}
 }
 
-func (m *GCTScriptStopAllRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStopAllRequest.Unmarshal(m, b)
+func (m *GetOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderbookStreamRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptStopAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStopAllRequest.Marshal(b, m, deterministic)
+func (m *GetOrderbookStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderbookStreamRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptStopAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStopAllRequest.Merge(m, src)
+func (m *GetOrderbookStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderbookStreamRequest.Merge(m, src)
 }
-func (m *GCTScriptStopAllRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStopAllRequest.Size(m)
+func (m *GetOrderbookStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderbookStreamRequest.Size(m)
 }
-func (m *GCTScriptStopAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStopAllRequest.DiscardUnknown(m)
+func (m *GetOrderbookStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderbookStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptStopAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetOrderbookStreamRequest proto.InternalMessageInfo
 
-type GCTScriptStatusRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetOrderbookStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
 
-func (m *GCTScriptStatusRequest) Reset()         { *m = GCTScriptStatusRequest{} }
-func (m *GCTScriptStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStatusRequest) ProtoMessage()    {}
-func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{106}
+func (m *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
 
-func (m *GCTScriptStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStatusRequest.Unmarshal(m, b)
-}
-func (m *GCTScriptStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStatusRequest.Marshal(b, m, deterministic)
-}
-func (m *GCTScriptStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStatusRequest.Merge(m, src)
-}
-func (m *GCTScriptStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStatusRequest.Size(m)
-}
-func (m *GCTScriptStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStatusRequest.DiscardUnknown(m)
+func (m *GetOrderbookStreamRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-var xxx_messageInfo_GCTScriptStatusRequest proto.InternalMessageInfo
-
-type GCTScriptListAllRequest struct {
+type GetExchangeOrderbookStreamRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScriptListAllRequest) Reset()         { *m = GCTScriptListAllRequest{} }
-func (m *GCTScriptListAllRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptListAllRequest) ProtoMessage()    {}
-func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+func (m *GetExchangeOrderbookStreamRequest) Reset()         { *m = GetExchangeOrderbookStreamRequest{} }
+func (m *GetExchangeOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeOrderbookStreamRequest) ProtoMessage()    {}
+func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{109}
 }
 
-func (m *GCTScriptListAllRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptListAllRequest.Unmarshal(m, b)
+func (m *GetExchangeOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptListAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptListAllRequest.Marshal(b, m, deterministic)
+func (m *GetExchangeOrderbookStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptListAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptListAllRequest.Merge(m, src)
+func (m *GetExchangeOrderbookStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeOrderbookStreamRequest.Merge(m, src)
 }
-func (m *GCTScriptListAllRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptListAllRequest.Size(m)
+func (m *GetExchangeOrderbookStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Size(m)
 }
-func (m *GCTScriptListAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptListAllRequest.DiscardUnknown(m)
+func (m *GetExchangeOrderbookStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeOrderbookStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptListAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetExchangeOrderbookStreamRequest proto.InternalMessageInfo
 
-type GCTScriptUploadRequest struct {
-	ScriptName           string   `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ScriptData           string   `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
-	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Archived             bool     `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
-	Overwrite            bool     `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetExchangeOrderbookStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
 
-func (m *GCTScriptUploadRequest) Reset()         { *m = GCTScriptUploadRequest{} }
-func (m *GCTScriptUploadRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptUploadRequest) ProtoMessage()    {}
-func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+type GetTickerStreamRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GCTScriptUploadRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptUploadRequest.Unmarshal(m, b)
+func (m *GetTickerStreamRequest) Reset()         { *m = GetTickerStreamRequest{} }
+func (m *GetTickerStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTickerStreamRequest) ProtoMessage()    {}
+func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{110}
 }
-func (m *GCTScriptUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptUploadRequest.Marshal(b, m, deterministic)
+
+func (m *GetTickerStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTickerStreamRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptUploadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptUploadRequest.Merge(m, src)
+func (m *GetTickerStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTickerStreamRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptUploadRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptUploadRequest.Size(m)
+func (m *GetTickerStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTickerStreamRequest.Merge(m, src)
 }
-func (m *GCTScriptUploadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptUploadRequest.DiscardUnknown(m)
+func (m *GetTickerStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTickerStreamRequest.Size(m)
 }
-
-var xxx_messageInfo_GCTScriptUploadRequest proto.InternalMessageInfo
-
-func (m *GCTScriptUploadRequest) GetScriptName() string {
-	if m != nil {
-		return m.ScriptName
-	}
-	return ""
+func (m *GetTickerStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTickerStreamRequest.DiscardUnknown(m)
 }
 
-func (m *GCTScriptUploadRequest) GetScriptData() string {
+var xxx_messageInfo_GetTickerStreamRequest proto.InternalMessageInfo
+
+func (m *GetTickerStreamRequest) GetExchange() string {
 	if m != nil {
-		return m.ScriptData
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScriptUploadRequest) GetData() []byte {
+func (m *GetTickerStreamRequest) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.Data
+		return m.Pair
 	}
 	return nil
 }
 
-func (m *GCTScriptUploadRequest) GetArchived() bool {
-	if m != nil {
-		return m.Archived
-	}
-	return false
-}
-
-func (m *GCTScriptUploadRequest) GetOverwrite() bool {
+func (m *GetTickerStreamRequest) GetAssetType() string {
 	if m != nil {
-		return m.Overwrite
+		return m.AssetType
 	}
-	return false
+	return ""
 }
 
-type GCTScriptReadScriptRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetExchangeTickerStreamRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScriptReadScriptRequest) Reset()         { *m = GCTScriptReadScriptRequest{} }
-func (m *GCTScriptReadScriptRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptReadScriptRequest) ProtoMessage()    {}
-func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{109}
+func (m *GetExchangeTickerStreamRequest) Reset()         { *m = GetExchangeTickerStreamRequest{} }
+func (m *GetExchangeTickerStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeTickerStreamRequest) ProtoMessage()    {}
+func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{111}
 }
 
-func (m *GCTScriptReadScriptRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptReadScriptRequest.Unmarshal(m, b)
+func (m *GetExchangeTickerStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeTickerStreamRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptReadScriptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptReadScriptRequest.Marshal(b, m, deterministic)
+func (m *GetExchangeTickerStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeTickerStreamRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptReadScriptRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptReadScriptRequest.Merge(m, src)
+func (m *GetExchangeTickerStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeTickerStreamRequest.Merge(m, src)
 }
-func (m *GCTScriptReadScriptRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptReadScriptRequest.Size(m)
+func (m *GetExchangeTickerStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeTickerStreamRequest.Size(m)
 }
-func (m *GCTScriptReadScriptRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptReadScriptRequest.DiscardUnknown(m)
+func (m *GetExchangeTickerStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeTickerStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptReadScriptRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetExchangeTickerStreamRequest proto.InternalMessageInfo
 
-func (m *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+func (m *GetExchangeTickerStreamRequest) GetExchange() string {
 	if m != nil {
-		return m.Script
+		return m.Exchange
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptQueryRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetAuditEventRequest struct {
+	StartDate            string   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderBy              string   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScriptQueryRequest) Reset()         { *m = GCTScriptQueryRequest{} }
-func (m *GCTScriptQueryRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptQueryRequest) ProtoMessage()    {}
-func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+func (m *GetAuditEventRequest) Reset()         { *m = GetAuditEventRequest{} }
+func (m *GetAuditEventRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuditEventRequest) ProtoMessage()    {}
+func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{112}
 }
 
-func (m *GCTScriptQueryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptQueryRequest.Unmarshal(m, b)
+func (m *GetAuditEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditEventRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptQueryRequest.Marshal(b, m, deterministic)
+func (m *GetAuditEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditEventRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptQueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptQueryRequest.Merge(m, src)
+func (m *GetAuditEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditEventRequest.Merge(m, src)
 }
-func (m *GCTScriptQueryRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptQueryRequest.Size(m)
+func (m *GetAuditEventRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuditEventRequest.Size(m)
 }
-func (m *GCTScriptQueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptQueryRequest.DiscardUnknown(m)
+func (m *GetAuditEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditEventRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptQueryRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetAuditEventRequest proto.InternalMessageInfo
 
-func (m *GCTScriptQueryRequest) GetScript() *GCTScript {
+func (m *GetAuditEventRequest) GetStartDate() string {
 	if m != nil {
-		return m.Script
+		return m.StartDate
 	}
-	return nil
-}
-
-type GCTScriptAutoLoadRequest struct {
-	Script               string   `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	Status               bool     `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return ""
 }
 
-func (m *GCTScriptAutoLoadRequest) Reset()         { *m = GCTScriptAutoLoadRequest{} }
-func (m *GCTScriptAutoLoadRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptAutoLoadRequest) ProtoMessage()    {}
-func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{111}
+func (m *GetAuditEventRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
 }
 
-func (m *GCTScriptAutoLoadRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptAutoLoadRequest.Unmarshal(m, b)
-}
-func (m *GCTScriptAutoLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptAutoLoadRequest.Marshal(b, m, deterministic)
-}
-func (m *GCTScriptAutoLoadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptAutoLoadRequest.Merge(m, src)
-}
-func (m *GCTScriptAutoLoadRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptAutoLoadRequest.Size(m)
-}
-func (m *GCTScriptAutoLoadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptAutoLoadRequest.DiscardUnknown(m)
+func (m *GetAuditEventRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
 }
 
-var xxx_messageInfo_GCTScriptAutoLoadRequest proto.InternalMessageInfo
-
-func (m *GCTScriptAutoLoadRequest) GetScript() string {
+func (m *GetAuditEventRequest) GetLimit() int32 {
 	if m != nil {
-		return m.Script
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-func (m *GCTScriptAutoLoadRequest) GetStatus() bool {
+func (m *GetAuditEventRequest) GetOffset() int32 {
 	if m != nil {
-		return m.Status
+		return m.Offset
 	}
-	return false
+	return 0
 }
 
-type GCTScriptStatusResponse struct {
-	Status               string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Scripts              []*GCTScript `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type GetAuditEventResponse struct {
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GCTScriptStatusResponse) Reset()         { *m = GCTScriptStatusResponse{} }
-func (m *GCTScriptStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStatusResponse) ProtoMessage()    {}
-func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{112}
+func (m *GetAuditEventResponse) Reset()         { *m = GetAuditEventResponse{} }
+func (m *GetAuditEventResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuditEventResponse) ProtoMessage()    {}
+func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{113}
 }
 
-func (m *GCTScriptStatusResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStatusResponse.Unmarshal(m, b)
+func (m *GetAuditEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditEventResponse.Unmarshal(m, b)
 }
-func (m *GCTScriptStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStatusResponse.Marshal(b, m, deterministic)
+func (m *GetAuditEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditEventResponse.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStatusResponse.Merge(m, src)
+func (m *GetAuditEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditEventResponse.Merge(m, src)
 }
-func (m *GCTScriptStatusResponse) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStatusResponse.Size(m)
+func (m *GetAuditEventResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuditEventResponse.Size(m)
 }
-func (m *GCTScriptStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStatusResponse.DiscardUnknown(m)
+func (m *GetAuditEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditEventResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetAuditEventResponse proto.InternalMessageInfo
 
-func (m *GCTScriptStatusResponse) GetStatus() string {
+func (m *GetAuditEventResponse) GetEvents() []*AuditEvent {
 	if m != nil {
-		return m.Status
+		return m.Events
 	}
-	return ""
+	return nil
 }
 
-func (m *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+type GetHistoricCandlesRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                string        `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string        `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Interval             string        `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetHistoricCandlesRequest) Reset()         { *m = GetHistoricCandlesRequest{} }
+func (m *GetHistoricCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHistoricCandlesRequest) ProtoMessage()    {}
+func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{114}
+}
+
+func (m *GetHistoricCandlesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHistoricCandlesRequest.Unmarshal(m, b)
+}
+func (m *GetHistoricCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHistoricCandlesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetHistoricCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHistoricCandlesRequest.Merge(m, src)
+}
+func (m *GetHistoricCandlesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHistoricCandlesRequest.Size(m)
+}
+func (m *GetHistoricCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHistoricCandlesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHistoricCandlesRequest proto.InternalMessageInfo
+
+func (m *GetHistoricCandlesRequest) GetExchange() string {
 	if m != nil {
-		return m.Scripts
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
 	return nil
 }
 
-type GCTScriptQueryResponse struct {
-	Status               string     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Script               *GCTScript `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
-	Data                 string     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *GetHistoricCandlesRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-func (m *GCTScriptQueryResponse) Reset()         { *m = GCTScriptQueryResponse{} }
-func (m *GCTScriptQueryResponse) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptQueryResponse) ProtoMessage()    {}
-func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{113}
+func (m *GetHistoricCandlesRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
 }
 
-func (m *GCTScriptQueryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptQueryResponse.Unmarshal(m, b)
+func (m *GetHistoricCandlesRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
 }
-func (m *GCTScriptQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptQueryResponse.Marshal(b, m, deterministic)
+
+func (m *GetHistoricCandlesRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
 }
-func (m *GCTScriptQueryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptQueryResponse.Merge(m, src)
+
+type GetHistoricCandlesResponse struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Start                string        `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string        `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Interval             string        `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candle               []*Candle     `protobuf:"bytes,6,rep,name=candle,proto3" json:"candle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *GCTScriptQueryResponse) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptQueryResponse.Size(m)
+
+func (m *GetHistoricCandlesResponse) Reset()         { *m = GetHistoricCandlesResponse{} }
+func (m *GetHistoricCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHistoricCandlesResponse) ProtoMessage()    {}
+func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{115}
 }
-func (m *GCTScriptQueryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptQueryResponse.DiscardUnknown(m)
+
+func (m *GetHistoricCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHistoricCandlesResponse.Unmarshal(m, b)
+}
+func (m *GetHistoricCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHistoricCandlesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetHistoricCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHistoricCandlesResponse.Merge(m, src)
+}
+func (m *GetHistoricCandlesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetHistoricCandlesResponse.Size(m)
+}
+func (m *GetHistoricCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHistoricCandlesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptQueryResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetHistoricCandlesResponse proto.InternalMessageInfo
 
-func (m *GCTScriptQueryResponse) GetStatus() string {
+func (m *GetHistoricCandlesResponse) GetExchange() string {
 	if m != nil {
-		return m.Status
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScriptQueryResponse) GetScript() *GCTScript {
+func (m *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.Script
+		return m.Pair
 	}
 	return nil
 }
 
-func (m *GCTScriptQueryResponse) GetData() string {
+func (m *GetHistoricCandlesResponse) GetStart() string {
 	if m != nil {
-		return m.Data
+		return m.Start
 	}
 	return ""
 }
 
-type GCTScriptGenericResponse struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (m *GetHistoricCandlesResponse) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *GetHistoricCandlesResponse) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *GetHistoricCandlesResponse) GetCandle() []*Candle {
+	if m != nil {
+		return m.Candle
+	}
+	return nil
+}
+
+type Candle struct {
+	Time                 string   `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Low                  float64  `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
+	High                 float64  `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Open                 float64  `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
+	Close                float64  `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume               float64  `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScriptGenericResponse) Reset()         { *m = GCTScriptGenericResponse{} }
-func (m *GCTScriptGenericResponse) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptGenericResponse) ProtoMessage()    {}
-func (*GCTScriptGenericResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{114}
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{116}
 }
 
-func (m *GCTScriptGenericResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptGenericResponse.Unmarshal(m, b)
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Candle.Unmarshal(m, b)
 }
-func (m *GCTScriptGenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptGenericResponse.Marshal(b, m, deterministic)
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptGenericResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptGenericResponse.Merge(m, src)
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
 }
-func (m *GCTScriptGenericResponse) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptGenericResponse.Size(m)
+func (m *Candle) XXX_Size() int {
+	return xxx_messageInfo_Candle.Size(m)
 }
-func (m *GCTScriptGenericResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptGenericResponse.DiscardUnknown(m)
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptGenericResponse proto.InternalMessageInfo
+var xxx_messageInfo_Candle proto.InternalMessageInfo
 
-func (m *GCTScriptGenericResponse) GetStatus() string {
+func (m *Candle) GetTime() string {
 	if m != nil {
-		return m.Status
+		return m.Time
 	}
 	return ""
 }
 
-func (m *GCTScriptGenericResponse) GetData() string {
+func (m *Candle) GetLow() float64 {
 	if m != nil {
-		return m.Data
+		return m.Low
+	}
+	return 0
+}
+
+func (m *Candle) GetHigh() float64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *Candle) GetOpen() float64 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
+}
+
+func (m *Candle) GetClose() float64 {
+	if m != nil {
+		return m.Close
+	}
+	return 0
+}
+
+func (m *Candle) GetVolume() float64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+type DataHistoryJob struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string        `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string        `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartDate            string        `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string        `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Status               string        `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CandlesExpected      int64         `protobuf:"varint,9,opt,name=candles_expected,json=candlesExpected,proto3" json:"candles_expected,omitempty"`
+	CandlesStored        int64         `protobuf:"varint,10,opt,name=candles_stored,json=candlesStored,proto3" json:"candles_stored,omitempty"`
+	MissingRanges        int64         `protobuf:"varint,11,opt,name=missing_ranges,json=missingRanges,proto3" json:"missing_ranges,omitempty"`
+	Progress             float64       `protobuf:"fixed64,12,opt,name=progress,proto3" json:"progress,omitempty"`
+	LastRun              string        `protobuf:"bytes,13,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	Error                string        `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DataHistoryJob) Reset()         { *m = DataHistoryJob{} }
+func (m *DataHistoryJob) String() string { return proto.CompactTextString(m) }
+func (*DataHistoryJob) ProtoMessage()    {}
+func (*DataHistoryJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{117}
+}
+
+func (m *DataHistoryJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataHistoryJob.Unmarshal(m, b)
+}
+func (m *DataHistoryJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataHistoryJob.Marshal(b, m, deterministic)
+}
+func (m *DataHistoryJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataHistoryJob.Merge(m, src)
+}
+func (m *DataHistoryJob) XXX_Size() int {
+	return xxx_messageInfo_DataHistoryJob.Size(m)
+}
+func (m *DataHistoryJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataHistoryJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataHistoryJob proto.InternalMessageInfo
+
+func (m *DataHistoryJob) GetId() string {
+	if m != nil {
+		return m.Id
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*GetInfoRequest)(nil), "gctrpc.GetInfoRequest")
-	proto.RegisterType((*GetInfoResponse)(nil), "gctrpc.GetInfoResponse")
-	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetInfoResponse.RpcEndpointsEntry")
-	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetInfoResponse.SubsystemStatusEntry")
-	proto.RegisterType((*GetCommunicationRelayersRequest)(nil), "gctrpc.GetCommunicationRelayersRequest")
-	proto.RegisterType((*CommunicationRelayer)(nil), "gctrpc.CommunicationRelayer")
-	proto.RegisterType((*GetCommunicationRelayersResponse)(nil), "gctrpc.GetCommunicationRelayersResponse")
-	proto.RegisterMapType((map[string]*CommunicationRelayer)(nil), "gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry")
-	proto.RegisterType((*GenericSubsystemRequest)(nil), "gctrpc.GenericSubsystemRequest")
-	proto.RegisterType((*GenericSubsystemResponse)(nil), "gctrpc.GenericSubsystemResponse")
-	proto.RegisterType((*GetSubsystemsRequest)(nil), "gctrpc.GetSubsystemsRequest")
-	proto.RegisterType((*GetSusbsytemsResponse)(nil), "gctrpc.GetSusbsytemsResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry")
-	proto.RegisterType((*GetRPCEndpointsRequest)(nil), "gctrpc.GetRPCEndpointsRequest")
-	proto.RegisterType((*RPCEndpoint)(nil), "gctrpc.RPCEndpoint")
-	proto.RegisterType((*GetRPCEndpointsResponse)(nil), "gctrpc.GetRPCEndpointsResponse")
-	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetRPCEndpointsResponse.EndpointsEntry")
-	proto.RegisterType((*GenericExchangeNameRequest)(nil), "gctrpc.GenericExchangeNameRequest")
-	proto.RegisterType((*GenericExchangeNameResponse)(nil), "gctrpc.GenericExchangeNameResponse")
-	proto.RegisterType((*GetExchangesRequest)(nil), "gctrpc.GetExchangesRequest")
-	proto.RegisterType((*GetExchangesResponse)(nil), "gctrpc.GetExchangesResponse")
-	proto.RegisterType((*GetExchangeOTPReponse)(nil), "gctrpc.GetExchangeOTPReponse")
-	proto.RegisterType((*GetExchangeOTPsRequest)(nil), "gctrpc.GetExchangeOTPsRequest")
-	proto.RegisterType((*GetExchangeOTPsResponse)(nil), "gctrpc.GetExchangeOTPsResponse")
-	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetExchangeOTPsResponse.OtpCodesEntry")
-	proto.RegisterType((*DisableExchangeRequest)(nil), "gctrpc.DisableExchangeRequest")
-	proto.RegisterType((*PairsSupported)(nil), "gctrpc.PairsSupported")
-	proto.RegisterType((*GetExchangeInfoResponse)(nil), "gctrpc.GetExchangeInfoResponse")
-	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry")
-	proto.RegisterType((*GetTickerRequest)(nil), "gctrpc.GetTickerRequest")
-	proto.RegisterType((*CurrencyPair)(nil), "gctrpc.CurrencyPair")
-	proto.RegisterType((*TickerResponse)(nil), "gctrpc.TickerResponse")
-	proto.RegisterType((*GetTickersRequest)(nil), "gctrpc.GetTickersRequest")
-	proto.RegisterType((*Tickers)(nil), "gctrpc.Tickers")
-	proto.RegisterType((*GetTickersResponse)(nil), "gctrpc.GetTickersResponse")
-	proto.RegisterType((*GetOrderbookRequest)(nil), "gctrpc.GetOrderbookRequest")
-	proto.RegisterType((*OrderbookItem)(nil), "gctrpc.OrderbookItem")
-	proto.RegisterType((*OrderbookResponse)(nil), "gctrpc.OrderbookResponse")
-	proto.RegisterType((*GetOrderbooksRequest)(nil), "gctrpc.GetOrderbooksRequest")
-	proto.RegisterType((*Orderbooks)(nil), "gctrpc.Orderbooks")
-	proto.RegisterType((*GetOrderbooksResponse)(nil), "gctrpc.GetOrderbooksResponse")
-	proto.RegisterType((*GetAccountInfoRequest)(nil), "gctrpc.GetAccountInfoRequest")
-	proto.RegisterType((*Account)(nil), "gctrpc.Account")
-	proto.RegisterType((*AccountCurrencyInfo)(nil), "gctrpc.AccountCurrencyInfo")
-	proto.RegisterType((*GetAccountInfoResponse)(nil), "gctrpc.GetAccountInfoResponse")
-	proto.RegisterType((*GetConfigRequest)(nil), "gctrpc.GetConfigRequest")
-	proto.RegisterType((*GetConfigResponse)(nil), "gctrpc.GetConfigResponse")
-	proto.RegisterType((*PortfolioAddress)(nil), "gctrpc.PortfolioAddress")
-	proto.RegisterType((*GetPortfolioRequest)(nil), "gctrpc.GetPortfolioRequest")
-	proto.RegisterType((*GetPortfolioResponse)(nil), "gctrpc.GetPortfolioResponse")
-	proto.RegisterType((*GetPortfolioSummaryRequest)(nil), "gctrpc.GetPortfolioSummaryRequest")
-	proto.RegisterType((*Coin)(nil), "gctrpc.Coin")
-	proto.RegisterType((*OfflineCoinSummary)(nil), "gctrpc.OfflineCoinSummary")
-	proto.RegisterType((*OnlineCoinSummary)(nil), "gctrpc.OnlineCoinSummary")
-	proto.RegisterType((*OfflineCoins)(nil), "gctrpc.OfflineCoins")
-	proto.RegisterType((*OnlineCoins)(nil), "gctrpc.OnlineCoins")
-	proto.RegisterMapType((map[string]*OnlineCoinSummary)(nil), "gctrpc.OnlineCoins.CoinsEntry")
-	proto.RegisterType((*GetPortfolioSummaryResponse)(nil), "gctrpc.GetPortfolioSummaryResponse")
-	proto.RegisterMapType((map[string]*OfflineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry")
-	proto.RegisterMapType((map[string]*OnlineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry")
-	proto.RegisterType((*AddPortfolioAddressRequest)(nil), "gctrpc.AddPortfolioAddressRequest")
-	proto.RegisterType((*AddPortfolioAddressResponse)(nil), "gctrpc.AddPortfolioAddressResponse")
-	proto.RegisterType((*RemovePortfolioAddressRequest)(nil), "gctrpc.RemovePortfolioAddressRequest")
-	proto.RegisterType((*RemovePortfolioAddressResponse)(nil), "gctrpc.RemovePortfolioAddressResponse")
-	proto.RegisterType((*GetForexProvidersRequest)(nil), "gctrpc.GetForexProvidersRequest")
-	proto.RegisterType((*ForexProvider)(nil), "gctrpc.ForexProvider")
-	proto.RegisterType((*GetForexProvidersResponse)(nil), "gctrpc.GetForexProvidersResponse")
-	proto.RegisterType((*GetForexRatesRequest)(nil), "gctrpc.GetForexRatesRequest")
-	proto.RegisterType((*ForexRatesConversion)(nil), "gctrpc.ForexRatesConversion")
-	proto.RegisterType((*GetForexRatesResponse)(nil), "gctrpc.GetForexRatesResponse")
-	proto.RegisterType((*OrderDetails)(nil), "gctrpc.OrderDetails")
-	proto.RegisterType((*GetOrdersRequest)(nil), "gctrpc.GetOrdersRequest")
-	proto.RegisterType((*GetOrdersResponse)(nil), "gctrpc.GetOrdersResponse")
-	proto.RegisterType((*GetOrderRequest)(nil), "gctrpc.GetOrderRequest")
-	proto.RegisterType((*SubmitOrderRequest)(nil), "gctrpc.SubmitOrderRequest")
-	proto.RegisterType((*SubmitOrderResponse)(nil), "gctrpc.SubmitOrderResponse")
-	proto.RegisterType((*SimulateOrderRequest)(nil), "gctrpc.SimulateOrderRequest")
-	proto.RegisterType((*SimulateOrderResponse)(nil), "gctrpc.SimulateOrderResponse")
-	proto.RegisterType((*WhaleBombRequest)(nil), "gctrpc.WhaleBombRequest")
-	proto.RegisterType((*CancelOrderRequest)(nil), "gctrpc.CancelOrderRequest")
-	proto.RegisterType((*CancelOrderResponse)(nil), "gctrpc.CancelOrderResponse")
-	proto.RegisterType((*CancelAllOrdersRequest)(nil), "gctrpc.CancelAllOrdersRequest")
-	proto.RegisterType((*CancelAllOrdersResponse)(nil), "gctrpc.CancelAllOrdersResponse")
-	proto.RegisterType((*CancelAllOrdersResponse_Orders)(nil), "gctrpc.CancelAllOrdersResponse.Orders")
-	proto.RegisterMapType((map[string]string)(nil), "gctrpc.CancelAllOrdersResponse.Orders.OrderStatusEntry")
-	proto.RegisterType((*GetEventsRequest)(nil), "gctrpc.GetEventsRequest")
-	proto.RegisterType((*ConditionParams)(nil), "gctrpc.ConditionParams")
-	proto.RegisterType((*GetEventsResponse)(nil), "gctrpc.GetEventsResponse")
-	proto.RegisterType((*AddEventRequest)(nil), "gctrpc.AddEventRequest")
-	proto.RegisterType((*AddEventResponse)(nil), "gctrpc.AddEventResponse")
-	proto.RegisterType((*RemoveEventRequest)(nil), "gctrpc.RemoveEventRequest")
-	proto.RegisterType((*RemoveEventResponse)(nil), "gctrpc.RemoveEventResponse")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressesRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressesRequest")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressesResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse")
-	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressRequest")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressResponse")
-	proto.RegisterType((*WithdrawCurrencyRequest)(nil), "gctrpc.WithdrawCurrencyRequest")
-	proto.RegisterType((*WithdrawResponse)(nil), "gctrpc.WithdrawResponse")
-	proto.RegisterType((*GetLoggerDetailsRequest)(nil), "gctrpc.GetLoggerDetailsRequest")
-	proto.RegisterType((*GetLoggerDetailsResponse)(nil), "gctrpc.GetLoggerDetailsResponse")
-	proto.RegisterType((*SetLoggerDetailsRequest)(nil), "gctrpc.SetLoggerDetailsRequest")
-	proto.RegisterType((*GetExchangePairsRequest)(nil), "gctrpc.GetExchangePairsRequest")
-	proto.RegisterType((*GetExchangePairsResponse)(nil), "gctrpc.GetExchangePairsResponse")
-	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangePairsResponse.SupportedAssetsEntry")
-	proto.RegisterType((*ExchangePairRequest)(nil), "gctrpc.ExchangePairRequest")
-	proto.RegisterType((*GetOrderbookStreamRequest)(nil), "gctrpc.GetOrderbookStreamRequest")
-	proto.RegisterType((*GetExchangeOrderbookStreamRequest)(nil), "gctrpc.GetExchangeOrderbookStreamRequest")
-	proto.RegisterType((*GetTickerStreamRequest)(nil), "gctrpc.GetTickerStreamRequest")
-	proto.RegisterType((*GetExchangeTickerStreamRequest)(nil), "gctrpc.GetExchangeTickerStreamRequest")
-	proto.RegisterType((*GetAuditEventRequest)(nil), "gctrpc.GetAuditEventRequest")
-	proto.RegisterType((*GetAuditEventResponse)(nil), "gctrpc.GetAuditEventResponse")
-	proto.RegisterType((*GetHistoricCandlesRequest)(nil), "gctrpc.GetHistoricCandlesRequest")
-	proto.RegisterType((*GetHistoricCandlesResponse)(nil), "gctrpc.GetHistoricCandlesResponse")
-	proto.RegisterType((*Candle)(nil), "gctrpc.Candle")
-	proto.RegisterType((*AuditEvent)(nil), "gctrpc.AuditEvent")
-	proto.RegisterType((*GCTScript)(nil), "gctrpc.GCTScript")
-	proto.RegisterType((*GCTScriptExecuteRequest)(nil), "gctrpc.GCTScriptExecuteRequest")
-	proto.RegisterType((*GCTScriptStopRequest)(nil), "gctrpc.GCTScriptStopRequest")
-	proto.RegisterType((*GCTScriptStopAllRequest)(nil), "gctrpc.GCTScriptStopAllRequest")
-	proto.RegisterType((*GCTScriptStatusRequest)(nil), "gctrpc.GCTScriptStatusRequest")
-	proto.RegisterType((*GCTScriptListAllRequest)(nil), "gctrpc.GCTScriptListAllRequest")
-	proto.RegisterType((*GCTScriptUploadRequest)(nil), "gctrpc.GCTScriptUploadRequest")
-	proto.RegisterType((*GCTScriptReadScriptRequest)(nil), "gctrpc.GCTScriptReadScriptRequest")
-	proto.RegisterType((*GCTScriptQueryRequest)(nil), "gctrpc.GCTScriptQueryRequest")
-	proto.RegisterType((*GCTScriptAutoLoadRequest)(nil), "gctrpc.GCTScriptAutoLoadRequest")
-	proto.RegisterType((*GCTScriptStatusResponse)(nil), "gctrpc.GCTScriptStatusResponse")
-	proto.RegisterType((*GCTScriptQueryResponse)(nil), "gctrpc.GCTScriptQueryResponse")
-	proto.RegisterType((*GCTScriptGenericResponse)(nil), "gctrpc.GCTScriptGenericResponse")
+func (m *DataHistoryJob) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
-
-var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 5495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3c, 0x4d, 0x6f, 0x1c, 0x57,
-	0x72, 0x98, 0x21, 0x45, 0x72, 0x8a, 0x5f, 0xc3, 0xc7, 0xaf, 0xd1, 0x88, 0x12, 0xa5, 0xf6, 0x5a,
-	0x96, 0xfc, 0x41, 0xd9, 0xb2, 0x36, 0xeb, 0xac, 0x9d, 0xdd, 0x50, 0x94, 0x4c, 0x6b, 0xad, 0xb5,
-	0xb8, 0x4d, 0x5a, 0x06, 0xbc, 0x81, 0x27, 0xcd, 0xe9, 0xc7, 0x61, 0x47, 0x3d, 0xdd, 0xed, 0xee,
-	0x37, 0xa4, 0xe8, 0x4d, 0x90, 0x85, 0x91, 0x04, 0x39, 0x04, 0x9b, 0xc3, 0x22, 0x40, 0x02, 0xe4,
-	0x92, 0x9c, 0x82, 0x00, 0xb9, 0x04, 0x39, 0xe5, 0xb0, 0xc8, 0x35, 0xc8, 0x31, 0x97, 0xfc, 0x80,
-	0x20, 0xb7, 0x24, 0x40, 0x80, 0x5c, 0x72, 0x0a, 0x5e, 0xbd, 0x8f, 0x7e, 0xaf, 0xbb, 0x67, 0x38,
-	0xb4, 0xb5, 0xde, 0x8b, 0x34, 0x5d, 0xaf, 0x5e, 0x55, 0xbd, 0x7a, 0xd5, 0xf5, 0xaa, 0x5e, 0x55,
-	0x13, 0x1a, 0x69, 0xd2, 0xdd, 0x4a, 0xd2, 0x98, 0xc5, 0x64, 0xaa, 0xd7, 0x65, 0x69, 0xd2, 0x6d,
-	0x6f, 0xf4, 0xe2, 0xb8, 0x17, 0xd2, 0x3b, 0x5e, 0x12, 0xdc, 0xf1, 0xa2, 0x28, 0x66, 0x1e, 0x0b,
-	0xe2, 0x28, 0x13, 0x58, 0x4e, 0x13, 0x16, 0x76, 0x29, 0x7b, 0x14, 0x1d, 0xc5, 0x2e, 0xfd, 0x7c,
-	0x40, 0x33, 0xe6, 0xfc, 0xc3, 0x24, 0x2c, 0x6a, 0x50, 0x96, 0xc4, 0x51, 0x46, 0xc9, 0x1a, 0x4c,
-	0x0d, 0x12, 0x16, 0xf4, 0x69, 0xab, 0x76, 0xbd, 0x76, 0xab, 0xe1, 0xca, 0x27, 0x72, 0x07, 0x96,
-	0xbd, 0x13, 0x2f, 0x08, 0xbd, 0xc3, 0x90, 0x76, 0xe8, 0xf3, 0xee, 0xb1, 0x17, 0xf5, 0x68, 0xd6,
-	0xaa, 0x5f, 0xaf, 0xdd, 0x9a, 0x70, 0x89, 0x1e, 0x7a, 0xa8, 0x46, 0xc8, 0x6b, 0xb0, 0x44, 0x23,
-	0x0e, 0xf2, 0x0d, 0xf4, 0x09, 0x44, 0x6f, 0xca, 0x81, 0x1c, 0xf9, 0x1e, 0xac, 0xf9, 0xf4, 0xc8,
-	0x1b, 0x84, 0xac, 0x73, 0x14, 0xa7, 0xf4, 0x79, 0x27, 0x49, 0xe3, 0x93, 0xc0, 0xa7, 0x69, 0x6b,
-	0x12, 0xa5, 0x58, 0x91, 0xa3, 0xef, 0xf3, 0xc1, 0x3d, 0x39, 0x46, 0xee, 0xc2, 0xaa, 0x9e, 0x15,
-	0x78, 0xac, 0xd3, 0x1d, 0xa4, 0x29, 0x8d, 0xba, 0x67, 0xad, 0x4b, 0x38, 0x69, 0x59, 0x4d, 0x0a,
-	0x3c, 0xb6, 0x23, 0x87, 0xc8, 0x27, 0xd0, 0xcc, 0x06, 0x87, 0xd9, 0x59, 0xc6, 0x68, 0xbf, 0x93,
-	0x31, 0x8f, 0x0d, 0xb2, 0xd6, 0xd4, 0xf5, 0x89, 0x5b, 0xb3, 0x77, 0x5f, 0xdf, 0x12, 0x6a, 0xdc,
-	0x2a, 0xa8, 0x64, 0x6b, 0x5f, 0xe1, 0xef, 0x23, 0xfa, 0xc3, 0x88, 0xa5, 0x67, 0xee, 0x62, 0x66,
-	0x43, 0xc9, 0x47, 0x30, 0x9f, 0x26, 0xdd, 0x0e, 0x8d, 0xfc, 0x24, 0x0e, 0x22, 0x96, 0xb5, 0xa6,
-	0x91, 0xea, 0xed, 0x61, 0x54, 0xdd, 0xa4, 0xfb, 0x50, 0xe1, 0x0a, 0x92, 0x73, 0xa9, 0x01, 0x6a,
-	0xdf, 0x87, 0x95, 0x2a, 0xc6, 0xa4, 0x09, 0x13, 0xcf, 0xe8, 0x99, 0xdc, 0x1d, 0xfe, 0x93, 0xac,
-	0xc0, 0xa5, 0x13, 0x2f, 0x1c, 0x50, 0xdc, 0x8c, 0x19, 0x57, 0x3c, 0x7c, 0xb7, 0xfe, 0x4e, 0xad,
-	0x7d, 0x00, 0x4b, 0x25, 0x36, 0x15, 0x04, 0x6e, 0x9b, 0x04, 0x66, 0xef, 0x2e, 0x2b, 0x91, 0xdd,
-	0xbd, 0x1d, 0x35, 0xd7, 0xa0, 0xea, 0xdc, 0x80, 0xcd, 0x5d, 0xca, 0x76, 0xe2, 0x7e, 0x7f, 0x10,
-	0x05, 0x5d, 0xb4, 0x31, 0x97, 0x86, 0xde, 0x19, 0x4d, 0x33, 0x65, 0x59, 0x1f, 0xc1, 0x4a, 0xd5,
-	0x38, 0x69, 0xc1, 0xb4, 0xdc, 0x7b, 0xe4, 0x3f, 0xe3, 0xaa, 0x47, 0xb2, 0x01, 0x8d, 0x6e, 0x1c,
-	0x45, 0xb4, 0xcb, 0xa8, 0x2f, 0x17, 0x92, 0x03, 0x9c, 0x3f, 0xaa, 0xc3, 0xf5, 0xe1, 0x3c, 0xa5,
-	0xe9, 0x7e, 0x01, 0x6b, 0x5d, 0x13, 0xa1, 0x93, 0x4a, 0x8c, 0x56, 0x0d, 0xb7, 0x62, 0xc7, 0xd8,
-	0x8a, 0x91, 0x94, 0xb6, 0x2a, 0x47, 0xc5, 0x26, 0xad, 0x76, 0xab, 0xc6, 0xda, 0x47, 0xd0, 0x1e,
-	0x3e, 0xa9, 0x42, 0xe5, 0x77, 0x6d, 0x95, 0x6f, 0x28, 0xd1, 0xaa, 0x88, 0x98, 0xba, 0xff, 0x0e,
-	0xac, 0xef, 0xd2, 0x88, 0xa6, 0x41, 0x57, 0x1b, 0x87, 0xd4, 0x39, 0xd7, 0xa0, 0xb6, 0x49, 0xc9,
-	0x2a, 0x07, 0x38, 0x6d, 0x68, 0x95, 0x27, 0x8a, 0xe5, 0x3a, 0x6b, 0xb0, 0xb2, 0x4b, 0x99, 0x86,
-	0xeb, 0x5d, 0xfc, 0x45, 0x0d, 0x56, 0x71, 0x20, 0x3b, 0xcc, 0xce, 0xc4, 0x80, 0x54, 0xf5, 0x6f,
-	0xc3, 0x92, 0x26, 0x9d, 0xa9, 0xd7, 0x48, 0x68, 0xf9, 0x6d, 0x43, 0xcb, 0xe5, 0x99, 0xf9, 0xcb,
-	0x94, 0x99, 0x6f, 0x53, 0xfe, 0x4e, 0x4a, 0x70, 0x7b, 0x07, 0x56, 0x2b, 0x51, 0x2f, 0x62, 0xff,
-	0x4e, 0x0b, 0xd6, 0x76, 0x29, 0x33, 0xcc, 0xd8, 0x30, 0xd0, 0x59, 0x03, 0xcc, 0xed, 0x32, 0x63,
-	0x5e, 0xca, 0x72, 0xbb, 0x94, 0x8f, 0xe4, 0x65, 0x58, 0x08, 0x83, 0x8c, 0xd1, 0xa8, 0xe3, 0xf9,
-	0x7e, 0x4a, 0x33, 0xe1, 0xf2, 0x1a, 0xee, 0xbc, 0x80, 0x6e, 0x0b, 0xa0, 0xf3, 0x8f, 0x35, 0xbe,
-	0x31, 0x05, 0x56, 0x52, 0x59, 0x8f, 0xa1, 0x91, 0x7b, 0x05, 0xa1, 0xa4, 0x2d, 0x43, 0x49, 0x55,
-	0x73, 0xb6, 0x0a, 0xae, 0x21, 0x27, 0xd0, 0xfe, 0x11, 0x2c, 0xbc, 0xe8, 0x17, 0xfa, 0x1d, 0x68,
-	0x4b, 0xdb, 0x50, 0x1e, 0xf9, 0x23, 0xaf, 0x4f, 0x95, 0x5d, 0xb5, 0x61, 0x46, 0x39, 0x70, 0xc9,
-	0x43, 0x3f, 0x3b, 0x57, 0xe1, 0x4a, 0xe5, 0x4c, 0x69, 0x58, 0x77, 0x60, 0x79, 0x97, 0x32, 0xed,
-	0xe6, 0x15, 0xc5, 0xa1, 0x5e, 0xc0, 0xb9, 0x87, 0x96, 0x68, 0x4c, 0x90, 0x2a, 0xdc, 0x80, 0x46,
-	0x7e, 0x88, 0x48, 0xdb, 0xd6, 0x00, 0xe7, 0x2e, 0x9a, 0xa9, 0x9a, 0xf5, 0xe4, 0x60, 0xcf, 0xa5,
-	0x62, 0xda, 0x65, 0x98, 0x89, 0x59, 0xd2, 0xe9, 0xc6, 0xbe, 0x12, 0x7d, 0x3a, 0x66, 0xc9, 0x4e,
-	0xec, 0x53, 0x69, 0x1a, 0xc6, 0x1c, 0x6d, 0x1a, 0x7f, 0x2d, 0xb6, 0xd2, 0x1e, 0x92, 0x72, 0xfc,
-	0x00, 0x1a, 0x8a, 0xa0, 0xda, 0xca, 0x37, 0x8c, 0xad, 0xac, 0x9a, 0xb3, 0xf5, 0x44, 0x70, 0x94,
-	0x3b, 0x39, 0x23, 0x05, 0xc8, 0xda, 0xef, 0xc2, 0xbc, 0x35, 0x74, 0x9e, 0x65, 0x37, 0xcc, 0x2d,
-	0xbb, 0x07, 0x6b, 0x0f, 0x82, 0xcc, 0x3c, 0x71, 0xc7, 0xd9, 0xae, 0xcf, 0x60, 0x61, 0xcf, 0x0b,
-	0xd2, 0x6c, 0x7f, 0x90, 0x24, 0x31, 0x9a, 0xf7, 0x2b, 0xb0, 0x98, 0x1f, 0xeb, 0x09, 0x1f, 0x93,
-	0x93, 0x16, 0x34, 0x18, 0x67, 0x90, 0x97, 0x60, 0x5e, 0x1d, 0xe7, 0x02, 0x4d, 0x88, 0x34, 0x27,
-	0x81, 0x88, 0xe4, 0x7c, 0x39, 0x69, 0xa9, 0xce, 0x0a, 0x2c, 0x08, 0x4c, 0x46, 0x9e, 0x0e, 0x2b,
-	0xf0, 0xb7, 0x69, 0x08, 0x75, 0xfb, 0x38, 0x68, 0xc1, 0xf4, 0x09, 0x4d, 0x0f, 0xe3, 0x8c, 0x62,
-	0xcc, 0x30, 0xe3, 0xaa, 0x47, 0x2e, 0xc8, 0x20, 0x0b, 0xa2, 0x5e, 0x27, 0xf3, 0x22, 0xff, 0x30,
-	0x7e, 0x8e, 0x11, 0xc2, 0x8c, 0x3b, 0x87, 0xc0, 0x7d, 0x01, 0x23, 0x37, 0x60, 0xee, 0x98, 0xb1,
-	0xa4, 0xc3, 0x43, 0x97, 0x78, 0xc0, 0x64, 0x40, 0x30, 0xcb, 0x61, 0x07, 0x02, 0xc4, 0x5f, 0x6c,
-	0x44, 0x19, 0x64, 0x34, 0xf5, 0x7a, 0x34, 0x62, 0xad, 0x29, 0xf1, 0x62, 0x73, 0xe8, 0xc7, 0x0a,
-	0x48, 0xae, 0x02, 0x20, 0x5a, 0x92, 0xc6, 0xcf, 0xcf, 0x5a, 0xd3, 0xc2, 0xf4, 0x38, 0x64, 0x8f,
-	0x03, 0xb8, 0xfe, 0x0e, 0xbd, 0x8c, 0xaa, 0xd0, 0x23, 0xa0, 0x59, 0x6b, 0x46, 0xe8, 0x8f, 0x83,
-	0x77, 0x34, 0x94, 0x74, 0x78, 0xdc, 0x21, 0xb5, 0xde, 0xf1, 0xb2, 0x8c, 0xb2, 0xac, 0xd5, 0x40,
-	0x03, 0xba, 0x57, 0x61, 0x40, 0x85, 0xf8, 0x43, 0xce, 0xdb, 0xc6, 0x69, 0x3a, 0xfe, 0xb0, 0xa0,
-	0x3c, 0xde, 0xf2, 0x06, 0xec, 0x98, 0x46, 0x8c, 0x9f, 0x1e, 0x9c, 0x49, 0x12, 0xb4, 0x00, 0x75,
-	0xd3, 0xb4, 0x06, 0xb6, 0x93, 0xa0, 0xfd, 0x29, 0x0f, 0x2e, 0xca, 0x54, 0x2b, 0x4c, 0xf0, 0x75,
-	0xdb, 0x95, 0xac, 0x29, 0x61, 0x6d, 0x3b, 0x32, 0x4d, 0xf3, 0x14, 0x9a, 0xbb, 0x94, 0x1d, 0x04,
-	0xdd, 0x67, 0x34, 0x1d, 0xc3, 0x28, 0xc9, 0x2d, 0x98, 0xe4, 0x16, 0x25, 0x19, 0xac, 0xe8, 0x93,
-	0x50, 0x46, 0x6c, 0x9c, 0x91, 0x8b, 0x18, 0x7c, 0x2f, 0x50, 0x73, 0x1d, 0x76, 0x96, 0x08, 0xbb,
-	0x68, 0xb8, 0x0d, 0x84, 0x1c, 0x9c, 0x25, 0xd4, 0x79, 0x0a, 0x73, 0xe6, 0x24, 0xee, 0x34, 0x7c,
-	0x1a, 0x06, 0xfd, 0x80, 0xd1, 0x54, 0x39, 0x0d, 0x0d, 0xe0, 0xf6, 0xc8, 0xb7, 0x48, 0xda, 0x31,
-	0xfe, 0xe6, 0xef, 0xdb, 0xe7, 0x83, 0x98, 0x29, 0xda, 0xe2, 0xc1, 0xf9, 0xb3, 0x3a, 0x2c, 0xa8,
-	0xe5, 0x48, 0x63, 0x56, 0x32, 0xd7, 0xce, 0x95, 0xf9, 0x06, 0xcc, 0x85, 0x5e, 0xc6, 0x3a, 0x83,
-	0xc4, 0xf7, 0x54, 0x68, 0x33, 0xe1, 0xce, 0x72, 0xd8, 0xc7, 0x02, 0xc4, 0x2d, 0x5a, 0x45, 0xae,
-	0xf8, 0x6e, 0x49, 0xee, 0x73, 0x5d, 0x73, 0x31, 0x04, 0x26, 0xf9, 0x1c, 0xb4, 0xf6, 0x9a, 0x8b,
-	0xbf, 0x39, 0xec, 0x38, 0xe8, 0x1d, 0xa3, 0x75, 0xd7, 0x5c, 0xfc, 0xcd, 0x77, 0x30, 0x8c, 0x4f,
-	0xd1, 0x96, 0x6b, 0x2e, 0xff, 0xc9, 0x21, 0x87, 0x81, 0x8f, 0xa6, 0x5b, 0x73, 0xf9, 0x4f, 0x0e,
-	0xf1, 0xb2, 0x67, 0x68, 0xa8, 0x35, 0x97, 0xff, 0xe4, 0x51, 0xff, 0x49, 0x1c, 0x0e, 0xfa, 0xb4,
-	0xd5, 0x40, 0xa0, 0x7c, 0x22, 0x57, 0xa0, 0x91, 0xa4, 0x41, 0x97, 0x76, 0x3c, 0x76, 0x8c, 0xc6,
-	0x54, 0x73, 0x67, 0x10, 0xb0, 0xcd, 0x8e, 0x9d, 0x65, 0x58, 0xd2, 0x1b, 0xad, 0xbd, 0xe7, 0x27,
-	0x30, 0x2d, 0x21, 0x23, 0x37, 0xfd, 0x4d, 0x98, 0x66, 0x02, 0xad, 0x55, 0xc7, 0xb7, 0x40, 0x1b,
-	0x96, 0xad, 0x69, 0x57, 0xa1, 0x39, 0xdf, 0x07, 0x62, 0x72, 0x93, 0x1b, 0x71, 0x3b, 0xa7, 0x23,
-	0xdc, 0xf1, 0xa2, 0x4d, 0x27, 0xcb, 0x09, 0x7c, 0x81, 0x87, 0xd1, 0x93, 0xd4, 0xe7, 0x8e, 0x24,
-	0x7e, 0xf6, 0x8d, 0x9a, 0xe6, 0x0f, 0x61, 0x5e, 0x33, 0x7e, 0xc4, 0x68, 0x9f, 0x2b, 0xdc, 0xeb,
-	0xc7, 0x83, 0x88, 0x21, 0xcf, 0x9a, 0x2b, 0x9f, 0xb8, 0x05, 0xa2, 0x7e, 0x91, 0x65, 0xcd, 0x15,
-	0x0f, 0x64, 0x01, 0xea, 0x81, 0x2f, 0x93, 0xa7, 0x7a, 0xe0, 0x3b, 0xff, 0x57, 0x83, 0x25, 0x63,
-	0x21, 0x17, 0x36, 0xca, 0x92, 0xc5, 0xd5, 0x2b, 0x2c, 0xee, 0x36, 0x4c, 0x1e, 0x06, 0x3e, 0xcf,
-	0xd9, 0xb8, 0x5e, 0x57, 0x15, 0x39, 0x6b, 0x1d, 0x2e, 0xa2, 0x70, 0x54, 0x2f, 0x7b, 0x96, 0xb5,
-	0x26, 0x47, 0xa2, 0x72, 0x94, 0xd2, 0xfb, 0x70, 0xa9, 0xfc, 0x3e, 0xd8, 0xba, 0x9c, 0x2a, 0xea,
-	0x52, 0x44, 0xab, 0x9a, 0xb6, 0xb6, 0xbc, 0x2e, 0x40, 0x0e, 0x1c, 0xb9, 0xad, 0xbf, 0x0e, 0x10,
-	0x6b, 0x4c, 0x69, 0x7f, 0x97, 0x4b, 0x42, 0x6b, 0x13, 0x34, 0x90, 0x9d, 0x0f, 0x31, 0xd4, 0x30,
-	0x99, 0x4b, 0xe5, 0xdf, 0xb5, 0x68, 0x0a, 0x5b, 0x24, 0x25, 0x9a, 0x99, 0x45, 0xec, 0x6d, 0x24,
-	0xb6, 0xdd, 0xed, 0xf2, 0xad, 0x37, 0x12, 0xf3, 0x91, 0x67, 0xf8, 0x53, 0x98, 0x96, 0x33, 0xa4,
-	0x59, 0x08, 0x84, 0x7a, 0xe0, 0x93, 0x77, 0x01, 0x8c, 0x73, 0x48, 0xac, 0xeb, 0x8a, 0x92, 0x41,
-	0x4e, 0x52, 0xd6, 0x80, 0xec, 0x0c, 0x74, 0xe7, 0x08, 0x96, 0x2b, 0x50, 0xb8, 0x28, 0x3a, 0xad,
-	0x96, 0xa2, 0xa8, 0x67, 0xb2, 0x09, 0xb3, 0x2c, 0x66, 0x5e, 0xd8, 0xc9, 0x4f, 0x88, 0x9a, 0x0b,
-	0x08, 0x7a, 0xca, 0x21, 0xe8, 0xa0, 0xe2, 0x50, 0x58, 0x2e, 0x77, 0x50, 0x71, 0xe8, 0x3b, 0x1e,
-	0x06, 0x5e, 0xd6, 0xa2, 0xa5, 0x0a, 0x47, 0x6d, 0xd9, 0x6b, 0x30, 0xe3, 0x89, 0x29, 0x6a, 0x61,
-	0x8b, 0x85, 0x85, 0xb9, 0x1a, 0xc1, 0x21, 0x78, 0x02, 0xed, 0xc4, 0xd1, 0x51, 0xd0, 0x53, 0xd6,
-	0xf1, 0x0a, 0x3a, 0x2b, 0x05, 0xcb, 0x63, 0x12, 0xdf, 0x63, 0x1e, 0x72, 0x9b, 0x73, 0xf1, 0xb7,
-	0xf3, 0x87, 0x35, 0x68, 0xee, 0xc5, 0x29, 0x3b, 0x8a, 0xc3, 0x20, 0x96, 0xe1, 0x3d, 0x0f, 0x47,
-	0x54, 0xf8, 0x2f, 0xe3, 0x48, 0xf9, 0xc8, 0x3d, 0x64, 0x37, 0x0e, 0x22, 0x61, 0xab, 0x75, 0xa9,
-	0xa0, 0x38, 0x88, 0xb8, 0xa9, 0x92, 0xeb, 0x30, 0xeb, 0xd3, 0xac, 0x9b, 0x06, 0x09, 0x4f, 0xe7,
-	0xa4, 0x5b, 0x30, 0x41, 0x9c, 0xf0, 0xa1, 0x17, 0x7a, 0x51, 0x97, 0x4a, 0xcf, 0xae, 0x1e, 0x9d,
-	0x55, 0x74, 0x57, 0x5a, 0x12, 0x23, 0xb3, 0xb6, 0xc1, 0x72, 0x29, 0xbf, 0x06, 0x8d, 0x44, 0x01,
-	0xa5, 0xf9, 0xb5, 0xf4, 0x59, 0x5d, 0x58, 0x8e, 0x9b, 0xa3, 0x3a, 0x1b, 0x3c, 0xf6, 0xcf, 0xe9,
-	0xed, 0x0f, 0xfa, 0x7d, 0x2f, 0x3d, 0x53, 0xdc, 0x22, 0x98, 0xdc, 0x89, 0x83, 0x88, 0x2b, 0x8a,
-	0x2f, 0x4a, 0x05, 0x6f, 0xfc, 0xb7, 0x29, 0x7a, 0xdd, 0x12, 0xdd, 0xd4, 0xd6, 0x84, 0xad, 0xad,
-	0x6b, 0x00, 0x09, 0x4d, 0xbb, 0x34, 0x62, 0x5e, 0x4f, 0xad, 0xd8, 0x80, 0x38, 0xc7, 0x40, 0x9e,
-	0x1c, 0x1d, 0x85, 0x41, 0x44, 0x39, 0x5b, 0x29, 0xcc, 0x08, 0xed, 0x0f, 0x97, 0xc1, 0xe6, 0x34,
-	0x51, 0xe2, 0xf4, 0x43, 0x58, 0x7a, 0x12, 0x55, 0x30, 0x52, 0xe4, 0x6a, 0xa3, 0xc8, 0xd5, 0x4b,
-	0xe4, 0x3e, 0x80, 0x39, 0x43, 0xf0, 0x8c, 0xbc, 0x03, 0x0d, 0x29, 0xa3, 0x4e, 0x14, 0xda, 0xda,
-	0x1b, 0x94, 0x56, 0xe8, 0xe6, 0xc8, 0xce, 0x9f, 0xd7, 0x60, 0x36, 0x97, 0x2c, 0x23, 0xf7, 0xe0,
-	0x12, 0x57, 0xb7, 0xa2, 0x72, 0x4d, 0x53, 0xc9, 0x71, 0xb6, 0xf0, 0x5f, 0x11, 0x17, 0x0a, 0xe4,
-	0xf6, 0x3e, 0x40, 0x0e, 0xac, 0x08, 0xeb, 0xee, 0xd8, 0x61, 0xdd, 0xe5, 0x32, 0x55, 0x25, 0x9a,
-	0x11, 0xd9, 0xfd, 0xcb, 0x24, 0x4f, 0xf7, 0x2a, 0x8c, 0x45, 0xda, 0xe0, 0x1b, 0x30, 0x2b, 0xde,
-	0x05, 0xee, 0x01, 0x94, 0xc0, 0x73, 0xf9, 0xd5, 0x46, 0x10, 0xb9, 0x80, 0xef, 0x06, 0x8e, 0x93,
-	0xb7, 0x60, 0x1e, 0x85, 0xed, 0xc4, 0x42, 0x21, 0xf2, 0xc5, 0xb6, 0x27, 0xcc, 0x21, 0x8a, 0x54,
-	0x19, 0x49, 0x60, 0xd5, 0x9a, 0xd2, 0xc9, 0x84, 0x08, 0xf2, 0x90, 0x7a, 0xcf, 0x08, 0xa5, 0x87,
-	0x49, 0x29, 0x94, 0x25, 0x09, 0xca, 0x31, 0xa1, 0xba, 0xe5, 0x6e, 0x79, 0x84, 0xdc, 0x81, 0x39,
-	0xc9, 0x11, 0x35, 0x23, 0x8f, 0x38, 0x5b, 0xc6, 0x59, 0x31, 0x11, 0x11, 0x48, 0x1f, 0x56, 0xcc,
-	0x09, 0x5a, 0xc2, 0x4b, 0x38, 0xf1, 0xdd, 0xf1, 0x25, 0x8c, 0x4a, 0x02, 0x92, 0x6e, 0x69, 0xa0,
-	0xfd, 0x5b, 0xd0, 0x1a, 0xb6, 0xa0, 0x8a, 0x6d, 0x7f, 0xd5, 0xde, 0xf6, 0x95, 0x0a, 0x93, 0xcc,
-	0xcc, 0x0b, 0xc4, 0x4f, 0x61, 0x7d, 0x88, 0x30, 0x17, 0xb8, 0x75, 0x30, 0x2c, 0xd5, 0xb4, 0xa6,
-	0x3f, 0xad, 0x41, 0x7b, 0xdb, 0xf7, 0x4b, 0xce, 0x29, 0xbf, 0x24, 0xf8, 0xa6, 0x5d, 0xee, 0x55,
-	0xb8, 0x52, 0x29, 0x90, 0xbc, 0xcd, 0x78, 0x0e, 0x57, 0x5d, 0xda, 0x8f, 0x4f, 0xe8, 0x37, 0x2d,
-	0xb2, 0x73, 0x1d, 0xae, 0x0d, 0xe3, 0x2c, 0x65, 0xc3, 0xeb, 0x3d, 0xfb, 0x7a, 0x5c, 0x07, 0x46,
-	0xff, 0x59, 0x83, 0x79, 0xfb, 0xe2, 0xfc, 0x45, 0xe5, 0xe2, 0xaf, 0x03, 0x49, 0x69, 0xc6, 0x3a,
-	0x49, 0x1c, 0x86, 0x3c, 0x25, 0xf7, 0x69, 0xe8, 0x9d, 0xc9, 0x2b, 0xfb, 0x26, 0x1f, 0xd9, 0x13,
-	0x03, 0x0f, 0x38, 0x9c, 0xac, 0xc3, 0xb4, 0x97, 0x04, 0x1d, 0x6e, 0x35, 0x22, 0x1f, 0x9f, 0xf2,
-	0x92, 0xe0, 0x43, 0x7a, 0x46, 0x1c, 0x98, 0x97, 0x03, 0x9d, 0x90, 0x9e, 0xd0, 0x10, 0x63, 0xbe,
-	0x09, 0x77, 0x56, 0x0c, 0x3f, 0xe6, 0x20, 0x72, 0x1b, 0x9a, 0x49, 0x1a, 0x70, 0xf3, 0xcb, 0x6b,
-	0x03, 0xd3, 0x28, 0xcd, 0xa2, 0x84, 0xab, 0xd5, 0x39, 0x3f, 0x86, 0xcb, 0x15, 0xba, 0x90, 0x3e,
-	0xea, 0x7b, 0xb0, 0x68, 0x57, 0x18, 0x94, 0x9f, 0xd2, 0x51, 0xab, 0x35, 0xd1, 0x5d, 0x38, 0xb2,
-	0xe8, 0xc8, 0xe8, 0x13, 0x71, 0x5c, 0x8f, 0xe9, 0x3b, 0x2d, 0xe7, 0x73, 0x58, 0xc9, 0x81, 0x3b,
-	0x71, 0x74, 0x42, 0xd3, 0x8c, 0x5b, 0x1b, 0x81, 0xc9, 0xa3, 0x34, 0x56, 0x17, 0xb2, 0xf8, 0x9b,
-	0xc7, 0x6d, 0x2c, 0x96, 0x66, 0x50, 0x67, 0x31, 0xc7, 0x49, 0x3d, 0xa6, 0x4e, 0x29, 0xfc, 0xcd,
-	0xe3, 0xe4, 0x00, 0x89, 0xd0, 0x0e, 0x8e, 0x09, 0x53, 0x9d, 0x95, 0x30, 0xce, 0xc5, 0x79, 0x8a,
-	0xe1, 0xa3, 0x29, 0x8a, 0x5c, 0xe3, 0x6f, 0xc0, 0xac, 0x58, 0x23, 0x9f, 0xa9, 0xd6, 0xb7, 0x61,
-	0xad, 0xaf, 0x20, 0xa6, 0x0b, 0x47, 0x1a, 0xea, 0xfc, 0x77, 0x1d, 0xe6, 0x30, 0x62, 0x7d, 0x40,
-	0x99, 0x17, 0x84, 0xa3, 0x63, 0x69, 0x11, 0x83, 0xd6, 0x75, 0x0c, 0xfa, 0x12, 0xcc, 0x9b, 0x17,
-	0x22, 0x67, 0x2a, 0x99, 0x35, 0xae, 0x43, 0xce, 0xc8, 0xcb, 0xb0, 0x80, 0xa9, 0x75, 0x8e, 0x25,
-	0x6c, 0x66, 0x1e, 0xa1, 0x1a, 0xcd, 0x4e, 0x04, 0x2e, 0x15, 0x12, 0x01, 0x3e, 0x8c, 0xc1, 0x74,
-	0x27, 0x0b, 0x7c, 0x9d, 0x27, 0x20, 0x64, 0x3f, 0xf0, 0x8d, 0x61, 0x9c, 0x3d, 0x6d, 0x0c, 0xe3,
-	0x6c, 0x9e, 0x03, 0xa5, 0x54, 0x14, 0x0a, 0xb0, 0xde, 0x35, 0x83, 0x46, 0x37, 0xa7, 0x80, 0x07,
-	0x41, 0x1f, 0xab, 0x61, 0xf2, 0x72, 0xbb, 0x21, 0x2c, 0x56, 0x3c, 0xe5, 0x69, 0x1a, 0x98, 0x69,
-	0x5a, 0x9e, 0xd4, 0xcd, 0x5a, 0x49, 0xdd, 0x26, 0xcc, 0xc6, 0x09, 0x8d, 0x3a, 0x32, 0xc5, 0x9e,
-	0x13, 0xd1, 0x03, 0x07, 0x3d, 0x45, 0x88, 0xbc, 0x32, 0x41, 0x9d, 0x67, 0xe3, 0xe4, 0xa5, 0xb6,
-	0x62, 0xea, 0x45, 0xc5, 0xa8, 0x44, 0x70, 0xe2, 0xbc, 0x44, 0xd0, 0xd9, 0xc6, 0xa8, 0x58, 0x31,
-	0x96, 0xe6, 0xf3, 0x3a, 0x4c, 0xa1, 0x9a, 0x94, 0xe5, 0xac, 0x58, 0x69, 0x8c, 0x34, 0x0a, 0x57,
-	0xe2, 0x38, 0x1f, 0x60, 0x0d, 0x11, 0x87, 0xc6, 0x11, 0xfd, 0x32, 0xcc, 0x88, 0x5d, 0xd1, 0x56,
-	0x33, 0x8d, 0xcf, 0x8f, 0x7c, 0xe7, 0xdf, 0x6a, 0x40, 0xf6, 0x07, 0x87, 0xfd, 0x60, 0x7c, 0x6a,
-	0xe3, 0x27, 0xe8, 0x04, 0x26, 0xd1, 0x4c, 0x84, 0x39, 0xe2, 0xef, 0x82, 0x85, 0x4c, 0x16, 0x2d,
-	0x24, 0xdf, 0xce, 0x4b, 0xd5, 0x39, 0xfa, 0x94, 0xb9, 0xf9, 0xdc, 0xc5, 0x87, 0x01, 0x8d, 0x58,
-	0x47, 0x5e, 0xb6, 0x70, 0x17, 0x8f, 0x80, 0x47, 0xbe, 0xb3, 0x0f, 0xcb, 0xd6, 0xca, 0xa4, 0xa6,
-	0x6f, 0xc0, 0x9c, 0x10, 0x20, 0x09, 0xbd, 0xae, 0xbe, 0x0d, 0x9f, 0x45, 0xd8, 0x1e, 0x82, 0x46,
-	0xe9, 0xeb, 0x8f, 0x6b, 0xb0, 0xb2, 0x1f, 0xf4, 0x07, 0xa1, 0xc7, 0xe8, 0x2f, 0x41, 0x63, 0xf9,
-	0xf2, 0x27, 0xac, 0xe5, 0x2b, 0x4d, 0x4e, 0xe6, 0x9a, 0x74, 0xfe, 0xa7, 0x06, 0xab, 0x05, 0x51,
-	0x74, 0x4c, 0x68, 0x1b, 0xd3, 0x90, 0xcb, 0x01, 0x89, 0x64, 0x30, 0xad, 0x5b, 0x4c, 0x5f, 0x82,
-	0xf9, 0x7e, 0x10, 0x05, 0xfd, 0x41, 0xbf, 0x23, 0x74, 0x2f, 0x64, 0x9a, 0x93, 0xc0, 0x3d, 0xdc,
-	0x02, 0x8e, 0xe4, 0x3d, 0x37, 0x90, 0x26, 0x25, 0x92, 0x00, 0x0a, 0xa4, 0x37, 0x61, 0x25, 0x8f,
-	0xdb, 0x3b, 0x3d, 0x2f, 0x88, 0x3a, 0x61, 0x9c, 0x65, 0x72, 0x8f, 0x49, 0x3e, 0xb6, 0xeb, 0x05,
-	0xd1, 0xe3, 0x38, 0xcb, 0x0c, 0x27, 0x30, 0x65, 0x3a, 0x01, 0x1e, 0xc0, 0x34, 0x3f, 0x39, 0xf6,
-	0x42, 0x7a, 0x3f, 0xee, 0x1f, 0xbe, 0x58, 0xdd, 0xdf, 0x80, 0x39, 0x71, 0xef, 0xc6, 0xbc, 0xb4,
-	0x47, 0xd5, 0x0e, 0xcc, 0x22, 0xec, 0x00, 0x41, 0x95, 0xdb, 0xf0, 0x5f, 0x35, 0x20, 0x3b, 0x3c,
-	0x94, 0x09, 0xc7, 0xb6, 0x07, 0xee, 0x4a, 0x44, 0xde, 0x9c, 0x5b, 0x58, 0x43, 0x42, 0x1e, 0xd9,
-	0xe6, 0x37, 0x61, 0x99, 0x9f, 0x5e, 0xcd, 0xe4, 0x05, 0x2f, 0xc7, 0x4a, 0x7e, 0xfc, 0x65, 0x58,
-	0x38, 0xf5, 0xc2, 0x90, 0x32, 0x5d, 0x62, 0x93, 0x37, 0xf1, 0x02, 0xaa, 0x72, 0x70, 0xb5, 0xe0,
-	0x69, 0x63, 0xc1, 0xab, 0xb0, 0x6c, 0xad, 0x57, 0x46, 0x43, 0xf7, 0x60, 0x4d, 0x80, 0xb7, 0xc3,
-	0x70, 0x6c, 0xaf, 0xea, 0xfc, 0x65, 0x1d, 0xd6, 0x4b, 0xd3, 0x74, 0xd8, 0x60, 0x9b, 0xf1, 0x4d,
-	0xbd, 0xdc, 0xea, 0x09, 0x5b, 0xf2, 0x51, 0xce, 0x6a, 0xff, 0x53, 0x0d, 0xa6, 0x04, 0x68, 0xe4,
-	0x6e, 0x7c, 0xaa, 0x1c, 0x82, 0x34, 0x38, 0x91, 0x11, 0x7d, 0x67, 0x3c, 0x66, 0xe2, 0x3f, 0xb3,
-	0xac, 0x2a, 0x3c, 0x89, 0xac, 0xa8, 0x7e, 0x0f, 0x9a, 0x45, 0x84, 0x0b, 0x95, 0x9c, 0xc4, 0xad,
-	0xca, 0xc3, 0x13, 0x6a, 0x94, 0x51, 0x7f, 0x51, 0x83, 0xc5, 0x9d, 0x38, 0xf2, 0x03, 0x7e, 0x62,
-	0xee, 0x79, 0xa9, 0xd7, 0xcf, 0x64, 0x25, 0x5f, 0x80, 0xd4, 0xb5, 0xbb, 0x06, 0x0c, 0xb9, 0xe0,
-	0xbc, 0x0a, 0xd0, 0x3d, 0xa6, 0xdd, 0x67, 0x1d, 0x79, 0xe3, 0x28, 0xca, 0xff, 0x1c, 0x72, 0x3f,
-	0xf0, 0x33, 0xf2, 0x06, 0x2c, 0xe7, 0xc3, 0x1d, 0x2f, 0xf2, 0x3b, 0xf2, 0xba, 0x11, 0xab, 0x1b,
-	0x1a, 0x6f, 0x3b, 0xf2, 0xb7, 0xb3, 0x67, 0x19, 0x8f, 0x15, 0xf5, 0x2d, 0x5b, 0xc7, 0x72, 0xe1,
-	0x8b, 0x1a, 0xbe, 0x8d, 0x60, 0xe7, 0x7f, 0x6b, 0x78, 0x02, 0xaa, 0x55, 0xc9, 0xdd, 0xce, 0x2f,
-	0xd6, 0xf0, 0xbe, 0xd5, 0xda, 0xb2, 0x7a, 0x61, 0xcb, 0x08, 0x4c, 0x06, 0x8c, 0xf6, 0xd5, 0xc1,
-	0xc2, 0x7f, 0x93, 0xfb, 0xd0, 0xd4, 0x2b, 0xee, 0x24, 0xa8, 0x16, 0xf9, 0x9a, 0xac, 0xe7, 0x89,
-	0xa3, 0xa5, 0x35, 0x77, 0xb1, 0x5b, 0x50, 0xa3, 0x7a, 0xbd, 0x2e, 0x8d, 0xe5, 0xa8, 0xbb, 0xa8,
-	0x6d, 0xe9, 0x9f, 0xc4, 0x93, 0x90, 0x9a, 0x76, 0x07, 0x8c, 0xfa, 0x32, 0x54, 0xd6, 0xcf, 0xce,
-	0x7f, 0xd4, 0x60, 0x71, 0xdb, 0xf7, 0x71, 0xdd, 0xe3, 0xb8, 0x09, 0xb5, 0xca, 0xfa, 0x39, 0xab,
-	0x9c, 0xf8, 0x8a, 0xab, 0xfc, 0xda, 0x4e, 0x64, 0x88, 0x12, 0x1c, 0x07, 0x9a, 0xf9, 0x3a, 0xab,
-	0xb7, 0xd7, 0xf9, 0x16, 0x10, 0x91, 0x5e, 0x59, 0xea, 0x28, 0x62, 0xad, 0xc2, 0xb2, 0x85, 0x25,
-	0x7d, 0xcd, 0xfb, 0x70, 0x6b, 0x97, 0xb2, 0x9d, 0xf4, 0x2c, 0x61, 0xb1, 0x0a, 0x67, 0x1f, 0xd0,
-	0x24, 0xce, 0x02, 0xe5, 0xb9, 0xe8, 0x58, 0xde, 0xe7, 0x9f, 0x6b, 0x70, 0x7b, 0x0c, 0x42, 0x72,
-	0x09, 0x9f, 0x95, 0xef, 0x97, 0x7e, 0xd3, 0x6c, 0x6f, 0x19, 0x8b, 0xca, 0x96, 0x86, 0xc8, 0x2e,
-	0x03, 0x4d, 0xb2, 0xfd, 0x1e, 0x2c, 0xd8, 0x83, 0x17, 0x72, 0x15, 0x21, 0xdc, 0x3c, 0x47, 0x88,
-	0x71, 0x6c, 0xee, 0x26, 0x2c, 0x74, 0x2d, 0x12, 0x92, 0x51, 0x01, 0xea, 0xec, 0xc0, 0x2b, 0xe7,
-	0x72, 0x93, 0x6a, 0x1b, 0x9a, 0xa1, 0x3b, 0x7f, 0x37, 0x09, 0xeb, 0x9f, 0x04, 0xec, 0xd8, 0x4f,
-	0xbd, 0x53, 0x65, 0x7d, 0xe3, 0x08, 0x59, 0x48, 0xde, 0xeb, 0xe5, 0xfb, 0x86, 0x57, 0x61, 0x29,
-	0x8e, 0x28, 0xe6, 0x18, 0x9d, 0xc4, 0xcb, 0xb2, 0xd3, 0x38, 0x55, 0x67, 0xe9, 0x62, 0x1c, 0x51,
-	0x9e, 0x67, 0xec, 0x49, 0x70, 0xe1, 0x34, 0x9e, 0x2c, 0x9e, 0xc6, 0x4d, 0x98, 0x48, 0x82, 0x48,
-	0xd6, 0x4c, 0xf8, 0x4f, 0x7e, 0x76, 0xb2, 0xd4, 0xf3, 0x0d, 0xca, 0xf2, 0xec, 0x44, 0xa8, 0xa6,
-	0x6b, 0xde, 0xe2, 0x4f, 0x17, 0x6e, 0xf1, 0x0d, 0x9d, 0xcc, 0xd8, 0xb7, 0x16, 0x9b, 0x30, 0x2b,
-	0x7f, 0x76, 0x98, 0xd7, 0x93, 0x29, 0x10, 0x48, 0xd0, 0x81, 0xd7, 0x33, 0xa2, 0x35, 0xb0, 0xa2,
-	0xb5, 0xab, 0x00, 0x47, 0x94, 0x76, 0xac, 0x64, 0xa8, 0x71, 0x44, 0xa9, 0x70, 0xba, 0x3c, 0x54,
-	0x3e, 0xf4, 0xa2, 0x67, 0x1d, 0xbc, 0x83, 0x98, 0x13, 0xe2, 0x70, 0xc0, 0x47, 0x5e, 0x1f, 0x63,
-	0x62, 0x1c, 0x54, 0x32, 0xcd, 0x0b, 0x8d, 0x72, 0xd8, 0x76, 0x7e, 0x9b, 0x82, 0x28, 0xdd, 0x80,
-	0x9d, 0xb5, 0x16, 0xf2, 0xf9, 0x3b, 0x01, 0x3b, 0xd3, 0xf3, 0x51, 0x67, 0xe9, 0x59, 0x6b, 0x31,
-	0x9f, 0xbf, 0x23, 0x40, 0x5c, 0xbc, 0xec, 0x34, 0x38, 0xa2, 0xa2, 0x31, 0xa4, 0x29, 0x5b, 0xa5,
-	0x38, 0x64, 0x27, 0xf6, 0x31, 0x8c, 0x3c, 0x0d, 0x52, 0x23, 0x39, 0x5d, 0x12, 0x29, 0x2c, 0x07,
-	0x2a, 0xd3, 0x70, 0x5e, 0x85, 0xa6, 0x32, 0x17, 0xb3, 0x77, 0x32, 0xa5, 0xd9, 0x20, 0x64, 0xaa,
-	0x77, 0x52, 0x3c, 0x39, 0x6f, 0x61, 0x57, 0xc4, 0xe3, 0xb8, 0xd7, 0xcb, 0xd3, 0x27, 0x69, 0x5a,
-	0x6b, 0x30, 0x15, 0x22, 0x5c, 0x4d, 0x11, 0x4f, 0x4e, 0x84, 0xf7, 0x39, 0x85, 0x29, 0x79, 0xd5,
-	0x22, 0x88, 0x8e, 0x62, 0x99, 0x2d, 0xe0, 0x6f, 0xfe, 0x2e, 0xfa, 0xf4, 0x70, 0xd0, 0x53, 0x3d,
-	0x50, 0xf8, 0xc0, 0x31, 0x4f, 0xbd, 0x34, 0x92, 0x07, 0x2a, 0xfe, 0xe6, 0x98, 0x34, 0x4d, 0xe3,
-	0x54, 0x9e, 0x9e, 0xe2, 0xc1, 0xd9, 0x85, 0xf5, 0xfd, 0x8b, 0x89, 0xc8, 0x09, 0x89, 0xdb, 0x1a,
-	0xf9, 0xfa, 0xe3, 0x83, 0xf3, 0xa1, 0xd5, 0x01, 0x82, 0x5d, 0x02, 0xe3, 0xbc, 0x46, 0x2b, 0x70,
-	0x09, 0x7d, 0xb9, 0x22, 0x86, 0x0f, 0x3c, 0x23, 0x6c, 0x95, 0xa9, 0xe9, 0x1e, 0xb4, 0x72, 0x47,
-	0x85, 0xf0, 0x84, 0xdf, 0xae, 0xe8, 0xa8, 0xb0, 0xe6, 0x8e, 0xd7, 0x52, 0xf1, 0x4b, 0xed, 0x92,
-	0xf8, 0x02, 0x96, 0x4d, 0xd1, 0xbe, 0xd1, 0xac, 0xff, 0xa7, 0x35, 0xbc, 0x21, 0xd3, 0x19, 0xd8,
-	0x3e, 0x4b, 0xa9, 0xd7, 0xff, 0x46, 0x0b, 0xe2, 0xdf, 0x87, 0x1b, 0x66, 0xbf, 0xd4, 0x85, 0x25,
-	0x71, 0x7e, 0x0f, 0xcb, 0x88, 0xa2, 0xc8, 0xff, 0x2b, 0x90, 0xff, 0x3d, 0xb8, 0x66, 0xc8, 0x7f,
-	0x41, 0x31, 0x9c, 0xbf, 0xa8, 0xe1, 0x2d, 0xe2, 0xf6, 0xc0, 0x0f, 0x98, 0x15, 0x73, 0x70, 0xcf,
-	0xc4, 0xbc, 0x94, 0x75, 0x7c, 0x8f, 0x51, 0xdd, 0xc4, 0xc9, 0x21, 0x0f, 0x3c, 0x86, 0x97, 0x27,
-	0x34, 0xf2, 0xc5, 0xa0, 0xbc, 0x0c, 0xa0, 0x91, 0xaf, 0x86, 0x44, 0xe6, 0x70, 0x78, 0x66, 0x25,
-	0x6a, 0xf7, 0xf1, 0x9c, 0xc6, 0xa6, 0x17, 0x7c, 0xe3, 0x2f, 0xb9, 0xe2, 0x81, 0xbf, 0xd6, 0xf1,
-	0xd1, 0x11, 0x7f, 0xe5, 0x2e, 0x21, 0x58, 0x3e, 0x39, 0x3b, 0xa2, 0x28, 0x6d, 0x88, 0x26, 0xdf,
-	0xb7, 0x57, 0x61, 0x8a, 0x62, 0x98, 0x5c, 0xac, 0x6e, 0x1b, 0xb8, 0x12, 0xc3, 0xf9, 0x2b, 0x61,
-	0x61, 0x1f, 0x04, 0x19, 0x8b, 0xd3, 0xa0, 0xbb, 0xe3, 0x45, 0x7e, 0x38, 0x56, 0x18, 0x74, 0x81,
-	0x1d, 0xda, 0x80, 0x46, 0x8a, 0xfd, 0x7f, 0xc1, 0x17, 0x54, 0xf6, 0x46, 0xe4, 0x00, 0x7e, 0x2e,
-	0xf7, 0x52, 0x2f, 0x1a, 0x84, 0x5e, 0xca, 0x4f, 0x89, 0x49, 0x71, 0xa3, 0x6c, 0x80, 0x9c, 0x07,
-	0x58, 0xf9, 0x2c, 0x89, 0x28, 0x57, 0x7b, 0x13, 0xa6, 0xba, 0x08, 0x92, 0xab, 0x5d, 0x30, 0x72,
-	0x30, 0x3f, 0xa4, 0xae, 0x1c, 0x75, 0xfe, 0xa0, 0x06, 0x53, 0x02, 0xc4, 0xbd, 0xad, 0x6e, 0x9c,
-	0x9f, 0x70, 0xf1, 0xb7, 0x6a, 0xc7, 0xa9, 0xe7, 0xed, 0x38, 0xaa, 0x69, 0x67, 0xc2, 0x68, 0xda,
-	0x21, 0x30, 0x19, 0x27, 0x34, 0x52, 0xcd, 0x3d, 0xfc, 0x37, 0xdf, 0xb5, 0x6e, 0x18, 0x67, 0x54,
-	0x66, 0x2e, 0xe2, 0xc1, 0x68, 0xd4, 0x99, 0x32, 0x1b, 0x75, 0x9c, 0xe7, 0x00, 0xf9, 0x36, 0xa0,
-	0x24, 0xdc, 0x6c, 0xe5, 0xa5, 0x33, 0xff, 0x4d, 0xae, 0x01, 0x04, 0x3e, 0x8d, 0x58, 0x70, 0x14,
-	0x50, 0xd5, 0xf0, 0x61, 0x40, 0x78, 0x18, 0xd0, 0xa7, 0x59, 0xa6, 0xaa, 0xa5, 0x0d, 0x57, 0x3d,
-	0x72, 0x45, 0xf3, 0xb5, 0x64, 0xcc, 0xeb, 0x27, 0x2a, 0x26, 0xd1, 0x00, 0xe7, 0x10, 0x1a, 0xbb,
-	0x3b, 0x07, 0xfb, 0x18, 0xee, 0x70, 0xc6, 0x1f, 0x7f, 0xfc, 0xe8, 0x81, 0x62, 0xcc, 0x7f, 0xeb,
-	0x62, 0x43, 0xdd, 0x28, 0x36, 0x10, 0xbe, 0xcb, 0xec, 0x58, 0x25, 0x4d, 0xfc, 0x37, 0xb7, 0xe0,
-	0x88, 0x3e, 0x67, 0x9d, 0x74, 0x10, 0x49, 0x2e, 0xd3, 0xfc, 0xd9, 0x1d, 0x44, 0xce, 0x03, 0x58,
-	0xd7, 0x3c, 0x1e, 0x8a, 0x14, 0x46, 0xd9, 0xd2, 0x6d, 0x98, 0x12, 0xa1, 0x96, 0x6c, 0x7b, 0x59,
-	0xd2, 0xbe, 0x5f, 0x4d, 0x70, 0x25, 0x82, 0xb3, 0x0d, 0x2b, 0x1a, 0xb8, 0xcf, 0xe2, 0xe4, 0x2b,
-	0x90, 0xb8, 0x6c, 0x08, 0xc2, 0x49, 0x6c, 0x87, 0xa1, 0x4a, 0x85, 0x5b, 0xb0, 0x66, 0x0c, 0xf1,
-	0x14, 0x5b, 0x8d, 0x98, 0x93, 0x1e, 0x07, 0x19, 0x33, 0x26, 0xfd, 0x4d, 0xcd, 0x98, 0xf5, 0x71,
-	0x12, 0xc6, 0x9e, 0xaf, 0xa4, 0xda, 0x84, 0x59, 0xc1, 0xb4, 0x63, 0x94, 0x6a, 0x40, 0x80, 0x30,
-	0x50, 0xca, 0x11, 0xb0, 0x87, 0xa1, 0x6e, 0x22, 0x3c, 0xf0, 0x98, 0xa7, 0xbb, 0x1b, 0x26, 0xf2,
-	0xee, 0x06, 0xfe, 0xea, 0x79, 0x69, 0xf7, 0x38, 0x38, 0xa1, 0xbe, 0x0c, 0x00, 0xf4, 0x33, 0xdf,
-	0xe7, 0xf8, 0x84, 0xa6, 0xa7, 0x69, 0xc0, 0x84, 0xd5, 0xcd, 0xb8, 0x39, 0xc0, 0xd9, 0x85, 0x76,
-	0xae, 0x0f, 0xea, 0xf9, 0xea, 0xd7, 0x85, 0x75, 0x78, 0x1f, 0x56, 0x35, 0xf0, 0x47, 0x03, 0xaa,
-	0x9b, 0x0d, 0x2e, 0x42, 0xe3, 0x07, 0xd0, 0xd2, 0xc0, 0xed, 0x01, 0x8b, 0x1f, 0x1b, 0x8a, 0x5b,
-	0xb3, 0xc8, 0x34, 0xd4, 0x1c, 0xe3, 0x1a, 0x4f, 0xc4, 0x48, 0xea, 0x1a, 0xef, 0x33, 0x6b, 0x4f,
-	0xc5, 0xc6, 0xe5, 0x01, 0x9d, 0xee, 0x6d, 0x37, 0xaf, 0xff, 0x5f, 0x83, 0x69, 0x41, 0x54, 0xdd,
-	0xd0, 0x54, 0x88, 0xaa, 0x30, 0x9c, 0xd8, 0xd8, 0x62, 0xb9, 0xde, 0x73, 0xc8, 0xe7, 0x8a, 0xa8,
-	0x9f, 0xa3, 0x08, 0x6b, 0x8f, 0x1b, 0xb2, 0x83, 0xe5, 0x7d, 0x43, 0x39, 0xb2, 0x3b, 0xfb, 0x5c,
-	0x96, 0x8a, 0x4e, 0x3d, 0xa7, 0x73, 0xf7, 0x67, 0xdf, 0x86, 0x85, 0xdd, 0x58, 0xe4, 0x55, 0x07,
-	0x3c, 0x9d, 0x48, 0xc9, 0x13, 0x98, 0x96, 0xdf, 0xb1, 0x90, 0xb5, 0xd2, 0x87, 0x2d, 0xa8, 0xfe,
-	0xf6, 0xfa, 0x90, 0x0f, 0x5e, 0x9c, 0xe5, 0x2f, 0xff, 0xf5, 0xdf, 0x7f, 0x5e, 0x9f, 0x27, 0xb3,
-	0x77, 0x4e, 0xde, 0xba, 0xd3, 0xa3, 0x0c, 0xe3, 0xd6, 0x1e, 0xcc, 0x5b, 0x9f, 0x1e, 0x90, 0x0d,
-	0xeb, 0xf3, 0x81, 0xc2, 0x17, 0x09, 0xed, 0xab, 0x23, 0x3f, 0x2e, 0x70, 0x2e, 0x23, 0x8b, 0x65,
-	0xb2, 0x24, 0x59, 0xe4, 0x5f, 0x15, 0x90, 0xcf, 0x61, 0xf1, 0x21, 0xd6, 0x33, 0x35, 0x51, 0xb2,
-	0x99, 0x13, 0xab, 0xfc, 0xa2, 0xa2, 0x7d, 0x7d, 0x38, 0x82, 0x64, 0x78, 0x05, 0x19, 0xae, 0x92,
-	0x65, 0xce, 0x50, 0xd4, 0x4b, 0x35, 0x4f, 0x92, 0x41, 0x53, 0xf6, 0x68, 0xbf, 0x50, 0x9e, 0x1b,
-	0xc8, 0x73, 0x8d, 0xac, 0x70, 0x9e, 0xbe, 0x60, 0x90, 0x33, 0x8d, 0xb1, 0x1c, 0x63, 0x7e, 0x53,
-	0x40, 0xae, 0x0d, 0xfd, 0xd8, 0x40, 0xb0, 0xdc, 0x3c, 0xe7, 0x63, 0x04, 0x7b, 0x95, 0x3d, 0xca,
-	0x71, 0xf5, 0xf7, 0x08, 0xe4, 0xe7, 0x22, 0x46, 0xaf, 0xfc, 0xfa, 0x85, 0xbc, 0x72, 0xfe, 0x27,
-	0x37, 0x42, 0x86, 0x5b, 0xe3, 0x7e, 0x9b, 0xe3, 0x7c, 0x0b, 0x85, 0xb9, 0x46, 0x36, 0xa4, 0x30,
-	0xd6, 0xf7, 0x38, 0xea, 0x8b, 0x1f, 0xd2, 0x85, 0x39, 0xf3, 0x43, 0x02, 0x72, 0xa5, 0x22, 0x25,
-	0xd0, 0xcc, 0x37, 0xaa, 0x07, 0x25, 0xc3, 0x16, 0x32, 0x24, 0xa4, 0x29, 0x19, 0xea, 0xef, 0x0e,
-	0xc8, 0x17, 0xb0, 0x58, 0x68, 0xc2, 0x27, 0x4e, 0x61, 0xfb, 0x2a, 0x3e, 0xa8, 0x68, 0xbf, 0x34,
-	0x12, 0x47, 0x72, 0xbd, 0x86, 0x5c, 0x5b, 0xce, 0xb2, 0xb1, 0xcb, 0x8a, 0xf3, 0x77, 0x6b, 0xaf,
-	0x92, 0x0c, 0xf7, 0xd9, 0xec, 0x17, 0x1f, 0x8b, 0xf7, 0xe6, 0x39, 0xcd, 0xe6, 0xa5, 0xbd, 0x56,
-	0x3c, 0xf1, 0x6d, 0xcd, 0xb0, 0x07, 0xd7, 0xf8, 0xca, 0x01, 0xf3, 0xe5, 0x71, 0xf8, 0x5e, 0xad,
-	0xfe, 0x4a, 0x42, 0x7e, 0xa8, 0xe1, 0xb4, 0x91, 0xeb, 0x0a, 0x21, 0x05, 0xae, 0x31, 0x4b, 0x48,
-	0x66, 0x7d, 0x44, 0x22, 0x99, 0xda, 0x56, 0x5d, 0xf1, 0x19, 0x47, 0xe5, 0x4a, 0xcd, 0xef, 0x32,
-	0x86, 0xae, 0x34, 0x66, 0x49, 0x46, 0x9e, 0xc3, 0x82, 0x70, 0x17, 0x2f, 0x7e, 0x67, 0xaf, 0x22,
-	0xdf, 0x75, 0x87, 0xe4, 0x3e, 0xc3, 0xdc, 0xd8, 0x4f, 0xa0, 0xa1, 0x13, 0x1b, 0xd2, 0x32, 0x16,
-	0x61, 0x75, 0xd4, 0xb7, 0x87, 0xf4, 0x4b, 0x2b, 0x6b, 0x75, 0xe6, 0xe5, 0xaa, 0x44, 0xf7, 0x33,
-	0x27, 0xfc, 0x63, 0x80, 0xbc, 0x81, 0x9a, 0x5c, 0x2e, 0x51, 0xd6, 0x9a, 0x6b, 0x57, 0x0d, 0xa9,
-	0x4f, 0xc5, 0x90, 0x7c, 0x93, 0x2c, 0x58, 0xe4, 0xd5, 0xfb, 0xa6, 0xf3, 0x38, 0xeb, 0x7d, 0x2b,
-	0xb6, 0x5c, 0xb7, 0x87, 0xf7, 0xda, 0xaa, 0x4d, 0x71, 0xd4, 0xcb, 0xa6, 0xef, 0xeb, 0xf9, 0x0a,
-	0xc4, 0x61, 0x61, 0x34, 0xf9, 0x6e, 0x54, 0x71, 0xa9, 0x3c, 0x2c, 0xca, 0x1d, 0xbb, 0xa5, 0xc3,
-	0x22, 0x6f, 0xcc, 0x25, 0xcf, 0xf0, 0x53, 0x59, 0xa3, 0x47, 0x95, 0x98, 0xb4, 0xca, 0x0d, 0xbb,
-	0xed, 0x6b, 0xc3, 0x86, 0xb3, 0x6a, 0xfb, 0x96, 0x57, 0x7a, 0xf8, 0x52, 0x9d, 0x89, 0x5c, 0x30,
-	0x9f, 0x25, 0xf2, 0xc8, 0xaf, 0xcb, 0xf2, 0x3a, 0xb2, 0x6c, 0x93, 0x56, 0x99, 0x65, 0x86, 0x0c,
-	0xde, 0xac, 0x49, 0x5b, 0x13, 0x4d, 0xb1, 0x96, 0xad, 0x59, 0xbd, 0xb3, 0xed, 0xcb, 0x15, 0x23,
-	0x92, 0xcb, 0x2a, 0x72, 0x59, 0x24, 0xf3, 0xda, 0x1b, 0x23, 0x2d, 0x61, 0x0e, 0xba, 0x5b, 0xc9,
-	0x32, 0x87, 0x62, 0x4b, 0xab, 0xe5, 0x7e, 0x4b, 0x8d, 0xad, 0x25, 0xf7, 0xab, 0x5b, 0x57, 0xc9,
-	0xef, 0xdb, 0x1d, 0xb2, 0xaa, 0x63, 0xcf, 0x19, 0xd9, 0x62, 0x57, 0x7a, 0x51, 0x87, 0xb6, 0xe1,
-	0x39, 0x9b, 0xc8, 0xf9, 0x32, 0x59, 0x2f, 0x72, 0x96, 0x2d, 0x7d, 0xe4, 0xcb, 0x1a, 0x2c, 0x57,
-	0x34, 0x8c, 0xe5, 0x12, 0x0c, 0x6f, 0x6f, 0xcb, 0x25, 0x18, 0xd5, 0x71, 0xe6, 0xa0, 0x04, 0x1b,
-	0x0e, 0x4a, 0xe0, 0xf9, 0xbe, 0x96, 0x40, 0x5e, 0x8e, 0xf2, 0x97, 0xe2, 0x67, 0x35, 0x58, 0xab,
-	0x6e, 0x0e, 0x23, 0x2f, 0xeb, 0xef, 0xfe, 0x46, 0xb5, 0xad, 0xb5, 0x6f, 0x9e, 0x87, 0x26, 0xa5,
-	0x79, 0x19, 0xa5, 0xd9, 0x74, 0xda, 0x5c, 0x9a, 0x14, 0x71, 0xab, 0x04, 0x3a, 0xc5, 0x8a, 0x9a,
-	0xdd, 0x7e, 0x45, 0x8c, 0xb0, 0xa6, 0xba, 0x4b, 0xad, 0x7d, 0x63, 0x04, 0x86, 0xed, 0x39, 0xc9,
-	0xaa, 0xdc, 0x10, 0xec, 0x59, 0xd2, 0x7d, 0x5c, 0xd2, 0x3d, 0xe4, 0xed, 0x4d, 0x96, 0x7b, 0x28,
-	0x75, 0x6c, 0x59, 0xee, 0xa1, 0xdc, 0x44, 0x55, 0x72, 0x0f, 0xc8, 0x0c, 0x1b, 0xaa, 0xc8, 0xa7,
-	0xf8, 0xda, 0xc8, 0x72, 0x6e, 0xab, 0xe8, 0x65, 0xb2, 0xaa, 0xd7, 0xc6, 0x2e, 0xd8, 0x96, 0xbc,
-	0xb4, 0xa8, 0x12, 0x73, 0xed, 0xb9, 0x30, 0xa3, 0xd0, 0xc9, 0x7a, 0x91, 0x80, 0xa2, 0x5c, 0xd9,
-	0x91, 0xe3, 0xac, 0x23, 0xd1, 0x25, 0x67, 0xce, 0x24, 0xca, 0x69, 0x1e, 0xc2, 0xac, 0xd1, 0x7d,
-	0x42, 0xb4, 0x7f, 0x2f, 0x37, 0xdb, 0xb4, 0xaf, 0x54, 0x8e, 0xd9, 0x5e, 0xcc, 0x59, 0xe4, 0x0c,
-	0x32, 0x44, 0xd0, 0x3c, 0x7e, 0x07, 0xe6, 0xad, 0x06, 0x90, 0x5c, 0xf9, 0x55, 0x2d, 0x2a, 0xb9,
-	0xf2, 0x2b, 0xbb, 0x46, 0x54, 0x8c, 0xeb, 0xa0, 0xf2, 0x33, 0x89, 0xa2, 0x79, 0x7d, 0x06, 0x0d,
-	0xdd, 0x77, 0x91, 0xeb, 0xbf, 0xd8, 0x8a, 0x71, 0x1e, 0x0f, 0x6b, 0x0f, 0x4e, 0xf9, 0xe4, 0xc3,
-	0xb8, 0x7f, 0x28, 0xf5, 0x65, 0x74, 0x15, 0xe4, 0xfa, 0x2a, 0xb7, 0x56, 0xe4, 0xfa, 0xaa, 0x6a,
-	0x43, 0xb0, 0xf4, 0xd5, 0x45, 0x04, 0xbd, 0x86, 0x14, 0x16, 0x0b, 0xd5, 0xfc, 0x3c, 0xa2, 0xa9,
-	0xee, 0x5d, 0xc8, 0x23, 0x9a, 0x21, 0x6d, 0x00, 0x76, 0xcc, 0x28, 0xf8, 0x79, 0x61, 0x98, 0xdb,
-	0x96, 0x70, 0xf7, 0xa2, 0xd6, 0x6d, 0xd9, 0xad, 0x55, 0xd4, 0xb7, 0xec, 0xd6, 0x2e, 0x8c, 0x97,
-	0xdc, 0xbd, 0xb8, 0xee, 0x23, 0x4f, 0x61, 0x46, 0x15, 0x59, 0x73, 0xa3, 0x2d, 0x94, 0x97, 0xdb,
-	0xad, 0xf2, 0x80, 0xa4, 0x6a, 0x19, 0xae, 0xe7, 0xfb, 0x48, 0x55, 0x6e, 0x84, 0x51, 0x72, 0xcd,
-	0x37, 0xa2, 0x5c, 0xad, 0xcd, 0x37, 0xa2, 0xaa, 0x46, 0x6b, 0x6d, 0x84, 0xf0, 0x5c, 0x9a, 0xc7,
-	0xdf, 0xd7, 0xf0, 0x2a, 0x7a, 0x74, 0xc5, 0x94, 0xbc, 0x79, 0x81, 0xe2, 0xaa, 0x10, 0xe8, 0xad,
-	0x0b, 0x97, 0x63, 0x9d, 0x5b, 0x28, 0xa6, 0xe3, 0x5c, 0x55, 0x87, 0x29, 0x4e, 0xf3, 0x05, 0xba,
-	0xae, 0xcd, 0x72, 0xa1, 0xff, 0xb6, 0x26, 0xfe, 0x06, 0xc3, 0x08, 0xba, 0x64, 0x6b, 0x4c, 0x01,
-	0x94, 0xc0, 0x77, 0xc6, 0xc6, 0x97, 0xe2, 0xde, 0x44, 0x71, 0xaf, 0x3b, 0x57, 0x46, 0x88, 0xcb,
-	0x85, 0xfd, 0x5d, 0xb8, 0xa2, 0x2b, 0xab, 0x16, 0xdd, 0xf7, 0x07, 0x91, 0x9f, 0xe5, 0x29, 0xf1,
-	0x90, 0xf2, 0x6b, 0x6e, 0x38, 0xc5, 0x82, 0x9b, 0x7d, 0x3e, 0x9e, 0xca, 0x51, 0x21, 0xc6, 0x11,
-	0xa7, 0xcd, 0xb9, 0x27, 0xb0, 0xa4, 0xe6, 0xbd, 0x1f, 0x78, 0xec, 0x6b, 0xf3, 0x94, 0x71, 0x95,
-	0xb3, 0x6a, 0xf2, 0x3c, 0x0a, 0x3c, 0xa6, 0x39, 0x66, 0xd8, 0x28, 0x63, 0xd5, 0xd2, 0xcc, 0xbc,
-	0xbf, 0xb2, 0xca, 0x66, 0xe6, 0xfd, 0xd5, 0x65, 0x3f, 0x3b, 0xef, 0xef, 0x51, 0x26, 0xca, 0x70,
-	0xbe, 0x64, 0x70, 0x02, 0xcd, 0xfd, 0xa1, 0x4c, 0xf7, 0xbf, 0x32, 0x53, 0x19, 0x03, 0x39, 0xc8,
-	0x34, 0x2b, 0x30, 0xe5, 0x8b, 0x3d, 0x11, 0x5d, 0x41, 0x66, 0x95, 0x8d, 0x6c, 0x0e, 0xaf, 0xbf,
-	0x95, 0xf9, 0x56, 0x16, 0xe8, 0x6c, 0xbe, 0x46, 0x72, 0x86, 0xdf, 0x9e, 0x73, 0xbe, 0x67, 0x40,
-	0xec, 0x04, 0x0d, 0xbf, 0x59, 0xd4, 0x5e, 0xa0, 0xa2, 0xb6, 0x36, 0x5e, 0x76, 0x76, 0x03, 0x19,
-	0x5f, 0x71, 0xd6, 0xca, 0xd9, 0x19, 0xe7, 0xcd, 0x59, 0xff, 0x04, 0x96, 0x0b, 0x69, 0xff, 0x0b,
-	0xe2, 0x6d, 0x99, 0x73, 0x21, 0xe7, 0x57, 0xcc, 0x19, 0xa6, 0xe0, 0x85, 0x82, 0x19, 0xb9, 0x51,
-	0x95, 0xea, 0x58, 0xf5, 0xa8, 0x51, 0x49, 0x97, 0x3c, 0x37, 0xc8, 0x5a, 0x29, 0x13, 0x52, 0x89,
-	0xc2, 0x9f, 0xd4, 0xb0, 0x58, 0x32, 0xa4, 0x5e, 0x47, 0x6e, 0x57, 0xe5, 0xda, 0x17, 0x16, 0x43,
-	0xfa, 0x13, 0x72, 0xad, 0x98, 0x90, 0x97, 0xc4, 0x39, 0xc6, 0xcb, 0x0f, 0xb3, 0xea, 0x66, 0x5d,
-	0x07, 0x54, 0x94, 0xe3, 0x86, 0xe6, 0xcb, 0xc5, 0x5b, 0x00, 0x99, 0xd0, 0x2a, 0x4e, 0x3f, 0xb5,
-	0xff, 0x18, 0x84, 0xc5, 0xf2, 0x66, 0xc5, 0xaa, 0x2f, 0xc2, 0xfa, 0x25, 0x64, 0x7d, 0x95, 0x5c,
-	0x29, 0xac, 0xb7, 0x20, 0x82, 0x08, 0x6b, 0x8d, 0xea, 0x8e, 0x19, 0xd6, 0x96, 0x4a, 0x88, 0x56,
-	0x58, 0x5b, 0xae, 0xe2, 0x95, 0xc2, 0x5a, 0x8f, 0xa3, 0xe0, 0x61, 0x48, 0x18, 0x34, 0x8b, 0x55,
-	0x16, 0xe3, 0x55, 0xae, 0xae, 0xbf, 0x18, 0xaf, 0xf2, 0x90, 0x2b, 0xe7, 0x42, 0xd4, 0xde, 0x65,
-	0xe2, 0xe6, 0xfa, 0x8e, 0x6c, 0x45, 0x23, 0x0c, 0x16, 0x0b, 0x15, 0x10, 0x63, 0x2f, 0x2b, 0x4b,
-	0x23, 0x63, 0xf0, 0xb4, 0xdd, 0x87, 0xe6, 0x39, 0x40, 0x32, 0xfc, 0x35, 0x7a, 0x0e, 0xcb, 0x15,
-	0xd5, 0x0c, 0x23, 0x77, 0x1c, 0x5a, 0xea, 0x68, 0x97, 0xa5, 0xb3, 0x6e, 0xf5, 0xed, 0xfb, 0x9d,
-	0x9c, 0x77, 0x4a, 0x05, 0xe7, 0xc4, 0x58, 0xaf, 0xfc, 0xd3, 0x51, 0x65, 0x8a, 0x56, 0x01, 0xa9,
-	0xbd, 0x39, 0x74, 0xbc, 0xf2, 0x68, 0xd0, 0x2c, 0xe5, 0xdd, 0x7e, 0x08, 0x0b, 0xb6, 0xa8, 0xc6,
-	0xd5, 0x42, 0x55, 0x21, 0xe6, 0xdc, 0x15, 0xda, 0xef, 0x8c, 0x66, 0xf7, 0x39, 0xd2, 0x8e, 0x60,
-	0xde, 0x2a, 0x91, 0x19, 0xe6, 0x5a, 0x51, 0x7c, 0x1b, 0xdf, 0x7e, 0x8a, 0xfa, 0xcc, 0x58, 0x9c,
-	0x08, 0x87, 0xd8, 0x2c, 0x96, 0xe4, 0xc8, 0x66, 0x25, 0xcb, 0xbc, 0xee, 0xf6, 0xf5, 0xb9, 0x66,
-	0x06, 0x57, 0x59, 0xd3, 0xab, 0xe0, 0x6a, 0x57, 0xfb, 0xce, 0xdf, 0xc7, 0x73, 0x98, 0xa2, 0x33,
-	0x2a, 0x96, 0xbd, 0x0e, 0xe2, 0x5e, 0x2f, 0xa4, 0xa4, 0xbc, 0xa2, 0x42, 0x5d, 0x6c, 0x8c, 0x35,
-	0x5b, 0x67, 0x5f, 0xce, 0xde, 0x1b, 0xb0, 0x58, 0xbd, 0x37, 0x3f, 0xc1, 0xe3, 0xa7, 0x50, 0x34,
-	0xb7, 0x8e, 0x9f, 0xea, 0x9a, 0x7f, 0xdb, 0x19, 0x85, 0x32, 0xe4, 0x1c, 0x3a, 0x96, 0x78, 0xa2,
-	0xd4, 0x9e, 0x1d, 0x4e, 0xe1, 0x1f, 0xb2, 0x7b, 0xfb, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x43,
-	0x1f, 0xbd, 0xbb, 0xfb, 0x4e, 0x00, 0x00,
+func (m *DataHistoryJob) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *DataHistoryJob) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
 
-// GoCryptoTraderClient is the client API for GoCryptoTrader service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GoCryptoTraderClient interface {
-	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
-	GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error)
-	EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
-	DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
-	GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error)
-	GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error)
-	GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error)
-	DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error)
-	GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error)
-	GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error)
-	EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error)
-	GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error)
-	GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error)
-	GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error)
-	GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error)
-	GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error)
-	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
-	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
-	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
-	AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error)
-	RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error)
-	GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error)
-	GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error)
-	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
-	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
-	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
-	SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
-	WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
-	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
-	CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error)
-	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
-	AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error)
-	RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error)
-	GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error)
-	GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error)
-	WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
-	WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
-	GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
-	SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
-	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
-	EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error)
-	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error)
-	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error)
-	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error)
-	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
-	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
-	GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
-	GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
-	GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
-	GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
+func (m *DataHistoryJob) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
 }
 
-type goCryptoTraderClient struct {
-	cc *grpc.ClientConn
+func (m *DataHistoryJob) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
 }
 
-func NewGoCryptoTraderClient(cc *grpc.ClientConn) GoCryptoTraderClient {
-	return &goCryptoTraderClient{cc}
+func (m *DataHistoryJob) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
-	out := new(GetInfoResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataHistoryJob) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error) {
-	out := new(GetSusbsytemsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSubsystems", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataHistoryJob) GetCandlesExpected() int64 {
+	if m != nil {
+		return m.CandlesExpected
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
-	out := new(GenericSubsystemResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableSubsystem", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataHistoryJob) GetCandlesStored() int64 {
+	if m != nil {
+		return m.CandlesStored
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
-	out := new(GenericSubsystemResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableSubsystem", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataHistoryJob) GetMissingRanges() int64 {
+	if m != nil {
+		return m.MissingRanges
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error) {
-	out := new(GetRPCEndpointsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRPCEndpoints", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataHistoryJob) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error) {
-	out := new(GetCommunicationRelayersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCommunicationRelayers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataHistoryJob) GetLastRun() string {
+	if m != nil {
+		return m.LastRun
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error) {
-	out := new(GetExchangesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchanges", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataHistoryJob) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchange", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AddDataHistoryJobRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string        `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartDate            string        `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string        `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error) {
-	out := new(GetExchangeInfoResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddDataHistoryJobRequest) Reset()         { *m = AddDataHistoryJobRequest{} }
+func (m *AddDataHistoryJobRequest) String() string { return proto.CompactTextString(m) }
+func (*AddDataHistoryJobRequest) ProtoMessage()    {}
+func (*AddDataHistoryJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{118}
 }
 
-func (c *goCryptoTraderClient) GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error) {
-	out := new(GetExchangeOTPReponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCode", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddDataHistoryJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddDataHistoryJobRequest.Unmarshal(m, b)
 }
-
-func (c *goCryptoTraderClient) GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error) {
-	out := new(GetExchangeOTPsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddDataHistoryJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddDataHistoryJobRequest.Marshal(b, m, deterministic)
 }
-
-func (c *goCryptoTraderClient) EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchange", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AddDataHistoryJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDataHistoryJobRequest.Merge(m, src)
+}
+func (m *AddDataHistoryJobRequest) XXX_Size() int {
+	return xxx_messageInfo_AddDataHistoryJobRequest.Size(m)
+}
+func (m *AddDataHistoryJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDataHistoryJobRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error) {
-	out := new(TickerResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTicker", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddDataHistoryJobRequest proto.InternalMessageInfo
+
+func (m *AddDataHistoryJobRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error) {
-	out := new(GetTickersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTickers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddDataHistoryJobRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error) {
-	out := new(OrderbookResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbook", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddDataHistoryJobRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error) {
-	out := new(GetOrderbooksResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbooks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddDataHistoryJobRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error) {
-	out := new(GetAccountInfoResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAccountInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddDataHistoryJobRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[0], "/gctrpc.GoCryptoTrader/GetAccountInfoStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetAccountInfoStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *AddDataHistoryJobRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
 	}
-	return x, nil
+	return ""
 }
 
-type GoCryptoTrader_GetAccountInfoStreamClient interface {
-	Recv() (*GetAccountInfoResponse, error)
-	grpc.ClientStream
+type GetDataHistoryJobRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type goCryptoTraderGetAccountInfoStreamClient struct {
-	grpc.ClientStream
+func (m *GetDataHistoryJobRequest) Reset()         { *m = GetDataHistoryJobRequest{} }
+func (m *GetDataHistoryJobRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDataHistoryJobRequest) ProtoMessage()    {}
+func (*GetDataHistoryJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{119}
 }
 
-func (x *goCryptoTraderGetAccountInfoStreamClient) Recv() (*GetAccountInfoResponse, error) {
-	m := new(GetAccountInfoResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetDataHistoryJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataHistoryJobRequest.Unmarshal(m, b)
 }
-
-func (c *goCryptoTraderClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
-	out := new(GetConfigResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetDataHistoryJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataHistoryJobRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDataHistoryJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataHistoryJobRequest.Merge(m, src)
+}
+func (m *GetDataHistoryJobRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDataHistoryJobRequest.Size(m)
+}
+func (m *GetDataHistoryJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataHistoryJobRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
-	out := new(GetPortfolioResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolio", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetDataHistoryJobRequest proto.InternalMessageInfo
+
+func (m *GetDataHistoryJobRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error) {
-	out := new(GetPortfolioSummaryResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioSummary", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetDataHistoryJobsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *goCryptoTraderClient) AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error) {
-	out := new(AddPortfolioAddressResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddPortfolioAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetDataHistoryJobsRequest) Reset()         { *m = GetDataHistoryJobsRequest{} }
+func (m *GetDataHistoryJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDataHistoryJobsRequest) ProtoMessage()    {}
+func (*GetDataHistoryJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{120}
 }
 
-func (c *goCryptoTraderClient) RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error) {
-	out := new(RemovePortfolioAddressResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemovePortfolioAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetDataHistoryJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataHistoryJobsRequest.Unmarshal(m, b)
+}
+func (m *GetDataHistoryJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataHistoryJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDataHistoryJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataHistoryJobsRequest.Merge(m, src)
+}
+func (m *GetDataHistoryJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDataHistoryJobsRequest.Size(m)
+}
+func (m *GetDataHistoryJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataHistoryJobsRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error) {
-	out := new(GetForexProvidersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_GetDataHistoryJobsRequest proto.InternalMessageInfo
+
+type GetDataHistoryJobsResponse struct {
+	Jobs                 []*DataHistoryJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error) {
-	out := new(GetForexRatesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetDataHistoryJobsResponse) Reset()         { *m = GetDataHistoryJobsResponse{} }
+func (m *GetDataHistoryJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDataHistoryJobsResponse) ProtoMessage()    {}
+func (*GetDataHistoryJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{121}
 }
 
-func (c *goCryptoTraderClient) GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error) {
-	out := new(GetOrdersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetDataHistoryJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataHistoryJobsResponse.Unmarshal(m, b)
+}
+func (m *GetDataHistoryJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataHistoryJobsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDataHistoryJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataHistoryJobsResponse.Merge(m, src)
+}
+func (m *GetDataHistoryJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDataHistoryJobsResponse.Size(m)
+}
+func (m *GetDataHistoryJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataHistoryJobsResponse.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error) {
-	out := new(OrderDetails)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetDataHistoryJobsResponse proto.InternalMessageInfo
+
+func (m *GetDataHistoryJobsResponse) GetJobs() []*DataHistoryJob {
+	if m != nil {
+		return m.Jobs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
-	out := new(SubmitOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RemoveDataHistoryJobRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *goCryptoTraderClient) SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
-	out := new(SimulateOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SimulateOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RemoveDataHistoryJobRequest) Reset()         { *m = RemoveDataHistoryJobRequest{} }
+func (m *RemoveDataHistoryJobRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDataHistoryJobRequest) ProtoMessage()    {}
+func (*RemoveDataHistoryJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{122}
 }
 
-func (c *goCryptoTraderClient) WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
-	out := new(SimulateOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WhaleBomb", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RemoveDataHistoryJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDataHistoryJobRequest.Unmarshal(m, b)
+}
+func (m *RemoveDataHistoryJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDataHistoryJobRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveDataHistoryJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDataHistoryJobRequest.Merge(m, src)
+}
+func (m *RemoveDataHistoryJobRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveDataHistoryJobRequest.Size(m)
+}
+func (m *RemoveDataHistoryJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDataHistoryJobRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
-	out := new(CancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveDataHistoryJobRequest proto.InternalMessageInfo
+
+func (m *RemoveDataHistoryJobRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error) {
-	out := new(CancelAllOrdersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelAllOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetOrderbookDepthRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	Amounts              []float64     `protobuf:"fixed64,5,rep,packed,name=amounts,proto3" json:"amounts,omitempty"`
+	QuoteAmounts         bool          `protobuf:"varint,6,opt,name=quote_amounts,json=quoteAmounts,proto3" json:"quote_amounts,omitempty"`
+	DepthPercent         float64       `protobuf:"fixed64,7,opt,name=depth_percent,json=depthPercent,proto3" json:"depth_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
-	out := new(GetEventsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetOrderbookDepthRequest) Reset()         { *m = GetOrderbookDepthRequest{} }
+func (m *GetOrderbookDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderbookDepthRequest) ProtoMessage()    {}
+func (*GetOrderbookDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{123}
 }
 
-func (c *goCryptoTraderClient) AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error) {
-	out := new(AddEventResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetOrderbookDepthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderbookDepthRequest.Unmarshal(m, b)
+}
+func (m *GetOrderbookDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderbookDepthRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderbookDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderbookDepthRequest.Merge(m, src)
+}
+func (m *GetOrderbookDepthRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderbookDepthRequest.Size(m)
+}
+func (m *GetOrderbookDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderbookDepthRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error) {
-	out := new(RemoveEventResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetOrderbookDepthRequest proto.InternalMessageInfo
+
+func (m *GetOrderbookDepthRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error) {
-	out := new(GetCryptocurrencyDepositAddressesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error) {
-	out := new(GetCryptocurrencyDepositAddressResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
-	out := new(WithdrawResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthRequest) GetSide() string {
+	if m != nil {
+		return m.Side
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
-	out := new(WithdrawResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawFiatFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthRequest) GetAmounts() []float64 {
+	if m != nil {
+		return m.Amounts
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
-	out := new(GetLoggerDetailsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLoggerDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthRequest) GetQuoteAmounts() bool {
+	if m != nil {
+		return m.QuoteAmounts
 	}
-	return out, nil
+	return false
 }
 
-func (c *goCryptoTraderClient) SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
-	out := new(GetLoggerDetailsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLoggerDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthRequest) GetDepthPercent() float64 {
+	if m != nil {
+		return m.DepthPercent
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error) {
-	out := new(GetExchangePairsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangePairs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type OrderbookVWAP struct {
+	Amount               float64  `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Vwap                 float64  `protobuf:"fixed64,2,opt,name=vwap,proto3" json:"vwap,omitempty"`
+	BaseAmount           float64  `protobuf:"fixed64,3,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`
+	QuoteAmount          float64  `protobuf:"fixed64,4,opt,name=quote_amount,json=quoteAmount,proto3" json:"quote_amount,omitempty"`
+	BestPrice            float64  `protobuf:"fixed64,5,opt,name=best_price,json=bestPrice,proto3" json:"best_price,omitempty"`
+	WorstPrice           float64  `protobuf:"fixed64,6,opt,name=worst_price,json=worstPrice,proto3" json:"worst_price,omitempty"`
+	PriceImpact          float64  `protobuf:"fixed64,7,opt,name=price_impact,json=priceImpact,proto3" json:"price_impact,omitempty"`
+	MidSlippage          float64  `protobuf:"fixed64,8,opt,name=mid_slippage,json=midSlippage,proto3" json:"mid_slippage,omitempty"`
+	Levels               int64    `protobuf:"varint,9,opt,name=levels,proto3" json:"levels,omitempty"`
+	FullyFilled          bool     `protobuf:"varint,10,opt,name=fully_filled,json=fullyFilled,proto3" json:"fully_filled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *goCryptoTraderClient) EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchangePair", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OrderbookVWAP) Reset()         { *m = OrderbookVWAP{} }
+func (m *OrderbookVWAP) String() string { return proto.CompactTextString(m) }
+func (*OrderbookVWAP) ProtoMessage()    {}
+func (*OrderbookVWAP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{124}
 }
 
-func (c *goCryptoTraderClient) DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchangePair", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OrderbookVWAP) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderbookVWAP.Unmarshal(m, b)
+}
+func (m *OrderbookVWAP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderbookVWAP.Marshal(b, m, deterministic)
+}
+func (m *OrderbookVWAP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderbookVWAP.Merge(m, src)
+}
+func (m *OrderbookVWAP) XXX_Size() int {
+	return xxx_messageInfo_OrderbookVWAP.Size(m)
+}
+func (m *OrderbookVWAP) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderbookVWAP.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[1], "/gctrpc.GoCryptoTrader/GetOrderbookStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetOrderbookStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_OrderbookVWAP proto.InternalMessageInfo
+
+func (m *OrderbookVWAP) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
 	}
-	return x, nil
+	return 0
 }
 
-type GoCryptoTrader_GetOrderbookStreamClient interface {
-	Recv() (*OrderbookResponse, error)
-	grpc.ClientStream
+func (m *OrderbookVWAP) GetVwap() float64 {
+	if m != nil {
+		return m.Vwap
+	}
+	return 0
 }
 
-type goCryptoTraderGetOrderbookStreamClient struct {
-	grpc.ClientStream
+func (m *OrderbookVWAP) GetBaseAmount() float64 {
+	if m != nil {
+		return m.BaseAmount
+	}
+	return 0
 }
 
-func (x *goCryptoTraderGetOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
-	m := new(OrderbookResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *OrderbookVWAP) GetQuoteAmount() float64 {
+	if m != nil {
+		return m.QuoteAmount
 	}
-	return m, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[2], "/gctrpc.GoCryptoTrader/GetExchangeOrderbookStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetExchangeOrderbookStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *OrderbookVWAP) GetBestPrice() float64 {
+	if m != nil {
+		return m.BestPrice
 	}
-	return x, nil
+	return 0
 }
 
-type GoCryptoTrader_GetExchangeOrderbookStreamClient interface {
-	Recv() (*OrderbookResponse, error)
-	grpc.ClientStream
+func (m *OrderbookVWAP) GetWorstPrice() float64 {
+	if m != nil {
+		return m.WorstPrice
+	}
+	return 0
 }
 
-type goCryptoTraderGetExchangeOrderbookStreamClient struct {
-	grpc.ClientStream
+func (m *OrderbookVWAP) GetPriceImpact() float64 {
+	if m != nil {
+		return m.PriceImpact
+	}
+	return 0
 }
 
-func (x *goCryptoTraderGetExchangeOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
-	m := new(OrderbookResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *OrderbookVWAP) GetMidSlippage() float64 {
+	if m != nil {
+		return m.MidSlippage
 	}
-	return m, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetTickerStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+func (m *OrderbookVWAP) GetLevels() int64 {
+	if m != nil {
+		return m.Levels
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *OrderbookVWAP) GetFullyFilled() bool {
+	if m != nil {
+		return m.FullyFilled
 	}
-	return x, nil
+	return false
 }
 
-type GoCryptoTrader_GetTickerStreamClient interface {
-	Recv() (*TickerResponse, error)
-	grpc.ClientStream
+type GetOrderbookDepthResponse struct {
+	BestBid              float64          `protobuf:"fixed64,1,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`
+	BestAsk              float64          `protobuf:"fixed64,2,opt,name=best_ask,json=bestAsk,proto3" json:"best_ask,omitempty"`
+	MidPrice             float64          `protobuf:"fixed64,3,opt,name=mid_price,json=midPrice,proto3" json:"mid_price,omitempty"`
+	Spread               float64          `protobuf:"fixed64,4,opt,name=spread,proto3" json:"spread,omitempty"`
+	SpreadBasisPoints    float64          `protobuf:"fixed64,5,opt,name=spread_basis_points,json=spreadBasisPoints,proto3" json:"spread_basis_points,omitempty"`
+	DepthPercent         float64          `protobuf:"fixed64,6,opt,name=depth_percent,json=depthPercent,proto3" json:"depth_percent,omitempty"`
+	BidAmount            float64          `protobuf:"fixed64,7,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	BidValue             float64          `protobuf:"fixed64,8,opt,name=bid_value,json=bidValue,proto3" json:"bid_value,omitempty"`
+	AskAmount            float64          `protobuf:"fixed64,9,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	AskValue             float64          `protobuf:"fixed64,10,opt,name=ask_value,json=askValue,proto3" json:"ask_value,omitempty"`
+	Imbalance            float64          `protobuf:"fixed64,11,opt,name=imbalance,proto3" json:"imbalance,omitempty"`
+	Slippage             []*OrderbookVWAP `protobuf:"bytes,12,rep,name=slippage,proto3" json:"slippage,omitempty"`
+	LastUpdated          string           `protobuf:"bytes,13,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-type goCryptoTraderGetTickerStreamClient struct {
-	grpc.ClientStream
+func (m *GetOrderbookDepthResponse) Reset()         { *m = GetOrderbookDepthResponse{} }
+func (m *GetOrderbookDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrderbookDepthResponse) ProtoMessage()    {}
+func (*GetOrderbookDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{125}
 }
 
-func (x *goCryptoTraderGetTickerStreamClient) Recv() (*TickerResponse, error) {
-	m := new(TickerResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetOrderbookDepthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderbookDepthResponse.Unmarshal(m, b)
+}
+func (m *GetOrderbookDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderbookDepthResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOrderbookDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderbookDepthResponse.Merge(m, src)
+}
+func (m *GetOrderbookDepthResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrderbookDepthResponse.Size(m)
+}
+func (m *GetOrderbookDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderbookDepthResponse.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetExchangeTickerStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+var xxx_messageInfo_GetOrderbookDepthResponse proto.InternalMessageInfo
+
+func (m *GetOrderbookDepthResponse) GetBestBid() float64 {
+	if m != nil {
+		return m.BestBid
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *GetOrderbookDepthResponse) GetBestAsk() float64 {
+	if m != nil {
+		return m.BestAsk
 	}
-	return x, nil
+	return 0
 }
 
-type GoCryptoTrader_GetExchangeTickerStreamClient interface {
-	Recv() (*TickerResponse, error)
-	grpc.ClientStream
+func (m *GetOrderbookDepthResponse) GetMidPrice() float64 {
+	if m != nil {
+		return m.MidPrice
+	}
+	return 0
 }
 
-type goCryptoTraderGetExchangeTickerStreamClient struct {
-	grpc.ClientStream
+func (m *GetOrderbookDepthResponse) GetSpread() float64 {
+	if m != nil {
+		return m.Spread
+	}
+	return 0
 }
 
-func (x *goCryptoTraderGetExchangeTickerStreamClient) Recv() (*TickerResponse, error) {
-	m := new(TickerResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetOrderbookDepthResponse) GetSpreadBasisPoints() float64 {
+	if m != nil {
+		return m.SpreadBasisPoints
 	}
-	return m, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error) {
-	out := new(GetAuditEventResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAuditEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthResponse) GetDepthPercent() float64 {
+	if m != nil {
+		return m.DepthPercent
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptExecute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthResponse) GetBidAmount() float64 {
+	if m != nil {
+		return m.BidAmount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptUpload", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthResponse) GetBidValue() float64 {
+	if m != nil {
+		return m.BidValue
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
-	out := new(GCTScriptQueryResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptReadScript", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthResponse) GetAskAmount() float64 {
+	if m != nil {
+		return m.AskAmount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
-	out := new(GCTScriptStatusResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthResponse) GetAskValue() float64 {
+	if m != nil {
+		return m.AskValue
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
-	out := new(GCTScriptQueryResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthResponse) GetImbalance() float64 {
+	if m != nil {
+		return m.Imbalance
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStop", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthResponse) GetSlippage() []*OrderbookVWAP {
+	if m != nil {
+		return m.Slippage
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStopAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookDepthResponse) GetLastUpdated() string {
+	if m != nil {
+		return m.LastUpdated
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
-	out := new(GCTScriptStatusResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptListAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+type GetConsolidatedOrderbookStreamRequest struct {
+	Pair                 *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Exchanges            []string      `protobuf:"bytes,3,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetConsolidatedOrderbookStreamRequest) Reset()         { *m = GetConsolidatedOrderbookStreamRequest{} }
+func (m *GetConsolidatedOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetConsolidatedOrderbookStreamRequest) ProtoMessage()    {}
+func (*GetConsolidatedOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{126}
+}
+
+func (m *GetConsolidatedOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConsolidatedOrderbookStreamRequest.Unmarshal(m, b)
+}
+func (m *GetConsolidatedOrderbookStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConsolidatedOrderbookStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetConsolidatedOrderbookStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConsolidatedOrderbookStreamRequest.Merge(m, src)
+}
+func (m *GetConsolidatedOrderbookStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetConsolidatedOrderbookStreamRequest.Size(m)
+}
+func (m *GetConsolidatedOrderbookStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConsolidatedOrderbookStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetConsolidatedOrderbookStreamRequest proto.InternalMessageInfo
+
+func (m *GetConsolidatedOrderbookStreamRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetConsolidatedOrderbookStreamRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error) {
-	out := new(GetHistoricCandlesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetHistoricCandles", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetConsolidatedOrderbookStreamRequest) GetExchanges() []string {
+	if m != nil {
+		return m.Exchanges
 	}
-	return out, nil
+	return nil
 }
 
-// GoCryptoTraderServer is the server API for GoCryptoTrader service.
-type GoCryptoTraderServer interface {
-	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
-	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
-	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
-	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
-	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
-	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
-	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
-	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
-	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
-	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error)
-	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
-	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
-	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
-	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
-	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
-	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
-	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
-	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
-	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
-	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
-	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error)
-	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error)
-	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
-	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
-	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
-	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
-	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
-	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
-	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
-	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
-	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
-	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
-	RemoveEvent(context.Context, *RemoveEventRequest) (*RemoveEventResponse, error)
-	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
-	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
-	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
-	WithdrawFiatFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
-	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
-	EnableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
-	DisableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
-	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
-	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
-	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
-	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
-	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
-	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error)
-	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
+type ConsolidatedOrderbookItem struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	OriginalPrice        float64  `protobuf:"fixed64,4,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedGoCryptoTraderServer can be embedded to have forward compatible implementations.
-type UnimplementedGoCryptoTraderServer struct {
+func (m *ConsolidatedOrderbookItem) Reset()         { *m = ConsolidatedOrderbookItem{} }
+func (m *ConsolidatedOrderbookItem) String() string { return proto.CompactTextString(m) }
+func (*ConsolidatedOrderbookItem) ProtoMessage()    {}
+func (*ConsolidatedOrderbookItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{127}
 }
 
-func (*UnimplementedGoCryptoTraderServer) GetInfo(ctx context.Context, req *GetInfoRequest) (*GetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+func (m *ConsolidatedOrderbookItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsolidatedOrderbookItem.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetSubsystems(ctx context.Context, req *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
+func (m *ConsolidatedOrderbookItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsolidatedOrderbookItem.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) EnableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+func (m *ConsolidatedOrderbookItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidatedOrderbookItem.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) DisableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+func (m *ConsolidatedOrderbookItem) XXX_Size() int {
+	return xxx_messageInfo_ConsolidatedOrderbookItem.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetRPCEndpoints(ctx context.Context, req *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+func (m *ConsolidatedOrderbookItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidatedOrderbookItem.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(ctx context.Context, req *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+
+var xxx_messageInfo_ConsolidatedOrderbookItem proto.InternalMessageInfo
+
+func (m *ConsolidatedOrderbookItem) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchanges(ctx context.Context, req *GetExchangesRequest) (*GetExchangesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+
+func (m *ConsolidatedOrderbookItem) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) DisableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+
+func (m *ConsolidatedOrderbookItem) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeInfo(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+
+func (m *ConsolidatedOrderbookItem) GetOriginalPrice() float64 {
+	if m != nil {
+		return m.OriginalPrice
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+
+type ConsolidatedOrderbookSource struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Rate                 float64       `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	LastUpdated          string        `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(ctx context.Context, req *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+
+func (m *ConsolidatedOrderbookSource) Reset()         { *m = ConsolidatedOrderbookSource{} }
+func (m *ConsolidatedOrderbookSource) String() string { return proto.CompactTextString(m) }
+func (*ConsolidatedOrderbookSource) ProtoMessage()    {}
+func (*ConsolidatedOrderbookSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{128}
 }
-func (*UnimplementedGoCryptoTraderServer) EnableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+
+func (m *ConsolidatedOrderbookSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsolidatedOrderbookSource.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetTicker(ctx context.Context, req *GetTickerRequest) (*TickerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+func (m *ConsolidatedOrderbookSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsolidatedOrderbookSource.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GetTickers(ctx context.Context, req *GetTickersRequest) (*GetTickersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+func (m *ConsolidatedOrderbookSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidatedOrderbookSource.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbook(ctx context.Context, req *GetOrderbookRequest) (*OrderbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+func (m *ConsolidatedOrderbookSource) XXX_Size() int {
+	return xxx_messageInfo_ConsolidatedOrderbookSource.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbooks(ctx context.Context, req *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+func (m *ConsolidatedOrderbookSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidatedOrderbookSource.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetAccountInfo(ctx context.Context, req *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+
+var xxx_messageInfo_ConsolidatedOrderbookSource proto.InternalMessageInfo
+
+func (m *ConsolidatedOrderbookSource) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetAccountInfoStream(req *GetAccountInfoRequest, srv GoCryptoTrader_GetAccountInfoStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+
+func (m *ConsolidatedOrderbookSource) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) GetConfig(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+
+func (m *ConsolidatedOrderbookSource) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetPortfolio(ctx context.Context, req *GetPortfolioRequest) (*GetPortfolioResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+
+func (m *ConsolidatedOrderbookSource) GetLastUpdated() string {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetPortfolioSummary(ctx context.Context, req *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+
+type ConsolidatedOrderbookResponse struct {
+	Pair                 *CurrencyPair                  `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string                         `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Bids                 []*ConsolidatedOrderbookItem   `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks                 []*ConsolidatedOrderbookItem   `protobuf:"bytes,4,rep,name=asks,proto3" json:"asks,omitempty"`
+	Sources              []*ConsolidatedOrderbookSource `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources,omitempty"`
+	LastUpdated          string                         `protobuf:"bytes,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) AddPortfolioAddress(ctx context.Context, req *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+
+func (m *ConsolidatedOrderbookResponse) Reset()         { *m = ConsolidatedOrderbookResponse{} }
+func (m *ConsolidatedOrderbookResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsolidatedOrderbookResponse) ProtoMessage()    {}
+func (*ConsolidatedOrderbookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{129}
 }
-func (*UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(ctx context.Context, req *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+
+func (m *ConsolidatedOrderbookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsolidatedOrderbookResponse.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetForexProviders(ctx context.Context, req *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+func (m *ConsolidatedOrderbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsolidatedOrderbookResponse.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GetForexRates(ctx context.Context, req *GetForexRatesRequest) (*GetForexRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+func (m *ConsolidatedOrderbookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidatedOrderbookResponse.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrders(ctx context.Context, req *GetOrdersRequest) (*GetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+func (m *ConsolidatedOrderbookResponse) XXX_Size() int {
+	return xxx_messageInfo_ConsolidatedOrderbookResponse.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrder(ctx context.Context, req *GetOrderRequest) (*OrderDetails, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+func (m *ConsolidatedOrderbookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidatedOrderbookResponse.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) SubmitOrder(ctx context.Context, req *SubmitOrderRequest) (*SubmitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+
+var xxx_messageInfo_ConsolidatedOrderbookResponse proto.InternalMessageInfo
+
+func (m *ConsolidatedOrderbookResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) SimulateOrder(ctx context.Context, req *SimulateOrderRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+
+func (m *ConsolidatedOrderbookResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) WhaleBomb(ctx context.Context, req *WhaleBombRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+
+func (m *ConsolidatedOrderbookResponse) GetBids() []*ConsolidatedOrderbookItem {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) CancelOrder(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+
+func (m *ConsolidatedOrderbookResponse) GetAsks() []*ConsolidatedOrderbookItem {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) CancelAllOrders(ctx context.Context, req *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+
+func (m *ConsolidatedOrderbookResponse) GetSources() []*ConsolidatedOrderbookSource {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) GetEvents(ctx context.Context, req *GetEventsRequest) (*GetEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+
+func (m *ConsolidatedOrderbookResponse) GetLastUpdated() string {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) AddEvent(ctx context.Context, req *AddEventRequest) (*AddEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+
+type GetArbitrageOpportunitiesRequest struct {
+	AssetType            string        `protobuf:"bytes,1,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) RemoveEvent(ctx context.Context, req *RemoveEventRequest) (*RemoveEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+
+func (m *GetArbitrageOpportunitiesRequest) Reset()         { *m = GetArbitrageOpportunitiesRequest{} }
+func (m *GetArbitrageOpportunitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetArbitrageOpportunitiesRequest) ProtoMessage()    {}
+func (*GetArbitrageOpportunitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{130}
 }
-func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(ctx context.Context, req *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+
+func (m *GetArbitrageOpportunitiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArbitrageOpportunitiesRequest.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(ctx context.Context, req *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+func (m *GetArbitrageOpportunitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArbitrageOpportunitiesRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+func (m *GetArbitrageOpportunitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArbitrageOpportunitiesRequest.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+func (m *GetArbitrageOpportunitiesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetArbitrageOpportunitiesRequest.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetLoggerDetails(ctx context.Context, req *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+func (m *GetArbitrageOpportunitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArbitrageOpportunitiesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) SetLoggerDetails(ctx context.Context, req *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+
+var xxx_messageInfo_GetArbitrageOpportunitiesRequest proto.InternalMessageInfo
+
+func (m *GetArbitrageOpportunitiesRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangePairs(ctx context.Context, req *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+
+func (m *GetArbitrageOpportunitiesRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) EnableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableExchangePair not implemented")
+
+type ArbitrageOpportunity struct {
+	Pair                   *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType              string        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	BuyExchange            string        `protobuf:"bytes,3,opt,name=buy_exchange,json=buyExchange,proto3" json:"buy_exchange,omitempty"`
+	BuyPrice               float64       `protobuf:"fixed64,4,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
+	BuyFee                 float64       `protobuf:"fixed64,5,opt,name=buy_fee,json=buyFee,proto3" json:"buy_fee,omitempty"`
+	SellExchange           string        `protobuf:"bytes,6,opt,name=sell_exchange,json=sellExchange,proto3" json:"sell_exchange,omitempty"`
+	SellPrice              float64       `protobuf:"fixed64,7,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	SellFee                float64       `protobuf:"fixed64,8,opt,name=sell_fee,json=sellFee,proto3" json:"sell_fee,omitempty"`
+	Amount                 float64       `protobuf:"fixed64,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	GrossSpread            float64       `protobuf:"fixed64,10,opt,name=gross_spread,json=grossSpread,proto3" json:"gross_spread,omitempty"`
+	GrossSpreadBasisPoints float64       `protobuf:"fixed64,11,opt,name=gross_spread_basis_points,json=grossSpreadBasisPoints,proto3" json:"gross_spread_basis_points,omitempty"`
+	NetSpread              float64       `protobuf:"fixed64,12,opt,name=net_spread,json=netSpread,proto3" json:"net_spread,omitempty"`
+	NetSpreadBasisPoints   float64       `protobuf:"fixed64,13,opt,name=net_spread_basis_points,json=netSpreadBasisPoints,proto3" json:"net_spread_basis_points,omitempty"`
+	NetProfit              float64       `protobuf:"fixed64,14,opt,name=net_profit,json=netProfit,proto3" json:"net_profit,omitempty"`
+	Updated                string        `protobuf:"bytes,15,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}      `json:"-"`
+	XXX_unrecognized       []byte        `json:"-"`
+	XXX_sizecache          int32         `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) DisableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableExchangePair not implemented")
+
+func (m *ArbitrageOpportunity) Reset()         { *m = ArbitrageOpportunity{} }
+func (m *ArbitrageOpportunity) String() string { return proto.CompactTextString(m) }
+func (*ArbitrageOpportunity) ProtoMessage()    {}
+func (*ArbitrageOpportunity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{131}
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbookStream(req *GetOrderbookStreamRequest, srv GoCryptoTrader_GetOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+
+func (m *ArbitrageOpportunity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArbitrageOpportunity.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(req *GetExchangeOrderbookStreamRequest, srv GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+func (m *ArbitrageOpportunity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArbitrageOpportunity.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GetTickerStream(req *GetTickerStreamRequest, srv GoCryptoTrader_GetTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+func (m *ArbitrageOpportunity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrageOpportunity.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(req *GetExchangeTickerStreamRequest, srv GoCryptoTrader_GetExchangeTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+func (m *ArbitrageOpportunity) XXX_Size() int {
+	return xxx_messageInfo_ArbitrageOpportunity.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetAuditEvent(ctx context.Context, req *GetAuditEventRequest) (*GetAuditEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+func (m *ArbitrageOpportunity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrageOpportunity.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptExecute(ctx context.Context, req *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+
+var xxx_messageInfo_ArbitrageOpportunity proto.InternalMessageInfo
+
+func (m *ArbitrageOpportunity) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptUpload(ctx context.Context, req *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+
+func (m *ArbitrageOpportunity) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptReadScript(ctx context.Context, req *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+
+func (m *ArbitrageOpportunity) GetBuyExchange() string {
+	if m != nil {
+		return m.BuyExchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptStatus(ctx context.Context, req *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+
+func (m *ArbitrageOpportunity) GetBuyPrice() float64 {
+	if m != nil {
+		return m.BuyPrice
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptQuery(ctx context.Context, req *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+
+func (m *ArbitrageOpportunity) GetBuyFee() float64 {
+	if m != nil {
+		return m.BuyFee
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptStop(ctx context.Context, req *GCTScriptStopRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+
+func (m *ArbitrageOpportunity) GetSellExchange() string {
+	if m != nil {
+		return m.SellExchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptStopAll(ctx context.Context, req *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+
+func (m *ArbitrageOpportunity) GetSellPrice() float64 {
+	if m != nil {
+		return m.SellPrice
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptListAll(ctx context.Context, req *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+
+func (m *ArbitrageOpportunity) GetSellFee() float64 {
+	if m != nil {
+		return m.SellFee
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(ctx context.Context, req *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+
+func (m *ArbitrageOpportunity) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetGrossSpread() float64 {
+	if m != nil {
+		return m.GrossSpread
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetGrossSpreadBasisPoints() float64 {
+	if m != nil {
+		return m.GrossSpreadBasisPoints
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetNetSpread() float64 {
+	if m != nil {
+		return m.NetSpread
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetNetSpreadBasisPoints() float64 {
+	if m != nil {
+		return m.NetSpreadBasisPoints
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetNetProfit() float64 {
+	if m != nil {
+		return m.NetProfit
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetUpdated() string {
+	if m != nil {
+		return m.Updated
+	}
+	return ""
+}
+
+type GetArbitrageOpportunitiesResponse struct {
+	AlertThreshold       float64                 `protobuf:"fixed64,1,opt,name=alert_threshold,json=alertThreshold,proto3" json:"alert_threshold,omitempty"`
+	Opportunities        []*ArbitrageOpportunity `protobuf:"bytes,2,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	Alerts               []*ArbitrageOpportunity `protobuf:"bytes,3,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetArbitrageOpportunitiesResponse) Reset()         { *m = GetArbitrageOpportunitiesResponse{} }
+func (m *GetArbitrageOpportunitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetArbitrageOpportunitiesResponse) ProtoMessage()    {}
+func (*GetArbitrageOpportunitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{132}
+}
+
+func (m *GetArbitrageOpportunitiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArbitrageOpportunitiesResponse.Unmarshal(m, b)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArbitrageOpportunitiesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArbitrageOpportunitiesResponse.Merge(m, src)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetArbitrageOpportunitiesResponse.Size(m)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArbitrageOpportunitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArbitrageOpportunitiesResponse proto.InternalMessageInfo
+
+func (m *GetArbitrageOpportunitiesResponse) GetAlertThreshold() float64 {
+	if m != nil {
+		return m.AlertThreshold
+	}
+	return 0
+}
+
+func (m *GetArbitrageOpportunitiesResponse) GetOpportunities() []*ArbitrageOpportunity {
+	if m != nil {
+		return m.Opportunities
+	}
+	return nil
+}
+
+func (m *GetArbitrageOpportunitiesResponse) GetAlerts() []*ArbitrageOpportunity {
+	if m != nil {
+		return m.Alerts
+	}
+	return nil
+}
+
+type GetDispatchStatsRequest struct {
+	LaggingOnly          bool     `protobuf:"varint,1,opt,name=lagging_only,json=laggingOnly,proto3" json:"lagging_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDispatchStatsRequest) Reset()         { *m = GetDispatchStatsRequest{} }
+func (m *GetDispatchStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDispatchStatsRequest) ProtoMessage()    {}
+func (*GetDispatchStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{133}
+}
+
+func (m *GetDispatchStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDispatchStatsRequest.Unmarshal(m, b)
+}
+func (m *GetDispatchStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDispatchStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDispatchStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDispatchStatsRequest.Merge(m, src)
+}
+func (m *GetDispatchStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDispatchStatsRequest.Size(m)
+}
+func (m *GetDispatchStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDispatchStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDispatchStatsRequest proto.InternalMessageInfo
+
+func (m *GetDispatchStatsRequest) GetLaggingOnly() bool {
+	if m != nil {
+		return m.LaggingOnly
+	}
+	return false
+}
+
+type DispatchPipeStats struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Policy               string   `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	BufferSize           int64    `protobuf:"varint,4,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	Published            uint64   `protobuf:"varint,5,opt,name=published,proto3" json:"published,omitempty"`
+	Dropped              uint64   `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Lag                  int64    `protobuf:"varint,7,opt,name=lag,proto3" json:"lag,omitempty"`
+	MaxLag               int64    `protobuf:"varint,8,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
+	Subscribed           string   `protobuf:"bytes,9,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DispatchPipeStats) Reset()         { *m = DispatchPipeStats{} }
+func (m *DispatchPipeStats) String() string { return proto.CompactTextString(m) }
+func (*DispatchPipeStats) ProtoMessage()    {}
+func (*DispatchPipeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{134}
+}
+
+func (m *DispatchPipeStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DispatchPipeStats.Unmarshal(m, b)
+}
+func (m *DispatchPipeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DispatchPipeStats.Marshal(b, m, deterministic)
+}
+func (m *DispatchPipeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DispatchPipeStats.Merge(m, src)
+}
+func (m *DispatchPipeStats) XXX_Size() int {
+	return xxx_messageInfo_DispatchPipeStats.Size(m)
+}
+func (m *DispatchPipeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DispatchPipeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DispatchPipeStats proto.InternalMessageInfo
+
+func (m *DispatchPipeStats) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DispatchPipeStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DispatchPipeStats) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *DispatchPipeStats) GetBufferSize() int64 {
+	if m != nil {
+		return m.BufferSize
+	}
+	return 0
+}
+
+func (m *DispatchPipeStats) GetPublished() uint64 {
+	if m != nil {
+		return m.Published
+	}
+	return 0
+}
+
+func (m *DispatchPipeStats) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+func (m *DispatchPipeStats) GetLag() int64 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *DispatchPipeStats) GetMaxLag() int64 {
+	if m != nil {
+		return m.MaxLag
+	}
+	return 0
+}
+
+func (m *DispatchPipeStats) GetSubscribed() string {
+	if m != nil {
+		return m.Subscribed
+	}
+	return ""
+}
+
+type GetDispatchStatsResponse struct {
+	Running              bool                 `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	Workers              int32                `protobuf:"varint,2,opt,name=workers,proto3" json:"workers,omitempty"`
+	Jobs                 int64                `protobuf:"varint,3,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	JobsLimit            int64                `protobuf:"varint,4,opt,name=jobs_limit,json=jobsLimit,proto3" json:"jobs_limit,omitempty"`
+	DroppedJobs          uint64               `protobuf:"varint,5,opt,name=dropped_jobs,json=droppedJobs,proto3" json:"dropped_jobs,omitempty"`
+	Pipes                []*DispatchPipeStats `protobuf:"bytes,6,rep,name=pipes,proto3" json:"pipes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDispatchStatsResponse) Reset()         { *m = GetDispatchStatsResponse{} }
+func (m *GetDispatchStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDispatchStatsResponse) ProtoMessage()    {}
+func (*GetDispatchStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{135}
+}
+
+func (m *GetDispatchStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDispatchStatsResponse.Unmarshal(m, b)
+}
+func (m *GetDispatchStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDispatchStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDispatchStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDispatchStatsResponse.Merge(m, src)
+}
+func (m *GetDispatchStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDispatchStatsResponse.Size(m)
+}
+func (m *GetDispatchStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDispatchStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDispatchStatsResponse proto.InternalMessageInfo
+
+func (m *GetDispatchStatsResponse) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *GetDispatchStatsResponse) GetWorkers() int32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+func (m *GetDispatchStatsResponse) GetJobs() int64 {
+	if m != nil {
+		return m.Jobs
+	}
+	return 0
+}
+
+func (m *GetDispatchStatsResponse) GetJobsLimit() int64 {
+	if m != nil {
+		return m.JobsLimit
+	}
+	return 0
+}
+
+func (m *GetDispatchStatsResponse) GetDroppedJobs() uint64 {
+	if m != nil {
+		return m.DroppedJobs
+	}
+	return 0
+}
+
+func (m *GetDispatchStatsResponse) GetPipes() []*DispatchPipeStats {
+	if m != nil {
+		return m.Pipes
+	}
+	return nil
+}
+
+type GetOrderReconciliationRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Reconcile            bool     `protobuf:"varint,2,opt,name=reconcile,proto3" json:"reconcile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrderReconciliationRequest) Reset()         { *m = GetOrderReconciliationRequest{} }
+func (m *GetOrderReconciliationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderReconciliationRequest) ProtoMessage()    {}
+func (*GetOrderReconciliationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{136}
+}
+
+func (m *GetOrderReconciliationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderReconciliationRequest.Unmarshal(m, b)
+}
+func (m *GetOrderReconciliationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderReconciliationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderReconciliationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderReconciliationRequest.Merge(m, src)
+}
+func (m *GetOrderReconciliationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderReconciliationRequest.Size(m)
+}
+func (m *GetOrderReconciliationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderReconciliationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderReconciliationRequest proto.InternalMessageInfo
+
+func (m *GetOrderReconciliationRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetOrderReconciliationRequest) GetReconcile() bool {
+	if m != nil {
+		return m.Reconcile
+	}
+	return false
+}
+
+type OrderReconciliationChange struct {
+	Exchange               string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id                     string        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	InternalId             string        `protobuf:"bytes,3,opt,name=internal_id,json=internalId,proto3" json:"internal_id,omitempty"`
+	Pair                   *CurrencyPair `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	Kind                   string        `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	PreviousStatus         string        `protobuf:"bytes,6,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	Status                 string        `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	PreviousExecutedAmount float64       `protobuf:"fixed64,8,opt,name=previous_executed_amount,json=previousExecutedAmount,proto3" json:"previous_executed_amount,omitempty"`
+	ExecutedAmount         float64       `protobuf:"fixed64,9,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	Amount                 float64       `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason                 string        `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}      `json:"-"`
+	XXX_unrecognized       []byte        `json:"-"`
+	XXX_sizecache          int32         `json:"-"`
+}
+
+func (m *OrderReconciliationChange) Reset()         { *m = OrderReconciliationChange{} }
+func (m *OrderReconciliationChange) String() string { return proto.CompactTextString(m) }
+func (*OrderReconciliationChange) ProtoMessage()    {}
+func (*OrderReconciliationChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{137}
+}
+
+func (m *OrderReconciliationChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderReconciliationChange.Unmarshal(m, b)
+}
+func (m *OrderReconciliationChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderReconciliationChange.Marshal(b, m, deterministic)
+}
+func (m *OrderReconciliationChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderReconciliationChange.Merge(m, src)
+}
+func (m *OrderReconciliationChange) XXX_Size() int {
+	return xxx_messageInfo_OrderReconciliationChange.Size(m)
+}
+func (m *OrderReconciliationChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderReconciliationChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderReconciliationChange proto.InternalMessageInfo
+
+func (m *OrderReconciliationChange) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *OrderReconciliationChange) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OrderReconciliationChange) GetInternalId() string {
+	if m != nil {
+		return m.InternalId
+	}
+	return ""
+}
+
+func (m *OrderReconciliationChange) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *OrderReconciliationChange) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *OrderReconciliationChange) GetPreviousStatus() string {
+	if m != nil {
+		return m.PreviousStatus
+	}
+	return ""
+}
+
+func (m *OrderReconciliationChange) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OrderReconciliationChange) GetPreviousExecutedAmount() float64 {
+	if m != nil {
+		return m.PreviousExecutedAmount
+	}
+	return 0
+}
+
+func (m *OrderReconciliationChange) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
+}
+
+func (m *OrderReconciliationChange) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *OrderReconciliationChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type OrderReconciliationReport struct {
+	Exchange             string                       `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Started              string                       `protobuf:"bytes,2,opt,name=started,proto3" json:"started,omitempty"`
+	Finished             string                       `protobuf:"bytes,3,opt,name=finished,proto3" json:"finished,omitempty"`
+	LocalOpenOrders      int64                        `protobuf:"varint,4,opt,name=local_open_orders,json=localOpenOrders,proto3" json:"local_open_orders,omitempty"`
+	ExchangeOpenOrders   int64                        `protobuf:"varint,5,opt,name=exchange_open_orders,json=exchangeOpenOrders,proto3" json:"exchange_open_orders,omitempty"`
+	Matched              int64                        `protobuf:"varint,6,opt,name=matched,proto3" json:"matched,omitempty"`
+	Changes              []*OrderReconciliationChange `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	Error                string                       `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *OrderReconciliationReport) Reset()         { *m = OrderReconciliationReport{} }
+func (m *OrderReconciliationReport) String() string { return proto.CompactTextString(m) }
+func (*OrderReconciliationReport) ProtoMessage()    {}
+func (*OrderReconciliationReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{138}
+}
+
+func (m *OrderReconciliationReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderReconciliationReport.Unmarshal(m, b)
+}
+func (m *OrderReconciliationReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderReconciliationReport.Marshal(b, m, deterministic)
+}
+func (m *OrderReconciliationReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderReconciliationReport.Merge(m, src)
+}
+func (m *OrderReconciliationReport) XXX_Size() int {
+	return xxx_messageInfo_OrderReconciliationReport.Size(m)
+}
+func (m *OrderReconciliationReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderReconciliationReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderReconciliationReport proto.InternalMessageInfo
+
+func (m *OrderReconciliationReport) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *OrderReconciliationReport) GetStarted() string {
+	if m != nil {
+		return m.Started
+	}
+	return ""
+}
+
+func (m *OrderReconciliationReport) GetFinished() string {
+	if m != nil {
+		return m.Finished
+	}
+	return ""
+}
+
+func (m *OrderReconciliationReport) GetLocalOpenOrders() int64 {
+	if m != nil {
+		return m.LocalOpenOrders
+	}
+	return 0
+}
+
+func (m *OrderReconciliationReport) GetExchangeOpenOrders() int64 {
+	if m != nil {
+		return m.ExchangeOpenOrders
+	}
+	return 0
+}
+
+func (m *OrderReconciliationReport) GetMatched() int64 {
+	if m != nil {
+		return m.Matched
+	}
+	return 0
+}
+
+func (m *OrderReconciliationReport) GetChanges() []*OrderReconciliationChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *OrderReconciliationReport) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetOrderReconciliationResponse struct {
+	Reports              []*OrderReconciliationReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *GetOrderReconciliationResponse) Reset()         { *m = GetOrderReconciliationResponse{} }
+func (m *GetOrderReconciliationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrderReconciliationResponse) ProtoMessage()    {}
+func (*GetOrderReconciliationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{139}
+}
+
+func (m *GetOrderReconciliationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderReconciliationResponse.Unmarshal(m, b)
+}
+func (m *GetOrderReconciliationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderReconciliationResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOrderReconciliationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderReconciliationResponse.Merge(m, src)
+}
+func (m *GetOrderReconciliationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrderReconciliationResponse.Size(m)
+}
+func (m *GetOrderReconciliationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderReconciliationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderReconciliationResponse proto.InternalMessageInfo
+
+func (m *GetOrderReconciliationResponse) GetReports() []*OrderReconciliationReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+type ConditionalOrder struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string        `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Condition            string        `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`
+	OrderType            string        `protobuf:"bytes,7,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	TriggerPrice         float64       `protobuf:"fixed64,8,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	TrailingAmount       float64       `protobuf:"fixed64,9,opt,name=trailing_amount,json=trailingAmount,proto3" json:"trailing_amount,omitempty"`
+	TrailingPercent      float64       `protobuf:"fixed64,10,opt,name=trailing_percent,json=trailingPercent,proto3" json:"trailing_percent,omitempty"`
+	LimitPrice           float64       `protobuf:"fixed64,11,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,12,opt,name=amount,proto3" json:"amount,omitempty"`
+	Watermark            float64       `protobuf:"fixed64,13,opt,name=watermark,proto3" json:"watermark,omitempty"`
+	Status               string        `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	OrderId              string        `protobuf:"bytes,15,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	InternalOrderId      string        `protobuf:"bytes,16,opt,name=internal_order_id,json=internalOrderId,proto3" json:"internal_order_id,omitempty"`
+	Error                string        `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt            string        `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string        `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ConditionalOrder) Reset()         { *m = ConditionalOrder{} }
+func (m *ConditionalOrder) String() string { return proto.CompactTextString(m) }
+func (*ConditionalOrder) ProtoMessage()    {}
+func (*ConditionalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{140}
+}
+
+func (m *ConditionalOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConditionalOrder.Unmarshal(m, b)
+}
+func (m *ConditionalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConditionalOrder.Marshal(b, m, deterministic)
+}
+func (m *ConditionalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalOrder.Merge(m, src)
+}
+func (m *ConditionalOrder) XXX_Size() int {
+	return xxx_messageInfo_ConditionalOrder.Size(m)
+}
+func (m *ConditionalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionalOrder proto.InternalMessageInfo
+
+func (m *ConditionalOrder) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *ConditionalOrder) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetCondition() string {
+	if m != nil {
+		return m.Condition
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetTriggerPrice() float64 {
+	if m != nil {
+		return m.TriggerPrice
+	}
+	return 0
+}
+
+func (m *ConditionalOrder) GetTrailingAmount() float64 {
+	if m != nil {
+		return m.TrailingAmount
+	}
+	return 0
+}
+
+func (m *ConditionalOrder) GetTrailingPercent() float64 {
+	if m != nil {
+		return m.TrailingPercent
+	}
+	return 0
+}
+
+func (m *ConditionalOrder) GetLimitPrice() float64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
+}
+
+func (m *ConditionalOrder) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ConditionalOrder) GetWatermark() float64 {
+	if m != nil {
+		return m.Watermark
+	}
+	return 0
+}
+
+func (m *ConditionalOrder) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetInternalOrderId() string {
+	if m != nil {
+		return m.InternalOrderId
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *ConditionalOrder) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type AddConditionalOrderRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	Condition            string        `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	OrderType            string        `protobuf:"bytes,6,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	TriggerPrice         float64       `protobuf:"fixed64,7,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	TrailingAmount       float64       `protobuf:"fixed64,8,opt,name=trailing_amount,json=trailingAmount,proto3" json:"trailing_amount,omitempty"`
+	TrailingPercent      float64       `protobuf:"fixed64,9,opt,name=trailing_percent,json=trailingPercent,proto3" json:"trailing_percent,omitempty"`
+	LimitPrice           float64       `protobuf:"fixed64,10,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,11,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AddConditionalOrderRequest) Reset()         { *m = AddConditionalOrderRequest{} }
+func (m *AddConditionalOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*AddConditionalOrderRequest) ProtoMessage()    {}
+func (*AddConditionalOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{141}
+}
+
+func (m *AddConditionalOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddConditionalOrderRequest.Unmarshal(m, b)
+}
+func (m *AddConditionalOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddConditionalOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *AddConditionalOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddConditionalOrderRequest.Merge(m, src)
+}
+func (m *AddConditionalOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_AddConditionalOrderRequest.Size(m)
+}
+func (m *AddConditionalOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddConditionalOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddConditionalOrderRequest proto.InternalMessageInfo
+
+func (m *AddConditionalOrderRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *AddConditionalOrderRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *AddConditionalOrderRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *AddConditionalOrderRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *AddConditionalOrderRequest) GetCondition() string {
+	if m != nil {
+		return m.Condition
+	}
+	return ""
+}
+
+func (m *AddConditionalOrderRequest) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *AddConditionalOrderRequest) GetTriggerPrice() float64 {
+	if m != nil {
+		return m.TriggerPrice
+	}
+	return 0
+}
+
+func (m *AddConditionalOrderRequest) GetTrailingAmount() float64 {
+	if m != nil {
+		return m.TrailingAmount
+	}
+	return 0
+}
+
+func (m *AddConditionalOrderRequest) GetTrailingPercent() float64 {
+	if m != nil {
+		return m.TrailingPercent
+	}
+	return 0
+}
+
+func (m *AddConditionalOrderRequest) GetLimitPrice() float64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
+}
+
+func (m *AddConditionalOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type CancelConditionalOrderRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelConditionalOrderRequest) Reset()         { *m = CancelConditionalOrderRequest{} }
+func (m *CancelConditionalOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelConditionalOrderRequest) ProtoMessage()    {}
+func (*CancelConditionalOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{142}
+}
+
+func (m *CancelConditionalOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelConditionalOrderRequest.Unmarshal(m, b)
+}
+func (m *CancelConditionalOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelConditionalOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelConditionalOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelConditionalOrderRequest.Merge(m, src)
+}
+func (m *CancelConditionalOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelConditionalOrderRequest.Size(m)
+}
+func (m *CancelConditionalOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelConditionalOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelConditionalOrderRequest proto.InternalMessageInfo
+
+func (m *CancelConditionalOrderRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetConditionalOrdersRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetConditionalOrdersRequest) Reset()         { *m = GetConditionalOrdersRequest{} }
+func (m *GetConditionalOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetConditionalOrdersRequest) ProtoMessage()    {}
+func (*GetConditionalOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{143}
+}
+
+func (m *GetConditionalOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConditionalOrdersRequest.Unmarshal(m, b)
+}
+func (m *GetConditionalOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConditionalOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetConditionalOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConditionalOrdersRequest.Merge(m, src)
+}
+func (m *GetConditionalOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetConditionalOrdersRequest.Size(m)
+}
+func (m *GetConditionalOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConditionalOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetConditionalOrdersRequest proto.InternalMessageInfo
+
+func (m *GetConditionalOrdersRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetConditionalOrdersRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type GetConditionalOrdersResponse struct {
+	Orders               []*ConditionalOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetConditionalOrdersResponse) Reset()         { *m = GetConditionalOrdersResponse{} }
+func (m *GetConditionalOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetConditionalOrdersResponse) ProtoMessage()    {}
+func (*GetConditionalOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{144}
+}
+
+func (m *GetConditionalOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConditionalOrdersResponse.Unmarshal(m, b)
+}
+func (m *GetConditionalOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConditionalOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetConditionalOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConditionalOrdersResponse.Merge(m, src)
+}
+func (m *GetConditionalOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetConditionalOrdersResponse.Size(m)
+}
+func (m *GetConditionalOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConditionalOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetConditionalOrdersResponse proto.InternalMessageInfo
+
+func (m *GetConditionalOrdersResponse) GetOrders() []*ConditionalOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type OrderGroupLeg struct {
+	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Side                 string   `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string   `protobuf:"bytes,3,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	TriggerPrice         float64  `protobuf:"fixed64,5,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	Amount               float64  `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExecutedAmount       float64  `protobuf:"fixed64,7,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	Status               string   `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	OrderId              string   `protobuf:"bytes,9,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	InternalOrderId      string   `protobuf:"bytes,10,opt,name=internal_order_id,json=internalOrderId,proto3" json:"internal_order_id,omitempty"`
+	ConditionalOrderId   string   `protobuf:"bytes,11,opt,name=conditional_order_id,json=conditionalOrderId,proto3" json:"conditional_order_id,omitempty"`
+	Error                string   `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderGroupLeg) Reset()         { *m = OrderGroupLeg{} }
+func (m *OrderGroupLeg) String() string { return proto.CompactTextString(m) }
+func (*OrderGroupLeg) ProtoMessage()    {}
+func (*OrderGroupLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{145}
+}
+
+func (m *OrderGroupLeg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderGroupLeg.Unmarshal(m, b)
+}
+func (m *OrderGroupLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderGroupLeg.Marshal(b, m, deterministic)
+}
+func (m *OrderGroupLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderGroupLeg.Merge(m, src)
+}
+func (m *OrderGroupLeg) XXX_Size() int {
+	return xxx_messageInfo_OrderGroupLeg.Size(m)
+}
+func (m *OrderGroupLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderGroupLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderGroupLeg proto.InternalMessageInfo
+
+func (m *OrderGroupLeg) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *OrderGroupLeg) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *OrderGroupLeg) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *OrderGroupLeg) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *OrderGroupLeg) GetTriggerPrice() float64 {
+	if m != nil {
+		return m.TriggerPrice
+	}
+	return 0
+}
+
+func (m *OrderGroupLeg) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *OrderGroupLeg) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
+}
+
+func (m *OrderGroupLeg) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OrderGroupLeg) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderGroupLeg) GetInternalOrderId() string {
+	if m != nil {
+		return m.InternalOrderId
+	}
+	return ""
+}
+
+func (m *OrderGroupLeg) GetConditionalOrderId() string {
+	if m != nil {
+		return m.ConditionalOrderId
+	}
+	return ""
+}
+
+func (m *OrderGroupLeg) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type OrderGroup struct {
+	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 string           `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Exchange             string           `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair    `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string           `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Status               string           `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Reason               string           `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Legs                 []*OrderGroupLeg `protobuf:"bytes,8,rep,name=legs,proto3" json:"legs,omitempty"`
+	CreatedAt            string           `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string           `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *OrderGroup) Reset()         { *m = OrderGroup{} }
+func (m *OrderGroup) String() string { return proto.CompactTextString(m) }
+func (*OrderGroup) ProtoMessage()    {}
+func (*OrderGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{146}
+}
+
+func (m *OrderGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderGroup.Unmarshal(m, b)
+}
+func (m *OrderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderGroup.Marshal(b, m, deterministic)
+}
+func (m *OrderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderGroup.Merge(m, src)
+}
+func (m *OrderGroup) XXX_Size() int {
+	return xxx_messageInfo_OrderGroup.Size(m)
+}
+func (m *OrderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderGroup proto.InternalMessageInfo
+
+func (m *OrderGroup) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OrderGroup) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *OrderGroup) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *OrderGroup) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *OrderGroup) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *OrderGroup) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *OrderGroup) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *OrderGroup) GetLegs() []*OrderGroupLeg {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+func (m *OrderGroup) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *OrderGroup) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type OrderGroupLegRequest struct {
+	Side                 string   `protobuf:"bytes,1,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string   `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Price                float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	TriggerPrice         float64  `protobuf:"fixed64,4,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	Amount               float64  `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderGroupLegRequest) Reset()         { *m = OrderGroupLegRequest{} }
+func (m *OrderGroupLegRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderGroupLegRequest) ProtoMessage()    {}
+func (*OrderGroupLegRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{147}
+}
+
+func (m *OrderGroupLegRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderGroupLegRequest.Unmarshal(m, b)
+}
+func (m *OrderGroupLegRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderGroupLegRequest.Marshal(b, m, deterministic)
+}
+func (m *OrderGroupLegRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderGroupLegRequest.Merge(m, src)
+}
+func (m *OrderGroupLegRequest) XXX_Size() int {
+	return xxx_messageInfo_OrderGroupLegRequest.Size(m)
+}
+func (m *OrderGroupLegRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderGroupLegRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderGroupLegRequest proto.InternalMessageInfo
+
+func (m *OrderGroupLegRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *OrderGroupLegRequest) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *OrderGroupLegRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *OrderGroupLegRequest) GetTriggerPrice() float64 {
+	if m != nil {
+		return m.TriggerPrice
+	}
+	return 0
+}
+
+func (m *OrderGroupLegRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type AddOCOOrderGroupRequest struct {
+	Exchange             string                  `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair           `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string                  `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Legs                 []*OrderGroupLegRequest `protobuf:"bytes,4,rep,name=legs,proto3" json:"legs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *AddOCOOrderGroupRequest) Reset()         { *m = AddOCOOrderGroupRequest{} }
+func (m *AddOCOOrderGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*AddOCOOrderGroupRequest) ProtoMessage()    {}
+func (*AddOCOOrderGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{148}
+}
+
+func (m *AddOCOOrderGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddOCOOrderGroupRequest.Unmarshal(m, b)
+}
+func (m *AddOCOOrderGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddOCOOrderGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *AddOCOOrderGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddOCOOrderGroupRequest.Merge(m, src)
+}
+func (m *AddOCOOrderGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_AddOCOOrderGroupRequest.Size(m)
+}
+func (m *AddOCOOrderGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddOCOOrderGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddOCOOrderGroupRequest proto.InternalMessageInfo
+
+func (m *AddOCOOrderGroupRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *AddOCOOrderGroupRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *AddOCOOrderGroupRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *AddOCOOrderGroupRequest) GetLegs() []*OrderGroupLegRequest {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+type AddBracketOrderGroupRequest struct {
+	Exchange             string                `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair         `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string                `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Entry                *OrderGroupLegRequest `protobuf:"bytes,4,opt,name=entry,proto3" json:"entry,omitempty"`
+	TakeProfit           *OrderGroupLegRequest `protobuf:"bytes,5,opt,name=take_profit,json=takeProfit,proto3" json:"take_profit,omitempty"`
+	StopLoss             *OrderGroupLegRequest `protobuf:"bytes,6,opt,name=stop_loss,json=stopLoss,proto3" json:"stop_loss,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AddBracketOrderGroupRequest) Reset()         { *m = AddBracketOrderGroupRequest{} }
+func (m *AddBracketOrderGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*AddBracketOrderGroupRequest) ProtoMessage()    {}
+func (*AddBracketOrderGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{149}
+}
+
+func (m *AddBracketOrderGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddBracketOrderGroupRequest.Unmarshal(m, b)
+}
+func (m *AddBracketOrderGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddBracketOrderGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *AddBracketOrderGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddBracketOrderGroupRequest.Merge(m, src)
+}
+func (m *AddBracketOrderGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_AddBracketOrderGroupRequest.Size(m)
+}
+func (m *AddBracketOrderGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddBracketOrderGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddBracketOrderGroupRequest proto.InternalMessageInfo
+
+func (m *AddBracketOrderGroupRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *AddBracketOrderGroupRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *AddBracketOrderGroupRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *AddBracketOrderGroupRequest) GetEntry() *OrderGroupLegRequest {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *AddBracketOrderGroupRequest) GetTakeProfit() *OrderGroupLegRequest {
+	if m != nil {
+		return m.TakeProfit
+	}
+	return nil
+}
+
+func (m *AddBracketOrderGroupRequest) GetStopLoss() *OrderGroupLegRequest {
+	if m != nil {
+		return m.StopLoss
+	}
+	return nil
+}
+
+type CancelOrderGroupRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelOrderGroupRequest) Reset()         { *m = CancelOrderGroupRequest{} }
+func (m *CancelOrderGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelOrderGroupRequest) ProtoMessage()    {}
+func (*CancelOrderGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{150}
+}
+
+func (m *CancelOrderGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOrderGroupRequest.Unmarshal(m, b)
+}
+func (m *CancelOrderGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOrderGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelOrderGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOrderGroupRequest.Merge(m, src)
+}
+func (m *CancelOrderGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelOrderGroupRequest.Size(m)
+}
+func (m *CancelOrderGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOrderGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOrderGroupRequest proto.InternalMessageInfo
+
+func (m *CancelOrderGroupRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetOrderGroupsRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrderGroupsRequest) Reset()         { *m = GetOrderGroupsRequest{} }
+func (m *GetOrderGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderGroupsRequest) ProtoMessage()    {}
+func (*GetOrderGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{151}
+}
+
+func (m *GetOrderGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderGroupsRequest.Unmarshal(m, b)
+}
+func (m *GetOrderGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderGroupsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderGroupsRequest.Merge(m, src)
+}
+func (m *GetOrderGroupsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderGroupsRequest.Size(m)
+}
+func (m *GetOrderGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderGroupsRequest proto.InternalMessageInfo
+
+func (m *GetOrderGroupsRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetOrderGroupsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetOrderGroupsResponse struct {
+	Groups               []*OrderGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetOrderGroupsResponse) Reset()         { *m = GetOrderGroupsResponse{} }
+func (m *GetOrderGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrderGroupsResponse) ProtoMessage()    {}
+func (*GetOrderGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{152}
+}
+
+func (m *GetOrderGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderGroupsResponse.Unmarshal(m, b)
+}
+func (m *GetOrderGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderGroupsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOrderGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderGroupsResponse.Merge(m, src)
+}
+func (m *GetOrderGroupsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrderGroupsResponse.Size(m)
+}
+func (m *GetOrderGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderGroupsResponse proto.InternalMessageInfo
+
+func (m *GetOrderGroupsResponse) GetGroups() []*OrderGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type IcebergOrder struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string        `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Price                float64       `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	DisplayAmount        float64       `protobuf:"fixed64,8,opt,name=display_amount,json=displayAmount,proto3" json:"display_amount,omitempty"`
+	SizeVariance         float64       `protobuf:"fixed64,9,opt,name=size_variance,json=sizeVariance,proto3" json:"size_variance,omitempty"`
+	ExecutedAmount       float64       `protobuf:"fixed64,10,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	Status               string        `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Reason               string        `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	OrderId              string        `protobuf:"bytes,13,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	InternalOrderId      string        `protobuf:"bytes,14,opt,name=internal_order_id,json=internalOrderId,proto3" json:"internal_order_id,omitempty"`
+	VisibleAmount        float64       `protobuf:"fixed64,15,opt,name=visible_amount,json=visibleAmount,proto3" json:"visible_amount,omitempty"`
+	Slices               int64         `protobuf:"varint,16,opt,name=slices,proto3" json:"slices,omitempty"`
+	CreatedAt            string        `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string        `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *IcebergOrder) Reset()         { *m = IcebergOrder{} }
+func (m *IcebergOrder) String() string { return proto.CompactTextString(m) }
+func (*IcebergOrder) ProtoMessage()    {}
+func (*IcebergOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{153}
+}
+
+func (m *IcebergOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IcebergOrder.Unmarshal(m, b)
+}
+func (m *IcebergOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IcebergOrder.Marshal(b, m, deterministic)
+}
+func (m *IcebergOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcebergOrder.Merge(m, src)
+}
+func (m *IcebergOrder) XXX_Size() int {
+	return xxx_messageInfo_IcebergOrder.Size(m)
+}
+func (m *IcebergOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcebergOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcebergOrder proto.InternalMessageInfo
+
+func (m *IcebergOrder) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *IcebergOrder) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *IcebergOrder) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *IcebergOrder) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *IcebergOrder) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *IcebergOrder) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *IcebergOrder) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *IcebergOrder) GetDisplayAmount() float64 {
+	if m != nil {
+		return m.DisplayAmount
+	}
+	return 0
+}
+
+func (m *IcebergOrder) GetSizeVariance() float64 {
+	if m != nil {
+		return m.SizeVariance
+	}
+	return 0
+}
+
+func (m *IcebergOrder) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
+}
+
+func (m *IcebergOrder) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *IcebergOrder) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *IcebergOrder) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *IcebergOrder) GetInternalOrderId() string {
+	if m != nil {
+		return m.InternalOrderId
+	}
+	return ""
+}
+
+func (m *IcebergOrder) GetVisibleAmount() float64 {
+	if m != nil {
+		return m.VisibleAmount
+	}
+	return 0
+}
+
+func (m *IcebergOrder) GetSlices() int64 {
+	if m != nil {
+		return m.Slices
+	}
+	return 0
+}
+
+func (m *IcebergOrder) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *IcebergOrder) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type AddIcebergOrderRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	Price                float64       `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	DisplayAmount        float64       `protobuf:"fixed64,7,opt,name=display_amount,json=displayAmount,proto3" json:"display_amount,omitempty"`
+	SizeVariance         float64       `protobuf:"fixed64,8,opt,name=size_variance,json=sizeVariance,proto3" json:"size_variance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AddIcebergOrderRequest) Reset()         { *m = AddIcebergOrderRequest{} }
+func (m *AddIcebergOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*AddIcebergOrderRequest) ProtoMessage()    {}
+func (*AddIcebergOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{154}
+}
+
+func (m *AddIcebergOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddIcebergOrderRequest.Unmarshal(m, b)
+}
+func (m *AddIcebergOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddIcebergOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *AddIcebergOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddIcebergOrderRequest.Merge(m, src)
+}
+func (m *AddIcebergOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_AddIcebergOrderRequest.Size(m)
+}
+func (m *AddIcebergOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddIcebergOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddIcebergOrderRequest proto.InternalMessageInfo
+
+func (m *AddIcebergOrderRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *AddIcebergOrderRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *AddIcebergOrderRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *AddIcebergOrderRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *AddIcebergOrderRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *AddIcebergOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *AddIcebergOrderRequest) GetDisplayAmount() float64 {
+	if m != nil {
+		return m.DisplayAmount
+	}
+	return 0
+}
+
+func (m *AddIcebergOrderRequest) GetSizeVariance() float64 {
+	if m != nil {
+		return m.SizeVariance
+	}
+	return 0
+}
+
+type CancelIcebergOrderRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelIcebergOrderRequest) Reset()         { *m = CancelIcebergOrderRequest{} }
+func (m *CancelIcebergOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelIcebergOrderRequest) ProtoMessage()    {}
+func (*CancelIcebergOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{155}
+}
+
+func (m *CancelIcebergOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelIcebergOrderRequest.Unmarshal(m, b)
+}
+func (m *CancelIcebergOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelIcebergOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelIcebergOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIcebergOrderRequest.Merge(m, src)
+}
+func (m *CancelIcebergOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelIcebergOrderRequest.Size(m)
+}
+func (m *CancelIcebergOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIcebergOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelIcebergOrderRequest proto.InternalMessageInfo
+
+func (m *CancelIcebergOrderRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetIcebergOrdersRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIcebergOrdersRequest) Reset()         { *m = GetIcebergOrdersRequest{} }
+func (m *GetIcebergOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIcebergOrdersRequest) ProtoMessage()    {}
+func (*GetIcebergOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{156}
+}
+
+func (m *GetIcebergOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIcebergOrdersRequest.Unmarshal(m, b)
+}
+func (m *GetIcebergOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIcebergOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetIcebergOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIcebergOrdersRequest.Merge(m, src)
+}
+func (m *GetIcebergOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIcebergOrdersRequest.Size(m)
+}
+func (m *GetIcebergOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIcebergOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIcebergOrdersRequest proto.InternalMessageInfo
+
+func (m *GetIcebergOrdersRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetIcebergOrdersRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetIcebergOrdersResponse struct {
+	Orders               []*IcebergOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetIcebergOrdersResponse) Reset()         { *m = GetIcebergOrdersResponse{} }
+func (m *GetIcebergOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIcebergOrdersResponse) ProtoMessage()    {}
+func (*GetIcebergOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{157}
+}
+
+func (m *GetIcebergOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIcebergOrdersResponse.Unmarshal(m, b)
+}
+func (m *GetIcebergOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIcebergOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetIcebergOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIcebergOrdersResponse.Merge(m, src)
+}
+func (m *GetIcebergOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIcebergOrdersResponse.Size(m)
+}
+func (m *GetIcebergOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIcebergOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIcebergOrdersResponse proto.InternalMessageInfo
+
+func (m *GetIcebergOrdersResponse) GetOrders() []*IcebergOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type ExecutionChild struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	InternalOrderId      string   `protobuf:"bytes,2,opt,name=internal_order_id,json=internalOrderId,proto3" json:"internal_order_id,omitempty"`
+	Amount               float64  `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ExecutedAmount       float64  `protobuf:"fixed64,5,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	Status               string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Submitted            string   `protobuf:"bytes,7,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecutionChild) Reset()         { *m = ExecutionChild{} }
+func (m *ExecutionChild) String() string { return proto.CompactTextString(m) }
+func (*ExecutionChild) ProtoMessage()    {}
+func (*ExecutionChild) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{158}
+}
+
+func (m *ExecutionChild) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutionChild.Unmarshal(m, b)
+}
+func (m *ExecutionChild) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutionChild.Marshal(b, m, deterministic)
+}
+func (m *ExecutionChild) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionChild.Merge(m, src)
+}
+func (m *ExecutionChild) XXX_Size() int {
+	return xxx_messageInfo_ExecutionChild.Size(m)
+}
+func (m *ExecutionChild) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionChild.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionChild proto.InternalMessageInfo
+
+func (m *ExecutionChild) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ExecutionChild) GetInternalOrderId() string {
+	if m != nil {
+		return m.InternalOrderId
+	}
+	return ""
+}
+
+func (m *ExecutionChild) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ExecutionChild) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ExecutionChild) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
+}
+
+func (m *ExecutionChild) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ExecutionChild) GetSubmitted() string {
+	if m != nil {
+		return m.Submitted
+	}
+	return ""
+}
+
+func (m *ExecutionChild) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ExecutionOrder struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string            `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair     `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string            `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string            `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Algorithm            string            `protobuf:"bytes,6,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Amount               float64           `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	LimitPrice           float64           `protobuf:"fixed64,8,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	ParticipationRate    float64           `protobuf:"fixed64,9,opt,name=participation_rate,json=participationRate,proto3" json:"participation_rate,omitempty"`
+	Duration             string            `protobuf:"bytes,10,opt,name=duration,proto3" json:"duration,omitempty"`
+	SliceInterval        string            `protobuf:"bytes,11,opt,name=slice_interval,json=sliceInterval,proto3" json:"slice_interval,omitempty"`
+	Status               string            `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	Reason               string            `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExecutedAmount       float64           `protobuf:"fixed64,14,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	Children             []*ExecutionChild `protobuf:"bytes,15,rep,name=children,proto3" json:"children,omitempty"`
+	StartTime            string            `protobuf:"bytes,16,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string            `protobuf:"bytes,17,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CreatedAt            string            `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string            `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExecutionOrder) Reset()         { *m = ExecutionOrder{} }
+func (m *ExecutionOrder) String() string { return proto.CompactTextString(m) }
+func (*ExecutionOrder) ProtoMessage()    {}
+func (*ExecutionOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{159}
+}
+
+func (m *ExecutionOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutionOrder.Unmarshal(m, b)
+}
+func (m *ExecutionOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutionOrder.Marshal(b, m, deterministic)
+}
+func (m *ExecutionOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionOrder.Merge(m, src)
+}
+func (m *ExecutionOrder) XXX_Size() int {
+	return xxx_messageInfo_ExecutionOrder.Size(m)
+}
+func (m *ExecutionOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionOrder proto.InternalMessageInfo
+
+func (m *ExecutionOrder) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *ExecutionOrder) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ExecutionOrder) GetLimitPrice() float64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
+}
+
+func (m *ExecutionOrder) GetParticipationRate() float64 {
+	if m != nil {
+		return m.ParticipationRate
+	}
+	return 0
+}
+
+func (m *ExecutionOrder) GetDuration() string {
+	if m != nil {
+		return m.Duration
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetSliceInterval() string {
+	if m != nil {
+		return m.SliceInterval
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
+}
+
+func (m *ExecutionOrder) GetChildren() []*ExecutionChild {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+func (m *ExecutionOrder) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *ExecutionOrder) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type AddExecutionOrderRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	Algorithm            string        `protobuf:"bytes,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Amount               float64       `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	LimitPrice           float64       `protobuf:"fixed64,7,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	ParticipationRate    float64       `protobuf:"fixed64,8,opt,name=participation_rate,json=participationRate,proto3" json:"participation_rate,omitempty"`
+	Duration             string        `protobuf:"bytes,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	SliceInterval        string        `protobuf:"bytes,10,opt,name=slice_interval,json=sliceInterval,proto3" json:"slice_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AddExecutionOrderRequest) Reset()         { *m = AddExecutionOrderRequest{} }
+func (m *AddExecutionOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*AddExecutionOrderRequest) ProtoMessage()    {}
+func (*AddExecutionOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{160}
+}
+
+func (m *AddExecutionOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddExecutionOrderRequest.Unmarshal(m, b)
+}
+func (m *AddExecutionOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddExecutionOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *AddExecutionOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddExecutionOrderRequest.Merge(m, src)
+}
+func (m *AddExecutionOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_AddExecutionOrderRequest.Size(m)
+}
+func (m *AddExecutionOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddExecutionOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddExecutionOrderRequest proto.InternalMessageInfo
+
+func (m *AddExecutionOrderRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *AddExecutionOrderRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *AddExecutionOrderRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *AddExecutionOrderRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *AddExecutionOrderRequest) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *AddExecutionOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *AddExecutionOrderRequest) GetLimitPrice() float64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
+}
+
+func (m *AddExecutionOrderRequest) GetParticipationRate() float64 {
+	if m != nil {
+		return m.ParticipationRate
+	}
+	return 0
+}
+
+func (m *AddExecutionOrderRequest) GetDuration() string {
+	if m != nil {
+		return m.Duration
+	}
+	return ""
+}
+
+func (m *AddExecutionOrderRequest) GetSliceInterval() string {
+	if m != nil {
+		return m.SliceInterval
+	}
+	return ""
+}
+
+type ExecutionOrderRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecutionOrderRequest) Reset()         { *m = ExecutionOrderRequest{} }
+func (m *ExecutionOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutionOrderRequest) ProtoMessage()    {}
+func (*ExecutionOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{161}
+}
+
+func (m *ExecutionOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutionOrderRequest.Unmarshal(m, b)
+}
+func (m *ExecutionOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutionOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *ExecutionOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionOrderRequest.Merge(m, src)
+}
+func (m *ExecutionOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecutionOrderRequest.Size(m)
+}
+func (m *ExecutionOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionOrderRequest proto.InternalMessageInfo
+
+func (m *ExecutionOrderRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetExecutionOrdersRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExecutionOrdersRequest) Reset()         { *m = GetExecutionOrdersRequest{} }
+func (m *GetExecutionOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExecutionOrdersRequest) ProtoMessage()    {}
+func (*GetExecutionOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{162}
+}
+
+func (m *GetExecutionOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExecutionOrdersRequest.Unmarshal(m, b)
+}
+func (m *GetExecutionOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExecutionOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExecutionOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExecutionOrdersRequest.Merge(m, src)
+}
+func (m *GetExecutionOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExecutionOrdersRequest.Size(m)
+}
+func (m *GetExecutionOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExecutionOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExecutionOrdersRequest proto.InternalMessageInfo
+
+func (m *GetExecutionOrdersRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetExecutionOrdersRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetExecutionOrdersResponse struct {
+	Orders               []*ExecutionOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetExecutionOrdersResponse) Reset()         { *m = GetExecutionOrdersResponse{} }
+func (m *GetExecutionOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExecutionOrdersResponse) ProtoMessage()    {}
+func (*GetExecutionOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{163}
+}
+
+func (m *GetExecutionOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExecutionOrdersResponse.Unmarshal(m, b)
+}
+func (m *GetExecutionOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExecutionOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExecutionOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExecutionOrdersResponse.Merge(m, src)
+}
+func (m *GetExecutionOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExecutionOrdersResponse.Size(m)
+}
+func (m *GetExecutionOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExecutionOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExecutionOrdersResponse proto.InternalMessageInfo
+
+func (m *GetExecutionOrdersResponse) GetOrders() []*ExecutionOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Identifier           string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp            string   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{164}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+type GCTScript struct {
+	UUID                 string   `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	NextRun              string   `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScript) Reset()         { *m = GCTScript{} }
+func (m *GCTScript) String() string { return proto.CompactTextString(m) }
+func (*GCTScript) ProtoMessage()    {}
+func (*GCTScript) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{165}
+}
+
+func (m *GCTScript) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScript.Unmarshal(m, b)
+}
+func (m *GCTScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScript.Marshal(b, m, deterministic)
+}
+func (m *GCTScript) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScript.Merge(m, src)
+}
+func (m *GCTScript) XXX_Size() int {
+	return xxx_messageInfo_GCTScript.Size(m)
+}
+func (m *GCTScript) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScript.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScript proto.InternalMessageInfo
+
+func (m *GCTScript) GetUUID() string {
+	if m != nil {
+		return m.UUID
+	}
+	return ""
+}
+
+func (m *GCTScript) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GCTScript) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GCTScript) GetNextRun() string {
+	if m != nil {
+		return m.NextRun
+	}
+	return ""
+}
+
+type GCTScriptExecuteRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptExecuteRequest) Reset()         { *m = GCTScriptExecuteRequest{} }
+func (m *GCTScriptExecuteRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptExecuteRequest) ProtoMessage()    {}
+func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{166}
+}
+
+func (m *GCTScriptExecuteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptExecuteRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptExecuteRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptExecuteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptExecuteRequest.Merge(m, src)
+}
+func (m *GCTScriptExecuteRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptExecuteRequest.Size(m)
+}
+func (m *GCTScriptExecuteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptExecuteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptExecuteRequest proto.InternalMessageInfo
+
+func (m *GCTScriptExecuteRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptStopRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptStopRequest) Reset()         { *m = GCTScriptStopRequest{} }
+func (m *GCTScriptStopRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStopRequest) ProtoMessage()    {}
+func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{167}
+}
+
+func (m *GCTScriptStopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStopRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStopRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStopRequest.Merge(m, src)
+}
+func (m *GCTScriptStopRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStopRequest.Size(m)
+}
+func (m *GCTScriptStopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStopRequest proto.InternalMessageInfo
+
+func (m *GCTScriptStopRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptStopAllRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptStopAllRequest) Reset()         { *m = GCTScriptStopAllRequest{} }
+func (m *GCTScriptStopAllRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStopAllRequest) ProtoMessage()    {}
+func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{168}
+}
+
+func (m *GCTScriptStopAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStopAllRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStopAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStopAllRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStopAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStopAllRequest.Merge(m, src)
+}
+func (m *GCTScriptStopAllRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStopAllRequest.Size(m)
+}
+func (m *GCTScriptStopAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStopAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStopAllRequest proto.InternalMessageInfo
+
+type GCTScriptStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptStatusRequest) Reset()         { *m = GCTScriptStatusRequest{} }
+func (m *GCTScriptStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStatusRequest) ProtoMessage()    {}
+func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{169}
+}
+
+func (m *GCTScriptStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStatusRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStatusRequest.Merge(m, src)
+}
+func (m *GCTScriptStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStatusRequest.Size(m)
+}
+func (m *GCTScriptStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStatusRequest proto.InternalMessageInfo
+
+type GCTScriptListAllRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptListAllRequest) Reset()         { *m = GCTScriptListAllRequest{} }
+func (m *GCTScriptListAllRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptListAllRequest) ProtoMessage()    {}
+func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{170}
+}
+
+func (m *GCTScriptListAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptListAllRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptListAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptListAllRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptListAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptListAllRequest.Merge(m, src)
+}
+func (m *GCTScriptListAllRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptListAllRequest.Size(m)
+}
+func (m *GCTScriptListAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptListAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptListAllRequest proto.InternalMessageInfo
+
+type GCTScriptUploadRequest struct {
+	ScriptName           string   `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ScriptData           string   `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Archived             bool     `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Overwrite            bool     `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptUploadRequest) Reset()         { *m = GCTScriptUploadRequest{} }
+func (m *GCTScriptUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptUploadRequest) ProtoMessage()    {}
+func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{171}
+}
+
+func (m *GCTScriptUploadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptUploadRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptUploadRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptUploadRequest.Merge(m, src)
+}
+func (m *GCTScriptUploadRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptUploadRequest.Size(m)
+}
+func (m *GCTScriptUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptUploadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptUploadRequest proto.InternalMessageInfo
+
+func (m *GCTScriptUploadRequest) GetScriptName() string {
+	if m != nil {
+		return m.ScriptName
+	}
+	return ""
+}
+
+func (m *GCTScriptUploadRequest) GetScriptData() string {
+	if m != nil {
+		return m.ScriptData
+	}
+	return ""
+}
+
+func (m *GCTScriptUploadRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GCTScriptUploadRequest) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+func (m *GCTScriptUploadRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+type GCTScriptReadScriptRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptReadScriptRequest) Reset()         { *m = GCTScriptReadScriptRequest{} }
+func (m *GCTScriptReadScriptRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptReadScriptRequest) ProtoMessage()    {}
+func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{172}
+}
+
+func (m *GCTScriptReadScriptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptReadScriptRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptReadScriptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptReadScriptRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptReadScriptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptReadScriptRequest.Merge(m, src)
+}
+func (m *GCTScriptReadScriptRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptReadScriptRequest.Size(m)
+}
+func (m *GCTScriptReadScriptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptReadScriptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptReadScriptRequest proto.InternalMessageInfo
+
+func (m *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptQueryRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptQueryRequest) Reset()         { *m = GCTScriptQueryRequest{} }
+func (m *GCTScriptQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptQueryRequest) ProtoMessage()    {}
+func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{173}
+}
+
+func (m *GCTScriptQueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptQueryRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptQueryRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptQueryRequest.Merge(m, src)
+}
+func (m *GCTScriptQueryRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptQueryRequest.Size(m)
+}
+func (m *GCTScriptQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptQueryRequest proto.InternalMessageInfo
+
+func (m *GCTScriptQueryRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptAutoLoadRequest struct {
+	Script               string   `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Status               bool     `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptAutoLoadRequest) Reset()         { *m = GCTScriptAutoLoadRequest{} }
+func (m *GCTScriptAutoLoadRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptAutoLoadRequest) ProtoMessage()    {}
+func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{174}
+}
+
+func (m *GCTScriptAutoLoadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptAutoLoadRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptAutoLoadRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptAutoLoadRequest.Merge(m, src)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptAutoLoadRequest.Size(m)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptAutoLoadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptAutoLoadRequest proto.InternalMessageInfo
+
+func (m *GCTScriptAutoLoadRequest) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *GCTScriptAutoLoadRequest) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type GCTScriptStatusResponse struct {
+	Status               string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Scripts              []*GCTScript `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GCTScriptStatusResponse) Reset()         { *m = GCTScriptStatusResponse{} }
+func (m *GCTScriptStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStatusResponse) ProtoMessage()    {}
+func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{175}
+}
+
+func (m *GCTScriptStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStatusResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStatusResponse.Merge(m, src)
+}
+func (m *GCTScriptStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStatusResponse.Size(m)
+}
+func (m *GCTScriptStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStatusResponse proto.InternalMessageInfo
+
+func (m *GCTScriptStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+	if m != nil {
+		return m.Scripts
+	}
+	return nil
+}
+
+type GCTScriptQueryResponse struct {
+	Status               string     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Script               *GCTScript `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	Data                 string     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptQueryResponse) Reset()         { *m = GCTScriptQueryResponse{} }
+func (m *GCTScriptQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptQueryResponse) ProtoMessage()    {}
+func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{176}
+}
+
+func (m *GCTScriptQueryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptQueryResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptQueryResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptQueryResponse.Merge(m, src)
+}
+func (m *GCTScriptQueryResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptQueryResponse.Size(m)
+}
+func (m *GCTScriptQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptQueryResponse proto.InternalMessageInfo
+
+func (m *GCTScriptQueryResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptQueryResponse) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+func (m *GCTScriptQueryResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type GCTScriptGenericResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptGenericResponse) Reset()         { *m = GCTScriptGenericResponse{} }
+func (m *GCTScriptGenericResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptGenericResponse) ProtoMessage()    {}
+func (*GCTScriptGenericResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{177}
+}
+
+func (m *GCTScriptGenericResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptGenericResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptGenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptGenericResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptGenericResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptGenericResponse.Merge(m, src)
+}
+func (m *GCTScriptGenericResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptGenericResponse.Size(m)
+}
+func (m *GCTScriptGenericResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptGenericResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptGenericResponse proto.InternalMessageInfo
+
+func (m *GCTScriptGenericResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptGenericResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GetInfoRequest)(nil), "gctrpc.GetInfoRequest")
+	proto.RegisterType((*GetInfoResponse)(nil), "gctrpc.GetInfoResponse")
+	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetInfoResponse.RpcEndpointsEntry")
+	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetInfoResponse.SubsystemStatusEntry")
+	proto.RegisterType((*GetCommunicationRelayersRequest)(nil), "gctrpc.GetCommunicationRelayersRequest")
+	proto.RegisterType((*CommunicationRelayer)(nil), "gctrpc.CommunicationRelayer")
+	proto.RegisterType((*GetCommunicationRelayersResponse)(nil), "gctrpc.GetCommunicationRelayersResponse")
+	proto.RegisterMapType((map[string]*CommunicationRelayer)(nil), "gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry")
+	proto.RegisterType((*GenericSubsystemRequest)(nil), "gctrpc.GenericSubsystemRequest")
+	proto.RegisterType((*GenericSubsystemResponse)(nil), "gctrpc.GenericSubsystemResponse")
+	proto.RegisterType((*GetSubsystemsRequest)(nil), "gctrpc.GetSubsystemsRequest")
+	proto.RegisterType((*GetSusbsytemsResponse)(nil), "gctrpc.GetSusbsytemsResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry")
+	proto.RegisterType((*GetRPCEndpointsRequest)(nil), "gctrpc.GetRPCEndpointsRequest")
+	proto.RegisterType((*RPCEndpoint)(nil), "gctrpc.RPCEndpoint")
+	proto.RegisterType((*GetRPCEndpointsResponse)(nil), "gctrpc.GetRPCEndpointsResponse")
+	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetRPCEndpointsResponse.EndpointsEntry")
+	proto.RegisterType((*GenericExchangeNameRequest)(nil), "gctrpc.GenericExchangeNameRequest")
+	proto.RegisterType((*GenericExchangeNameResponse)(nil), "gctrpc.GenericExchangeNameResponse")
+	proto.RegisterType((*GetExchangesRequest)(nil), "gctrpc.GetExchangesRequest")
+	proto.RegisterType((*GetExchangesResponse)(nil), "gctrpc.GetExchangesResponse")
+	proto.RegisterType((*GetExchangeOTPReponse)(nil), "gctrpc.GetExchangeOTPReponse")
+	proto.RegisterType((*GetExchangeOTPsRequest)(nil), "gctrpc.GetExchangeOTPsRequest")
+	proto.RegisterType((*GetExchangeOTPsResponse)(nil), "gctrpc.GetExchangeOTPsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetExchangeOTPsResponse.OtpCodesEntry")
+	proto.RegisterType((*DisableExchangeRequest)(nil), "gctrpc.DisableExchangeRequest")
+	proto.RegisterType((*PairsSupported)(nil), "gctrpc.PairsSupported")
+	proto.RegisterType((*GetExchangeInfoResponse)(nil), "gctrpc.GetExchangeInfoResponse")
+	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry")
+	proto.RegisterType((*GetTickerRequest)(nil), "gctrpc.GetTickerRequest")
+	proto.RegisterType((*CurrencyPair)(nil), "gctrpc.CurrencyPair")
+	proto.RegisterType((*TickerResponse)(nil), "gctrpc.TickerResponse")
+	proto.RegisterType((*GetTickersRequest)(nil), "gctrpc.GetTickersRequest")
+	proto.RegisterType((*Tickers)(nil), "gctrpc.Tickers")
+	proto.RegisterType((*GetTickersResponse)(nil), "gctrpc.GetTickersResponse")
+	proto.RegisterType((*GetOrderbookRequest)(nil), "gctrpc.GetOrderbookRequest")
+	proto.RegisterType((*OrderbookItem)(nil), "gctrpc.OrderbookItem")
+	proto.RegisterType((*OrderbookResponse)(nil), "gctrpc.OrderbookResponse")
+	proto.RegisterType((*GetOrderbooksRequest)(nil), "gctrpc.GetOrderbooksRequest")
+	proto.RegisterType((*Orderbooks)(nil), "gctrpc.Orderbooks")
+	proto.RegisterType((*GetOrderbooksResponse)(nil), "gctrpc.GetOrderbooksResponse")
+	proto.RegisterType((*GetAccountInfoRequest)(nil), "gctrpc.GetAccountInfoRequest")
+	proto.RegisterType((*Account)(nil), "gctrpc.Account")
+	proto.RegisterType((*AccountCurrencyInfo)(nil), "gctrpc.AccountCurrencyInfo")
+	proto.RegisterType((*GetAccountInfoResponse)(nil), "gctrpc.GetAccountInfoResponse")
+	proto.RegisterType((*GetConfigRequest)(nil), "gctrpc.GetConfigRequest")
+	proto.RegisterType((*GetConfigResponse)(nil), "gctrpc.GetConfigResponse")
+	proto.RegisterType((*PortfolioAddress)(nil), "gctrpc.PortfolioAddress")
+	proto.RegisterType((*GetPortfolioRequest)(nil), "gctrpc.GetPortfolioRequest")
+	proto.RegisterType((*GetPortfolioResponse)(nil), "gctrpc.GetPortfolioResponse")
+	proto.RegisterType((*GetPortfolioSummaryRequest)(nil), "gctrpc.GetPortfolioSummaryRequest")
+	proto.RegisterType((*Coin)(nil), "gctrpc.Coin")
+	proto.RegisterType((*OfflineCoinSummary)(nil), "gctrpc.OfflineCoinSummary")
+	proto.RegisterType((*OnlineCoinSummary)(nil), "gctrpc.OnlineCoinSummary")
+	proto.RegisterType((*OfflineCoins)(nil), "gctrpc.OfflineCoins")
+	proto.RegisterType((*OnlineCoins)(nil), "gctrpc.OnlineCoins")
+	proto.RegisterMapType((map[string]*OnlineCoinSummary)(nil), "gctrpc.OnlineCoins.CoinsEntry")
+	proto.RegisterType((*GetPortfolioSummaryResponse)(nil), "gctrpc.GetPortfolioSummaryResponse")
+	proto.RegisterMapType((map[string]*OfflineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry")
+	proto.RegisterMapType((map[string]*OnlineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry")
+	proto.RegisterType((*AddPortfolioAddressRequest)(nil), "gctrpc.AddPortfolioAddressRequest")
+	proto.RegisterType((*AddPortfolioAddressResponse)(nil), "gctrpc.AddPortfolioAddressResponse")
+	proto.RegisterType((*RemovePortfolioAddressRequest)(nil), "gctrpc.RemovePortfolioAddressRequest")
+	proto.RegisterType((*RemovePortfolioAddressResponse)(nil), "gctrpc.RemovePortfolioAddressResponse")
+	proto.RegisterType((*GetForexProvidersRequest)(nil), "gctrpc.GetForexProvidersRequest")
+	proto.RegisterType((*ForexProvider)(nil), "gctrpc.ForexProvider")
+	proto.RegisterType((*GetForexProvidersResponse)(nil), "gctrpc.GetForexProvidersResponse")
+	proto.RegisterType((*GetForexRatesRequest)(nil), "gctrpc.GetForexRatesRequest")
+	proto.RegisterType((*ForexRatesConversion)(nil), "gctrpc.ForexRatesConversion")
+	proto.RegisterType((*GetForexRatesResponse)(nil), "gctrpc.GetForexRatesResponse")
+	proto.RegisterType((*OrderDetails)(nil), "gctrpc.OrderDetails")
+	proto.RegisterType((*GetOrdersRequest)(nil), "gctrpc.GetOrdersRequest")
+	proto.RegisterType((*GetOrdersResponse)(nil), "gctrpc.GetOrdersResponse")
+	proto.RegisterType((*GetOrderRequest)(nil), "gctrpc.GetOrderRequest")
+	proto.RegisterType((*SubmitOrderRequest)(nil), "gctrpc.SubmitOrderRequest")
+	proto.RegisterType((*SubmitOrderResponse)(nil), "gctrpc.SubmitOrderResponse")
+	proto.RegisterType((*BatchOrder)(nil), "gctrpc.BatchOrder")
+	proto.RegisterType((*SubmitOrdersRequest)(nil), "gctrpc.SubmitOrdersRequest")
+	proto.RegisterType((*BatchOrderResult)(nil), "gctrpc.BatchOrderResult")
+	proto.RegisterType((*SubmitOrdersResponse)(nil), "gctrpc.SubmitOrdersResponse")
+	proto.RegisterType((*SimulateOrderRequest)(nil), "gctrpc.SimulateOrderRequest")
+	proto.RegisterType((*SimulateOrderResponse)(nil), "gctrpc.SimulateOrderResponse")
+	proto.RegisterType((*WhaleBombRequest)(nil), "gctrpc.WhaleBombRequest")
+	proto.RegisterType((*CancelOrderRequest)(nil), "gctrpc.CancelOrderRequest")
+	proto.RegisterType((*CancelOrderResponse)(nil), "gctrpc.CancelOrderResponse")
+	proto.RegisterType((*BatchCancelOrder)(nil), "gctrpc.BatchCancelOrder")
+	proto.RegisterType((*CancelOrdersRequest)(nil), "gctrpc.CancelOrdersRequest")
+	proto.RegisterType((*CancelOrdersResponse)(nil), "gctrpc.CancelOrdersResponse")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.CancelOrdersResponse.OrderStatusEntry")
+	proto.RegisterType((*ModifyOrderRequest)(nil), "gctrpc.ModifyOrderRequest")
+	proto.RegisterType((*ModifyOrderResponse)(nil), "gctrpc.ModifyOrderResponse")
+	proto.RegisterType((*CancelAllOrdersRequest)(nil), "gctrpc.CancelAllOrdersRequest")
+	proto.RegisterType((*CancelAllOrdersResponse)(nil), "gctrpc.CancelAllOrdersResponse")
+	proto.RegisterType((*CancelAllOrdersResponse_Orders)(nil), "gctrpc.CancelAllOrdersResponse.Orders")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.CancelAllOrdersResponse.Orders.OrderStatusEntry")
+	proto.RegisterType((*KillSwitchStatus)(nil), "gctrpc.KillSwitchStatus")
+	proto.RegisterType((*ActivateKillSwitchRequest)(nil), "gctrpc.ActivateKillSwitchRequest")
+	proto.RegisterType((*ActivateKillSwitchResponse)(nil), "gctrpc.ActivateKillSwitchResponse")
+	proto.RegisterType((*ResetKillSwitchRequest)(nil), "gctrpc.ResetKillSwitchRequest")
+	proto.RegisterType((*GetKillSwitchStatusRequest)(nil), "gctrpc.GetKillSwitchStatusRequest")
+	proto.RegisterType((*GetTradingRulesRequest)(nil), "gctrpc.GetTradingRulesRequest")
+	proto.RegisterType((*TradingRules)(nil), "gctrpc.TradingRules")
+	proto.RegisterType((*GetEventsRequest)(nil), "gctrpc.GetEventsRequest")
+	proto.RegisterType((*ConditionParams)(nil), "gctrpc.ConditionParams")
+	proto.RegisterType((*GetEventsResponse)(nil), "gctrpc.GetEventsResponse")
+	proto.RegisterType((*AddEventRequest)(nil), "gctrpc.AddEventRequest")
+	proto.RegisterType((*AddEventResponse)(nil), "gctrpc.AddEventResponse")
+	proto.RegisterType((*RemoveEventRequest)(nil), "gctrpc.RemoveEventRequest")
+	proto.RegisterType((*RemoveEventResponse)(nil), "gctrpc.RemoveEventResponse")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressesRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressesRequest")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressesResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressRequest")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressResponse")
+	proto.RegisterType((*WithdrawCurrencyRequest)(nil), "gctrpc.WithdrawCurrencyRequest")
+	proto.RegisterType((*WithdrawResponse)(nil), "gctrpc.WithdrawResponse")
+	proto.RegisterType((*GetLoggerDetailsRequest)(nil), "gctrpc.GetLoggerDetailsRequest")
+	proto.RegisterType((*GetLoggerDetailsResponse)(nil), "gctrpc.GetLoggerDetailsResponse")
+	proto.RegisterType((*SetLoggerDetailsRequest)(nil), "gctrpc.SetLoggerDetailsRequest")
+	proto.RegisterType((*GetExchangePairsRequest)(nil), "gctrpc.GetExchangePairsRequest")
+	proto.RegisterType((*GetExchangePairsResponse)(nil), "gctrpc.GetExchangePairsResponse")
+	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangePairsResponse.SupportedAssetsEntry")
+	proto.RegisterType((*ExchangePairRequest)(nil), "gctrpc.ExchangePairRequest")
+	proto.RegisterType((*GetOrderbookStreamRequest)(nil), "gctrpc.GetOrderbookStreamRequest")
+	proto.RegisterType((*GetExchangeOrderbookStreamRequest)(nil), "gctrpc.GetExchangeOrderbookStreamRequest")
+	proto.RegisterType((*GetTickerStreamRequest)(nil), "gctrpc.GetTickerStreamRequest")
+	proto.RegisterType((*GetExchangeTickerStreamRequest)(nil), "gctrpc.GetExchangeTickerStreamRequest")
+	proto.RegisterType((*GetAuditEventRequest)(nil), "gctrpc.GetAuditEventRequest")
+	proto.RegisterType((*GetAuditEventResponse)(nil), "gctrpc.GetAuditEventResponse")
+	proto.RegisterType((*GetHistoricCandlesRequest)(nil), "gctrpc.GetHistoricCandlesRequest")
+	proto.RegisterType((*GetHistoricCandlesResponse)(nil), "gctrpc.GetHistoricCandlesResponse")
+	proto.RegisterType((*Candle)(nil), "gctrpc.Candle")
+	proto.RegisterType((*DataHistoryJob)(nil), "gctrpc.DataHistoryJob")
+	proto.RegisterType((*AddDataHistoryJobRequest)(nil), "gctrpc.AddDataHistoryJobRequest")
+	proto.RegisterType((*GetDataHistoryJobRequest)(nil), "gctrpc.GetDataHistoryJobRequest")
+	proto.RegisterType((*GetDataHistoryJobsRequest)(nil), "gctrpc.GetDataHistoryJobsRequest")
+	proto.RegisterType((*GetDataHistoryJobsResponse)(nil), "gctrpc.GetDataHistoryJobsResponse")
+	proto.RegisterType((*RemoveDataHistoryJobRequest)(nil), "gctrpc.RemoveDataHistoryJobRequest")
+	proto.RegisterType((*GetOrderbookDepthRequest)(nil), "gctrpc.GetOrderbookDepthRequest")
+	proto.RegisterType((*OrderbookVWAP)(nil), "gctrpc.OrderbookVWAP")
+	proto.RegisterType((*GetOrderbookDepthResponse)(nil), "gctrpc.GetOrderbookDepthResponse")
+	proto.RegisterType((*GetConsolidatedOrderbookStreamRequest)(nil), "gctrpc.GetConsolidatedOrderbookStreamRequest")
+	proto.RegisterType((*ConsolidatedOrderbookItem)(nil), "gctrpc.ConsolidatedOrderbookItem")
+	proto.RegisterType((*ConsolidatedOrderbookSource)(nil), "gctrpc.ConsolidatedOrderbookSource")
+	proto.RegisterType((*ConsolidatedOrderbookResponse)(nil), "gctrpc.ConsolidatedOrderbookResponse")
+	proto.RegisterType((*GetArbitrageOpportunitiesRequest)(nil), "gctrpc.GetArbitrageOpportunitiesRequest")
+	proto.RegisterType((*ArbitrageOpportunity)(nil), "gctrpc.ArbitrageOpportunity")
+	proto.RegisterType((*GetArbitrageOpportunitiesResponse)(nil), "gctrpc.GetArbitrageOpportunitiesResponse")
+	proto.RegisterType((*GetDispatchStatsRequest)(nil), "gctrpc.GetDispatchStatsRequest")
+	proto.RegisterType((*DispatchPipeStats)(nil), "gctrpc.DispatchPipeStats")
+	proto.RegisterType((*GetDispatchStatsResponse)(nil), "gctrpc.GetDispatchStatsResponse")
+	proto.RegisterType((*GetOrderReconciliationRequest)(nil), "gctrpc.GetOrderReconciliationRequest")
+	proto.RegisterType((*OrderReconciliationChange)(nil), "gctrpc.OrderReconciliationChange")
+	proto.RegisterType((*OrderReconciliationReport)(nil), "gctrpc.OrderReconciliationReport")
+	proto.RegisterType((*GetOrderReconciliationResponse)(nil), "gctrpc.GetOrderReconciliationResponse")
+	proto.RegisterType((*ConditionalOrder)(nil), "gctrpc.ConditionalOrder")
+	proto.RegisterType((*AddConditionalOrderRequest)(nil), "gctrpc.AddConditionalOrderRequest")
+	proto.RegisterType((*CancelConditionalOrderRequest)(nil), "gctrpc.CancelConditionalOrderRequest")
+	proto.RegisterType((*GetConditionalOrdersRequest)(nil), "gctrpc.GetConditionalOrdersRequest")
+	proto.RegisterType((*GetConditionalOrdersResponse)(nil), "gctrpc.GetConditionalOrdersResponse")
+	proto.RegisterType((*OrderGroupLeg)(nil), "gctrpc.OrderGroupLeg")
+	proto.RegisterType((*OrderGroup)(nil), "gctrpc.OrderGroup")
+	proto.RegisterType((*OrderGroupLegRequest)(nil), "gctrpc.OrderGroupLegRequest")
+	proto.RegisterType((*AddOCOOrderGroupRequest)(nil), "gctrpc.AddOCOOrderGroupRequest")
+	proto.RegisterType((*AddBracketOrderGroupRequest)(nil), "gctrpc.AddBracketOrderGroupRequest")
+	proto.RegisterType((*CancelOrderGroupRequest)(nil), "gctrpc.CancelOrderGroupRequest")
+	proto.RegisterType((*GetOrderGroupsRequest)(nil), "gctrpc.GetOrderGroupsRequest")
+	proto.RegisterType((*GetOrderGroupsResponse)(nil), "gctrpc.GetOrderGroupsResponse")
+	proto.RegisterType((*IcebergOrder)(nil), "gctrpc.IcebergOrder")
+	proto.RegisterType((*AddIcebergOrderRequest)(nil), "gctrpc.AddIcebergOrderRequest")
+	proto.RegisterType((*CancelIcebergOrderRequest)(nil), "gctrpc.CancelIcebergOrderRequest")
+	proto.RegisterType((*GetIcebergOrdersRequest)(nil), "gctrpc.GetIcebergOrdersRequest")
+	proto.RegisterType((*GetIcebergOrdersResponse)(nil), "gctrpc.GetIcebergOrdersResponse")
+	proto.RegisterType((*ExecutionChild)(nil), "gctrpc.ExecutionChild")
+	proto.RegisterType((*ExecutionOrder)(nil), "gctrpc.ExecutionOrder")
+	proto.RegisterType((*AddExecutionOrderRequest)(nil), "gctrpc.AddExecutionOrderRequest")
+	proto.RegisterType((*ExecutionOrderRequest)(nil), "gctrpc.ExecutionOrderRequest")
+	proto.RegisterType((*GetExecutionOrdersRequest)(nil), "gctrpc.GetExecutionOrdersRequest")
+	proto.RegisterType((*GetExecutionOrdersResponse)(nil), "gctrpc.GetExecutionOrdersResponse")
+	proto.RegisterType((*AuditEvent)(nil), "gctrpc.AuditEvent")
+	proto.RegisterType((*GCTScript)(nil), "gctrpc.GCTScript")
+	proto.RegisterType((*GCTScriptExecuteRequest)(nil), "gctrpc.GCTScriptExecuteRequest")
+	proto.RegisterType((*GCTScriptStopRequest)(nil), "gctrpc.GCTScriptStopRequest")
+	proto.RegisterType((*GCTScriptStopAllRequest)(nil), "gctrpc.GCTScriptStopAllRequest")
+	proto.RegisterType((*GCTScriptStatusRequest)(nil), "gctrpc.GCTScriptStatusRequest")
+	proto.RegisterType((*GCTScriptListAllRequest)(nil), "gctrpc.GCTScriptListAllRequest")
+	proto.RegisterType((*GCTScriptUploadRequest)(nil), "gctrpc.GCTScriptUploadRequest")
+	proto.RegisterType((*GCTScriptReadScriptRequest)(nil), "gctrpc.GCTScriptReadScriptRequest")
+	proto.RegisterType((*GCTScriptQueryRequest)(nil), "gctrpc.GCTScriptQueryRequest")
+	proto.RegisterType((*GCTScriptAutoLoadRequest)(nil), "gctrpc.GCTScriptAutoLoadRequest")
+	proto.RegisterType((*GCTScriptStatusResponse)(nil), "gctrpc.GCTScriptStatusResponse")
+	proto.RegisterType((*GCTScriptQueryResponse)(nil), "gctrpc.GCTScriptQueryResponse")
+	proto.RegisterType((*GCTScriptGenericResponse)(nil), "gctrpc.GCTScriptGenericResponse")
+}
+
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
+
+var fileDescriptor_77a6da22d6a3feb1 = []byte{
+	// 9018 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x5b, 0x6c, 0x24, 0xc9,
+	0x91, 0x18, 0xba, 0xd9, 0x24, 0xbb, 0x83, 0xef, 0xe2, 0xab, 0x59, 0x24, 0x87, 0x33, 0xb5, 0x9a,
+	0xdd, 0x9d, 0x95, 0x76, 0x66, 0xb4, 0x5a, 0xf9, 0xa4, 0x5b, 0x9d, 0xce, 0x1c, 0xce, 0xec, 0xec,
+	0x48, 0x23, 0x0d, 0x55, 0x9c, 0xdd, 0x85, 0x75, 0x3e, 0xb5, 0x8b, 0x5d, 0xc9, 0x66, 0x89, 0xd5,
+	0x55, 0xb5, 0x55, 0xd5, 0xe4, 0x70, 0xe5, 0x87, 0x20, 0x9f, 0x0d, 0xc3, 0x77, 0x38, 0x03, 0x3e,
+	0x18, 0x7e, 0xe0, 0xbe, 0x0c, 0x7f, 0x1c, 0x0c, 0xf8, 0x70, 0x38, 0xf8, 0xe3, 0xec, 0x8f, 0x83,
+	0x3f, 0x0c, 0x1b, 0x86, 0x01, 0xff, 0x1c, 0x6c, 0x18, 0xb0, 0x3f, 0xfc, 0x61, 0xd8, 0x5f, 0xb6,
+	0x01, 0x1b, 0x86, 0x61, 0x01, 0x06, 0x8c, 0x8c, 0x7c, 0x54, 0x66, 0x55, 0x56, 0xb3, 0xb9, 0x3b,
+	0x1a, 0xe9, 0x87, 0xec, 0x8c, 0x8c, 0xcc, 0xc8, 0x8c, 0x8c, 0xcc, 0x8c, 0x8c, 0x8c, 0x8c, 0x82,
+	0x4e, 0x9a, 0xf4, 0xef, 0x26, 0x69, 0x9c, 0xc7, 0xd6, 0xcc, 0xa0, 0x9f, 0xa7, 0x49, 0xdf, 0xde,
+	0x19, 0xc4, 0xf1, 0x20, 0x24, 0xf7, 0xbc, 0x24, 0xb8, 0xe7, 0x45, 0x51, 0x9c, 0x7b, 0x79, 0x10,
+	0x47, 0x19, 0xc3, 0x72, 0x96, 0x61, 0xf1, 0x31, 0xc9, 0x9f, 0x44, 0x27, 0xb1, 0x4b, 0x3e, 0x19,
+	0x91, 0x2c, 0x77, 0xfe, 0x71, 0x0b, 0x96, 0x24, 0x28, 0x4b, 0xe2, 0x28, 0x23, 0xd6, 0x06, 0xcc,
+	0x8c, 0x92, 0x3c, 0x18, 0x92, 0x6e, 0xe3, 0x66, 0xe3, 0xcd, 0x8e, 0xcb, 0x53, 0xd6, 0x3d, 0x58,
+	0xf5, 0xce, 0xbd, 0x20, 0xf4, 0x8e, 0x43, 0xd2, 0x23, 0x2f, 0xfa, 0xa7, 0x5e, 0x34, 0x20, 0x59,
+	0xb7, 0x79, 0xb3, 0xf1, 0xe6, 0x94, 0x6b, 0xc9, 0xac, 0x47, 0x22, 0xc7, 0xfa, 0x22, 0xac, 0x90,
+	0x88, 0x82, 0x7c, 0x05, 0x7d, 0x0a, 0xd1, 0x97, 0x79, 0x46, 0x81, 0xfc, 0x2e, 0x6c, 0xf8, 0xe4,
+	0xc4, 0x1b, 0x85, 0x79, 0xef, 0x24, 0x4e, 0xc9, 0x8b, 0x5e, 0x92, 0xc6, 0xe7, 0x81, 0x4f, 0xd2,
+	0x6e, 0x0b, 0x5b, 0xb1, 0xc6, 0x73, 0xdf, 0xa7, 0x99, 0x87, 0x3c, 0xcf, 0x7a, 0x07, 0xd6, 0x65,
+	0xa9, 0xc0, 0xcb, 0x7b, 0xfd, 0x51, 0x9a, 0x92, 0xa8, 0x7f, 0xd9, 0x9d, 0xc6, 0x42, 0xab, 0xa2,
+	0x50, 0xe0, 0xe5, 0x07, 0x3c, 0xcb, 0xfa, 0x18, 0x96, 0xb3, 0xd1, 0x71, 0x76, 0x99, 0xe5, 0x64,
+	0xd8, 0xcb, 0x72, 0x2f, 0x1f, 0x65, 0xdd, 0x99, 0x9b, 0x53, 0x6f, 0xce, 0xbd, 0xf3, 0xa5, 0xbb,
+	0x8c, 0x8d, 0x77, 0x4b, 0x2c, 0xb9, 0x7b, 0x24, 0xf0, 0x8f, 0x10, 0xfd, 0x51, 0x94, 0xa7, 0x97,
+	0xee, 0x52, 0xa6, 0x43, 0xad, 0xef, 0xc2, 0x42, 0x9a, 0xf4, 0x7b, 0x24, 0xf2, 0x93, 0x38, 0x88,
+	0xf2, 0xac, 0x3b, 0x8b, 0xb5, 0xde, 0xa9, 0xab, 0xd5, 0x4d, 0xfa, 0x8f, 0x04, 0x2e, 0xab, 0x72,
+	0x3e, 0x55, 0x40, 0xf6, 0x03, 0x58, 0x33, 0x11, 0xb6, 0x96, 0x61, 0xea, 0x8c, 0x5c, 0xf2, 0xd1,
+	0xa1, 0x3f, 0xad, 0x35, 0x98, 0x3e, 0xf7, 0xc2, 0x11, 0xc1, 0xc1, 0x68, 0xbb, 0x2c, 0xf1, 0xcb,
+	0xcd, 0xaf, 0x35, 0xec, 0xe7, 0xb0, 0x52, 0x21, 0x63, 0xa8, 0xe0, 0x8e, 0x5a, 0xc1, 0xdc, 0x3b,
+	0xab, 0xa2, 0xc9, 0xee, 0xe1, 0x81, 0x28, 0xab, 0xd4, 0xea, 0xdc, 0x82, 0xbd, 0xc7, 0x24, 0x3f,
+	0x88, 0x87, 0xc3, 0x51, 0x14, 0xf4, 0x51, 0xc6, 0x5c, 0x12, 0x7a, 0x97, 0x24, 0xcd, 0x84, 0x64,
+	0x7d, 0x17, 0xd6, 0x4c, 0xf9, 0x56, 0x17, 0x66, 0xf9, 0xd8, 0x23, 0xfd, 0xb6, 0x2b, 0x92, 0xd6,
+	0x0e, 0x74, 0xfa, 0x71, 0x14, 0x91, 0x7e, 0x4e, 0x7c, 0xde, 0x91, 0x02, 0xe0, 0xfc, 0xd5, 0x26,
+	0xdc, 0xac, 0xa7, 0xc9, 0x45, 0xf7, 0x53, 0xd8, 0xe8, 0xab, 0x08, 0xbd, 0x94, 0x63, 0x74, 0x1b,
+	0x38, 0x14, 0x07, 0xca, 0x50, 0x8c, 0xad, 0xe9, 0xae, 0x31, 0x97, 0x0d, 0xd2, 0x7a, 0xdf, 0x94,
+	0x67, 0x9f, 0x80, 0x5d, 0x5f, 0xc8, 0xc0, 0xf2, 0x77, 0x74, 0x96, 0xef, 0x88, 0xa6, 0x99, 0x2a,
+	0x51, 0x79, 0xff, 0x4b, 0xb0, 0xf9, 0x98, 0x44, 0x24, 0x0d, 0xfa, 0x52, 0x38, 0x38, 0xcf, 0x29,
+	0x07, 0xa5, 0x4c, 0x72, 0x52, 0x05, 0xc0, 0xb1, 0xa1, 0x5b, 0x2d, 0xc8, 0xba, 0xeb, 0x6c, 0xc0,
+	0xda, 0x63, 0x92, 0x4b, 0xb8, 0x1c, 0xc5, 0x3f, 0x6e, 0xc0, 0x3a, 0x66, 0x64, 0xc7, 0xd9, 0x25,
+	0xcb, 0xe0, 0xac, 0xfe, 0x73, 0xb0, 0x22, 0xab, 0xce, 0xc4, 0x34, 0x62, 0x5c, 0xfe, 0x8a, 0xc2,
+	0xe5, 0x6a, 0xc9, 0x62, 0x32, 0x65, 0xea, 0x6c, 0x5a, 0xce, 0x4a, 0x60, 0xfb, 0x00, 0xd6, 0x8d,
+	0xa8, 0xd7, 0x91, 0x7f, 0xa7, 0x0b, 0x1b, 0x8f, 0x49, 0xae, 0x88, 0xb1, 0x22, 0xa0, 0x73, 0x0a,
+	0x98, 0xca, 0x65, 0x96, 0x7b, 0x69, 0x5e, 0xc8, 0x25, 0x4f, 0x5a, 0xb7, 0x61, 0x31, 0x0c, 0xb2,
+	0x9c, 0x44, 0x3d, 0xcf, 0xf7, 0x53, 0x92, 0xb1, 0x25, 0xaf, 0xe3, 0x2e, 0x30, 0xe8, 0x3e, 0x03,
+	0x3a, 0xff, 0xb4, 0x01, 0x9b, 0x15, 0x52, 0x9c, 0x59, 0x4f, 0xa1, 0x53, 0xac, 0x0a, 0x8c, 0x49,
+	0x77, 0x15, 0x26, 0x99, 0xca, 0xdc, 0x2d, 0x2d, 0x0d, 0x45, 0x05, 0xf6, 0xf7, 0x60, 0xf1, 0x65,
+	0x4f, 0xe8, 0xaf, 0x81, 0xcd, 0x65, 0x43, 0xac, 0xc8, 0xdf, 0xf5, 0x86, 0x44, 0xc8, 0x95, 0x0d,
+	0x6d, 0xb1, 0x80, 0x73, 0x1a, 0x32, 0xed, 0xec, 0xc2, 0xb6, 0xb1, 0x24, 0x17, 0xac, 0x7b, 0xb0,
+	0xfa, 0x98, 0xe4, 0x22, 0x4b, 0x30, 0xbf, 0x7e, 0x15, 0x70, 0xde, 0x85, 0x35, 0xbd, 0x00, 0x67,
+	0xe1, 0x0e, 0x74, 0x8a, 0x4d, 0x84, 0xcb, 0xb6, 0x04, 0x38, 0xef, 0xc0, 0xba, 0x52, 0xea, 0xd9,
+	0xf3, 0x43, 0x97, 0xb0, 0x62, 0x5b, 0xd0, 0x8e, 0xf3, 0xa4, 0xd7, 0x8f, 0x7d, 0xd1, 0xf4, 0xd9,
+	0x38, 0x4f, 0x0e, 0x62, 0x9f, 0x70, 0xd1, 0x50, 0xca, 0x48, 0xd1, 0xf8, 0xfb, 0x6c, 0x28, 0xf5,
+	0x2c, 0xde, 0x8e, 0x6f, 0x41, 0x47, 0x54, 0x28, 0x86, 0xf2, 0x6d, 0x65, 0x28, 0x4d, 0x65, 0xee,
+	0x3e, 0x63, 0x14, 0xf9, 0x48, 0xb6, 0x79, 0x03, 0x32, 0xfb, 0x3d, 0x58, 0xd0, 0xb2, 0xae, 0x92,
+	0xec, 0x8e, 0x3a, 0x64, 0xef, 0xc2, 0xc6, 0xc3, 0x20, 0x53, 0x77, 0xdc, 0x49, 0x86, 0xeb, 0x07,
+	0xb0, 0x78, 0xe8, 0x05, 0x69, 0x76, 0x34, 0x4a, 0x92, 0x18, 0xc5, 0xfb, 0x0d, 0x58, 0x2a, 0xb6,
+	0xf5, 0x84, 0xe6, 0xf1, 0x42, 0x8b, 0x12, 0x8c, 0x25, 0xac, 0xd7, 0x60, 0x41, 0x6c, 0xe7, 0x0c,
+	0x8d, 0x35, 0x69, 0x9e, 0x03, 0x11, 0xc9, 0xf9, 0x49, 0x4b, 0x63, 0x9d, 0xa6, 0x58, 0x58, 0xd0,
+	0x8a, 0x3c, 0xa9, 0x56, 0xe0, 0x6f, 0x55, 0x10, 0x9a, 0xfa, 0x76, 0xd0, 0x85, 0xd9, 0x73, 0x92,
+	0x1e, 0xc7, 0x19, 0x41, 0x9d, 0xa1, 0xed, 0x8a, 0x24, 0x6d, 0xc8, 0x28, 0x0b, 0xa2, 0x41, 0x2f,
+	0xf3, 0x22, 0xff, 0x38, 0x7e, 0x81, 0x1a, 0x42, 0xdb, 0x9d, 0x47, 0xe0, 0x11, 0x83, 0x59, 0xb7,
+	0x60, 0xfe, 0x34, 0xcf, 0x93, 0x1e, 0x55, 0x5d, 0xe2, 0x51, 0xce, 0x15, 0x82, 0x39, 0x0a, 0x7b,
+	0xce, 0x40, 0x74, 0x62, 0x23, 0xca, 0x28, 0x23, 0xa9, 0x37, 0x20, 0x51, 0xde, 0x9d, 0x61, 0x13,
+	0x9b, 0x42, 0x3f, 0x14, 0x40, 0x6b, 0x17, 0x00, 0xd1, 0x92, 0x34, 0x7e, 0x71, 0xd9, 0x9d, 0x65,
+	0xa2, 0x47, 0x21, 0x87, 0x14, 0x40, 0xf9, 0x77, 0xec, 0x65, 0x44, 0xa8, 0x1e, 0x01, 0xc9, 0xba,
+	0x6d, 0xc6, 0x3f, 0x0a, 0x3e, 0x90, 0x50, 0xab, 0x47, 0xf5, 0x0e, 0xce, 0xf5, 0x9e, 0x97, 0x65,
+	0x24, 0xcf, 0xba, 0x1d, 0x14, 0xa0, 0x77, 0x0d, 0x02, 0x54, 0xd2, 0x3f, 0x78, 0xb9, 0x7d, 0x2c,
+	0x26, 0xf5, 0x0f, 0x0d, 0x4a, 0xf5, 0x2d, 0x6f, 0x94, 0x9f, 0x92, 0x28, 0xa7, 0xbb, 0x07, 0x25,
+	0x92, 0x04, 0x5d, 0x40, 0xde, 0x2c, 0x6b, 0x19, 0xfb, 0x49, 0x60, 0x7f, 0x9f, 0x2a, 0x17, 0xd5,
+	0x5a, 0x0d, 0x22, 0xf8, 0x25, 0x7d, 0x29, 0xd9, 0x10, 0x8d, 0xd5, 0xe5, 0x48, 0x15, 0xcd, 0x0b,
+	0x58, 0x7e, 0x4c, 0xf2, 0xe7, 0x41, 0xff, 0x8c, 0xa4, 0x13, 0x08, 0xa5, 0xf5, 0x26, 0xb4, 0xa8,
+	0x44, 0x71, 0x02, 0x6b, 0x72, 0x27, 0xe4, 0x1a, 0x1b, 0x25, 0xe4, 0x22, 0x06, 0x1d, 0x0b, 0xe4,
+	0x5c, 0x2f, 0xbf, 0x4c, 0x98, 0x5c, 0x74, 0xdc, 0x0e, 0x42, 0x9e, 0x5f, 0x26, 0xc4, 0xf9, 0x08,
+	0xe6, 0xd5, 0x42, 0x74, 0xd1, 0xf0, 0x49, 0x18, 0x0c, 0x83, 0x9c, 0xa4, 0x62, 0xd1, 0x90, 0x00,
+	0x2a, 0x8f, 0x74, 0x88, 0xb8, 0x1c, 0xe3, 0x6f, 0x3a, 0xdf, 0x3e, 0x19, 0xc5, 0xb9, 0xa8, 0x9b,
+	0x25, 0x9c, 0xbf, 0xd5, 0x84, 0x45, 0xd1, 0x1d, 0x2e, 0xcc, 0xa2, 0xcd, 0x8d, 0x2b, 0xdb, 0x7c,
+	0x0b, 0xe6, 0x43, 0x2f, 0xcb, 0x7b, 0xa3, 0xc4, 0xf7, 0x84, 0x6a, 0x33, 0xe5, 0xce, 0x51, 0xd8,
+	0x87, 0x0c, 0x44, 0x25, 0x5a, 0x68, 0xae, 0x38, 0xb7, 0x38, 0xf5, 0xf9, 0xbe, 0xda, 0x19, 0x0b,
+	0x5a, 0xb4, 0x0c, 0x4a, 0x7b, 0xc3, 0xc5, 0xdf, 0x14, 0x76, 0x1a, 0x0c, 0x4e, 0x51, 0xba, 0x1b,
+	0x2e, 0xfe, 0xa6, 0x23, 0x18, 0xc6, 0x17, 0x28, 0xcb, 0x0d, 0x97, 0xfe, 0xa4, 0x90, 0xe3, 0xc0,
+	0x47, 0xd1, 0x6d, 0xb8, 0xf4, 0x27, 0x85, 0x78, 0xd9, 0x19, 0x0a, 0x6a, 0xc3, 0xa5, 0x3f, 0xa9,
+	0xd6, 0x7f, 0x1e, 0x87, 0xa3, 0x21, 0xe9, 0x76, 0x10, 0xc8, 0x53, 0xd6, 0x36, 0x74, 0x92, 0x34,
+	0xe8, 0x93, 0x9e, 0x97, 0x9f, 0xa2, 0x30, 0x35, 0xdc, 0x36, 0x02, 0xf6, 0xf3, 0x53, 0x67, 0x15,
+	0x56, 0xe4, 0x40, 0xcb, 0xd5, 0xf3, 0x63, 0x98, 0xe5, 0x90, 0xb1, 0x83, 0x7e, 0x1f, 0x66, 0x73,
+	0x86, 0xd6, 0x6d, 0xde, 0x9c, 0x52, 0x05, 0x4b, 0xe7, 0xb4, 0x2b, 0xd0, 0x9c, 0x5f, 0x05, 0x4b,
+	0xa5, 0xc6, 0x07, 0xe2, 0x4e, 0x51, 0x0f, 0x5b, 0x8e, 0x97, 0xf4, 0x7a, 0xb2, 0xa2, 0x82, 0x4f,
+	0x71, 0x33, 0x7a, 0x96, 0xfa, 0x74, 0x21, 0x89, 0xcf, 0x5e, 0xa9, 0x68, 0x7e, 0x07, 0x16, 0x24,
+	0xe1, 0x27, 0x39, 0x19, 0x52, 0x86, 0x7b, 0xc3, 0x78, 0x14, 0xe5, 0x48, 0xb3, 0xe1, 0xf2, 0x14,
+	0x95, 0x40, 0xe4, 0x2f, 0x92, 0x6c, 0xb8, 0x2c, 0x61, 0x2d, 0x42, 0x33, 0xf0, 0xf9, 0xe1, 0xa9,
+	0x19, 0xf8, 0xce, 0x4f, 0x1b, 0xb0, 0xa2, 0x74, 0xe4, 0xda, 0x42, 0x59, 0x91, 0xb8, 0xa6, 0x41,
+	0xe2, 0xee, 0x40, 0xeb, 0x38, 0xf0, 0xe9, 0x99, 0x8d, 0xf2, 0x75, 0x5d, 0x54, 0xa7, 0xf5, 0xc3,
+	0x45, 0x14, 0x8a, 0xea, 0x65, 0x67, 0x59, 0xb7, 0x35, 0x16, 0x95, 0xa2, 0x54, 0xe6, 0xc3, 0x74,
+	0x75, 0x3e, 0xe8, 0xbc, 0x9c, 0x29, 0xf3, 0x92, 0x69, 0xab, 0xb2, 0x6e, 0x29, 0x79, 0x7d, 0x80,
+	0x02, 0x38, 0x76, 0x58, 0xbf, 0x0e, 0x10, 0x4b, 0x4c, 0x2e, 0x7f, 0x5b, 0x95, 0x46, 0x4b, 0x11,
+	0x54, 0x90, 0x9d, 0x6f, 0xa3, 0xaa, 0xa1, 0x12, 0xe7, 0xcc, 0x7f, 0x47, 0xab, 0x93, 0xc9, 0xa2,
+	0x55, 0xa9, 0x33, 0xd3, 0x2a, 0xfb, 0x0a, 0x56, 0xb6, 0xdf, 0xef, 0xd3, 0xa1, 0x57, 0x0e, 0xe6,
+	0x63, 0xf7, 0xf0, 0x8f, 0x60, 0x96, 0x97, 0xe0, 0x62, 0xc1, 0x10, 0x9a, 0x81, 0x6f, 0xbd, 0x07,
+	0xa0, 0xec, 0x43, 0xac, 0x5f, 0xdb, 0xa2, 0x0d, 0xbc, 0x90, 0x90, 0x06, 0x24, 0xa7, 0xa0, 0x3b,
+	0x27, 0xb0, 0x6a, 0x40, 0xa1, 0x4d, 0x91, 0xc7, 0x6a, 0xde, 0x14, 0x91, 0xb6, 0xf6, 0x60, 0x2e,
+	0x8f, 0x73, 0x2f, 0xec, 0x15, 0x3b, 0x44, 0xc3, 0x05, 0x04, 0x7d, 0x44, 0x21, 0xb8, 0x40, 0xc5,
+	0x21, 0x93, 0x5c, 0xba, 0x40, 0xc5, 0xa1, 0xef, 0x78, 0xa8, 0x78, 0x69, 0x9d, 0xe6, 0x2c, 0x1c,
+	0x37, 0x64, 0x5f, 0x84, 0xb6, 0xc7, 0x8a, 0x88, 0x8e, 0x2d, 0x95, 0x3a, 0xe6, 0x4a, 0x04, 0xc7,
+	0xc2, 0x1d, 0xe8, 0x20, 0x8e, 0x4e, 0x82, 0x81, 0x90, 0x8e, 0x37, 0x60, 0x45, 0x81, 0x15, 0x3a,
+	0x89, 0xef, 0xe5, 0x1e, 0x52, 0x9b, 0x77, 0xf1, 0xb7, 0xf3, 0x57, 0x1a, 0xb0, 0x7c, 0x18, 0xa7,
+	0xf9, 0x49, 0x1c, 0x06, 0x31, 0x57, 0xef, 0xa9, 0x3a, 0x22, 0xd4, 0x7f, 0xae, 0x47, 0xf2, 0x24,
+	0x5d, 0x21, 0xfb, 0x71, 0x10, 0x31, 0x59, 0x6d, 0x72, 0x06, 0xc5, 0x41, 0x44, 0x45, 0xd5, 0xba,
+	0x09, 0x73, 0x3e, 0xc9, 0xfa, 0x69, 0x90, 0xd0, 0xe3, 0x1c, 0x5f, 0x16, 0x54, 0x10, 0xad, 0xf8,
+	0xd8, 0x0b, 0xbd, 0xa8, 0x4f, 0xf8, 0xca, 0x2e, 0x92, 0xce, 0x3a, 0x2e, 0x57, 0xb2, 0x25, 0xca,
+	0xc9, 0x5a, 0x07, 0xf3, 0xae, 0xfc, 0x29, 0xe8, 0x24, 0x02, 0xc8, 0xc5, 0xaf, 0x2b, 0xf7, 0xea,
+	0x52, 0x77, 0xdc, 0x02, 0xd5, 0xd9, 0x01, 0x5b, 0xad, 0xef, 0x68, 0x34, 0x1c, 0x7a, 0xe9, 0xa5,
+	0xa0, 0x16, 0x41, 0xeb, 0x20, 0x0e, 0x22, 0xca, 0x28, 0xda, 0x29, 0xa1, 0xbc, 0xd1, 0xdf, 0x6a,
+	0xd3, 0x9b, 0x5a, 0xd3, 0x55, 0x6e, 0x4d, 0xe9, 0xdc, 0xba, 0x01, 0x90, 0x90, 0xb4, 0x4f, 0xa2,
+	0xdc, 0x1b, 0x88, 0x1e, 0x2b, 0x10, 0xe7, 0x14, 0xac, 0x67, 0x27, 0x27, 0x61, 0x10, 0x11, 0x4a,
+	0x96, 0x37, 0x66, 0x0c, 0xf7, 0xeb, 0xdb, 0xa0, 0x53, 0x9a, 0xaa, 0x50, 0xfa, 0x0e, 0xac, 0x3c,
+	0x8b, 0x0c, 0x84, 0x44, 0x75, 0x8d, 0x71, 0xd5, 0x35, 0x2b, 0xd5, 0x7d, 0x00, 0xf3, 0x4a, 0xc3,
+	0x33, 0xeb, 0x6b, 0xd0, 0xe1, 0x6d, 0x94, 0x07, 0x05, 0x5b, 0xae, 0x06, 0x95, 0x1e, 0xba, 0x05,
+	0xb2, 0xf3, 0x77, 0x1a, 0x30, 0x57, 0xb4, 0x8c, 0x9a, 0xc6, 0xa6, 0x29, 0xbb, 0x45, 0x2d, 0x37,
+	0x64, 0x2d, 0x05, 0xce, 0x5d, 0xfc, 0xcb, 0xf4, 0x42, 0x86, 0x6c, 0x1f, 0x01, 0x14, 0x40, 0x83,
+	0x5a, 0x77, 0x4f, 0x57, 0xeb, 0xb6, 0xaa, 0xb5, 0x8a, 0xa6, 0x29, 0x9a, 0xdd, 0xbf, 0x6e, 0xc1,
+	0xb6, 0x51, 0x58, 0xb8, 0x0c, 0xbe, 0x0d, 0x73, 0x6c, 0x2e, 0xd0, 0x15, 0x40, 0x34, 0x78, 0xbe,
+	0x30, 0x6d, 0x04, 0x91, 0x0b, 0x38, 0x37, 0x30, 0xdf, 0xfa, 0x32, 0x2c, 0xd0, 0x54, 0xd6, 0x8b,
+	0x19, 0x43, 0xba, 0x4d, 0x43, 0x81, 0x79, 0x44, 0xe1, 0x2c, 0xb3, 0x12, 0x58, 0xd7, 0x8a, 0xf4,
+	0x32, 0xd6, 0x04, 0xbe, 0x49, 0x7d, 0x43, 0x51, 0xa5, 0xeb, 0x5a, 0x79, 0xf7, 0x40, 0xa9, 0x90,
+	0xe7, 0x31, 0xd6, 0xad, 0xf6, 0xab, 0x39, 0xd6, 0x3d, 0x98, 0xe7, 0x14, 0x91, 0x33, 0xdd, 0x96,
+	0xa1, 0x8d, 0x73, 0xac, 0x20, 0x22, 0x58, 0x43, 0x58, 0x53, 0x0b, 0xc8, 0x16, 0x4e, 0x63, 0xc1,
+	0xf7, 0x26, 0x6f, 0x61, 0x54, 0x69, 0xa0, 0xd5, 0xaf, 0x64, 0xd8, 0x7f, 0x16, 0xba, 0x75, 0x1d,
+	0x32, 0x0c, 0xfb, 0x5b, 0xfa, 0xb0, 0xaf, 0x19, 0x44, 0x32, 0x53, 0x0d, 0x88, 0xdf, 0x87, 0xcd,
+	0x9a, 0xc6, 0x5c, 0xc3, 0xea, 0xf0, 0x2c, 0x32, 0xd5, 0xed, 0xfc, 0x8d, 0x06, 0xd8, 0xfb, 0xbe,
+	0x5f, 0x59, 0x9c, 0x0a, 0x23, 0xc1, 0xab, 0x5e, 0x72, 0x77, 0x61, 0xdb, 0xd8, 0x20, 0x6e, 0xcd,
+	0x78, 0x01, 0xbb, 0x2e, 0x19, 0xc6, 0xe7, 0xe4, 0x55, 0x37, 0xd9, 0xb9, 0x09, 0x37, 0xea, 0x28,
+	0xf3, 0xb6, 0xa1, 0x79, 0x4f, 0x37, 0x8f, 0x4b, 0xc5, 0xe8, 0xbf, 0x35, 0x60, 0x41, 0xcb, 0x79,
+	0x69, 0x67, 0xf1, 0x2f, 0x81, 0x95, 0x92, 0x2c, 0xef, 0x25, 0x71, 0x18, 0xd2, 0x23, 0xb9, 0x4f,
+	0x0d, 0x96, 0xdc, 0x64, 0xbf, 0x4c, 0x73, 0x0e, 0x59, 0xc6, 0x43, 0x0a, 0xb7, 0x36, 0x61, 0xd6,
+	0x4b, 0x82, 0x1e, 0x95, 0x1a, 0x76, 0x1e, 0x9f, 0xf1, 0x92, 0xe0, 0xdb, 0xe4, 0xd2, 0x72, 0x60,
+	0x81, 0x67, 0xf4, 0x42, 0x72, 0x4e, 0x42, 0xd4, 0xf9, 0xa6, 0xdc, 0x39, 0x96, 0xfd, 0x94, 0x82,
+	0xac, 0x3b, 0xb0, 0x9c, 0xa4, 0x01, 0x15, 0xbf, 0xe2, 0x6e, 0x60, 0x16, 0x5b, 0xb3, 0xc4, 0xe1,
+	0xa2, 0x77, 0xce, 0xaf, 0xc1, 0x96, 0x81, 0x17, 0x7c, 0x8d, 0xfa, 0x26, 0x2c, 0xe9, 0x37, 0x0c,
+	0x62, 0x9d, 0x92, 0x5a, 0xab, 0x56, 0xd0, 0x5d, 0x3c, 0xd1, 0xea, 0xe1, 0xda, 0x27, 0xe2, 0xb8,
+	0x5e, 0x2e, 0x6d, 0x5a, 0xce, 0x27, 0xb0, 0x56, 0x00, 0x0f, 0xe2, 0xe8, 0x9c, 0xa4, 0x19, 0x95,
+	0x36, 0x0b, 0x5a, 0x27, 0x69, 0x2c, 0x0c, 0xb2, 0xf8, 0x9b, 0xea, 0x6d, 0x79, 0xcc, 0xc5, 0xa0,
+	0x99, 0xc7, 0x14, 0x27, 0xf5, 0x72, 0xb1, 0x4b, 0xe1, 0x6f, 0xaa, 0x27, 0x07, 0x58, 0x09, 0xe9,
+	0x61, 0x1e, 0x13, 0xd5, 0x39, 0x0e, 0xa3, 0x54, 0x9c, 0x8f, 0x50, 0x7d, 0x54, 0x9b, 0xc2, 0xfb,
+	0xf8, 0x2b, 0x30, 0xc7, 0xfa, 0x48, 0x4b, 0x8a, 0xfe, 0xed, 0x68, 0xfd, 0x2b, 0x35, 0xd3, 0x85,
+	0x13, 0x09, 0x75, 0xfe, 0x47, 0x13, 0xe6, 0x51, 0x63, 0x7d, 0x48, 0x72, 0x2f, 0x08, 0xc7, 0xeb,
+	0xd2, 0x4c, 0x07, 0x6d, 0x4a, 0x1d, 0xf4, 0x35, 0x58, 0x50, 0x0d, 0x22, 0x97, 0xe2, 0x30, 0xab,
+	0x98, 0x43, 0x2e, 0xa9, 0xed, 0x05, 0x8f, 0xd6, 0x05, 0x16, 0x93, 0x99, 0x05, 0x84, 0x4a, 0x34,
+	0xfd, 0x20, 0x30, 0x5d, 0x3a, 0x08, 0xd0, 0x6c, 0x54, 0xa6, 0x7b, 0x59, 0xe0, 0xcb, 0x73, 0x02,
+	0x42, 0x8e, 0x02, 0x5f, 0xc9, 0xc6, 0xd2, 0xb3, 0x4a, 0x36, 0x96, 0xa6, 0x67, 0xa0, 0x94, 0xb0,
+	0x8b, 0x02, 0xbc, 0xef, 0x6a, 0xa3, 0xd0, 0xcd, 0x0b, 0x20, 0xb5, 0x13, 0xd1, 0x63, 0x1a, 0x37,
+	0x6e, 0x77, 0x98, 0xc4, 0xb2, 0x54, 0x71, 0x4c, 0x03, 0xf5, 0x98, 0x56, 0x1c, 0xea, 0xe6, 0xb4,
+	0x43, 0xdd, 0x1e, 0xcc, 0xc5, 0x09, 0x89, 0x7a, 0xfc, 0x88, 0x3d, 0x8f, 0x99, 0x40, 0x41, 0x1f,
+	0x21, 0x84, 0x9b, 0x4c, 0x90, 0xe7, 0xd9, 0x24, 0xe7, 0x52, 0x9d, 0x31, 0xcd, 0x32, 0x63, 0xc4,
+	0x41, 0x70, 0xea, 0xaa, 0x83, 0xa0, 0xb3, 0x0f, 0x2b, 0x0a, 0x61, 0x2e, 0x3e, 0x5f, 0x82, 0x19,
+	0x64, 0x93, 0x90, 0x9c, 0x35, 0xed, 0x18, 0xc3, 0x85, 0xc2, 0xe5, 0x38, 0xce, 0x07, 0x78, 0x87,
+	0x88, 0x59, 0x93, 0x34, 0x9d, 0x9a, 0x64, 0x71, 0x54, 0xa4, 0xd4, 0xcc, 0x62, 0xfa, 0x89, 0xef,
+	0xfc, 0xfb, 0x06, 0x58, 0x47, 0xa3, 0xe3, 0x61, 0x30, 0x79, 0x6d, 0x93, 0x1f, 0xd0, 0x2d, 0x68,
+	0xa1, 0x98, 0x30, 0x71, 0xc4, 0xdf, 0x25, 0x09, 0x69, 0x95, 0x25, 0xa4, 0x18, 0xce, 0x69, 0xf3,
+	0x19, 0x7d, 0x46, 0x1d, 0x7c, 0xba, 0xc4, 0x87, 0x01, 0x89, 0xf2, 0x1e, 0x37, 0xb6, 0xd0, 0x25,
+	0x1e, 0x01, 0x4f, 0x7c, 0xe7, 0x08, 0x56, 0xb5, 0x9e, 0x71, 0x4e, 0xdf, 0x82, 0x79, 0xd6, 0x80,
+	0x24, 0xf4, 0xfa, 0xd2, 0x1a, 0x3e, 0x87, 0xb0, 0x43, 0x04, 0x8d, 0xe3, 0xd7, 0x9f, 0x34, 0x00,
+	0x1e, 0x78, 0x79, 0xff, 0x14, 0x2b, 0xbd, 0xc6, 0xf1, 0x5f, 0xf0, 0xa2, 0x59, 0xcb, 0x8b, 0xa9,
+	0x7a, 0x5e, 0xb4, 0xcc, 0xbc, 0x98, 0xae, 0xe5, 0xc5, 0x8c, 0xce, 0x8b, 0x92, 0xf0, 0xce, 0x96,
+	0x8f, 0xf7, 0xbf, 0xae, 0xb1, 0x6a, 0xa2, 0xe9, 0xf0, 0x96, 0x14, 0xd8, 0xa6, 0x7e, 0xee, 0x2e,
+	0xb8, 0x23, 0xc5, 0xf5, 0x6f, 0x36, 0x60, 0x59, 0x01, 0x93, 0x6c, 0x14, 0xe6, 0x9f, 0x6f, 0x1c,
+	0xac, 0xb7, 0x60, 0x25, 0x88, 0x72, 0x92, 0x46, 0x5e, 0xd8, 0x93, 0x38, 0x8c, 0x83, 0x4b, 0x22,
+	0xe3, 0x19, 0xc7, 0x5d, 0x83, 0x69, 0x92, 0xa6, 0xb1, 0xb8, 0xd7, 0x66, 0x09, 0xe7, 0x03, 0x58,
+	0xd3, 0xfb, 0xcc, 0xe5, 0xe3, 0x7e, 0x69, 0x26, 0x76, 0x0d, 0x1d, 0xc3, 0x1e, 0xc8, 0xee, 0xfd,
+	0xb5, 0x06, 0xac, 0x1d, 0x05, 0xc3, 0x51, 0xe8, 0xe5, 0xe4, 0x67, 0x30, 0x8b, 0x0a, 0x31, 0x98,
+	0xd2, 0xc4, 0x40, 0x48, 0x54, 0xab, 0x90, 0x28, 0xe7, 0x7f, 0x36, 0x60, 0xbd, 0xd4, 0x14, 0x79,
+	0x4e, 0xd0, 0xbb, 0x55, 0x63, 0x30, 0xe2, 0x48, 0x0a, 0xd1, 0xa6, 0x46, 0xf4, 0x35, 0x58, 0x18,
+	0x06, 0x51, 0x30, 0x1c, 0x0d, 0x7b, 0x4c, 0x06, 0x59, 0x9b, 0xe6, 0x39, 0xf0, 0x90, 0xc2, 0x10,
+	0xc9, 0x7b, 0xa1, 0x20, 0xb5, 0x38, 0x92, 0xf7, 0xa2, 0x40, 0xba, 0x0f, 0x6b, 0xc5, 0x59, 0xae,
+	0x37, 0xf0, 0x82, 0xa8, 0x17, 0xc6, 0x59, 0xc6, 0x85, 0xda, 0x2a, 0xf2, 0x1e, 0x7b, 0x41, 0xf4,
+	0x34, 0xce, 0x32, 0x65, 0x63, 0x98, 0x51, 0x37, 0x06, 0xaa, 0xd4, 0x2e, 0x7f, 0x7c, 0xea, 0x85,
+	0xe4, 0x41, 0x3c, 0x3c, 0x7e, 0xb9, 0xbc, 0xbf, 0x05, 0xf3, 0xcc, 0x16, 0x9b, 0x7b, 0xe9, 0x80,
+	0x88, 0x11, 0x98, 0x43, 0xd8, 0x73, 0x04, 0x19, 0x87, 0xe1, 0xbf, 0x37, 0xc0, 0x3a, 0xa0, 0xea,
+	0x6d, 0x38, 0xb1, 0x3c, 0xd0, 0x19, 0xca, 0x6c, 0x29, 0x85, 0xb4, 0x77, 0x38, 0xe4, 0x89, 0x3e,
+	0x15, 0xa6, 0xf4, 0xa9, 0x20, 0x7a, 0xd3, 0xba, 0xa6, 0xc1, 0xb4, 0xb2, 0xb7, 0xdf, 0x86, 0xc5,
+	0x0b, 0x2f, 0x0c, 0x49, 0x2e, 0xaf, 0x5d, 0xf9, 0xed, 0x0c, 0x83, 0x0a, 0xbb, 0x8c, 0xe8, 0xf0,
+	0xac, 0xd2, 0xe1, 0x75, 0x58, 0xd5, 0xfa, 0xcb, 0x35, 0xe4, 0xbf, 0x2e, 0x26, 0xbe, 0x92, 0xa9,
+	0x75, 0xa5, 0x61, 0xee, 0xca, 0xe7, 0xb5, 0xfd, 0x1a, 0x07, 0xa5, 0xaf, 0xb5, 0x71, 0xa2, 0x45,
+	0xee, 0x7e, 0x69, 0x91, 0xd3, 0xd7, 0x02, 0xb5, 0xc7, 0x62, 0x2d, 0xf8, 0xbd, 0x06, 0xac, 0xe9,
+	0x54, 0xf8, 0xfc, 0x3b, 0x14, 0xcb, 0x9d, 0x76, 0x71, 0x2f, 0x2f, 0x32, 0x4d, 0x65, 0xd8, 0xd4,
+	0x54, 0xaf, 0xec, 0xe7, 0xe2, 0x02, 0x62, 0x7f, 0x13, 0x96, 0xcb, 0x08, 0xd7, 0xba, 0xce, 0xfc,
+	0xdf, 0x0d, 0xb0, 0xbe, 0x13, 0xfb, 0xc1, 0xc9, 0xe5, 0x4b, 0x50, 0x24, 0x26, 0xd7, 0x7f, 0x4a,
+	0x43, 0xd7, 0xaa, 0x1b, 0xba, 0xe9, 0xda, 0x8d, 0x72, 0xa6, 0xbc, 0x51, 0xca, 0x0d, 0x71, 0xd6,
+	0xac, 0x19, 0xb6, 0xd5, 0x25, 0xcc, 0xb9, 0x0f, 0xab, 0x5a, 0xb7, 0xb3, 0xe2, 0xde, 0xda, 0x2c,
+	0x96, 0xf4, 0xe2, 0x97, 0x8d, 0xcf, 0x7e, 0x38, 0xb9, 0xf0, 0x38, 0x7f, 0xd8, 0x84, 0xcd, 0x4a,
+	0x31, 0x79, 0x22, 0xd2, 0x57, 0xe3, 0xd7, 0x75, 0x39, 0xd8, 0x0f, 0x8d, 0xa2, 0x20, 0x15, 0x40,
+	0xfb, 0xdf, 0x35, 0x60, 0x86, 0x81, 0xc6, 0x8e, 0xd7, 0xf7, 0x4b, 0x42, 0xc7, 0xa4, 0xf8, 0x97,
+	0x26, 0x23, 0x36, 0x5e, 0xfc, 0x8a, 0x5d, 0x75, 0x4a, 0xd9, 0x55, 0x3f, 0xb7, 0x50, 0x12, 0x58,
+	0xfe, 0x76, 0x10, 0x86, 0x47, 0x17, 0x41, 0xde, 0x3f, 0xe5, 0x94, 0xe8, 0x40, 0xf6, 0xf3, 0xe0,
+	0x9c, 0x70, 0x1d, 0x81, 0xa7, 0x28, 0x3c, 0x25, 0x5e, 0x16, 0x47, 0xbc, 0x1a, 0x9e, 0xa2, 0x8b,
+	0x36, 0x62, 0xb0, 0x1b, 0xd9, 0x5c, 0x1c, 0xee, 0x25, 0x6c, 0x3f, 0x77, 0xbe, 0x02, 0x5b, 0xfb,
+	0x3c, 0x59, 0x90, 0x13, 0x83, 0x5a, 0xd4, 0xdb, 0x50, 0xeb, 0x75, 0x7e, 0x9b, 0x1a, 0x4f, 0x0c,
+	0xa5, 0x0a, 0xc5, 0x41, 0xce, 0xed, 0x86, 0xba, 0x58, 0x94, 0x3b, 0x24, 0x4f, 0x34, 0xdf, 0x2c,
+	0x2d, 0x2f, 0xd7, 0x94, 0x02, 0xea, 0x4f, 0xe1, 0x92, 0x8c, 0xe4, 0x95, 0x2e, 0x70, 0x0b, 0x73,
+	0x85, 0x30, 0xcf, 0xfd, 0x0b, 0x78, 0x1d, 0xf0, 0x3c, 0xf5, 0xfc, 0x20, 0x1a, 0xb8, 0xa3, 0x90,
+	0x64, 0xaf, 0xf4, 0x62, 0xee, 0x3f, 0x35, 0x61, 0x5e, 0x25, 0xfe, 0x4a, 0xa8, 0x5a, 0xaf, 0xc3,
+	0x12, 0xdf, 0xca, 0x83, 0xfe, 0x59, 0x2f, 0x0b, 0x3e, 0x15, 0x6a, 0xc9, 0x02, 0xdb, 0xcd, 0x83,
+	0xfe, 0xd9, 0x51, 0xf0, 0x29, 0xea, 0xd1, 0xc3, 0x20, 0xea, 0xa9, 0x1a, 0x76, 0x7b, 0x18, 0x44,
+	0x87, 0x42, 0xc9, 0x1e, 0x7a, 0x2f, 0x7a, 0xea, 0x51, 0xa4, 0x3d, 0xf4, 0x5e, 0xb0, 0xcc, 0x37,
+	0x61, 0x99, 0x2d, 0x31, 0xbd, 0x2c, 0x27, 0x09, 0x23, 0xc1, 0x56, 0xa4, 0x45, 0x06, 0x3f, 0xca,
+	0x49, 0x82, 0x34, 0x76, 0x01, 0x28, 0x0d, 0x6d, 0x79, 0xa2, 0x54, 0xf7, 0x11, 0x80, 0xd9, 0xde,
+	0x0b, 0x91, 0xdd, 0xe1, 0xd9, 0xde, 0x0b, 0x9e, 0x7d, 0x0b, 0xa8, 0xba, 0xd5, 0x8b, 0x62, 0x7a,
+	0x64, 0xf6, 0x42, 0x7e, 0x1e, 0x9e, 0x1b, 0x06, 0xd1, 0x77, 0x39, 0x88, 0xdf, 0xc6, 0x3c, 0x3a,
+	0x27, 0x8a, 0xfb, 0xd5, 0x1f, 0x37, 0x60, 0xe9, 0x20, 0x8e, 0xfc, 0x80, 0xe2, 0x1c, 0x7a, 0xa9,
+	0x37, 0xcc, 0xb8, 0x07, 0x20, 0x03, 0x89, 0xeb, 0x7a, 0x09, 0xa8, 0xb9, 0x18, 0xdd, 0x05, 0xe8,
+	0x9f, 0x92, 0xfe, 0x59, 0x8f, 0xdf, 0x54, 0x32, 0xb7, 0x41, 0x0a, 0x79, 0x40, 0xef, 0x25, 0xdf,
+	0x86, 0xd5, 0x22, 0xbb, 0xe7, 0x45, 0x7e, 0x8f, 0x5f, 0x53, 0xa2, 0x57, 0x84, 0xc4, 0xdb, 0x8f,
+	0xfc, 0x7d, 0x7a, 0x37, 0x79, 0x07, 0x96, 0xe5, 0xed, 0x5c, 0x4f, 0x3b, 0xfa, 0x2d, 0x49, 0x38,
+	0xeb, 0x37, 0xdd, 0xb0, 0x56, 0x94, 0x5e, 0xf1, 0x69, 0x57, 0x5c, 0xc8, 0xe1, 0x3d, 0xad, 0x26,
+	0x4c, 0xcd, 0x92, 0x30, 0x59, 0xd0, 0x0a, 0x72, 0x32, 0x14, 0x07, 0x52, 0xfa, 0xdb, 0x7a, 0x00,
+	0xcb, 0xb2, 0xc7, 0xbd, 0x04, 0xd9, 0xc2, 0x55, 0xa9, 0xcd, 0xc2, 0xe0, 0xac, 0x71, 0xcd, 0x5d,
+	0xea, 0x97, 0xd8, 0x28, 0x84, 0x74, 0x7a, 0x22, 0x65, 0xbe, 0x8f, 0xdc, 0xe6, 0x3a, 0x2c, 0x4b,
+	0xb1, 0x56, 0x93, 0xfe, 0x28, 0x27, 0x3e, 0x37, 0xb1, 0xc9, 0xb4, 0xf3, 0x5f, 0x1a, 0xb0, 0xb4,
+	0xef, 0xfb, 0xd8, 0xef, 0x49, 0x26, 0xaa, 0xe8, 0x65, 0xf3, 0x8a, 0x5e, 0x4e, 0x7d, 0xc6, 0x5e,
+	0x7e, 0x6e, 0x45, 0xb3, 0x86, 0x09, 0x8e, 0x03, 0xcb, 0x45, 0x3f, 0xcd, 0xc3, 0xeb, 0x7c, 0x01,
+	0x2c, 0x66, 0x96, 0xd5, 0xd8, 0x51, 0xc6, 0x5a, 0x87, 0x55, 0x0d, 0x8b, 0xeb, 0xa3, 0xef, 0xc3,
+	0x9b, 0xf4, 0x42, 0x32, 0xbd, 0x4c, 0xf2, 0x58, 0x98, 0xc1, 0x1e, 0x92, 0x24, 0xce, 0x02, 0xa1,
+	0xdd, 0x4e, 0xb4, 0x14, 0x3a, 0xff, 0xaa, 0x01, 0x77, 0x26, 0xa8, 0x88, 0x77, 0xe1, 0x07, 0xd5,
+	0x7b, 0xa9, 0x3f, 0xad, 0xba, 0xc5, 0x4e, 0x54, 0xcb, 0x5d, 0x09, 0xe1, 0xde, 0x89, 0xb2, 0x4a,
+	0xfb, 0x1b, 0xb0, 0xa8, 0x67, 0x5e, 0x6b, 0xc7, 0x0d, 0xe1, 0xf5, 0x2b, 0x1a, 0x31, 0x89, 0xcc,
+	0xbd, 0x0e, 0x8b, 0x7d, 0xad, 0x0a, 0x4e, 0xa8, 0x04, 0x75, 0x0e, 0xe0, 0x8d, 0x2b, 0xa9, 0x71,
+	0xb6, 0xd5, 0x5a, 0xf6, 0x9d, 0x7f, 0xd4, 0x82, 0xcd, 0x8f, 0x83, 0xfc, 0xd4, 0x4f, 0xbd, 0x0b,
+	0x21, 0x7d, 0x93, 0x34, 0xb2, 0x64, 0xf4, 0x6f, 0x56, 0xef, 0x29, 0xde, 0x82, 0x95, 0x38, 0x22,
+	0x68, 0x9b, 0xec, 0x25, 0x5e, 0x96, 0x5d, 0xc4, 0xa9, 0x34, 0x2b, 0xc4, 0x11, 0xa1, 0xf6, 0xc9,
+	0x43, 0x0e, 0x2e, 0x9d, 0xd8, 0x5a, 0xe5, 0x13, 0xdb, 0x32, 0x4c, 0x25, 0x41, 0xc4, 0x7d, 0x2d,
+	0xe8, 0x4f, 0x7a, 0xbe, 0xca, 0x53, 0xcf, 0x57, 0x6a, 0xe6, 0xe7, 0x2b, 0x84, 0xca, 0x7a, 0xd5,
+	0xdb, 0xff, 0xd9, 0xd2, 0xed, 0xbf, 0xc2, 0x93, 0xb6, 0x7e, 0xdb, 0xb1, 0x07, 0x73, 0xfc, 0x67,
+	0x2f, 0xf7, 0x06, 0xdc, 0x74, 0x0a, 0x1c, 0xf4, 0xdc, 0x1b, 0x28, 0xea, 0x30, 0x68, 0x27, 0xfa,
+	0x5d, 0x80, 0x13, 0x42, 0x7a, 0x9a, 0x11, 0xb5, 0x73, 0x42, 0x08, 0xdf, 0x6c, 0xb6, 0xa1, 0x73,
+	0xec, 0x45, 0x67, 0xbd, 0xc8, 0xe3, 0x56, 0xd4, 0x8e, 0xdb, 0xa6, 0x00, 0xea, 0x73, 0x4a, 0x77,
+	0x22, 0xcc, 0x14, 0x6d, 0x5a, 0x60, 0x1c, 0xa5, 0xb0, 0xfd, 0xe2, 0x16, 0x06, 0x51, 0xfa, 0x41,
+	0x7e, 0xd9, 0x5d, 0x2c, 0xca, 0x1f, 0x04, 0xf9, 0xa5, 0x2c, 0x8f, 0x3c, 0x4b, 0x2f, 0xbb, 0x4b,
+	0x45, 0xf9, 0x03, 0x06, 0xa2, 0xcd, 0xcb, 0x2e, 0x82, 0x13, 0xc2, 0x1c, 0x4a, 0x97, 0x19, 0x97,
+	0x11, 0x42, 0xbd, 0x38, 0xa9, 0xa9, 0xe1, 0x22, 0x48, 0x15, 0xa3, 0xf6, 0x0a, 0x33, 0x7d, 0x53,
+	0xa0, 0x10, 0x0d, 0xe7, 0x2d, 0x58, 0x16, 0xe2, 0xa2, 0xbe, 0xb9, 0x48, 0xd1, 0x8c, 0x53, 0x28,
+	0x79, 0x34, 0xe5, 0x7c, 0x19, 0xbd, 0x29, 0x9f, 0xc6, 0x83, 0x41, 0x61, 0x76, 0x2d, 0xf4, 0xc2,
+	0x10, 0xe1, 0xa2, 0x08, 0x4b, 0x39, 0x11, 0x74, 0xab, 0x45, 0x0a, 0x6f, 0x87, 0x20, 0x3a, 0x89,
+	0xb9, 0xe6, 0x8a, 0xbf, 0xe9, 0x5c, 0xf4, 0xc9, 0xf1, 0x68, 0x20, 0x7c, 0xa7, 0x31, 0x41, 0x31,
+	0x2f, 0xbc, 0x34, 0xe2, 0x1b, 0x2a, 0xfe, 0xd6, 0x2d, 0x57, 0x6d, 0x61, 0xb9, 0x7a, 0x0c, 0x9b,
+	0x47, 0xd7, 0x6b, 0x22, 0xad, 0x88, 0xdd, 0xf2, 0xf0, 0xe9, 0x8f, 0x09, 0xe7, 0xdb, 0x9a, 0xe7,
+	0x28, 0x7a, 0x17, 0x4e, 0x32, 0x8d, 0xd6, 0x60, 0x1a, 0xd7, 0x72, 0x51, 0x19, 0x26, 0xa8, 0x25,
+	0xb9, 0x5b, 0xad, 0x4d, 0xfa, 0xae, 0x57, 0x3d, 0x31, 0xd9, 0x4a, 0xf8, 0x55, 0x83, 0x27, 0xa6,
+	0x56, 0x76, 0x32, 0x57, 0xcc, 0x9f, 0xa9, 0x77, 0xe5, 0xa7, 0xb0, 0xaa, 0x36, 0xed, 0x95, 0xde,
+	0x16, 0xfc, 0xb8, 0x81, 0x37, 0x6b, 0xd2, 0x4a, 0x77, 0x94, 0xa7, 0xc4, 0x1b, 0xbe, 0x52, 0x7d,
+	0xfd, 0x57, 0xe1, 0x96, 0xea, 0x67, 0x7d, 0xed, 0x96, 0x88, 0xf3, 0x06, 0xfa, 0x04, 0xfe, 0x1c,
+	0xda, 0xff, 0x0d, 0xb8, 0xa1, 0xb4, 0xff, 0x9a, 0xcd, 0x70, 0xfe, 0x6e, 0x03, 0x6f, 0x1f, 0xf7,
+	0x47, 0x7e, 0x90, 0x6b, 0x3a, 0x07, 0x5d, 0x99, 0x72, 0x2f, 0xcd, 0x7b, 0xbe, 0x97, 0x13, 0xf9,
+	0xf8, 0x83, 0x42, 0x1e, 0x7a, 0x39, 0xda, 0x13, 0x48, 0xe4, 0xb3, 0x4c, 0x6e, 0x2b, 0x21, 0x91,
+	0x2f, 0xb2, 0xd8, 0xb1, 0xfc, 0xf8, 0x52, 0x33, 0xe6, 0x3d, 0xc0, 0x7d, 0x1a, 0x9d, 0x65, 0x71,
+	0xc6, 0x4f, 0xbb, 0x2c, 0x41, 0xa7, 0x75, 0x7c, 0x72, 0x42, 0xa7, 0xdc, 0x34, 0x82, 0x79, 0xca,
+	0x39, 0x80, 0xf5, 0x52, 0xd3, 0xf8, 0x7c, 0x7b, 0x0b, 0x66, 0x08, 0x05, 0x54, 0xbc, 0xe2, 0x14,
+	0x5c, 0x8e, 0xe1, 0xfc, 0x73, 0x26, 0x61, 0x1f, 0x04, 0x59, 0x1e, 0xa7, 0x41, 0xff, 0xc0, 0x8b,
+	0xfc, 0x57, 0x7c, 0x22, 0xa4, 0xbd, 0x46, 0xc6, 0x09, 0x0b, 0x3d, 0x26, 0xe8, 0xd4, 0x25, 0x91,
+	0xcf, 0xd5, 0x47, 0xfa, 0x93, 0x36, 0x06, 0x8d, 0xfb, 0xe7, 0x5e, 0x28, 0xae, 0x38, 0x44, 0xda,
+	0xf9, 0x37, 0x0d, 0xb0, 0x4d, 0xdd, 0x98, 0xc0, 0xd1, 0x6d, 0xf2, 0x7e, 0xc8, 0x86, 0x4e, 0x19,
+	0x1a, 0xda, 0x32, 0x37, 0x74, 0x5a, 0x6f, 0xa8, 0xf5, 0x3a, 0xcc, 0xf4, 0xb1, 0x71, 0xfc, 0x0d,
+	0xdc, 0xa2, 0x72, 0xea, 0xf7, 0x43, 0xe2, 0xf2, 0x5c, 0xe7, 0x37, 0x1a, 0x30, 0xc3, 0x40, 0x74,
+	0x6f, 0x50, 0x9e, 0x07, 0xe2, 0x6f, 0xe1, 0x74, 0xdc, 0x2c, 0x9c, 0x8e, 0x85, 0x6b, 0xf2, 0x94,
+	0xe2, 0x9a, 0x6c, 0x41, 0x8b, 0xde, 0x79, 0x0a, 0x17, 0x66, 0xfa, 0x9b, 0x76, 0xa2, 0x1f, 0xc6,
+	0x99, 0xbc, 0x3f, 0xc2, 0x84, 0xe2, 0x8e, 0x3c, 0xa3, 0xba, 0x23, 0x3b, 0xff, 0x64, 0x0a, 0x16,
+	0x1f, 0x7a, 0xb9, 0xc7, 0x18, 0x7b, 0xf9, 0xad, 0xf8, 0xb8, 0xe2, 0x03, 0x39, 0xee, 0xc8, 0xf5,
+	0xd2, 0xec, 0x82, 0xe3, 0x58, 0xaa, 0x4f, 0xc5, 0x99, 0x71, 0x53, 0x71, 0x56, 0x9f, 0x8a, 0xc5,
+	0x9d, 0x42, 0x5b, 0xbb, 0x6c, 0xbe, 0x03, 0xcb, 0x6c, 0x18, 0xb2, 0x1e, 0x79, 0x91, 0xb0, 0x17,
+	0x72, 0x1d, 0x54, 0xe5, 0x96, 0x38, 0xfc, 0x11, 0x07, 0x53, 0xb5, 0x4e, 0xa0, 0x52, 0x0e, 0x11,
+	0x1f, 0x15, 0xac, 0x29, 0x77, 0x81, 0x43, 0x8f, 0x10, 0x48, 0xd1, 0x86, 0x41, 0x86, 0xaf, 0x28,
+	0x52, 0xf6, 0xa6, 0x66, 0x8e, 0xa1, 0x71, 0xa8, 0x8b, 0x40, 0xda, 0xcd, 0x24, 0x8d, 0x07, 0xa8,
+	0x4e, 0xcd, 0x0b, 0xe7, 0x6f, 0x96, 0xa6, 0xfd, 0x40, 0x3f, 0xde, 0x74, 0x14, 0x71, 0x55, 0x6b,
+	0x96, 0xa6, 0xdd, 0x91, 0xa2, 0x29, 0x2c, 0xaa, 0x77, 0x5c, 0xff, 0xb6, 0x01, 0xdd, 0x7d, 0xdf,
+	0xd7, 0x87, 0xef, 0x95, 0xce, 0x6c, 0x75, 0xd4, 0x5a, 0x63, 0x47, 0x6d, 0x7a, 0xdc, 0xa8, 0xcd,
+	0x68, 0xa3, 0xe6, 0xbc, 0x85, 0xaa, 0x86, 0xb9, 0x5b, 0x25, 0xe1, 0x74, 0xb6, 0x61, 0xab, 0x82,
+	0x2b, 0x6d, 0x22, 0x1f, 0x80, 0x6d, 0xca, 0x94, 0xab, 0x68, 0xeb, 0x87, 0xf1, 0xb1, 0x58, 0x43,
+	0xa5, 0xa2, 0x50, 0xa2, 0x8b, 0x38, 0xce, 0xdb, 0xb0, 0xcd, 0x4e, 0x9c, 0x93, 0xb5, 0xea, 0xff,
+	0x30, 0x6d, 0x49, 0x6e, 0xa6, 0x0f, 0x49, 0x92, 0x9f, 0xbe, 0xd2, 0x91, 0x31, 0x5c, 0x91, 0xe0,
+	0xf1, 0x62, 0xc8, 0x1c, 0x7e, 0xa9, 0xeb, 0x5c, 0xc3, 0x15, 0x49, 0xaa, 0x67, 0x33, 0xef, 0x11,
+	0x91, 0x3f, 0xc3, 0x5e, 0x00, 0x21, 0x70, 0xbf, 0x40, 0xf2, 0x69, 0x3f, 0x7a, 0xfc, 0xf2, 0x8e,
+	0x5b, 0xbf, 0xe6, 0x11, 0x78, 0xc8, 0x60, 0xce, 0x3f, 0x6b, 0x2a, 0x7e, 0xf9, 0x1f, 0x7d, 0xbc,
+	0x7f, 0x58, 0xeb, 0x97, 0x6f, 0x41, 0xeb, 0xfc, 0xc2, 0x4b, 0xf8, 0x12, 0x87, 0xbf, 0xe9, 0x31,
+	0x07, 0x5d, 0x5d, 0xb4, 0x1b, 0x51, 0xa0, 0xa0, 0xc2, 0x38, 0xa6, 0x36, 0x54, 0xf8, 0xf0, 0x28,
+	0xed, 0xa4, 0x8c, 0x39, 0x46, 0x0f, 0x2a, 0xc5, 0xc4, 0xd7, 0xa1, 0x10, 0x66, 0xc6, 0xdb, 0x83,
+	0xb9, 0x8b, 0x38, 0x95, 0xf9, 0x6c, 0x35, 0x04, 0x04, 0x31, 0x04, 0x79, 0x29, 0x18, 0x0c, 0x13,
+	0xaf, 0x2f, 0x7a, 0xc9, 0x2e, 0x05, 0x9f, 0x20, 0x88, 0x99, 0xe8, 0xfc, 0x5e, 0x16, 0x06, 0x49,
+	0x42, 0x9d, 0x57, 0xdb, 0xc2, 0x44, 0xe7, 0x1f, 0x71, 0x10, 0xaa, 0xea, 0x54, 0x0b, 0xcf, 0xf8,
+	0xba, 0xc2, 0x53, 0xb4, 0xe8, 0xc9, 0x28, 0x0c, 0x2f, 0x7b, 0x27, 0x41, 0x18, 0xf2, 0xc5, 0xa4,
+	0xed, 0xce, 0x21, 0xec, 0x7d, 0x04, 0x39, 0xff, 0x72, 0x0a, 0xb6, 0x0c, 0xc2, 0x53, 0x5c, 0x64,
+	0x60, 0xf7, 0x8e, 0xb9, 0xc0, 0x51, 0x67, 0x3b, 0x92, 0xe5, 0x0f, 0x02, 0x5f, 0x66, 0xd1, 0x97,
+	0x28, 0xcd, 0x22, 0x6b, 0x3f, 0x3b, 0x63, 0x66, 0x4f, 0x5f, 0xbb, 0xd4, 0x6d, 0x0f, 0x03, 0xff,
+	0x50, 0x5c, 0xa5, 0x64, 0x49, 0x4a, 0x3c, 0x5f, 0x78, 0x22, 0xb0, 0x94, 0x75, 0x17, 0x56, 0xd9,
+	0xaf, 0xde, 0xb1, 0x97, 0x05, 0x59, 0x8f, 0xbf, 0xb7, 0x64, 0x2c, 0x5d, 0x61, 0x59, 0x0f, 0x68,
+	0xce, 0x61, 0x1c, 0x18, 0x05, 0x64, 0xa6, 0x2a, 0x20, 0x38, 0x3c, 0x81, 0x2f, 0xc6, 0x6f, 0x96,
+	0x0f, 0x4f, 0xe0, 0x2b, 0x07, 0xd2, 0xc0, 0xe7, 0xee, 0xef, 0x8c, 0xaf, 0xed, 0xe3, 0xc0, 0x67,
+	0xce, 0xef, 0x28, 0xf3, 0x67, 0x25, 0xcb, 0xa9, 0x97, 0x9d, 0x15, 0x65, 0x69, 0x36, 0x2b, 0xcb,
+	0x9f, 0xd6, 0x78, 0xd9, 0x19, 0x2b, 0xbb, 0x03, 0x9d, 0x60, 0x28, 0xbc, 0x14, 0xf9, 0x39, 0x58,
+	0x02, 0xac, 0x2f, 0x43, 0x5b, 0x8e, 0xe6, 0x7c, 0xcd, 0x0d, 0x3a, 0x95, 0x66, 0x57, 0xa2, 0x55,
+	0x9e, 0x5d, 0xf0, 0xd3, 0xb1, 0xf2, 0xec, 0x82, 0x5e, 0x29, 0xdc, 0x66, 0x2e, 0xf2, 0x59, 0x1c,
+	0x06, 0x08, 0xab, 0xd1, 0xaf, 0x27, 0xf7, 0x34, 0xb9, 0xe2, 0xe8, 0xa1, 0x3d, 0xeb, 0xa4, 0x2e,
+	0xbc, 0xda, 0xb3, 0xce, 0xdf, 0x6a, 0xc0, 0x96, 0xb1, 0x35, 0xf8, 0x82, 0x66, 0xdc, 0xc2, 0x54,
+	0xe7, 0x31, 0x20, 0x8d, 0xc8, 0x53, 0xaa, 0x11, 0xf9, 0x36, 0x2c, 0xc6, 0x69, 0x30, 0x08, 0xa8,
+	0xff, 0x86, 0xea, 0x23, 0xb0, 0x20, 0xa0, 0x28, 0x78, 0xce, 0xdf, 0x6b, 0xc0, 0xb6, 0x99, 0x39,
+	0xf1, 0x28, 0xed, 0x93, 0x97, 0xe7, 0xa7, 0x64, 0xf2, 0x05, 0xd4, 0x06, 0xaf, 0x55, 0x1d, 0xbc,
+	0x3f, 0x6a, 0xc2, 0xae, 0xb1, 0x71, 0x9f, 0xe1, 0x75, 0xd0, 0x15, 0x83, 0xf6, 0x55, 0xed, 0x5d,
+	0xd0, 0x2d, 0xc5, 0x64, 0x6b, 0x1e, 0x29, 0xfe, 0x46, 0xe8, 0xab, 0xda, 0x1b, 0xa1, 0x49, 0x8a,
+	0x51, 0x74, 0xeb, 0x57, 0x60, 0x36, 0x43, 0xfe, 0x66, 0xdc, 0x83, 0xfa, 0xb5, 0xb1, 0x25, 0xd9,
+	0x58, 0xb8, 0xa2, 0x4c, 0x85, 0x75, 0x33, 0x55, 0xd6, 0x9d, 0x61, 0x68, 0x81, 0xfd, 0xf4, 0x38,
+	0xc8, 0x53, 0x6f, 0x40, 0x9e, 0xe1, 0xa1, 0x7b, 0x14, 0x05, 0x79, 0x50, 0x9c, 0x3c, 0x74, 0x96,
+	0x34, 0xea, 0x8e, 0xd0, 0x57, 0x0e, 0xaf, 0xf3, 0xbb, 0x2d, 0x58, 0x33, 0x90, 0xba, 0x7c, 0x79,
+	0xc3, 0x43, 0xed, 0x58, 0xa3, 0x4b, 0x19, 0x73, 0x43, 0xdc, 0x38, 0x1e, 0x8f, 0x2e, 0xc5, 0xa9,
+	0x13, 0x97, 0xad, 0xd1, 0xa5, 0x26, 0xeb, 0xed, 0xe3, 0xd1, 0x25, 0x5b, 0x5f, 0x37, 0x61, 0x96,
+	0x66, 0x9e, 0x10, 0xb1, 0x1d, 0xcd, 0x1c, 0x8f, 0x2e, 0xdf, 0x27, 0x68, 0xde, 0xca, 0x48, 0x18,
+	0x16, 0x35, 0x33, 0x5e, 0xce, 0x53, 0xe0, 0x23, 0xc5, 0xd6, 0x80, 0x48, 0xea, 0x1d, 0x78, 0x87,
+	0x42, 0x58, 0xe5, 0x5b, 0xd0, 0xc6, 0xec, 0x13, 0x22, 0xd6, 0xcb, 0x59, 0x9a, 0x7e, 0x9f, 0xa8,
+	0x73, 0xb6, 0xa3, 0xcd, 0xd9, 0x5b, 0x30, 0x3f, 0x48, 0xe3, 0x2c, 0xeb, 0xf1, 0x55, 0x9f, 0xdf,
+	0x30, 0x21, 0xec, 0x08, 0x41, 0xd6, 0xd7, 0x61, 0x4b, 0x45, 0xd1, 0x37, 0x00, 0xb6, 0x7a, 0x6e,
+	0x28, 0xf8, 0xea, 0x2e, 0xb0, 0x0b, 0x10, 0x91, 0x5c, 0xd4, 0xcd, 0x94, 0xdc, 0x4e, 0x44, 0x72,
+	0x5e, 0xf3, 0x57, 0x61, 0xb3, 0xc8, 0xd6, 0xeb, 0x5d, 0x40, 0xdc, 0x35, 0x89, 0x6b, 0xa8, 0x35,
+	0x49, 0xe3, 0x93, 0x20, 0xef, 0x2e, 0xca, 0x5a, 0x0f, 0x11, 0x40, 0x55, 0x1b, 0x21, 0x8f, 0xcc,
+	0xca, 0x28, 0x92, 0xce, 0xbf, 0x68, 0xa0, 0x7d, 0xa3, 0x4e, 0x18, 0xf9, 0x54, 0xa6, 0x8f, 0xb6,
+	0x43, 0x92, 0xe6, 0xbd, 0xfc, 0x34, 0x25, 0x19, 0xbe, 0xb0, 0x6a, 0xf0, 0xbb, 0x3d, 0x0a, 0x7e,
+	0x2e, 0xa0, 0xd6, 0x03, 0x58, 0x88, 0xd5, 0x1a, 0xba, 0x4d, 0xdd, 0x15, 0xd8, 0x24, 0x89, 0xae,
+	0x5e, 0xc4, 0x7a, 0x17, 0x66, 0xb0, 0x56, 0x31, 0xe1, 0xc7, 0x17, 0xe6, 0xb8, 0xce, 0x37, 0xd0,
+	0x9c, 0xf7, 0x30, 0xc8, 0x12, 0x8f, 0x5f, 0xf9, 0xca, 0xb9, 0x84, 0x53, 0x72, 0x30, 0xa0, 0x87,
+	0x8f, 0x38, 0x0a, 0x2f, 0x85, 0xb3, 0x1d, 0x87, 0x3d, 0x8b, 0xc2, 0x4b, 0xe7, 0x7f, 0x35, 0x60,
+	0x45, 0x94, 0x3d, 0x0c, 0x12, 0x82, 0xe5, 0x2b, 0x47, 0x3d, 0xe1, 0xc5, 0xde, 0x54, 0xbc, 0xd8,
+	0x37, 0x60, 0x26, 0x89, 0xc3, 0x40, 0xfa, 0x1d, 0xf3, 0x14, 0xea, 0x6a, 0xa3, 0x93, 0x13, 0x92,
+	0x16, 0xb7, 0xad, 0x53, 0x2e, 0x30, 0x10, 0x5e, 0x83, 0xee, 0x40, 0x27, 0x19, 0x1d, 0x87, 0x41,
+	0x76, 0xca, 0x1f, 0x25, 0xb6, 0xdc, 0x02, 0x40, 0x47, 0xcc, 0x4f, 0xe3, 0x24, 0xe1, 0x2b, 0x48,
+	0xcb, 0x15, 0x49, 0x3c, 0xfa, 0x7a, 0x03, 0x94, 0xf4, 0x29, 0x97, 0xfe, 0xa4, 0x13, 0x88, 0xde,
+	0x98, 0x52, 0x28, 0x73, 0x29, 0x9e, 0x19, 0x7a, 0x2f, 0x9e, 0x7a, 0x03, 0xfa, 0x86, 0x88, 0x86,
+	0xb9, 0xe8, 0xa7, 0xc1, 0x31, 0x3f, 0xc1, 0x75, 0x5c, 0x05, 0x82, 0x27, 0xa4, 0x2a, 0xd3, 0x8a,
+	0x1b, 0x88, 0x74, 0x14, 0x45, 0x41, 0x34, 0x10, 0x31, 0x13, 0x78, 0x92, 0xe6, 0x5c, 0xc4, 0x29,
+	0x7f, 0x4a, 0x4b, 0x2d, 0x32, 0x22, 0x49, 0x19, 0x84, 0x67, 0x06, 0xf6, 0x70, 0x14, 0x7f, 0x53,
+	0xd1, 0xa4, 0xff, 0x7b, 0x85, 0x65, 0x67, 0xca, 0xed, 0x50, 0xc8, 0x53, 0x0a, 0xa0, 0x83, 0xc3,
+	0x7b, 0xd6, 0xc3, 0xa2, 0x8c, 0x13, 0x73, 0x1c, 0x46, 0x4f, 0x24, 0xf4, 0xe9, 0x50, 0x12, 0x24,
+	0x44, 0x84, 0xcd, 0x91, 0x4f, 0x87, 0x2a, 0x03, 0xe6, 0x32, 0x3c, 0xe7, 0xcf, 0xc0, 0x6e, 0xe1,
+	0x21, 0xdc, 0x8f, 0xa3, 0x7e, 0x10, 0x06, 0x3c, 0xbe, 0xc9, 0xd5, 0x67, 0x8c, 0x1d, 0xe8, 0xa4,
+	0xbc, 0x90, 0x08, 0xf0, 0x51, 0x00, 0x9c, 0x9f, 0x36, 0x61, 0xcb, 0x50, 0xf1, 0x01, 0x2b, 0x7b,
+	0x1d, 0xbf, 0xf5, 0x3d, 0x98, 0x93, 0x4e, 0x9c, 0xd2, 0xaf, 0x0d, 0x04, 0xe8, 0x5a, 0xae, 0x6d,
+	0x16, 0xb4, 0xce, 0x02, 0x69, 0x2c, 0xc2, 0xdf, 0x74, 0xca, 0x26, 0x29, 0x39, 0x0f, 0xe2, 0x51,
+	0xd6, 0xd3, 0x1c, 0x07, 0x17, 0x05, 0xb8, 0x70, 0x30, 0xe1, 0xf9, 0xb3, 0x9a, 0x11, 0xe0, 0x6b,
+	0xd0, 0x95, 0x15, 0x88, 0xdb, 0x58, 0xfd, 0xd2, 0x7e, 0x43, 0xe4, 0x3f, 0xe2, 0xd9, 0x5c, 0xd1,
+	0x7c, 0x03, 0x96, 0xca, 0x05, 0xd8, 0x0a, 0xbb, 0x48, 0x74, 0xc4, 0xba, 0x5b, 0x99, 0xc2, 0x07,
+	0x65, 0x4e, 0xf3, 0x41, 0xf9, 0x23, 0x33, 0xf3, 0x5d, 0x42, 0x97, 0x83, 0xb1, 0xcc, 0x57, 0xc2,
+	0xad, 0x70, 0x73, 0x24, 0x4f, 0xd2, 0x52, 0x27, 0x41, 0xc4, 0x66, 0x21, 0x1b, 0x03, 0x99, 0xa6,
+	0x17, 0x62, 0x61, 0xdc, 0xa7, 0x4e, 0xb6, 0xd4, 0x99, 0x9e, 0x7b, 0xab, 0x30, 0x09, 0x5e, 0xc2,
+	0x8c, 0x67, 0x09, 0x89, 0xb8, 0x27, 0xd2, 0x7d, 0x58, 0x13, 0xd4, 0x34, 0x74, 0x76, 0x05, 0x66,
+	0x89, 0x3c, 0xa5, 0x44, 0x97, 0x4e, 0xdb, 0xbc, 0x7f, 0xca, 0xa7, 0xf8, 0x94, 0x2b, 0x92, 0xd6,
+	0x7b, 0x30, 0x2b, 0x74, 0xd4, 0x59, 0x5d, 0x79, 0xa9, 0x15, 0x3d, 0x57, 0x94, 0x28, 0x8c, 0x21,
+	0x6d, 0xd5, 0x18, 0xf2, 0xeb, 0x68, 0x06, 0x36, 0x32, 0x8f, 0xcf, 0xf7, 0xf7, 0x60, 0x36, 0x45,
+	0x46, 0x8a, 0x23, 0xff, 0x38, 0xa2, 0x8c, 0xe5, 0xae, 0x28, 0xe1, 0xfc, 0x87, 0x16, 0x2c, 0xcb,
+	0x2b, 0x74, 0xee, 0x7c, 0xfc, 0xf3, 0xb1, 0x94, 0x99, 0x3c, 0xe8, 0x34, 0x47, 0x8f, 0x99, 0xb2,
+	0xa3, 0xc7, 0xd5, 0xcf, 0x36, 0xf2, 0x34, 0xa0, 0x17, 0x49, 0x5c, 0xc7, 0x60, 0xc2, 0x3f, 0xcf,
+	0x81, 0x4c, 0xcd, 0x78, 0x03, 0x96, 0xf2, 0xd4, 0x0b, 0xf0, 0x4d, 0x92, 0x2e, 0xf2, 0x02, 0xcc,
+	0x45, 0xfe, 0x0e, 0x2c, 0x4b, 0x44, 0x71, 0x0e, 0x64, 0xc2, 0x2f, 0x2b, 0x10, 0x47, 0xc1, 0x3d,
+	0x98, 0xc3, 0x35, 0x93, 0x93, 0x65, 0x6a, 0x05, 0x20, 0xe8, 0xb0, 0xe4, 0xe3, 0x37, 0xaf, 0x4d,
+	0x9f, 0x1d, 0xe8, 0x5c, 0x78, 0x39, 0x49, 0x87, 0x5e, 0x7a, 0xc6, 0xb5, 0x86, 0x02, 0xa0, 0xcc,
+	0xf7, 0x45, 0x6d, 0xbe, 0xab, 0x2e, 0x80, 0x4b, 0x13, 0xf8, 0x9b, 0x2f, 0x5f, 0xe1, 0x6f, 0xbe,
+	0xa2, 0x88, 0x1f, 0xe5, 0x31, 0x3e, 0x73, 0x61, 0x3e, 0x69, 0x16, 0x1f, 0x02, 0x06, 0xd9, 0x47,
+	0x6d, 0x97, 0x2b, 0x24, 0x34, 0x7b, 0x95, 0x65, 0x73, 0xc8, 0x7e, 0xee, 0xfc, 0xc6, 0x14, 0x3e,
+	0xdc, 0x2b, 0x0b, 0xd8, 0xcf, 0xdd, 0x62, 0xa4, 0xc9, 0xd5, 0xf4, 0x78, 0xb9, 0x9a, 0xb9, 0x52,
+	0xae, 0x66, 0x27, 0x93, 0xab, 0xf6, 0xc4, 0x72, 0xd5, 0x99, 0x48, 0xae, 0x60, 0x8c, 0x5c, 0x69,
+	0xaf, 0x8a, 0x9c, 0x7b, 0xb0, 0xcb, 0x7c, 0xf3, 0xea, 0x06, 0xa2, 0x6c, 0xe7, 0xfb, 0x1e, 0xbe,
+	0xde, 0x2d, 0x63, 0x4f, 0x74, 0xbb, 0x52, 0x48, 0x69, 0x53, 0x73, 0x77, 0x3f, 0x84, 0x1d, 0x73,
+	0x95, 0x57, 0x3d, 0x60, 0xa8, 0xb4, 0x99, 0xe3, 0x39, 0xff, 0x57, 0x98, 0xe4, 0x1e, 0xa7, 0xf1,
+	0x28, 0x79, 0x4a, 0xf0, 0x32, 0x3a, 0x8d, 0x43, 0x79, 0xe1, 0x40, 0x7f, 0x7f, 0x96, 0x17, 0x2c,
+	0xf2, 0xec, 0xdf, 0x52, 0xcf, 0xfe, 0x95, 0x61, 0x9f, 0x36, 0x0c, 0x7b, 0x31, 0x02, 0x33, 0xda,
+	0xcc, 0x36, 0xec, 0xac, 0xb3, 0x75, 0x3b, 0xab, 0xd1, 0xb2, 0xaf, 0x4e, 0xf2, 0xce, 0x04, 0x93,
+	0x1c, 0xcc, 0x93, 0xfc, 0x3e, 0x7d, 0x72, 0x2c, 0xf9, 0x59, 0xa0, 0xb3, 0xed, 0xda, 0xea, 0x97,
+	0x78, 0xad, 0x2e, 0x0b, 0xf3, 0xea, 0xae, 0xf4, 0xfb, 0x4d, 0x80, 0x82, 0xf7, 0x26, 0x7d, 0x5b,
+	0x39, 0x72, 0xe2, 0x6f, 0x4d, 0x68, 0xa6, 0x6a, 0x26, 0xfb, 0xcb, 0xf0, 0xd1, 0x32, 0x3d, 0xb6,
+	0x50, 0x14, 0x93, 0x59, 0xcd, 0xe9, 0xf6, 0x0e, 0xb4, 0x42, 0x32, 0xa0, 0xcc, 0xae, 0xda, 0xc6,
+	0x84, 0x58, 0xb9, 0x88, 0x52, 0x5a, 0x09, 0x3b, 0xe3, 0x57, 0x42, 0x28, 0xaf, 0x84, 0xbf, 0xdb,
+	0x80, 0x35, 0xbd, 0x56, 0x3e, 0x97, 0x84, 0x7c, 0x36, 0x6a, 0xe5, 0xb3, 0x59, 0x2b, 0x9f, 0x53,
+	0x63, 0xe5, 0xb3, 0x35, 0x56, 0x3e, 0xb5, 0x87, 0x6a, 0xce, 0x1f, 0x34, 0x60, 0x73, 0xdf, 0xf7,
+	0x9f, 0x1d, 0x3c, 0x2b, 0x1a, 0xf9, 0x4a, 0x57, 0xe9, 0xfb, 0x7c, 0x20, 0x5a, 0xfa, 0xc9, 0xd1,
+	0xc4, 0x32, 0x36, 0x1e, 0xce, 0x1f, 0x34, 0xf1, 0x0d, 0xf6, 0x83, 0xd4, 0xeb, 0x9f, 0x91, 0xbc,
+	0x40, 0x7c, 0xa5, 0xcd, 0x7e, 0x07, 0xa6, 0x09, 0xfa, 0x00, 0xb5, 0xf4, 0xe0, 0x8c, 0xc6, 0x76,
+	0x33, 0x54, 0xfa, 0xe6, 0x36, 0xf7, 0xce, 0x88, 0x38, 0xf3, 0x4f, 0x4f, 0x50, 0x12, 0x68, 0x01,
+	0x6e, 0x12, 0xf8, 0x3a, 0x74, 0xb2, 0x3c, 0x4e, 0xd8, 0xb3, 0xa3, 0x99, 0x09, 0x0a, 0xb7, 0x29,
+	0x3a, 0x7d, 0x8a, 0xe4, 0xdc, 0x11, 0xae, 0xfd, 0x55, 0x6e, 0x95, 0x77, 0x80, 0x83, 0x22, 0x7a,
+	0x0d, 0xe2, 0x4d, 0xb4, 0xf6, 0x97, 0x4e, 0x4a, 0xce, 0x43, 0xd8, 0x28, 0x57, 0x52, 0xdc, 0xf4,
+	0x0f, 0x10, 0x62, 0x8c, 0x7f, 0xc3, 0x5a, 0xc6, 0x31, 0x9c, 0xdf, 0x6f, 0xc1, 0xfc, 0x93, 0x3e,
+	0x39, 0x26, 0xe9, 0xe0, 0x17, 0x4c, 0x3d, 0x35, 0x3f, 0xef, 0x2c, 0xe6, 0xd8, 0xac, 0xb6, 0x07,
+	0xdc, 0x86, 0x45, 0x3f, 0xc8, 0x92, 0xd0, 0xbb, 0xd4, 0x35, 0x82, 0x05, 0x0e, 0xdd, 0x97, 0x6f,
+	0xd5, 0xa8, 0xe1, 0xa1, 0x77, 0xee, 0xa5, 0x01, 0x1a, 0xf5, 0x99, 0x36, 0x30, 0x4f, 0x81, 0x1f,
+	0x71, 0x98, 0x69, 0x3f, 0x81, 0x2b, 0xf6, 0x93, 0xb9, 0x9a, 0x05, 0x71, 0x5e, 0x5b, 0x10, 0xd5,
+	0x7d, 0x66, 0x61, 0x82, 0x7d, 0x66, 0xd1, 0xbc, 0xcf, 0xdc, 0x86, 0xc5, 0xf3, 0x20, 0x0b, 0x68,
+	0xa8, 0x40, 0xde, 0xbc, 0x25, 0xd6, 0x57, 0x0e, 0x55, 0x5a, 0x17, 0x06, 0x7d, 0x92, 0xa1, 0x52,
+	0x3a, 0xe5, 0xf2, 0x54, 0x69, 0xad, 0x5d, 0x19, 0xbf, 0xd6, 0x5a, 0xe5, 0xb5, 0xf6, 0x37, 0x9b,
+	0xb0, 0xb1, 0xef, 0xfb, 0xaa, 0xcc, 0xfc, 0xdc, 0x35, 0x4e, 0xf3, 0xeb, 0xd7, 0x3a, 0x75, 0xa1,
+	0x2a, 0x2a, 0xb3, 0x13, 0x89, 0x4a, 0xbb, 0x2a, 0x2a, 0xce, 0x17, 0x61, 0x8b, 0x4d, 0x7a, 0x13,
+	0x3f, 0xca, 0xd3, 0xfe, 0x11, 0x1a, 0xe3, 0x54, 0xcc, 0xcf, 0x34, 0xf1, 0x3f, 0x80, 0x6e, 0xb5,
+	0x9a, 0xab, 0xde, 0x8c, 0x6b, 0xed, 0x13, 0x4a, 0xde, 0x4f, 0x1b, 0xb0, 0xc8, 0xac, 0x14, 0x78,
+	0x62, 0x0e, 0x42, 0x7f, 0xdc, 0x4b, 0x3c, 0xa3, 0x88, 0x36, 0xcd, 0x22, 0x5a, 0xf7, 0x40, 0xd5,
+	0xac, 0xfd, 0x19, 0x26, 0xdc, 0xf4, 0x15, 0x13, 0x4e, 0xd7, 0x40, 0x58, 0xcc, 0xdd, 0x61, 0x90,
+	0x0b, 0x5f, 0xf9, 0x8e, 0x5b, 0x00, 0x6a, 0xce, 0xfe, 0xff, 0xb5, 0xa5, 0x74, 0xfe, 0x17, 0xef,
+	0x68, 0xee, 0x85, 0x83, 0x38, 0x0d, 0xf2, 0xd3, 0xa1, 0x8c, 0xbc, 0x26, 0x00, 0xb5, 0x6b, 0x60,
+	0xe9, 0x08, 0xd3, 0xae, 0x1c, 0x61, 0xde, 0x06, 0x2b, 0xf1, 0xd2, 0x3c, 0xe8, 0x07, 0x09, 0x8f,
+	0xcc, 0xec, 0xe5, 0x62, 0x09, 0x5c, 0xd1, 0x72, 0x5c, 0x2f, 0x47, 0xa5, 0xd2, 0x1f, 0xa5, 0x98,
+	0xe6, 0x3a, 0x97, 0x4c, 0xd3, 0x49, 0x84, 0xcb, 0x49, 0x4f, 0xba, 0x72, 0xb0, 0x25, 0x70, 0x01,
+	0xa1, 0x4f, 0x38, 0x50, 0x19, 0xb0, 0xf9, 0x9a, 0x15, 0x72, 0x41, 0x5b, 0x21, 0x0d, 0x92, 0xb0,
+	0x68, 0x94, 0x84, 0x77, 0xa0, 0xdd, 0xa7, 0x02, 0x9b, 0x92, 0xa8, 0xbb, 0xa4, 0xfb, 0x62, 0xe8,
+	0x12, 0xed, 0x4a, 0xbc, 0xc2, 0xb9, 0x04, 0x1d, 0xa7, 0x96, 0x15, 0xe7, 0x12, 0x0c, 0x32, 0xc1,
+	0x9d, 0x4b, 0x30, 0x73, 0x45, 0x3a, 0x97, 0x60, 0xd6, 0xe7, 0x3b, 0xa8, 0xff, 0xc7, 0x26, 0xba,
+	0xdc, 0xe8, 0xc2, 0xf6, 0x8b, 0x70, 0x4c, 0x2f, 0x64, 0x6c, 0xba, 0x5e, 0xc6, 0x66, 0xc6, 0xc9,
+	0xd8, 0xec, 0x84, 0x32, 0xd6, 0x9e, 0x44, 0xc6, 0x3a, 0x57, 0xca, 0x18, 0x18, 0x64, 0xcc, 0x79,
+	0x03, 0xd6, 0xcd, 0xac, 0x2d, 0xaf, 0xbf, 0x8f, 0xd1, 0x45, 0x42, 0xc7, 0xfd, 0x4c, 0x2b, 0xf0,
+	0x53, 0xb0, 0x4d, 0x15, 0xf1, 0x35, 0xf8, 0x6e, 0x69, 0x0d, 0xae, 0x0a, 0xa6, 0xbe, 0x0a, 0xbf,
+	0x00, 0x28, 0x5c, 0x30, 0xe5, 0xe9, 0xae, 0xa1, 0x9c, 0xee, 0x6e, 0x00, 0x04, 0x3e, 0x89, 0xf2,
+	0xe0, 0x24, 0x20, 0x22, 0x48, 0xa4, 0x02, 0x41, 0x9b, 0x29, 0xc9, 0x32, 0x4f, 0x1e, 0xfe, 0x44,
+	0x92, 0x0e, 0x32, 0x95, 0xe7, 0x2c, 0xf7, 0x86, 0x89, 0x58, 0x7a, 0x24, 0xc0, 0x39, 0x86, 0xce,
+	0xe3, 0x83, 0xe7, 0x47, 0xf8, 0xd4, 0x81, 0x12, 0xfe, 0xf0, 0xc3, 0x27, 0x0f, 0x05, 0x61, 0xfa,
+	0xdb, 0x78, 0xb5, 0x63, 0x51, 0xa1, 0xcc, 0x4f, 0x39, 0x25, 0xfc, 0x4d, 0xa7, 0x4e, 0x44, 0x5e,
+	0x30, 0x2f, 0x34, 0x46, 0x65, 0x96, 0xa6, 0xdd, 0x51, 0xe4, 0x3c, 0x84, 0x4d, 0x49, 0x83, 0x31,
+	0x40, 0x86, 0x48, 0xbe, 0x03, 0x33, 0xec, 0x99, 0x05, 0xbf, 0x6d, 0x5d, 0x91, 0x7e, 0xdf, 0xa2,
+	0x80, 0xcb, 0x11, 0x9c, 0x7d, 0x58, 0x93, 0xc0, 0xa3, 0x3c, 0x4e, 0x3e, 0x43, 0x15, 0x5b, 0xb0,
+	0xa9, 0x55, 0xb1, 0x1f, 0x86, 0xbc, 0x16, 0x0c, 0x42, 0x5d, 0x64, 0xa9, 0xcf, 0x22, 0xd5, 0x42,
+	0x4f, 0x83, 0x2c, 0x57, 0x0a, 0xfd, 0x5e, 0x43, 0x29, 0xf5, 0x61, 0x12, 0xc6, 0x9e, 0x2f, 0x5a,
+	0xb5, 0x07, 0x73, 0x8c, 0x68, 0x4f, 0x09, 0xef, 0x04, 0x0c, 0x84, 0x8f, 0x24, 0x0a, 0x04, 0x8c,
+	0x7b, 0xd8, 0x54, 0x11, 0xa8, 0xb3, 0x98, 0x8c, 0x88, 0x38, 0x55, 0x44, 0x44, 0xa4, 0x12, 0xea,
+	0xa5, 0xfd, 0xd3, 0xe0, 0x9c, 0xbb, 0x1e, 0xb4, 0x5d, 0x99, 0xa6, 0xe3, 0x1c, 0x9f, 0x93, 0xf4,
+	0x22, 0x0d, 0xb8, 0xdb, 0x5c, 0xdb, 0x2d, 0x00, 0xce, 0x63, 0xb0, 0x0b, 0x7e, 0x10, 0xcf, 0x17,
+	0xbf, 0xae, 0xcd, 0xc3, 0x07, 0xb0, 0x2e, 0x81, 0xdf, 0x1b, 0x91, 0xf4, 0xf2, 0x33, 0xd4, 0xf1,
+	0x2d, 0xe8, 0x4a, 0xe0, 0xfe, 0x28, 0x8f, 0x9f, 0x2a, 0x8c, 0xdb, 0xd0, 0xaa, 0xe9, 0x88, 0x32,
+	0x25, 0xbb, 0x57, 0x5b, 0xda, 0xbd, 0x7e, 0xa0, 0x8d, 0x29, 0x1b, 0xb8, 0xe2, 0x31, 0x87, 0xf2,
+	0xf4, 0xb6, 0xd8, 0x79, 0xbe, 0x08, 0xb3, 0xac, 0x52, 0x71, 0x0b, 0x6b, 0x68, 0xaa, 0xc0, 0x70,
+	0x62, 0xd8, 0x28, 0xf7, 0xf7, 0x8a, 0xea, 0x0b, 0x46, 0x34, 0xaf, 0x60, 0x84, 0x36, 0xc6, 0x1d,
+	0x1e, 0xf5, 0xf2, 0x7d, 0x85, 0x39, 0x3c, 0xa2, 0xfb, 0x95, 0x24, 0x45, 0x3d, 0xcd, 0xa2, 0x9e,
+	0x77, 0xfe, 0xdf, 0xf7, 0x60, 0xf1, 0x71, 0xcc, 0xde, 0x54, 0xd1, 0x77, 0xb5, 0x24, 0xb5, 0x9e,
+	0xc1, 0x2c, 0xff, 0xf6, 0x85, 0xb5, 0x51, 0xf9, 0x18, 0x06, 0xb2, 0xdf, 0xde, 0xac, 0xf9, 0x48,
+	0x86, 0xb3, 0xfa, 0x93, 0x3f, 0xf9, 0xcf, 0xbf, 0xd3, 0x5c, 0xb0, 0xe6, 0xee, 0x9d, 0x7f, 0xf9,
+	0xde, 0x80, 0xe4, 0xf8, 0x66, 0x65, 0x00, 0x0b, 0xda, 0xe7, 0x0a, 0xac, 0x1d, 0xed, 0x93, 0x03,
+	0xa5, 0xaf, 0x18, 0xd8, 0xbb, 0x63, 0x3f, 0x48, 0xe0, 0x6c, 0x21, 0x89, 0x55, 0x6b, 0x85, 0x93,
+	0x28, 0xbe, 0x44, 0x60, 0x7d, 0x02, 0x4b, 0x8f, 0x30, 0x06, 0x9a, 0xac, 0xd4, 0xda, 0x2b, 0x2a,
+	0x33, 0x7e, 0x85, 0xc1, 0xbe, 0x59, 0x8f, 0xc0, 0x09, 0x6e, 0x23, 0xc1, 0x75, 0x6b, 0x95, 0x12,
+	0x64, 0x31, 0xd6, 0x24, 0x4d, 0x2b, 0x83, 0x65, 0x1e, 0xd7, 0xfd, 0xa5, 0xd2, 0xdc, 0x41, 0x9a,
+	0x1b, 0xd6, 0x1a, 0xa5, 0xe9, 0x07, 0x99, 0x4e, 0x34, 0xc6, 0x10, 0x4e, 0xea, 0x77, 0x08, 0xac,
+	0x1b, 0xb5, 0x1f, 0x28, 0x60, 0x24, 0xf7, 0xae, 0xf8, 0x80, 0x81, 0xde, 0xcb, 0x01, 0xa1, 0xb8,
+	0xf2, 0x1b, 0x06, 0xd6, 0xef, 0xb0, 0x9b, 0x6e, 0xe3, 0x17, 0x33, 0xac, 0x37, 0xae, 0xfe, 0x4c,
+	0x07, 0x6b, 0xc3, 0x9b, 0x93, 0x7e, 0xcf, 0xc3, 0xf9, 0x02, 0x36, 0xe6, 0x86, 0xb5, 0xc3, 0x1b,
+	0xa3, 0x7d, 0xc3, 0x43, 0x7c, 0x25, 0xc4, 0xea, 0xc3, 0xbc, 0xfa, 0xf1, 0x01, 0x6b, 0xdb, 0xf0,
+	0x1c, 0x48, 0x12, 0xdf, 0x31, 0x67, 0x72, 0x82, 0x5d, 0x24, 0x68, 0x59, 0xcb, 0x9c, 0xa0, 0x74,
+	0x6a, 0xb3, 0x3e, 0x85, 0xa5, 0x52, 0xe0, 0x7e, 0xcb, 0x29, 0x0d, 0x9f, 0xe1, 0x23, 0x0c, 0xf6,
+	0x6b, 0x63, 0x71, 0x38, 0xd5, 0x1b, 0x48, 0xb5, 0xeb, 0xac, 0x2a, 0xa3, 0x2c, 0x28, 0xff, 0x72,
+	0xe3, 0x2d, 0x2b, 0xc3, 0x71, 0x56, 0x63, 0xcc, 0x4f, 0x44, 0x7b, 0xef, 0x8a, 0x00, 0xf5, 0x95,
+	0xb1, 0x16, 0x34, 0x71, 0xb6, 0x66, 0x60, 0x29, 0xe5, 0x9e, 0x3d, 0x3f, 0xc4, 0xb7, 0x72, 0x93,
+	0xd0, 0xdd, 0x35, 0x7f, 0x59, 0x81, 0x7f, 0xdc, 0xc1, 0xb1, 0x91, 0xea, 0x9a, 0x65, 0x95, 0xa8,
+	0xc6, 0x79, 0x62, 0x65, 0xb0, 0x5a, 0x25, 0xaa, 0x4b, 0xb5, 0xe1, 0xd3, 0x0f, 0xf6, 0x5e, 0x6d,
+	0xfe, 0x15, 0x3d, 0x8d, 0xf3, 0x24, 0xb3, 0x5e, 0xd0, 0x2f, 0x73, 0xfc, 0x6c, 0x46, 0x76, 0x17,
+	0xe9, 0x6e, 0x3a, 0x56, 0xb1, 0x66, 0xa8, 0x03, 0xfb, 0x31, 0x74, 0xe4, 0xa3, 0x26, 0xab, 0xab,
+	0x74, 0x42, 0x8b, 0xc2, 0x6f, 0xd7, 0xc4, 0x58, 0x17, 0xd2, 0xea, 0x2c, 0xf0, 0x5e, 0xb1, 0x88,
+	0xe9, 0xb4, 0xe2, 0x5f, 0x03, 0x90, 0xb5, 0x64, 0xd6, 0x56, 0xa5, 0x66, 0xc9, 0x39, 0xdb, 0x94,
+	0xc5, 0xab, 0xdf, 0xc0, 0xea, 0x97, 0xad, 0x45, 0xad, 0x7a, 0x31, 0xdf, 0xa4, 0xeb, 0x9e, 0x36,
+	0xdf, 0xca, 0x61, 0xda, 0xed, 0xfa, 0xf8, 0xdc, 0x62, 0x50, 0x1c, 0x31, 0xd9, 0xe4, 0x5b, 0x7d,
+	0xda, 0x03, 0xb6, 0x59, 0xc8, 0x42, 0xfa, 0x66, 0x51, 0x09, 0x22, 0x6e, 0xef, 0xd6, 0xe4, 0xd6,
+	0x6c, 0x16, 0x71, 0x51, 0xef, 0x19, 0x7e, 0x5e, 0x4b, 0x89, 0x6b, 0x6d, 0xa9, 0x75, 0x55, 0x83,
+	0x7c, 0xdb, 0x37, 0xea, 0xb2, 0x33, 0xb3, 0x7c, 0xf3, 0xe7, 0xbc, 0x38, 0xa9, 0x2e, 0xd9, 0x3b,
+	0xb0, 0xa2, 0x14, 0x73, 0xd0, 0xfd, 0xbc, 0x24, 0x6f, 0x22, 0x49, 0xdb, 0xea, 0x56, 0x49, 0x66,
+	0x48, 0xe0, 0x7e, 0x83, 0xcb, 0x1a, 0x0b, 0xa4, 0xad, 0xc9, 0x9a, 0x16, 0x6f, 0xdb, 0xde, 0x32,
+	0xe4, 0x70, 0x2a, 0xeb, 0x48, 0x65, 0xc9, 0x5a, 0x90, 0xab, 0x31, 0xd6, 0xc5, 0xc4, 0x41, 0x46,
+	0x38, 0xd5, 0xc4, 0xa1, 0x1c, 0x06, 0xdb, 0xde, 0x31, 0x67, 0xd6, 0x2c, 0xbf, 0x32, 0xdc, 0xb5,
+	0xf5, 0x97, 0xf4, 0xa8, 0xda, 0x22, 0xca, 0xaf, 0x33, 0x36, 0x2c, 0x6f, 0x65, 0xa2, 0xd6, 0x86,
+	0xee, 0x75, 0xf6, 0x90, 0xf2, 0x96, 0xb5, 0x59, 0xa6, 0xcc, 0xc3, 0x00, 0x5b, 0x3f, 0x69, 0xc0,
+	0xaa, 0x21, 0xc8, 0x6c, 0xd1, 0x82, 0xfa, 0x90, 0xb8, 0xf6, 0x6b, 0x63, 0x71, 0x78, 0x0b, 0x1c,
+	0x6c, 0xc1, 0x8e, 0x83, 0x2d, 0xf0, 0x7c, 0x5f, 0xb6, 0x80, 0x3f, 0x8c, 0xa6, 0x93, 0xe2, 0xb7,
+	0x1b, 0xb0, 0xc1, 0x5e, 0x88, 0x54, 0xda, 0x71, 0x5b, 0xd0, 0x18, 0x1b, 0xea, 0xd6, 0x7e, 0xfd,
+	0x2a, 0x34, 0xde, 0x9a, 0xdb, 0xd8, 0x9a, 0x3d, 0xc7, 0xa6, 0xad, 0x49, 0x11, 0xd7, 0xd4, 0xa0,
+	0x0b, 0x8c, 0xa6, 0xa1, 0x87, 0x6c, 0xb5, 0x14, 0xb5, 0xc6, 0x1c, 0xd9, 0xd6, 0xbe, 0x35, 0x06,
+	0x43, 0x5f, 0x39, 0xad, 0x75, 0x3e, 0x20, 0x18, 0xe7, 0x54, 0xc6, 0x7e, 0xe5, 0xcb, 0x43, 0x11,
+	0x12, 0x55, 0x5b, 0x1e, 0x2a, 0x51, 0x5e, 0xed, 0xdd, 0x9a, 0xdc, 0x9a, 0xe5, 0x01, 0x89, 0x61,
+	0x10, 0x56, 0xeb, 0xfb, 0xd0, 0x11, 0x4b, 0x4a, 0xa6, 0x4d, 0x1b, 0xcd, 0x1a, 0x60, 0x6f, 0x19,
+	0x72, 0x6a, 0x56, 0x69, 0x76, 0x8a, 0xa7, 0xdc, 0x73, 0xa1, 0x2d, 0xd0, 0xad, 0xcd, 0x72, 0x05,
+	0xa2, 0x66, 0x63, 0x14, 0x4f, 0x67, 0x13, 0x2b, 0x5d, 0x71, 0xe6, 0xd5, 0x4a, 0x69, 0x9d, 0xc7,
+	0x30, 0xa7, 0x84, 0x24, 0xb4, 0xe4, 0xfa, 0x5e, 0x0d, 0xd0, 0x69, 0x6f, 0x1b, 0xf3, 0xf4, 0x55,
+	0xcc, 0x59, 0xa2, 0x04, 0x98, 0x69, 0x54, 0xd2, 0x38, 0x81, 0x79, 0xa5, 0x88, 0xa2, 0x70, 0x19,
+	0x02, 0x40, 0xda, 0x3b, 0xe6, 0x4c, 0xd3, 0x1e, 0xa0, 0x90, 0x41, 0xfe, 0xfc, 0x10, 0x16, 0xb4,
+	0x40, 0x84, 0xc5, 0x20, 0x9b, 0x42, 0x25, 0xda, 0xbb, 0x35, 0xb9, 0xba, 0x2e, 0xed, 0xe0, 0x20,
+	0x67, 0x1c, 0x45, 0xf6, 0xe9, 0x07, 0xd0, 0x91, 0xf1, 0xff, 0x8a, 0x71, 0x2e, 0x87, 0x04, 0xbc,
+	0x8a, 0x86, 0x36, 0xd6, 0x17, 0xb4, 0xf0, 0x71, 0x3c, 0x3c, 0xe6, 0xe3, 0xa2, 0x06, 0xb0, 0xb3,
+	0x0d, 0x41, 0xdb, 0x2a, 0xe3, 0x62, 0x0a, 0x87, 0xa7, 0x8d, 0x4b, 0x1f, 0x11, 0xd4, 0x71, 0x51,
+	0x8a, 0x28, 0xe3, 0x62, 0x88, 0x59, 0x67, 0xef, 0x98, 0x33, 0x4d, 0xe3, 0xa2, 0x90, 0xc9, 0x78,
+	0x5f, 0x94, 0xe8, 0x67, 0x45, 0x5f, 0xaa, 0x91, 0xe0, 0xec, 0x6d, 0x63, 0x9e, 0xa9, 0x2f, 0x43,
+	0x44, 0x90, 0x7d, 0x49, 0x61, 0xa9, 0x14, 0xc1, 0xaa, 0xd0, 0x02, 0xcd, 0x81, 0xd4, 0xec, 0xbd,
+	0xda, 0x7c, 0x93, 0x9e, 0xcd, 0x3a, 0xe5, 0x85, 0x4a, 0xbf, 0xfe, 0x22, 0x58, 0xd5, 0xd8, 0x5c,
+	0xd6, 0xad, 0xe2, 0x83, 0x15, 0x35, 0xd1, 0xbe, 0x6c, 0x67, 0x1c, 0x0a, 0x27, 0x7e, 0x0b, 0x89,
+	0x6f, 0x3b, 0x1b, 0xb8, 0xbe, 0x73, 0xbc, 0xb3, 0x20, 0x0c, 0x33, 0xc4, 0xa3, 0xf4, 0xcf, 0x60,
+	0xa9, 0x14, 0x8b, 0xab, 0xe8, 0xb3, 0x39, 0x48, 0x97, 0x5d, 0x1b, 0x20, 0x4c, 0xef, 0x6c, 0x4a,
+	0x4b, 0xeb, 0xc4, 0x72, 0xdc, 0x51, 0xcb, 0xc5, 0xb4, 0x1d, 0xb5, 0x26, 0xf6, 0xd7, 0x18, 0xa2,
+	0xe5, 0x6d, 0xb4, 0x20, 0xc9, 0x6d, 0x0f, 0x03, 0x3c, 0xca, 0x68, 0x91, 0xbb, 0x54, 0xe5, 0xc6,
+	0x10, 0x4f, 0xac, 0x58, 0x00, 0xd5, 0x4c, 0xbd, 0x7b, 0x54, 0x39, 0x65, 0x99, 0x29, 0xcd, 0x2c,
+	0x54, 0x6b, 0x16, 0xe7, 0x49, 0x5b, 0xb7, 0xb5, 0x80, 0x56, 0xf6, 0x96, 0x21, 0xa7, 0x46, 0xdd,
+	0x61, 0x4f, 0xdd, 0xad, 0x8f, 0xa0, 0x2d, 0x02, 0x0c, 0x15, 0x8b, 0x76, 0x29, 0xb4, 0x92, 0xdd,
+	0xad, 0x66, 0xf0, 0x5a, 0xb5, 0x85, 0xdb, 0xf3, 0x7d, 0xac, 0x95, 0x4f, 0x2a, 0x25, 0xdc, 0x50,
+	0x31, 0xa9, 0xaa, 0x91, 0x8a, 0xec, 0x6d, 0x63, 0x9e, 0x69, 0x52, 0xb1, 0x9d, 0x5b, 0xd2, 0xf8,
+	0x43, 0xf6, 0x4c, 0x61, 0x7c, 0xb4, 0x20, 0xeb, 0xfe, 0x35, 0x02, 0x0b, 0xb1, 0x06, 0x7d, 0xf9,
+	0xda, 0xa1, 0x88, 0x9c, 0x37, 0xb1, 0x99, 0x8e, 0xb3, 0x2b, 0x94, 0x49, 0x2c, 0xe6, 0x33, 0x74,
+	0x19, 0x97, 0x88, 0x36, 0xfa, 0x1f, 0x36, 0xd8, 0x77, 0x4b, 0xc7, 0xd4, 0x6b, 0xdd, 0x9d, 0xb0,
+	0x01, 0xa2, 0xc1, 0xf7, 0x26, 0xc6, 0xe7, 0xcd, 0x7d, 0x1d, 0x9b, 0x7b, 0xd3, 0xd9, 0x1e, 0xd3,
+	0x5c, 0xda, 0xd8, 0x3f, 0x0f, 0xdb, 0x32, 0xaa, 0x90, 0x56, 0xef, 0xfb, 0xa3, 0xc8, 0xcf, 0x0a,
+	0x93, 0x50, 0x4d, 0xe8, 0x21, 0xbb, 0x5b, 0x46, 0x30, 0xeb, 0x87, 0x17, 0x3c, 0x97, 0x35, 0xe3,
+	0x84, 0xd6, 0x4d, 0xa9, 0x27, 0xb0, 0x22, 0xca, 0xd1, 0x8f, 0xe7, 0x7e, 0x6e, 0x9a, 0xfc, 0x5c,
+	0xe1, 0xac, 0xab, 0x34, 0xe9, 0x27, 0x7b, 0x25, 0xc5, 0x0c, 0x83, 0xc4, 0x69, 0x71, 0x64, 0x54,
+	0xbb, 0x97, 0x31, 0xc2, 0x8c, 0x7d, 0xb3, 0x1e, 0xc1, 0x64, 0xf7, 0x1a, 0x90, 0x9c, 0x85, 0xa0,
+	0xf1, 0x39, 0x81, 0x73, 0x58, 0x3e, 0xaa, 0x25, 0x7a, 0xf4, 0x99, 0x89, 0xf2, 0xc5, 0xcb, 0x41,
+	0xa2, 0x59, 0x89, 0x28, 0xed, 0xec, 0x39, 0x8b, 0x88, 0xa7, 0x46, 0x98, 0xb1, 0xf6, 0xea, 0x63,
+	0xcf, 0x54, 0xe9, 0x1a, 0x83, 0xd3, 0xe8, 0x74, 0x15, 0xe3, 0x04, 0x7e, 0xaf, 0x91, 0xd2, 0xbd,
+	0x04, 0x4b, 0x37, 0x50, 0xd0, 0xf2, 0xc5, 0xee, 0x6e, 0x88, 0x2b, 0x33, 0x99, 0x75, 0x42, 0xdb,
+	0x92, 0x74, 0xeb, 0x04, 0xa5, 0x4d, 0x49, 0xff, 0x08, 0x56, 0x4b, 0x66, 0xaf, 0x97, 0x44, 0x5b,
+	0x13, 0xe7, 0x92, 0xcd, 0x4b, 0x10, 0xcf, 0xd1, 0x04, 0x55, 0x7a, 0xcc, 0x6a, 0xdd, 0x32, 0x1d,
+	0xf5, 0xb5, 0x87, 0xae, 0xe3, 0x8c, 0x0e, 0x7c, 0xdf, 0xb0, 0x36, 0x2a, 0x96, 0x00, 0x71, 0x50,
+	0xfe, 0xad, 0x06, 0xbf, 0xad, 0x33, 0xc6, 0xaa, 0xb1, 0xee, 0x98, 0x6c, 0x4d, 0xd7, 0x6e, 0x06,
+	0x5f, 0x4f, 0xac, 0x1b, 0x65, 0x83, 0x54, 0xa5, 0x39, 0xa7, 0x6c, 0xc7, 0x54, 0x22, 0xce, 0xe8,
+	0x3b, 0x66, 0x35, 0x14, 0x4d, 0xad, 0xbd, 0xa8, 0x6c, 0x05, 0xe3, 0x06, 0x1d, 0x41, 0xe9, 0xc7,
+	0xfa, 0x07, 0x54, 0x35, 0x92, 0xaf, 0x1b, 0x7a, 0x7d, 0x1d, 0xd2, 0xaf, 0x21, 0xe9, 0x5d, 0x6b,
+	0xbb, 0xd4, 0xdf, 0x52, 0x13, 0xd8, 0xb1, 0x4e, 0xb9, 0xdd, 0x54, 0x8f, 0x75, 0x95, 0xf0, 0x39,
+	0xf6, 0x6e, 0x4d, 0x6e, 0xcd, 0xb1, 0xce, 0xa3, 0x28, 0xb8, 0x19, 0x5a, 0x39, 0x2c, 0x97, 0x6f,
+	0x19, 0x95, 0xa9, 0x6c, 0xbe, 0x7f, 0xb4, 0x6f, 0x56, 0x10, 0x4a, 0x57, 0x2e, 0xa5, 0x53, 0x6b,
+	0x3f, 0x67, 0x37, 0x37, 0xf7, 0xb8, 0x2f, 0x82, 0x95, 0xc3, 0x52, 0xe9, 0x06, 0x50, 0x19, 0x4b,
+	0xe3, 0xd5, 0xe0, 0x04, 0x34, 0xf5, 0xe5, 0x43, 0xd2, 0x1c, 0x61, 0x35, 0x74, 0x1a, 0xbd, 0x80,
+	0x55, 0xc3, 0x6d, 0x9e, 0xa2, 0xe9, 0xd5, 0x5e, 0xf5, 0xd9, 0xd5, 0xd6, 0x69, 0xb7, 0x5a, 0xba,
+	0x7d, 0xb3, 0xa0, 0x9d, 0x12, 0x46, 0x39, 0x51, 0xfa, 0xcb, 0xf5, 0xcb, 0x6a, 0x8d, 0xba, 0x6e,
+	0xb9, 0x57, 0x9b, 0x6f, 0xdc, 0x1a, 0x24, 0x49, 0xae, 0x5f, 0x86, 0xb0, 0xa8, 0x37, 0x55, 0x31,
+	0xad, 0x99, 0x2e, 0x22, 0xaf, 0xec, 0xa1, 0x3e, 0x67, 0x24, 0xb9, 0x4f, 0xb0, 0xee, 0x08, 0x16,
+	0xb4, 0x2b, 0x62, 0x45, 0x5c, 0x0d, 0x97, 0xcf, 0x93, 0xcb, 0x4f, 0x99, 0x9f, 0x59, 0x1e, 0x27,
+	0x6c, 0x41, 0x5c, 0x2e, 0x5f, 0x49, 0x5b, 0x7b, 0x46, 0x92, 0xc5, 0xbd, 0xf3, 0xe7, 0xa7, 0x9a,
+	0xc1, 0x72, 0xf9, 0x4e, 0xdb, 0x40, 0x55, 0xbf, 0xed, 0xbe, 0x7a, 0x1c, 0xaf, 0x20, 0x8a, 0x8b,
+	0x51, 0xf9, 0xda, 0xf7, 0x79, 0x3c, 0x18, 0x84, 0xc4, 0xaa, 0xf6, 0xa8, 0x74, 0x2f, 0x3c, 0x41,
+	0x9f, 0xb5, 0xbd, 0xaf, 0x20, 0xef, 0x8d, 0xf2, 0x58, 0xcc, 0x9b, 0x1f, 0xe1, 0xf6, 0x53, 0x0a,
+	0x06, 0xa5, 0x6d, 0x3f, 0xe6, 0x78, 0x57, 0xb6, 0x33, 0x0e, 0xa5, 0x66, 0x1f, 0x3a, 0xe5, 0x78,
+	0x3c, 0xe0, 0x8f, 0x15, 0xc3, 0x4a, 0x25, 0xea, 0x4e, 0xd1, 0xf1, 0xba, 0x80, 0x3c, 0x76, 0x4d,
+	0x80, 0x19, 0x5d, 0x93, 0xf3, 0x7c, 0x9f, 0x5e, 0xfa, 0x32, 0x92, 0x97, 0x3f, 0x8c, 0xd1, 0x40,
+	0x11, 0xa2, 0x29, 0xaf, 0x8e, 0x60, 0x5d, 0xa8, 0x9c, 0x5a, 0x82, 0x65, 0xfb, 0x9d, 0x4e, 0x90,
+	0xf3, 0x56, 0x2f, 0xa3, 0xf3, 0xd6, 0x1c, 0x6d, 0xc7, 0x76, 0xc6, 0xa1, 0xd4, 0xf0, 0x56, 0xa7,
+	0x9d, 0x51, 0x5b, 0xee, 0x9a, 0x29, 0xd0, 0x8e, 0xf5, 0x9a, 0x7e, 0xb0, 0x32, 0xf7, 0xf8, 0xea,
+	0x5b, 0x5b, 0xbe, 0xd9, 0x39, 0xdd, 0xe2, 0x08, 0x56, 0xe5, 0xf7, 0x45, 0xf1, 0x09, 0x1f, 0x19,
+	0x7f, 0x45, 0xe3, 0xb7, 0x31, 0xae, 0x8f, 0x7d, 0x6b, 0x0c, 0x46, 0x8d, 0xe9, 0x54, 0xea, 0x14,
+	0x18, 0x22, 0xc5, 0xfa, 0x07, 0x0d, 0x7c, 0xc4, 0x38, 0x26, 0x5e, 0x88, 0xf5, 0xb6, 0x7e, 0x09,
+	0x70, 0x45, 0x5c, 0x11, 0xfb, 0xf6, 0xd8, 0xd0, 0x0e, 0xb2, 0x5d, 0x6f, 0x61, 0xbb, 0xbe, 0x60,
+	0x39, 0xc5, 0xfd, 0x81, 0xc4, 0xae, 0xea, 0x3d, 0x7f, 0x9b, 0x85, 0x94, 0x33, 0x3f, 0xa9, 0xb7,
+	0xd4, 0x3b, 0xe4, 0xb1, 0x21, 0x20, 0xec, 0x3b, 0x13, 0x60, 0xea, 0x46, 0x6f, 0x4b, 0x9c, 0x49,
+	0x3d, 0x81, 0xae, 0xbf, 0xac, 0x67, 0x67, 0x1e, 0xed, 0xb9, 0xb7, 0x76, 0x0c, 0x30, 0xbd, 0x9e,
+	0xb7, 0x6f, 0xd6, 0x23, 0xd4, 0x9c, 0x79, 0x7c, 0x8e, 0x95, 0x21, 0x81, 0xdf, 0x6c, 0x14, 0xee,
+	0xfb, 0xfa, 0x23, 0xd2, 0xc2, 0xf4, 0x3f, 0xf6, 0xb5, 0xb6, 0xfd, 0xfa, 0x55, 0x68, 0xba, 0x66,
+	0x6e, 0xd9, 0xaa, 0xf8, 0xa4, 0x3a, 0xc9, 0x0b, 0xbc, 0x0c, 0xa9, 0x3c, 0x55, 0x55, 0x2f, 0x43,
+	0x6a, 0x5e, 0xb7, 0xd9, 0xb5, 0x4f, 0xc9, 0x2a, 0x37, 0x20, 0xca, 0xfb, 0x27, 0x69, 0x16, 0xfc,
+	0x71, 0x43, 0x7c, 0x47, 0xa1, 0x42, 0xfc, 0xb6, 0x6e, 0xfe, 0xbb, 0x3e, 0x7d, 0xed, 0xce, 0x83,
+	0x99, 0x07, 0x4d, 0x4d, 0xf8, 0xcb, 0x2c, 0x98, 0x63, 0xb9, 0x78, 0x66, 0xbd, 0xa6, 0xcf, 0x1a,
+	0xe3, 0x6b, 0x3d, 0xfb, 0x0b, 0xe3, 0x91, 0x6a, 0x2e, 0xf4, 0xca, 0xed, 0xc8, 0xac, 0x53, 0x58,
+	0x2e, 0x3f, 0x11, 0x2a, 0x84, 0xb0, 0xe6, 0xf1, 0x90, 0x6d, 0x78, 0xd8, 0xa1, 0xab, 0x8f, 0x9e,
+	0xef, 0xc7, 0xfd, 0x18, 0x49, 0xe0, 0x7b, 0x0f, 0xa6, 0x74, 0xac, 0x99, 0x5e, 0xf6, 0x58, 0xea,
+	0xad, 0x56, 0xdd, 0xbb, 0x1f, 0x23, 0x45, 0x6d, 0x79, 0xf4, 0x7c, 0xff, 0x98, 0x15, 0xd6, 0xa9,
+	0x9e, 0xc2, 0x72, 0xf9, 0x75, 0x8c, 0xb5, 0x67, 0x30, 0x59, 0x5f, 0xaf, 0x7f, 0x8a, 0x25, 0x5b,
+	0x52, 0x62, 0x17, 0xc0, 0x45, 0x89, 0xcc, 0xaa, 0x5c, 0x26, 0x6b, 0x8f, 0x6e, 0xec, 0x1b, 0x75,
+	0xd9, 0x35, 0x17, 0xc0, 0x05, 0x39, 0xb4, 0x7f, 0x96, 0x1e, 0x43, 0x14, 0x1a, 0xb1, 0xf9, 0x95,
+	0x84, 0x6d, 0x74, 0xc9, 0xd7, 0xed, 0x9f, 0x9e, 0xef, 0x07, 0x2c, 0x53, 0x4a, 0x69, 0x22, 0xbe,
+	0x1e, 0xa4, 0xd1, 0xba, 0xa5, 0x73, 0x70, 0x72, 0x72, 0x9a, 0xba, 0xc4, 0xb8, 0x58, 0xa6, 0xc8,
+	0x96, 0x45, 0xb5, 0x94, 0xbe, 0x2c, 0x9a, 0xde, 0x31, 0xd8, 0x37, 0xeb, 0x11, 0x6a, 0x96, 0x45,
+	0x95, 0xac, 0x50, 0x93, 0x4a, 0x6e, 0xf9, 0xaa, 0x9a, 0x64, 0xf4, 0xf4, 0xb5, 0x6b, 0x5c, 0x6c,
+	0x2b, 0x6a, 0x12, 0x11, 0xd9, 0x0a, 0x5f, 0x57, 0x0f, 0xbd, 0x51, 0x46, 0x4a, 0x24, 0x77, 0xcd,
+	0x15, 0x5e, 0x45, 0x4f, 0x5b, 0xf2, 0x12, 0x5a, 0x6f, 0x95, 0x62, 0x4a, 0x95, 0x95, 0x6c, 0x34,
+	0x7c, 0x49, 0x24, 0x4b, 0xca, 0x09, 0xad, 0xd8, 0x48, 0x93, 0x49, 0xc8, 0xcf, 0x80, 0x26, 0x93,
+	0x9e, 0x2a, 0xcd, 0x1f, 0x71, 0x87, 0x23, 0xb5, 0xa4, 0xae, 0x12, 0x9a, 0x3d, 0xb1, 0x6d, 0x67,
+	0x1c, 0x4a, 0x8d, 0x4a, 0xa8, 0x93, 0xcf, 0x8e, 0x67, 0x92, 0x34, 0xce, 0xe3, 0xaf, 0xfc, 0xff,
+	0x01, 0x00, 0xe6, 0x04, 0xc3, 0x91, 0x9e, 0x8d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GoCryptoTraderClient is the client API for GoCryptoTrader service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GoCryptoTraderClient interface {
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error)
+	EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
+	DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
+	GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error)
+	GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error)
+	GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error)
+	DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error)
+	GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error)
+	GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error)
+	EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error)
+	GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error)
+	GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error)
+	GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error)
+	GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error)
+	GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error)
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error)
+	RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error)
+	GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error)
+	GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error)
+	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
+	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	SubmitOrders(ctx context.Context, in *SubmitOrdersRequest, opts ...grpc.CallOption) (*SubmitOrdersResponse, error)
+	SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
+	WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	CancelOrders(ctx context.Context, in *CancelOrdersRequest, opts ...grpc.CallOption) (*CancelOrdersResponse, error)
+	ModifyOrder(ctx context.Context, in *ModifyOrderRequest, opts ...grpc.CallOption) (*ModifyOrderResponse, error)
+	CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error)
+	ActivateKillSwitch(ctx context.Context, in *ActivateKillSwitchRequest, opts ...grpc.CallOption) (*ActivateKillSwitchResponse, error)
+	ResetKillSwitch(ctx context.Context, in *ResetKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error)
+	GetKillSwitchStatus(ctx context.Context, in *GetKillSwitchStatusRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error)
+	GetTradingRules(ctx context.Context, in *GetTradingRulesRequest, opts ...grpc.CallOption) (*TradingRules, error)
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error)
+	RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error)
+	GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error)
+	GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error)
+	WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
+	SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
+	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
+	EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error)
+	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error)
+	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error)
+	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error)
+	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
+	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
+	GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
+	GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
+	GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
+	GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
+	AddDataHistoryJob(ctx context.Context, in *AddDataHistoryJobRequest, opts ...grpc.CallOption) (*DataHistoryJob, error)
+	GetDataHistoryJob(ctx context.Context, in *GetDataHistoryJobRequest, opts ...grpc.CallOption) (*DataHistoryJob, error)
+	GetDataHistoryJobs(ctx context.Context, in *GetDataHistoryJobsRequest, opts ...grpc.CallOption) (*GetDataHistoryJobsResponse, error)
+	RemoveDataHistoryJob(ctx context.Context, in *RemoveDataHistoryJobRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
+	GetOrderbookDepth(ctx context.Context, in *GetOrderbookDepthRequest, opts ...grpc.CallOption) (*GetOrderbookDepthResponse, error)
+	GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetConsolidatedOrderbookStreamClient, error)
+	GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error)
+	GetDispatchStats(ctx context.Context, in *GetDispatchStatsRequest, opts ...grpc.CallOption) (*GetDispatchStatsResponse, error)
+	GetOrderReconciliation(ctx context.Context, in *GetOrderReconciliationRequest, opts ...grpc.CallOption) (*GetOrderReconciliationResponse, error)
+	AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrder, error)
+	CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrder, error)
+	GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*GetConditionalOrdersResponse, error)
+	AddOCOOrderGroup(ctx context.Context, in *AddOCOOrderGroupRequest, opts ...grpc.CallOption) (*OrderGroup, error)
+	AddBracketOrderGroup(ctx context.Context, in *AddBracketOrderGroupRequest, opts ...grpc.CallOption) (*OrderGroup, error)
+	CancelOrderGroup(ctx context.Context, in *CancelOrderGroupRequest, opts ...grpc.CallOption) (*OrderGroup, error)
+	GetOrderGroups(ctx context.Context, in *GetOrderGroupsRequest, opts ...grpc.CallOption) (*GetOrderGroupsResponse, error)
+	AddIcebergOrder(ctx context.Context, in *AddIcebergOrderRequest, opts ...grpc.CallOption) (*IcebergOrder, error)
+	CancelIcebergOrder(ctx context.Context, in *CancelIcebergOrderRequest, opts ...grpc.CallOption) (*IcebergOrder, error)
+	GetIcebergOrders(ctx context.Context, in *GetIcebergOrdersRequest, opts ...grpc.CallOption) (*GetIcebergOrdersResponse, error)
+	AddExecutionOrder(ctx context.Context, in *AddExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error)
+	PauseExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error)
+	ResumeExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error)
+	CancelExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error)
+	GetExecutionOrders(ctx context.Context, in *GetExecutionOrdersRequest, opts ...grpc.CallOption) (*GetExecutionOrdersResponse, error)
+}
+
+type goCryptoTraderClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGoCryptoTraderClient(cc *grpc.ClientConn) GoCryptoTraderClient {
+	return &goCryptoTraderClient{cc}
+}
+
+func (c *goCryptoTraderClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error) {
+	out := new(GetSusbsytemsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSubsystems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
+	out := new(GenericSubsystemResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableSubsystem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
+	out := new(GenericSubsystemResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableSubsystem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error) {
+	out := new(GetRPCEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRPCEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error) {
+	out := new(GetCommunicationRelayersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCommunicationRelayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error) {
+	out := new(GetExchangesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error) {
+	out := new(GetExchangeInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error) {
+	out := new(GetExchangeOTPReponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error) {
+	out := new(GetExchangeOTPsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error) {
+	out := new(TickerResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTicker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error) {
+	out := new(GetTickersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error) {
+	out := new(OrderbookResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error) {
+	out := new(GetOrderbooksResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error) {
+	out := new(GetAccountInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[0], "/gctrpc.GoCryptoTrader/GetAccountInfoStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetAccountInfoStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetAccountInfoStreamClient interface {
+	Recv() (*GetAccountInfoResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetAccountInfoStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetAccountInfoStreamClient) Recv() (*GetAccountInfoResponse, error) {
+	m := new(GetAccountInfoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
+	out := new(GetPortfolioResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error) {
+	out := new(GetPortfolioSummaryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error) {
+	out := new(AddPortfolioAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddPortfolioAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error) {
+	out := new(RemovePortfolioAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemovePortfolioAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error) {
+	out := new(GetForexProvidersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error) {
+	out := new(GetForexRatesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error) {
+	out := new(GetOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error) {
+	out := new(OrderDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
+	out := new(SubmitOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitOrders(ctx context.Context, in *SubmitOrdersRequest, opts ...grpc.CallOption) (*SubmitOrdersResponse, error) {
+	out := new(SubmitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
+	out := new(SimulateOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SimulateOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
+	out := new(SimulateOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WhaleBomb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelOrders(ctx context.Context, in *CancelOrdersRequest, opts ...grpc.CallOption) (*CancelOrdersResponse, error) {
+	out := new(CancelOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ModifyOrder(ctx context.Context, in *ModifyOrderRequest, opts ...grpc.CallOption) (*ModifyOrderResponse, error) {
+	out := new(ModifyOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ModifyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error) {
+	out := new(CancelAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ActivateKillSwitch(ctx context.Context, in *ActivateKillSwitchRequest, opts ...grpc.CallOption) (*ActivateKillSwitchResponse, error) {
+	out := new(ActivateKillSwitchResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ActivateKillSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ResetKillSwitch(ctx context.Context, in *ResetKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error) {
+	out := new(KillSwitchStatus)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ResetKillSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetKillSwitchStatus(ctx context.Context, in *GetKillSwitchStatusRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error) {
+	out := new(KillSwitchStatus)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetKillSwitchStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTradingRules(ctx context.Context, in *GetTradingRulesRequest, opts ...grpc.CallOption) (*TradingRules, error) {
+	out := new(TradingRules)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTradingRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error) {
+	out := new(AddEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error) {
+	out := new(RemoveEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error) {
+	out := new(GetCryptocurrencyDepositAddressesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error) {
+	out := new(GetCryptocurrencyDepositAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawFiatFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
+	out := new(GetLoggerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLoggerDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
+	out := new(GetLoggerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLoggerDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error) {
+	out := new(GetExchangePairsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangePairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchangePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchangePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[1], "/gctrpc.GoCryptoTrader/GetOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetOrderbookStreamClient interface {
+	Recv() (*OrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
+	m := new(OrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[2], "/gctrpc.GoCryptoTrader/GetExchangeOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetExchangeOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetExchangeOrderbookStreamClient interface {
+	Recv() (*OrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetExchangeOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetExchangeOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
+	m := new(OrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetTickerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetTickerStreamClient interface {
+	Recv() (*TickerResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetTickerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetTickerStreamClient) Recv() (*TickerResponse, error) {
+	m := new(TickerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetExchangeTickerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetExchangeTickerStreamClient interface {
+	Recv() (*TickerResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetExchangeTickerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetExchangeTickerStreamClient) Recv() (*TickerResponse, error) {
+	m := new(TickerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error) {
+	out := new(GetAuditEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAuditEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
+	out := new(GCTScriptQueryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptReadScript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
+	out := new(GCTScriptStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
+	out := new(GCTScriptQueryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStopAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
+	out := new(GCTScriptStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptListAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error) {
+	out := new(GetHistoricCandlesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetHistoricCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddDataHistoryJob(ctx context.Context, in *AddDataHistoryJobRequest, opts ...grpc.CallOption) (*DataHistoryJob, error) {
+	out := new(DataHistoryJob)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddDataHistoryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDataHistoryJob(ctx context.Context, in *GetDataHistoryJobRequest, opts ...grpc.CallOption) (*DataHistoryJob, error) {
+	out := new(DataHistoryJob)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataHistoryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDataHistoryJobs(ctx context.Context, in *GetDataHistoryJobsRequest, opts ...grpc.CallOption) (*GetDataHistoryJobsResponse, error) {
+	out := new(GetDataHistoryJobsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataHistoryJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemoveDataHistoryJob(ctx context.Context, in *RemoveDataHistoryJobRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
+	out := new(GenericSubsystemResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveDataHistoryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbookDepth(ctx context.Context, in *GetOrderbookDepthRequest, opts ...grpc.CallOption) (*GetOrderbookDepthResponse, error) {
+	out := new(GetOrderbookDepthResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbookDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetConsolidatedOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[5], "/gctrpc.GoCryptoTrader/GetConsolidatedOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetConsolidatedOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetConsolidatedOrderbookStreamClient interface {
+	Recv() (*ConsolidatedOrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetConsolidatedOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetConsolidatedOrderbookStreamClient) Recv() (*ConsolidatedOrderbookResponse, error) {
+	m := new(ConsolidatedOrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error) {
+	out := new(GetArbitrageOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDispatchStats(ctx context.Context, in *GetDispatchStatsRequest, opts ...grpc.CallOption) (*GetDispatchStatsResponse, error) {
+	out := new(GetDispatchStatsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDispatchStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderReconciliation(ctx context.Context, in *GetOrderReconciliationRequest, opts ...grpc.CallOption) (*GetOrderReconciliationResponse, error) {
+	out := new(GetOrderReconciliationResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderReconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrder, error) {
+	out := new(ConditionalOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddConditionalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrder, error) {
+	out := new(ConditionalOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelConditionalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*GetConditionalOrdersResponse, error) {
+	out := new(GetConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConditionalOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddOCOOrderGroup(ctx context.Context, in *AddOCOOrderGroupRequest, opts ...grpc.CallOption) (*OrderGroup, error) {
+	out := new(OrderGroup)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddOCOOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddBracketOrderGroup(ctx context.Context, in *AddBracketOrderGroupRequest, opts ...grpc.CallOption) (*OrderGroup, error) {
+	out := new(OrderGroup)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddBracketOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelOrderGroup(ctx context.Context, in *CancelOrderGroupRequest, opts ...grpc.CallOption) (*OrderGroup, error) {
+	out := new(OrderGroup)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderGroups(ctx context.Context, in *GetOrderGroupsRequest, opts ...grpc.CallOption) (*GetOrderGroupsResponse, error) {
+	out := new(GetOrderGroupsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddIcebergOrder(ctx context.Context, in *AddIcebergOrderRequest, opts ...grpc.CallOption) (*IcebergOrder, error) {
+	out := new(IcebergOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddIcebergOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelIcebergOrder(ctx context.Context, in *CancelIcebergOrderRequest, opts ...grpc.CallOption) (*IcebergOrder, error) {
+	out := new(IcebergOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelIcebergOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetIcebergOrders(ctx context.Context, in *GetIcebergOrdersRequest, opts ...grpc.CallOption) (*GetIcebergOrdersResponse, error) {
+	out := new(GetIcebergOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetIcebergOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddExecutionOrder(ctx context.Context, in *AddExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error) {
+	out := new(ExecutionOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) PauseExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error) {
+	out := new(ExecutionOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/PauseExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ResumeExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error) {
+	out := new(ExecutionOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ResumeExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error) {
+	out := new(ExecutionOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExecutionOrders(ctx context.Context, in *GetExecutionOrdersRequest, opts ...grpc.CallOption) (*GetExecutionOrdersResponse, error) {
+	out := new(GetExecutionOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExecutionOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoCryptoTraderServer is the server API for GoCryptoTrader service.
+type GoCryptoTraderServer interface {
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
+	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
+	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
+	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
+	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
+	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
+	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
+	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
+	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error)
+	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
+	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
+	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
+	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
+	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
+	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
+	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error)
+	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error)
+	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
+	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
+	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
+	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	SubmitOrders(context.Context, *SubmitOrdersRequest) (*SubmitOrdersResponse, error)
+	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
+	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	CancelOrders(context.Context, *CancelOrdersRequest) (*CancelOrdersResponse, error)
+	ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error)
+	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
+	ActivateKillSwitch(context.Context, *ActivateKillSwitchRequest) (*ActivateKillSwitchResponse, error)
+	ResetKillSwitch(context.Context, *ResetKillSwitchRequest) (*KillSwitchStatus, error)
+	GetKillSwitchStatus(context.Context, *GetKillSwitchStatusRequest) (*KillSwitchStatus, error)
+	GetTradingRules(context.Context, *GetTradingRulesRequest) (*TradingRules, error)
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
+	RemoveEvent(context.Context, *RemoveEventRequest) (*RemoveEventResponse, error)
+	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
+	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
+	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
+	WithdrawFiatFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
+	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
+	EnableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
+	DisableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
+	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
+	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
+	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
+	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
+	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
+	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error)
+	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
+	AddDataHistoryJob(context.Context, *AddDataHistoryJobRequest) (*DataHistoryJob, error)
+	GetDataHistoryJob(context.Context, *GetDataHistoryJobRequest) (*DataHistoryJob, error)
+	GetDataHistoryJobs(context.Context, *GetDataHistoryJobsRequest) (*GetDataHistoryJobsResponse, error)
+	RemoveDataHistoryJob(context.Context, *RemoveDataHistoryJobRequest) (*GenericSubsystemResponse, error)
+	GetOrderbookDepth(context.Context, *GetOrderbookDepthRequest) (*GetOrderbookDepthResponse, error)
+	GetConsolidatedOrderbookStream(*GetConsolidatedOrderbookStreamRequest, GoCryptoTrader_GetConsolidatedOrderbookStreamServer) error
+	GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error)
+	GetDispatchStats(context.Context, *GetDispatchStatsRequest) (*GetDispatchStatsResponse, error)
+	GetOrderReconciliation(context.Context, *GetOrderReconciliationRequest) (*GetOrderReconciliationResponse, error)
+	AddConditionalOrder(context.Context, *AddConditionalOrderRequest) (*ConditionalOrder, error)
+	CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*ConditionalOrder, error)
+	GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*GetConditionalOrdersResponse, error)
+	AddOCOOrderGroup(context.Context, *AddOCOOrderGroupRequest) (*OrderGroup, error)
+	AddBracketOrderGroup(context.Context, *AddBracketOrderGroupRequest) (*OrderGroup, error)
+	CancelOrderGroup(context.Context, *CancelOrderGroupRequest) (*OrderGroup, error)
+	GetOrderGroups(context.Context, *GetOrderGroupsRequest) (*GetOrderGroupsResponse, error)
+	AddIcebergOrder(context.Context, *AddIcebergOrderRequest) (*IcebergOrder, error)
+	CancelIcebergOrder(context.Context, *CancelIcebergOrderRequest) (*IcebergOrder, error)
+	GetIcebergOrders(context.Context, *GetIcebergOrdersRequest) (*GetIcebergOrdersResponse, error)
+	AddExecutionOrder(context.Context, *AddExecutionOrderRequest) (*ExecutionOrder, error)
+	PauseExecutionOrder(context.Context, *ExecutionOrderRequest) (*ExecutionOrder, error)
+	ResumeExecutionOrder(context.Context, *ExecutionOrderRequest) (*ExecutionOrder, error)
+	CancelExecutionOrder(context.Context, *ExecutionOrderRequest) (*ExecutionOrder, error)
+	GetExecutionOrders(context.Context, *GetExecutionOrdersRequest) (*GetExecutionOrdersResponse, error)
+}
+
+// UnimplementedGoCryptoTraderServer can be embedded to have forward compatible implementations.
+type UnimplementedGoCryptoTraderServer struct {
+}
+
+func (*UnimplementedGoCryptoTraderServer) GetInfo(ctx context.Context, req *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetSubsystems(ctx context.Context, req *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) EnableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) DisableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetRPCEndpoints(ctx context.Context, req *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(ctx context.Context, req *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchanges(ctx context.Context, req *GetExchangesRequest) (*GetExchangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) DisableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeInfo(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(ctx context.Context, req *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) EnableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTicker(ctx context.Context, req *GetTickerRequest) (*TickerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTickers(ctx context.Context, req *GetTickersRequest) (*GetTickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbook(ctx context.Context, req *GetOrderbookRequest) (*OrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbooks(ctx context.Context, req *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAccountInfo(ctx context.Context, req *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAccountInfoStream(req *GetAccountInfoRequest, srv GoCryptoTrader_GetAccountInfoStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetConfig(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetPortfolio(ctx context.Context, req *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetPortfolioSummary(ctx context.Context, req *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddPortfolioAddress(ctx context.Context, req *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(ctx context.Context, req *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetForexProviders(ctx context.Context, req *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetForexRates(ctx context.Context, req *GetForexRatesRequest) (*GetForexRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrders(ctx context.Context, req *GetOrdersRequest) (*GetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrder(ctx context.Context, req *GetOrderRequest) (*OrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SubmitOrder(ctx context.Context, req *SubmitOrderRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SubmitOrders(ctx context.Context, req *SubmitOrdersRequest) (*SubmitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SimulateOrder(ctx context.Context, req *SimulateOrderRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) WhaleBomb(ctx context.Context, req *WhaleBombRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelOrder(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelOrders(ctx context.Context, req *CancelOrdersRequest) (*CancelOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ModifyOrder(ctx context.Context, req *ModifyOrderRequest) (*ModifyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelAllOrders(ctx context.Context, req *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ActivateKillSwitch(ctx context.Context, req *ActivateKillSwitchRequest) (*ActivateKillSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateKillSwitch not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ResetKillSwitch(ctx context.Context, req *ResetKillSwitchRequest) (*KillSwitchStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetKillSwitch not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetKillSwitchStatus(ctx context.Context, req *GetKillSwitchStatusRequest) (*KillSwitchStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKillSwitchStatus not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTradingRules(ctx context.Context, req *GetTradingRulesRequest) (*TradingRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradingRules not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetEvents(ctx context.Context, req *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddEvent(ctx context.Context, req *AddEventRequest) (*AddEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RemoveEvent(ctx context.Context, req *RemoveEventRequest) (*RemoveEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(ctx context.Context, req *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(ctx context.Context, req *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetLoggerDetails(ctx context.Context, req *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SetLoggerDetails(ctx context.Context, req *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangePairs(ctx context.Context, req *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) EnableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExchangePair not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) DisableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExchangePair not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbookStream(req *GetOrderbookStreamRequest, srv GoCryptoTrader_GetOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(req *GetExchangeOrderbookStreamRequest, srv GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTickerStream(req *GetTickerStreamRequest, srv GoCryptoTrader_GetTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(req *GetExchangeTickerStreamRequest, srv GoCryptoTrader_GetExchangeTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAuditEvent(ctx context.Context, req *GetAuditEventRequest) (*GetAuditEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptExecute(ctx context.Context, req *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptUpload(ctx context.Context, req *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptReadScript(ctx context.Context, req *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptStatus(ctx context.Context, req *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptQuery(ctx context.Context, req *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptStop(ctx context.Context, req *GCTScriptStopRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptStopAll(ctx context.Context, req *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptListAll(ctx context.Context, req *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(ctx context.Context, req *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetHistoricCandles(ctx context.Context, req *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddDataHistoryJob(ctx context.Context, req *AddDataHistoryJobRequest) (*DataHistoryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDataHistoryJob not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetDataHistoryJob(ctx context.Context, req *GetDataHistoryJobRequest) (*DataHistoryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJob not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetDataHistoryJobs(ctx context.Context, req *GetDataHistoryJobsRequest) (*GetDataHistoryJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobs not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RemoveDataHistoryJob(ctx context.Context, req *RemoveDataHistoryJobRequest) (*GenericSubsystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDataHistoryJob not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbookDepth(ctx context.Context, req *GetOrderbookDepthRequest) (*GetOrderbookDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookDepth not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetConsolidatedOrderbookStream(req *GetConsolidatedOrderbookStreamRequest, srv GoCryptoTrader_GetConsolidatedOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetArbitrageOpportunities(ctx context.Context, req *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunities not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetDispatchStats(ctx context.Context, req *GetDispatchStatsRequest) (*GetDispatchStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispatchStats not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderReconciliation(ctx context.Context, req *GetOrderReconciliationRequest) (*GetOrderReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderReconciliation not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddConditionalOrder(ctx context.Context, req *AddConditionalOrderRequest) (*ConditionalOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConditionalOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelConditionalOrder(ctx context.Context, req *CancelConditionalOrderRequest) (*ConditionalOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConditionalOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetConditionalOrders(ctx context.Context, req *GetConditionalOrdersRequest) (*GetConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConditionalOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddOCOOrderGroup(ctx context.Context, req *AddOCOOrderGroupRequest) (*OrderGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOCOOrderGroup not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddBracketOrderGroup(ctx context.Context, req *AddBracketOrderGroupRequest) (*OrderGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBracketOrderGroup not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelOrderGroup(ctx context.Context, req *CancelOrderGroupRequest) (*OrderGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrderGroup not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderGroups(ctx context.Context, req *GetOrderGroupsRequest) (*GetOrderGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderGroups not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddIcebergOrder(ctx context.Context, req *AddIcebergOrderRequest) (*IcebergOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIcebergOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelIcebergOrder(ctx context.Context, req *CancelIcebergOrderRequest) (*IcebergOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIcebergOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetIcebergOrders(ctx context.Context, req *GetIcebergOrdersRequest) (*GetIcebergOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIcebergOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddExecutionOrder(ctx context.Context, req *AddExecutionOrderRequest) (*ExecutionOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExecutionOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) PauseExecutionOrder(ctx context.Context, req *ExecutionOrderRequest) (*ExecutionOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseExecutionOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ResumeExecutionOrder(ctx context.Context, req *ExecutionOrderRequest) (*ExecutionOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeExecutionOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelExecutionOrder(ctx context.Context, req *ExecutionOrderRequest) (*ExecutionOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExecutionOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExecutionOrders(ctx context.Context, req *GetExecutionOrdersRequest) (*GetExecutionOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionOrders not implemented")
+}
+
+func RegisterGoCryptoTraderServer(s *grpc.Server, srv GoCryptoTraderServer) {
+	s.RegisterService(&_GoCryptoTrader_serviceDesc, srv)
+}
+
+func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubsystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRPCEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunicationRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeOTPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAccountInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
+}
+
+type GoCryptoTrader_GetAccountInfoStreamServer interface {
+	Send(*GetAccountInfoResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetAccountInfoStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGoCryptoTraderServer) GetHistoricCandles(ctx context.Context, req *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+
+func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGoCryptoTraderServer(s *grpc.Server, srv GoCryptoTraderServer) {
-	s.RegisterService(&_GoCryptoTrader_serviceDesc, srv)
+func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubsystemsRequest)
+func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_SubmitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).SubmitOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).SubmitOrders(ctx, req.(*SubmitOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRPCEndpointsRequest)
+func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhaleBombRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommunicationRelayersRequest)
+func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangesRequest)
+func _GoCryptoTrader_CancelOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+		return srv.(GoCryptoTraderServer).CancelOrders(ctx, req.(*CancelOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_ModifyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/ModifyOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, req.(*ModifyOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_ActivateKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateKillSwitchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+		return srv.(GoCryptoTraderServer).ActivateKillSwitch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+		FullMethod: "/gctrpc.GoCryptoTrader/ActivateKillSwitch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).ActivateKillSwitch(ctx, req.(*ActivateKillSwitchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeOTPsRequest)
+func _GoCryptoTrader_ResetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetKillSwitchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+		return srv.(GoCryptoTraderServer).ResetKillSwitch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+		FullMethod: "/gctrpc.GoCryptoTrader/ResetKillSwitch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+		return srv.(GoCryptoTraderServer).ResetKillSwitch(ctx, req.(*ResetKillSwitchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_GetKillSwitchStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKillSwitchStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).GetKillSwitchStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetKillSwitchStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).GetKillSwitchStatus(ctx, req.(*GetKillSwitchStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickerRequest)
+func _GoCryptoTrader_GetTradingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradingRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+		return srv.(GoCryptoTraderServer).GetTradingRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTradingRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+		return srv.(GoCryptoTraderServer).GetTradingRules(ctx, req.(*GetTradingRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickersRequest)
+func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbookRequest)
+func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbooksRequest)
+func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetAccountInfoRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type GoCryptoTrader_GetAccountInfoStreamServer interface {
-	Send(*GetAccountInfoResponse) error
-	grpc.ServerStream
+func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type goCryptoTraderGetAccountInfoStreamServer struct {
-	grpc.ServerStream
+func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoggerDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigRequest)
+func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioRequest)
+func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangePairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioSummaryRequest)
+func _GoCryptoTrader_EnableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchangePair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, req.(*ExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPortfolioAddressRequest)
+func _GoCryptoTrader_DisableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchangePair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, req.(*ExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePortfolioAddressRequest)
+func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexProvidersRequest)
+func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptExecuteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexRatesRequest)
+func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptReadScriptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderRequest)
+func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitOrderRequest)
+func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateOrderRequest)
+func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WhaleBombRequest)
+func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptListAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelAllOrdersRequest)
+func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptAutoLoadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventsRequest)
+func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoricCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddEventRequest)
+func _GoCryptoTrader_AddDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDataHistoryJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).AddDataHistoryJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddDataHistoryJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
+		return srv.(GoCryptoTraderServer).AddDataHistoryJob(ctx, req.(*AddDataHistoryJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveEventRequest)
+func _GoCryptoTrader_GetDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDataHistoryJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
+		return srv.(GoCryptoTraderServer).GetDataHistoryJob(ctx, req.(*GetDataHistoryJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressesRequest)
+func _GoCryptoTrader_GetDataHistoryJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobs(ctx, req.(*GetDataHistoryJobsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
-
-func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressRequest)
+
+func _GoCryptoTrader_RemoveDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDataHistoryJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).RemoveDataHistoryJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveDataHistoryJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
+		return srv.(GoCryptoTraderServer).RemoveDataHistoryJob(ctx, req.(*RemoveDataHistoryJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCurrencyRequest)
+func _GoCryptoTrader_GetOrderbookDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookDepthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOrderbookDepth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbookDepth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCurrencyRequest))
+		return srv.(GoCryptoTraderServer).GetOrderbookDepth(ctx, req.(*GetOrderbookDepthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCurrencyRequest)
+func _GoCryptoTrader_GetConsolidatedOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConsolidatedOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetConsolidatedOrderbookStream(m, &goCryptoTraderGetConsolidatedOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetConsolidatedOrderbookStreamServer interface {
+	Send(*ConsolidatedOrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetConsolidatedOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetConsolidatedOrderbookStreamServer) Send(m *ConsolidatedOrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArbitrageOpportunitiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawCurrencyRequest))
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, req.(*GetArbitrageOpportunitiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoggerDetailsRequest)
+func _GoCryptoTrader_GetDispatchStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDispatchStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDispatchStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDispatchStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).GetDispatchStats(ctx, req.(*GetDispatchStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLoggerDetailsRequest)
+func _GoCryptoTrader_GetOrderReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderReconciliationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOrderReconciliation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderReconciliation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).GetOrderReconciliation(ctx, req.(*GetOrderReconciliationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangePairsRequest)
+func _GoCryptoTrader_AddConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConditionalOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServer).AddConditionalOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddConditionalOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
+		return srv.(GoCryptoTraderServer).AddConditionalOrder(ctx, req.(*AddConditionalOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangePairRequest)
+func _GoCryptoTrader_CancelConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelConditionalOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelConditionalOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelConditionalOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, req.(*ExchangePairRequest))
+		return srv.(GoCryptoTraderServer).CancelConditionalOrder(ctx, req.(*CancelConditionalOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangePairRequest)
+func _GoCryptoTrader_GetConditionalOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConditionalOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).GetConditionalOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConditionalOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, req.(*ExchangePairRequest))
+		return srv.(GoCryptoTraderServer).GetConditionalOrders(ctx, req.(*GetConditionalOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoCryptoTrader_AddOCOOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOCOOrderGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddOCOOrderGroup(ctx, in)
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddOCOOrderGroup",
 	}
-	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddOCOOrderGroup(ctx, req.(*AddOCOOrderGroupRequest))
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuditEventRequest)
+func _GoCryptoTrader_AddBracketOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBracketOrderGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).AddBracketOrderGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddBracketOrderGroup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
+		return srv.(GoCryptoTraderServer).AddBracketOrderGroup(ctx, req.(*AddBracketOrderGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptExecuteRequest)
+func _GoCryptoTrader_CancelOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelOrderGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrderGroup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
+		return srv.(GoCryptoTraderServer).CancelOrderGroup(ctx, req.(*CancelOrderGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptUploadRequest)
+func _GoCryptoTrader_GetOrderGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderGroupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOrderGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderGroups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
+		return srv.(GoCryptoTraderServer).GetOrderGroups(ctx, req.(*GetOrderGroupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptReadScriptRequest)
+func _GoCryptoTrader_AddIcebergOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddIcebergOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
+		return srv.(GoCryptoTraderServer).AddIcebergOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddIcebergOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
+		return srv.(GoCryptoTraderServer).AddIcebergOrder(ctx, req.(*AddIcebergOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStatusRequest)
+func _GoCryptoTrader_CancelIcebergOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIcebergOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelIcebergOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelIcebergOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
+		return srv.(GoCryptoTraderServer).CancelIcebergOrder(ctx, req.(*CancelIcebergOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptQueryRequest)
+func _GoCryptoTrader_GetIcebergOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIcebergOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
+		return srv.(GoCryptoTraderServer).GetIcebergOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetIcebergOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
+		return srv.(GoCryptoTraderServer).GetIcebergOrders(ctx, req.(*GetIcebergOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopRequest)
+func _GoCryptoTrader_AddExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExecutionOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
+		return srv.(GoCryptoTraderServer).AddExecutionOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddExecutionOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
+		return srv.(GoCryptoTraderServer).AddExecutionOrder(ctx, req.(*AddExecutionOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopAllRequest)
+func _GoCryptoTrader_PauseExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
+		return srv.(GoCryptoTraderServer).PauseExecutionOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/PauseExecutionOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
+		return srv.(GoCryptoTraderServer).PauseExecutionOrder(ctx, req.(*ExecutionOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptListAllRequest)
+func _GoCryptoTrader_ResumeExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
+		return srv.(GoCryptoTraderServer).ResumeExecutionOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/ResumeExecutionOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
+		return srv.(GoCryptoTraderServer).ResumeExecutionOrder(ctx, req.(*ExecutionOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptAutoLoadRequest)
+func _GoCryptoTrader_CancelExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelExecutionOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelExecutionOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
+		return srv.(GoCryptoTraderServer).CancelExecutionOrder(ctx, req.(*ExecutionOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHistoricCandlesRequest)
+func _GoCryptoTrader_GetExecutionOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExecutionOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExecutionOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
+		return srv.(GoCryptoTraderServer).GetExecutionOrders(ctx, req.(*GetExecutionOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -8484,6 +14387,10 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitOrder",
 			Handler:    _GoCryptoTrader_SubmitOrder_Handler,
 		},
+		{
+			MethodName: "SubmitOrders",
+			Handler:    _GoCryptoTrader_SubmitOrders_Handler,
+		},
 		{
 			MethodName: "SimulateOrder",
 			Handler:    _GoCryptoTrader_SimulateOrder_Handler,
@@ -8496,10 +14403,34 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _GoCryptoTrader_CancelOrder_Handler,
 		},
+		{
+			MethodName: "CancelOrders",
+			Handler:    _GoCryptoTrader_CancelOrders_Handler,
+		},
+		{
+			MethodName: "ModifyOrder",
+			Handler:    _GoCryptoTrader_ModifyOrder_Handler,
+		},
 		{
 			MethodName: "CancelAllOrders",
 			Handler:    _GoCryptoTrader_CancelAllOrders_Handler,
 		},
+		{
+			MethodName: "ActivateKillSwitch",
+			Handler:    _GoCryptoTrader_ActivateKillSwitch_Handler,
+		},
+		{
+			MethodName: "ResetKillSwitch",
+			Handler:    _GoCryptoTrader_ResetKillSwitch_Handler,
+		},
+		{
+			MethodName: "GetKillSwitchStatus",
+			Handler:    _GoCryptoTrader_GetKillSwitchStatus_Handler,
+		},
+		{
+			MethodName: "GetTradingRules",
+			Handler:    _GoCryptoTrader_GetTradingRules_Handler,
+		},
 		{
 			MethodName: "GetEvents",
 			Handler:    _GoCryptoTrader_GetEvents_Handler,
@@ -8592,6 +14523,98 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetHistoricCandles",
 			Handler:    _GoCryptoTrader_GetHistoricCandles_Handler,
 		},
+		{
+			MethodName: "AddDataHistoryJob",
+			Handler:    _GoCryptoTrader_AddDataHistoryJob_Handler,
+		},
+		{
+			MethodName: "GetDataHistoryJob",
+			Handler:    _GoCryptoTrader_GetDataHistoryJob_Handler,
+		},
+		{
+			MethodName: "GetDataHistoryJobs",
+			Handler:    _GoCryptoTrader_GetDataHistoryJobs_Handler,
+		},
+		{
+			MethodName: "RemoveDataHistoryJob",
+			Handler:    _GoCryptoTrader_RemoveDataHistoryJob_Handler,
+		},
+		{
+			MethodName: "GetOrderbookDepth",
+			Handler:    _GoCryptoTrader_GetOrderbookDepth_Handler,
+		},
+		{
+			MethodName: "GetArbitrageOpportunities",
+			Handler:    _GoCryptoTrader_GetArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "GetDispatchStats",
+			Handler:    _GoCryptoTrader_GetDispatchStats_Handler,
+		},
+		{
+			MethodName: "GetOrderReconciliation",
+			Handler:    _GoCryptoTrader_GetOrderReconciliation_Handler,
+		},
+		{
+			MethodName: "AddConditionalOrder",
+			Handler:    _GoCryptoTrader_AddConditionalOrder_Handler,
+		},
+		{
+			MethodName: "CancelConditionalOrder",
+			Handler:    _GoCryptoTrader_CancelConditionalOrder_Handler,
+		},
+		{
+			MethodName: "GetConditionalOrders",
+			Handler:    _GoCryptoTrader_GetConditionalOrders_Handler,
+		},
+		{
+			MethodName: "AddOCOOrderGroup",
+			Handler:    _GoCryptoTrader_AddOCOOrderGroup_Handler,
+		},
+		{
+			MethodName: "AddBracketOrderGroup",
+			Handler:    _GoCryptoTrader_AddBracketOrderGroup_Handler,
+		},
+		{
+			MethodName: "CancelOrderGroup",
+			Handler:    _GoCryptoTrader_CancelOrderGroup_Handler,
+		},
+		{
+			MethodName: "GetOrderGroups",
+			Handler:    _GoCryptoTrader_GetOrderGroups_Handler,
+		},
+		{
+			MethodName: "AddIcebergOrder",
+			Handler:    _GoCryptoTrader_AddIcebergOrder_Handler,
+		},
+		{
+			MethodName: "CancelIcebergOrder",
+			Handler:    _GoCryptoTrader_CancelIcebergOrder_Handler,
+		},
+		{
+			MethodName: "GetIcebergOrders",
+			Handler:    _GoCryptoTrader_GetIcebergOrders_Handler,
+		},
+		{
+			MethodName: "AddExecutionOrder",
+			Handler:    _GoCryptoTrader_AddExecutionOrder_Handler,
+		},
+		{
+			MethodName: "PauseExecutionOrder",
+			Handler:    _GoCryptoTrader_PauseExecutionOrder_Handler,
+		},
+		{
+			MethodName: "ResumeExecutionOrder",
+			Handler:    _GoCryptoTrader_ResumeExecutionOrder_Handler,
+		},
+		{
+			MethodName: "CancelExecutionOrder",
+			Handler:    _GoCryptoTrader_CancelExecutionOrder_Handler,
+		},
+		{
+			MethodName: "GetExecutionOrders",
+			Handler:    _GoCryptoTrader_GetExecutionOrders_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -8619,6 +14642,11 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetExchangeTickerStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetConsolidatedOrderbookStream",
+			Handler:       _GoCryptoTrader_GetConsolidatedOrderbookStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
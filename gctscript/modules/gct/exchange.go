@@ -22,6 +22,7 @@ var exchangeModule = map[string]objects.Object{
 	"orderquery":     &objects.UserFunction{Name: "orderquery", Value: ExchangeOrderQuery},
 	"ordercancel":    &objects.UserFunction{Name: "ordercancel", Value: ExchangeOrderCancel},
 	"ordersubmit":    &objects.UserFunction{Name: "ordersubmit", Value: ExchangeOrderSubmit},
+	"ordermodify":    &objects.UserFunction{Name: "ordermodify", Value: ExchangeOrderModify},
 	"withdrawcrypto": &objects.UserFunction{Name: "withdrawcrypto", Value: ExchangeWithdrawCrypto},
 	"withdrawfiat":   &objects.UserFunction{Name: "withdrawfiat", Value: ExchangeWithdrawFiat},
 }
@@ -375,6 +376,66 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	}, nil
 }
 
+// ExchangeOrderModify amends the price or amount of an order on exchange, a
+// zero price or amount leaves it unchanged and an empty pair or side is looked
+// up from the exchange
+func ExchangeOrderModify(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	orderID, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderID)
+	}
+	orderSide, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderSide)
+	}
+	orderPrice, ok := objects.ToFloat64(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderPrice)
+	}
+	orderAmount, ok := objects.ToFloat64(args[6])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, orderAmount)
+	}
+
+	tempModify := &order.Modify{
+		OrderID: orderID,
+		Side:    order.Side(orderSide),
+		Price:   orderPrice,
+		Amount:  orderAmount,
+	}
+	if currencyPair != "" {
+		tempModify.CurrencyPair = currency.NewPairDelimiter(currencyPair, delimiter)
+	}
+
+	err := tempModify.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	rtn, err := wrappers.GetWrapper().ModifyOrder(exchangeName, tempModify)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objects.String{Value: rtn}, nil
+}
+
 // ExchangeDepositAddress returns deposit address (if supported by exchange)
 func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 2 {
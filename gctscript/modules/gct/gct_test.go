@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 )
@@ -210,6 +211,35 @@ func TestExchangeOrderSubmit(t *testing.T) {
 	}
 }
 
+func TestExchangeOrderModify(t *testing.T) {
+	_, err := ExchangeOrderModify()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	orderSide := &objects.String{Value: "BUY"}
+	orderPrice := &objects.Float{Value: 1}
+	orderAmount := &objects.Float{Value: 0}
+
+	_, err = ExchangeOrderModify(exch, currencyPair, delimiter,
+		orderID, orderSide, orderPrice, orderAmount)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ExchangeOrderModify(exch, currencyPair, delimiter,
+		orderID, orderSide, orderAmount, orderAmount)
+	if !errors.Is(err, order.ErrModifyIsEmpty) {
+		t.Fatal(err)
+	}
+
+	_, err = ExchangeOrderModify(exchError, currencyPair, delimiter,
+		orderID, orderSide, orderPrice, orderAmount)
+	if err != nil && !errors.Is(err, errTestFailed) {
+		t.Fatal(err)
+	}
+}
+
 func TestAllModuleNames(t *testing.T) {
 	x := AllModuleNames()
 	xType := reflect.TypeOf(x).Kind()
@@ -118,6 +118,40 @@ func (e Exchange) CancelOrder(exch, orderID string) (bool, error) {
 	return true, nil
 }
 
+// ModifyOrder wrapper to amend the price or amount of an order on exchange,
+// the order pair and side are looked up when not supplied. Orders held by the
+// order manager are looked up there as not every exchange can query an order.
+func (e Exchange) ModifyOrder(exch string, mod *order.Modify) (string, error) {
+	err := mod.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	if mod.CurrencyPair.IsEmpty() || mod.Side == "" {
+		var orderDetails order.Detail
+		orderDetails, err = engine.Bot.OrderManager.GetOrder(exch, mod.OrderID)
+		if err != nil {
+			var queried *order.Detail
+			queried, err = e.QueryOrder(exch, mod.OrderID)
+			if err != nil {
+				return "", err
+			}
+			orderDetails = *queried
+		}
+		if mod.CurrencyPair.IsEmpty() {
+			mod.CurrencyPair = orderDetails.CurrencyPair
+		}
+		if mod.Side == "" {
+			mod.Side = orderDetails.OrderSide
+		}
+		if mod.Type == "" {
+			mod.Type = orderDetails.OrderType
+		}
+	}
+
+	return engine.Bot.OrderManager.Modify(exch, mod)
+}
+
 // AccountInformation returns account information (balance etc) for requested exchange
 func (e Exchange) AccountInformation(exch string) (account.Holdings, error) {
 	ex, err := e.GetExchange(exch)
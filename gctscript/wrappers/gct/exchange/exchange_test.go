@@ -167,6 +167,22 @@ func TestExchange_CancelOrder(t *testing.T) {
 	}
 }
 
+func TestExchange_ModifyOrder(t *testing.T) {
+	if !configureExchangeKeys() {
+		t.Skip("no exchange configured test skipped")
+	}
+	_, err := exchangeTest.ModifyOrder(exchName, &order.Modify{
+		OrderID:      orderID,
+		CurrencyPair: currency.NewPairDelimiter(pairs, delimiter),
+		Side:         orderSide,
+		Price:        orderPrice,
+		Amount:       orderAmount,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func setupEngine() (err error) {
 	engine.Bot, err = engine.NewFromSettings(&settings)
 	if err != nil {
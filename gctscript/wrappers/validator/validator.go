@@ -151,6 +151,14 @@ func (w Wrapper) CancelOrder(exch, orderid string) (bool, error) {
 	return orderid != "false", nil
 }
 
+// ModifyOrder validator for test execution/scripts
+func (w Wrapper) ModifyOrder(exch string, mod *order.Modify) (string, error) {
+	if exch == exchError.String() {
+		return "", errTestFailed
+	}
+	return mod.OrderID, nil
+}
+
 // AccountInformation validator for test execution/scripts
 func (w Wrapper) AccountInformation(exch string) (account.Holdings, error) {
 	if exch == exchError.String() {
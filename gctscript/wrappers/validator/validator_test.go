@@ -85,6 +85,23 @@ func TestWrapper_CancelOrder(t *testing.T) {
 	}
 }
 
+func TestWrapper_ModifyOrder(t *testing.T) {
+	t.Parallel()
+
+	id, err := testWrapper.ModifyOrder(exchName, &order.Modify{OrderID: orderID, Price: orderPrice})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != orderID {
+		t.Fatalf("expected order ID %v received %v", orderID, id)
+	}
+
+	_, err = testWrapper.ModifyOrder(exchError.String(), nil)
+	if err == nil {
+		t.Fatal("expected ModifyOrder to return error on invalid name")
+	}
+}
+
 func TestWrapper_DepositAddress(t *testing.T) {
 	_, err := testWrapper.DepositAddress(exchError.String(), currency.NewCode("BTC"))
 	if err == nil {
@@ -45,10 +45,19 @@ func main() {
 	flag.BoolVar(&settings.EnableCoinmarketcapAnalysis, "coinmarketcap", false, "overrides config and runs currency analysis")
 	flag.BoolVar(&settings.EnableEventManager, "eventmanager", true, "enables the event manager")
 	flag.BoolVar(&settings.EnableOrderManager, "ordermanager", true, "enables the order manager")
+	flag.BoolVar(&settings.EnableConditionalOrderManager, "conditionalordermanager", true, "enables the engine managed stop-loss, take-profit and trailing stop order manager")
+	flag.BoolVar(&settings.EnableExecutionManager, "executionmanager", true, "enables the TWAP and VWAP order execution manager")
+	flag.DurationVar(&settings.OrderReconciliationInterval, "orderreconciliationinterval", engine.DefaultOrderReconciliationInterval, "the amount of time between reconciling stored orders with exchange active orders and order history, 0 disables periodic reconciliation")
+	flag.BoolVar(&settings.CancelOrdersOnShutdown, "cancelordersonshutdown", false, "cancels the open orders of every authenticated exchange when the order manager shuts down")
+	flag.BoolVar(&settings.EnableDeadMansSwitch, "deadmansswitch", false, "arms exchange dead man's switches so open orders are cancelled if the bot stops sending heartbeats, WARNING: exchanges without one only have their open orders cancelled on a graceful shutdown and are not protected if the bot crashes or is killed")
+	flag.DurationVar(&settings.DeadMansSwitchTimeout, "deadmansswitchtimeout", engine.DefaultDeadMansSwitchTimeout, "the amount of time an exchange dead man's switch waits for a heartbeat before cancelling all open orders")
 	flag.BoolVar(&settings.EnableDepositAddressManager, "depositaddressmanager", true, "enables the deposit address manager")
 	flag.BoolVar(&settings.EnableConnectivityMonitor, "connectivitymonitor", true, "enables the connectivity monitor")
 	flag.BoolVar(&settings.EnableDatabaseManager, "databasemanager", true, "enables database manager")
 	flag.BoolVar(&settings.EnableGCTScriptManager, "gctscriptmanager", true, "enables gctscript manager")
+	flag.BoolVar(&settings.EnableDataHistoryManager, "datahistorymanager", true, "enables the data history manager")
+	flag.BoolVar(&settings.EnableArbitrageMonitor, "arbitragemonitor", true, "enables the cross exchange arbitrage monitor")
+	flag.BoolVar(&settings.EnableTradePersistence, "tradepersistence", false, "enables writing websocket trade prints to the database")
 	flag.DurationVar(&settings.EventManagerDelay, "eventmanagerdelay", time.Duration(0), "sets the event managers sleep delay between event checking")
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
@@ -64,6 +73,19 @@ func main() {
 	flag.DurationVar(&settings.SyncTimeout, "synctimeout", engine.DefaultSyncerTimeout,
 		"the amount of time before the syncer will switch from one protocol to the other (e.g. from REST to websocket)")
 
+	// Trade persistence settings
+	flag.IntVar(&settings.TradePersistenceBatchSize, "tradepersistencebatchsize", engine.DefaultTradePersistenceBatchSize, "the amount of trades buffered before they are written to the database")
+	flag.DurationVar(&settings.TradePersistenceFlushInterval, "tradepersistenceflushinterval", engine.DefaultTradePersistenceFlushInterval, "the maximum amount of time buffered trades are held before they are written to the database")
+
+	// Candle builder settings
+	flag.BoolVar(&settings.EnableCandleBuilder, "candlebuilder", false, "enables building candles from websocket trade prints")
+	flag.StringVar(&settings.CandleBuilderIntervals, "candlebuilderintervals", engine.DefaultCandleBuilderIntervals, "comma delimited list of intervals to build candles for e.g. 1m,5m,1h")
+	flag.BoolVar(&settings.CandleBuilderPersist, "candlebuilderpersist", false, "writes candles built from websocket trade prints to the database")
+
+	// Orderbook recorder settings
+	flag.BoolVar(&settings.EnableOrderbookRecorder, "orderbookrecorder", false, "records websocket orderbook snapshots and updates for replay")
+	flag.StringVar(&settings.OrderbookRecorderDir, "orderbookrecorderdir", "", "the directory orderbook recordings are written to, defaults to <datadir>/orderbooks")
+
 	// Forex provider settings
 	flag.BoolVar(&settings.EnableCurrencyConverter, "currencyconverter", false, "overrides config and sets up foreign exchange Currency Converter")
 	flag.BoolVar(&settings.EnableCurrencyLayer, "currencylayer", false, "overrides config and sets up foreign exchange Currency Layer")